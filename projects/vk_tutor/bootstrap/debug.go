@@ -0,0 +1,65 @@
+package bootstrap
+
+import (
+	"log"
+	"strings"
+
+	"example.com/vk_tutor/vulkan"
+)
+
+// Messages passed to the debug messenger by RequestValidation.
+const (
+	DefaultSeverity = vulkan.VkDebugUtilsMessageSeverityFlagsEXT(vulkan.VK_DEBUG_UTILS_MESSAGE_SEVERITY_WARNING_BIT_EXT |
+		vulkan.VK_DEBUG_UTILS_MESSAGE_SEVERITY_ERROR_BIT_EXT)
+
+	DefaultMessageType = vulkan.VkDebugUtilsMessageTypeFlagsEXT(vulkan.VK_DEBUG_UTILS_MESSAGE_TYPE_GENERAL_BIT_EXT |
+		vulkan.VK_DEBUG_UTILS_MESSAGE_TYPE_VALIDATION_BIT_EXT |
+		vulkan.VK_DEBUG_UTILS_MESSAGE_TYPE_PERFORMANCE_BIT_EXT)
+)
+
+// LogMessage is the default debug messenger callback, it prints the message
+// with the standard logger and never aborts the call.
+func LogMessage(
+	severity vulkan.VkDebugUtilsMessageSeverityFlagBitsEXT,
+	types vulkan.VkDebugUtilsMessageTypeFlagsEXT,
+	data *vulkan.VkDebugUtilsMessengerCallbackDataEXT,
+) bool {
+	log.Printf("vulkan %v [%v] %v", SeverityName(severity), MessageTypeNames(types), data.PMessage)
+	return false
+}
+
+// SeverityName returns "verbose", "info", "warning" or "error".
+func SeverityName(severity vulkan.VkDebugUtilsMessageSeverityFlagBitsEXT) string {
+
+	switch {
+	case 0 != severity&vulkan.VK_DEBUG_UTILS_MESSAGE_SEVERITY_ERROR_BIT_EXT:
+		return "error"
+	case 0 != severity&vulkan.VK_DEBUG_UTILS_MESSAGE_SEVERITY_WARNING_BIT_EXT:
+		return "warning"
+	case 0 != severity&vulkan.VK_DEBUG_UTILS_MESSAGE_SEVERITY_INFO_BIT_EXT:
+		return "info"
+	} // switch
+
+	return "verbose"
+}
+
+// MessageTypeNames joins the names of the message type bits with "+".
+func MessageTypeNames(types vulkan.VkDebugUtilsMessageTypeFlagsEXT) string {
+
+	var r []string
+
+	if 0 != types&vulkan.VkDebugUtilsMessageTypeFlagsEXT(vulkan.VK_DEBUG_UTILS_MESSAGE_TYPE_GENERAL_BIT_EXT) {
+		r = append(r, "general")
+	}
+	if 0 != types&vulkan.VkDebugUtilsMessageTypeFlagsEXT(vulkan.VK_DEBUG_UTILS_MESSAGE_TYPE_VALIDATION_BIT_EXT) {
+		r = append(r, "validation")
+	}
+	if 0 != types&vulkan.VkDebugUtilsMessageTypeFlagsEXT(vulkan.VK_DEBUG_UTILS_MESSAGE_TYPE_PERFORMANCE_BIT_EXT) {
+		r = append(r, "performance")
+	}
+	if 0 != types&vulkan.VkDebugUtilsMessageTypeFlagsEXT(vulkan.VK_DEBUG_UTILS_MESSAGE_TYPE_DEVICE_ADDRESS_BINDING_BIT_EXT) {
+		r = append(r, "device address")
+	}
+
+	return strings.Join(r, "+")
+}
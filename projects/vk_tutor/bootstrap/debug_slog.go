@@ -0,0 +1,57 @@
+//go:build go1.21
+
+// Built only by toolchains that have log/slog, the module declares Go 1.20.
+
+package bootstrap
+
+import (
+	"context"
+	"log/slog"
+
+	"example.com/vk_tutor/vulkan"
+)
+
+// SlogMessenger returns a debug messenger callback logging the messages to
+// logger, slog.Default() for nil, at SlogLevel of the severity with the
+// attributes "type", e.g. type=validation, "message_id" and
+// "message_id_number". Like LogMessage it never aborts the call:
+//
+//	builder.RequestLayers(bootstrap.ValidationLayer).
+//		DebugMessenger(bootstrap.DefaultSeverity, bootstrap.DefaultMessageType, bootstrap.SlogMessenger(nil))
+func SlogMessenger(logger *slog.Logger) vulkan.PFN_vkDebugUtilsMessengerCallbackEXT {
+
+	return func(
+		severity vulkan.VkDebugUtilsMessageSeverityFlagBitsEXT,
+		types vulkan.VkDebugUtilsMessageTypeFlagsEXT,
+		data *vulkan.VkDebugUtilsMessengerCallbackDataEXT,
+	) bool {
+
+		var l = logger
+		if nil == l {
+			l = slog.Default()
+		}
+
+		l.LogAttrs(context.Background(), SlogLevel(severity), data.PMessage,
+			slog.String("type", MessageTypeNames(types)),
+			slog.String("message_id", data.PMessageIdName),
+			slog.Int("message_id_number", int(data.MessageIdNumber)))
+
+		return false
+	}
+}
+
+// SlogLevel maps the highest severity bit to a level: error, warning and
+// info to the levels of the same name, verbose to slog.LevelDebug.
+func SlogLevel(severity vulkan.VkDebugUtilsMessageSeverityFlagBitsEXT) slog.Level {
+
+	switch SeverityName(severity) {
+	case "error":
+		return slog.LevelError
+	case "warning":
+		return slog.LevelWarn
+	case "info":
+		return slog.LevelInfo
+	} // switch
+
+	return slog.LevelDebug
+}
@@ -0,0 +1,61 @@
+//go:build go1.21
+
+package bootstrap
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"example.com/vk_tutor/vulkan"
+)
+
+func TestSlogMessenger(t *testing.T) {
+
+	var b bytes.Buffer
+	var logger = slog.New(slog.NewTextHandler(&b, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if slog.TimeKey == a.Key {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	var callback = SlogMessenger(logger)
+
+	var abort = callback(vulkan.VK_DEBUG_UTILS_MESSAGE_SEVERITY_WARNING_BIT_EXT,
+		vulkan.VkDebugUtilsMessageTypeFlagsEXT(vulkan.VK_DEBUG_UTILS_MESSAGE_TYPE_VALIDATION_BIT_EXT),
+		&vulkan.VkDebugUtilsMessengerCallbackDataEXT{
+			PMessageIdName:  "VUID-vkDestroyDevice-device-00378",
+			MessageIdNumber: 1901072314,
+			PMessage:        "objects not destroyed",
+		})
+
+	if abort {
+		t.Error("callback aborts the call")
+	}
+
+	var want = `level=WARN msg="objects not destroyed" type=validation message_id=VUID-vkDestroyDevice-device-00378 message_id_number=1901072314` + "\n"
+	if want != b.String() {
+		t.Errorf("logged %q, want %q", b.String(), want)
+	}
+}
+
+func TestSlogLevel(t *testing.T) {
+
+	for _, c := range []struct {
+		severity vulkan.VkDebugUtilsMessageSeverityFlagBitsEXT
+		want     slog.Level
+	}{
+		{vulkan.VK_DEBUG_UTILS_MESSAGE_SEVERITY_VERBOSE_BIT_EXT, slog.LevelDebug},
+		{vulkan.VK_DEBUG_UTILS_MESSAGE_SEVERITY_INFO_BIT_EXT, slog.LevelInfo},
+		{vulkan.VK_DEBUG_UTILS_MESSAGE_SEVERITY_WARNING_BIT_EXT, slog.LevelWarn},
+		{vulkan.VK_DEBUG_UTILS_MESSAGE_SEVERITY_ERROR_BIT_EXT, slog.LevelError},
+	} {
+		if got := SlogLevel(c.severity); c.want != got {
+			t.Errorf("SlogLevel(%v) = %v, want %v", c.severity, got, c.want)
+		}
+	} // for
+}
@@ -0,0 +1,255 @@
+package bootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	"example.com/vk_tutor/selector"
+	"example.com/vk_tutor/vulkan"
+)
+
+// DeviceBuilder collects the parameters of a VkDevice, see NewDeviceBuilder.
+type DeviceBuilder struct {
+	physical   *selector.Device
+	queues     map[int][]float32 // Family to priorities
+	families   []int             // In request order
+	extensions []request
+	required   vulkan.FeatureSet
+	requested  vulkan.FeatureSet
+	plans      []*QueuePlan
+	apiVersion uint32
+}
+
+// NewDeviceBuilder returns a builder for a logical device of physical,
+// usually the device picked by selector.Select.
+func NewDeviceBuilder(physical *selector.Device) *DeviceBuilder {
+	return &DeviceBuilder{
+		physical: physical,
+		queues:   make(map[int][]float32),
+	}
+}
+
+// Queues requests one queue of family per priority, a single queue of
+// priority 1 without priorities. Requesting a family again raises its queue
+// count to the larger of both requests, so asking for the graphics and the
+// present family creates one queue when they are the same.
+func (o *DeviceBuilder) Queues(family int, priorities ...float32) *DeviceBuilder {
+
+	if 0 == len(priorities) {
+		priorities = []float32{1}
+	}
+
+	var old, ok = o.queues[family]
+	if !ok {
+		o.families = append(o.families, family)
+	}
+
+	if len(priorities) > len(old) {
+		o.queues[family] = append([]float32(nil), priorities...)
+	}
+
+	return o
+}
+
+// QueuePlan requests the queues of every family of p, the queues of its
+// roles are then available through Device.Role.
+func (o *DeviceBuilder) QueuePlan(p *QueuePlan) *DeviceBuilder {
+
+	for _, ci := range p.CreateInfos() {
+		o.Queues(ci.QueueFamilyIndex, ci.PQueuePriorities...)
+	}
+	o.plans = append(o.plans, p)
+
+	return o
+}
+
+// APIVersion sets the version the device is used with, usually the one of
+// the instance. Extensions promoted to it are not enabled.
+func (o *DeviceBuilder) APIVersion(version uint32) *DeviceBuilder {
+	o.apiVersion = version
+	return o
+}
+
+// RequireExtensions adds extensions that must be supported, with their
+// dependencies.
+func (o *DeviceBuilder) RequireExtensions(names ...string) *DeviceBuilder {
+	o.extensions = addRequests(o.extensions, names, true)
+	return o
+}
+
+// RequestExtensions adds extensions that are enabled only if supported.
+func (o *DeviceBuilder) RequestExtensions(names ...string) *DeviceBuilder {
+	o.extensions = addRequests(o.extensions, names, false)
+	return o
+}
+
+// RequireFeatures adds features to the ones that must be supported.
+func (o *DeviceBuilder) RequireFeatures(features vulkan.FeatureSet) *DeviceBuilder {
+	o.required = o.required.Union(features)
+	return o
+}
+
+// RequestFeatures adds features to the ones enabled only if supported.
+func (o *DeviceBuilder) RequestFeatures(features vulkan.FeatureSet) *DeviceBuilder {
+	o.requested = o.requested.Union(features)
+	return o
+}
+
+// Device is a created VkDevice with what was enabled on it.
+type Device struct {
+	Handle       vulkan.VkDevice
+	Physical     *selector.Device
+	Extensions   []string
+	Features     vulkan.VkPhysicalDeviceFeatures
+	Optional     []Optional
+	Requirements *RequirementReport
+	Roles        map[string][]vulkan.VkQueue // Queues of the planned roles
+
+	queues map[int][]vulkan.VkQueue
+}
+
+// Queue returns queue index of family. It panics if the queue was not
+// requested.
+func (o *Device) Queue(family, index int) vulkan.VkQueue {
+
+	var a = o.queues[family]
+	if index >= len(a) {
+		panic(fmt.Sprintf("bootstrap: queue %v of family %v not created", index, family))
+	}
+
+	return a[index]
+}
+
+// Role returns the first queue of role. It panics if the role was not
+// planned.
+func (o *Device) Role(role string) vulkan.VkQueue {
+
+	var a = o.Roles[role]
+	if 0 == len(a) {
+		panic(fmt.Sprintf("bootstrap: no queue for role %q", role))
+	}
+
+	return a[0]
+}
+
+// QueueCount returns the number of queues created in family.
+func (o *Device) QueueCount(family int) int {
+	return len(o.queues[family])
+}
+
+// Enabled reports whether the extension name is enabled.
+func (o *Device) Enabled(name string) bool {
+	return contains(o.Extensions, name)
+}
+
+// Destroy destroys the device.
+func (o *Device) Destroy() {
+	vulkan.VkDestroyDevice(o.Handle, nil)
+}
+
+// Build checks the queues, extensions and features against the physical
+// device, see CheckDevice, and creates the device. The error lists all missing required items
+// at once.
+func (o *DeviceBuilder) Build() (*Device, error) {
+
+	var pd = o.physical
+	var missing []string
+
+	var r = &Device{
+		Physical: pd,
+		queues:   make(map[int][]vulkan.VkQueue),
+		Roles:    make(map[string][]vulkan.VkQueue),
+	}
+
+	// Queues -----
+
+	if 0 == len(o.families) {
+		missing = append(missing, "no queue requested")
+	}
+
+	var queue_infos []vulkan.VkDeviceQueueCreateInfo
+
+	for _, family := range o.families {
+
+		var prios = o.queues[family]
+
+		switch {
+		case family < 0 || family >= len(pd.QueueFamilies):
+			missing = append(missing, fmt.Sprintf("queue family %v", family))
+			continue
+		case len(prios) > int(pd.QueueFamilies[family].QueueCount):
+			missing = append(missing, fmt.Sprintf("%v queues in family %v (has %v)", len(prios), family, pd.QueueFamilies[family].QueueCount))
+			continue
+		} // switch
+
+		queue_infos = append(queue_infos, vulkan.VkDeviceQueueCreateInfo{
+			QueueFamilyIndex: family,
+			QueueCount:       len(prios),
+			PQueuePriorities: prios,
+		})
+	} // for
+
+	// Extensions and features -----
+
+	var req = DeviceRequirements{
+		APIVersion:       o.apiVersion,
+		Features:         o.required,
+		OptionalFeatures: o.requested,
+	}
+
+	for _, e := range o.extensions {
+		if e.required {
+			req.Extensions = append(req.Extensions, e.name)
+		} else {
+			req.OptionalExtensions = append(req.OptionalExtensions, e.name)
+		}
+	} // for
+
+	r.Requirements = CheckDevice(pd, &req)
+	r.Extensions = r.Requirements.Enable
+	r.Features = r.Requirements.Features.VkPhysicalDeviceFeatures()
+	r.Optional = r.Requirements.Optional
+
+	missing = append(missing, r.Requirements.Missing...)
+	for _, name := range r.Requirements.MissingFeatures {
+		missing = append(missing, "feature "+name)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing device requirements on %v: %v", pd, strings.Join(missing, ", "))
+	}
+
+	// Create -----
+
+	var create_info = vulkan.VkDeviceCreateInfo{
+		QueueCreateInfoCount:    len(queue_infos),
+		PQueueCreateInfos:       queue_infos,
+		EnabledExtensionCount:   len(r.Extensions),
+		PpEnabledExtensionNames: r.Extensions,
+		PEnabledFeatures:        &r.Features,
+	}
+
+	if res := vulkan.VkCreateDevice(pd.Handle, &create_info, nil, &r.Handle); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkCreateDevice failed: %v", res)
+	}
+
+	for _, qi := range queue_infos {
+		var a = make([]vulkan.VkQueue, qi.QueueCount)
+		for i := range a {
+			vulkan.VkGetDeviceQueue(r.Handle, qi.QueueFamilyIndex, i, &a[i])
+		}
+		r.queues[qi.QueueFamilyIndex] = a
+	} // for
+
+	for _, p := range o.plans {
+		for _, a := range p.Assignments {
+			var queues = make([]vulkan.VkQueue, len(a.Indices))
+			for i, idx := range a.Indices {
+				queues[i] = r.queues[a.Family][idx]
+			}
+			r.Roles[a.Role] = queues
+		}
+	} // for
+
+	return r, nil
+}
@@ -0,0 +1,18 @@
+// Instance and logical device creation
+//
+// InstanceBuilder and DeviceBuilder replace the boilerplate of filling
+// VkInstanceCreateInfo and VkDeviceCreateInfo by hand. Layers, extensions
+// and features are either required, failing Build with the full list of
+// what is missing, or requested, enabled only when available. The Optional
+// list of the result tells exactly which requested items were enabled.
+//
+// Device extensions are resolved against a table of their dependencies and
+// core promotions: dependencies are enabled first, and an extension
+// promoted to the API version in use is not enabled at all. CheckDevice
+// reports the outcome as a diff against the device.
+//
+// The API version is negotiated with vkEnumerateInstanceVersion, and a
+// debug messenger is created when VK_EXT_debug_utils is available. The same
+// messenger is chained into VkInstanceCreateInfo, so vkCreateInstance and
+// vkDestroyInstance are reported too.
+package bootstrap
@@ -0,0 +1,144 @@
+package bootstrap
+
+import "example.com/vk_tutor/vulkan"
+
+// Extension is what the registry says about a device extension.
+type Extension struct {
+	Name       string
+	Promoted   uint32   // Core version including it, 0 if never promoted
+	MinVersion uint32   // Lowest API version it may be used with, 0 for 1.0
+	Requires   []string // Device extensions it depends on
+	Instance   []string // Instance extensions it depends on
+}
+
+// LookupExtension returns the registry entry of a device extension.
+// Unknown extensions are reported without dependencies.
+func LookupExtension(name string) (Extension, bool) {
+
+	var e, ok = deviceExtensions[name]
+	e.Name = name
+
+	return e, ok
+}
+
+// Instance extensions the device extensions below depend on
+const (
+	instSurface     = "VK_KHR_surface"
+	instProperties2 = "VK_KHR_get_physical_device_properties2"
+	instDeviceGroup = "VK_KHR_device_group_creation"
+	instExtMemory   = "VK_KHR_external_memory_capabilities"
+	instExtSema     = "VK_KHR_external_semaphore_capabilities"
+	instExtFence    = "VK_KHR_external_fence_capabilities"
+)
+
+const (
+	v11 = vulkan.VK_API_VERSION_1_1
+	v12 = vulkan.VK_API_VERSION_1_2
+	v13 = vulkan.VK_API_VERSION_1_3
+)
+
+// Dependencies and promotions of the common device extensions, from the
+// Vulkan registry (vk.xml).
+var deviceExtensions = map[string]Extension{
+
+	// Promoted to Vulkan 1.1
+	"VK_KHR_16bit_storage":                {Promoted: v11, Requires: []string{"VK_KHR_storage_buffer_storage_class"}, Instance: []string{instProperties2}},
+	"VK_KHR_bind_memory2":                 {Promoted: v11},
+	"VK_KHR_dedicated_allocation":         {Promoted: v11, Requires: []string{"VK_KHR_get_memory_requirements2"}},
+	"VK_KHR_descriptor_update_template":   {Promoted: v11},
+	"VK_KHR_device_group":                 {Promoted: v11, Instance: []string{instDeviceGroup}},
+	"VK_KHR_external_fence":               {Promoted: v11, Instance: []string{instExtFence}},
+	"VK_KHR_external_memory":              {Promoted: v11, Instance: []string{instExtMemory}},
+	"VK_KHR_external_semaphore":           {Promoted: v11, Instance: []string{instExtSema}},
+	"VK_KHR_get_memory_requirements2":     {Promoted: v11},
+	"VK_KHR_maintenance1":                 {Promoted: v11},
+	"VK_KHR_maintenance2":                 {Promoted: v11},
+	"VK_KHR_maintenance3":                 {Promoted: v11, Instance: []string{instProperties2}},
+	"VK_KHR_multiview":                    {Promoted: v11, Instance: []string{instProperties2}},
+	"VK_KHR_relaxed_block_layout":         {Promoted: v11},
+	"VK_KHR_sampler_ycbcr_conversion":     {Promoted: v11, Requires: []string{"VK_KHR_maintenance1", "VK_KHR_bind_memory2", "VK_KHR_get_memory_requirements2"}, Instance: []string{instProperties2}},
+	"VK_KHR_shader_draw_parameters":       {Promoted: v11},
+	"VK_KHR_storage_buffer_storage_class": {Promoted: v11},
+	"VK_KHR_variable_pointers":            {Promoted: v11, Requires: []string{"VK_KHR_storage_buffer_storage_class"}, Instance: []string{instProperties2}},
+
+	// Promoted to Vulkan 1.2
+	"VK_KHR_8bit_storage":                   {Promoted: v12, Requires: []string{"VK_KHR_storage_buffer_storage_class"}, Instance: []string{instProperties2}},
+	"VK_KHR_buffer_device_address":          {Promoted: v12, Requires: []string{"VK_KHR_device_group"}, Instance: []string{instProperties2}},
+	"VK_KHR_create_renderpass2":             {Promoted: v12, Requires: []string{"VK_KHR_multiview", "VK_KHR_maintenance2"}},
+	"VK_KHR_depth_stencil_resolve":          {Promoted: v12, Requires: []string{"VK_KHR_create_renderpass2"}},
+	"VK_KHR_draw_indirect_count":            {Promoted: v12},
+	"VK_KHR_driver_properties":              {Promoted: v12, Instance: []string{instProperties2}},
+	"VK_KHR_image_format_list":              {Promoted: v12},
+	"VK_KHR_imageless_framebuffer":          {Promoted: v12, Requires: []string{"VK_KHR_maintenance2", "VK_KHR_image_format_list"}, Instance: []string{instProperties2}},
+	"VK_KHR_sampler_mirror_clamp_to_edge":   {Promoted: v12},
+	"VK_KHR_separate_depth_stencil_layouts": {Promoted: v12, Requires: []string{"VK_KHR_create_renderpass2"}, Instance: []string{instProperties2}},
+	"VK_KHR_shader_atomic_int64":            {Promoted: v12, Instance: []string{instProperties2}},
+	"VK_KHR_shader_float16_int8":            {Promoted: v12, Instance: []string{instProperties2}},
+	"VK_KHR_shader_float_controls":          {Promoted: v12, Instance: []string{instProperties2}},
+	"VK_KHR_shader_subgroup_extended_types": {Promoted: v12, MinVersion: v11},
+	"VK_KHR_spirv_1_4":                      {Promoted: v12, MinVersion: v11, Requires: []string{"VK_KHR_shader_float_controls"}},
+	"VK_KHR_timeline_semaphore":             {Promoted: v12, Instance: []string{instProperties2}},
+	"VK_KHR_uniform_buffer_standard_layout": {Promoted: v12, Instance: []string{instProperties2}},
+	"VK_KHR_vulkan_memory_model":            {Promoted: v12, Instance: []string{instProperties2}},
+	"VK_EXT_descriptor_indexing":            {Promoted: v12, Requires: []string{"VK_KHR_maintenance3"}, Instance: []string{instProperties2}},
+	"VK_EXT_host_query_reset":               {Promoted: v12, Instance: []string{instProperties2}},
+	"VK_EXT_sampler_filter_minmax":          {Promoted: v12, Instance: []string{instProperties2}},
+	"VK_EXT_scalar_block_layout":            {Promoted: v12, Instance: []string{instProperties2}},
+	"VK_EXT_separate_stencil_usage":         {Promoted: v12},
+	"VK_EXT_shader_viewport_index_layer":    {Promoted: v12},
+
+	// Promoted to Vulkan 1.3
+	"VK_KHR_copy_commands2":                     {Promoted: v13},
+	"VK_KHR_dynamic_rendering":                  {Promoted: v13, Requires: []string{"VK_KHR_depth_stencil_resolve"}, Instance: []string{instProperties2}},
+	"VK_KHR_format_feature_flags2":              {Promoted: v13},
+	"VK_KHR_maintenance4":                       {Promoted: v13, MinVersion: v11},
+	"VK_KHR_shader_integer_dot_product":         {Promoted: v13, Instance: []string{instProperties2}},
+	"VK_KHR_shader_non_semantic_info":           {Promoted: v13},
+	"VK_KHR_shader_terminate_invocation":        {Promoted: v13, Instance: []string{instProperties2}},
+	"VK_KHR_synchronization2":                   {Promoted: v13, Instance: []string{instProperties2}},
+	"VK_KHR_zero_initialize_workgroup_memory":   {Promoted: v13, Instance: []string{instProperties2}},
+	"VK_EXT_4444_formats":                       {Promoted: v13, Instance: []string{instProperties2}},
+	"VK_EXT_extended_dynamic_state":             {Promoted: v13, Instance: []string{instProperties2}},
+	"VK_EXT_extended_dynamic_state2":            {Promoted: v13, Instance: []string{instProperties2}},
+	"VK_EXT_image_robustness":                   {Promoted: v13, Instance: []string{instProperties2}},
+	"VK_EXT_inline_uniform_block":               {Promoted: v13, Requires: []string{"VK_KHR_maintenance1"}, Instance: []string{instProperties2}},
+	"VK_EXT_pipeline_creation_cache_control":    {Promoted: v13},
+	"VK_EXT_pipeline_creation_feedback":         {Promoted: v13},
+	"VK_EXT_private_data":                       {Promoted: v13, Instance: []string{instProperties2}},
+	"VK_EXT_shader_demote_to_helper_invocation": {Promoted: v13, Instance: []string{instProperties2}},
+	"VK_EXT_subgroup_size_control":              {Promoted: v13, MinVersion: v11},
+	"VK_EXT_texel_buffer_alignment":             {Promoted: v13, Instance: []string{instProperties2}},
+	"VK_EXT_texture_compression_astc_hdr":       {Promoted: v13, Instance: []string{instProperties2}},
+	"VK_EXT_tooling_info":                       {Promoted: v13},
+	"VK_EXT_ycbcr_2plane_444_formats":           {Promoted: v13, Requires: []string{"VK_KHR_sampler_ycbcr_conversion"}},
+
+	// Not promoted
+	vulkan.VK_KHR_SWAPCHAIN_EXTENSION_NAME:        {Instance: []string{instSurface}},
+	"VK_KHR_swapchain_mutable_format":             {Requires: []string{vulkan.VK_KHR_SWAPCHAIN_EXTENSION_NAME, "VK_KHR_maintenance2", "VK_KHR_image_format_list"}},
+	"VK_KHR_incremental_present":                  {Requires: []string{vulkan.VK_KHR_SWAPCHAIN_EXTENSION_NAME}},
+	"VK_KHR_present_id":                           {Requires: []string{vulkan.VK_KHR_SWAPCHAIN_EXTENSION_NAME}, Instance: []string{instProperties2}},
+	"VK_KHR_present_wait":                         {Requires: []string{vulkan.VK_KHR_SWAPCHAIN_EXTENSION_NAME, "VK_KHR_present_id"}},
+	"VK_KHR_push_descriptor":                      {Instance: []string{instProperties2}},
+	"VK_KHR_deferred_host_operations":             {},
+	"VK_KHR_pipeline_library":                     {},
+	"VK_KHR_acceleration_structure":               {MinVersion: v11, Requires: []string{"VK_EXT_descriptor_indexing", "VK_KHR_buffer_device_address", "VK_KHR_deferred_host_operations"}},
+	"VK_KHR_ray_tracing_pipeline":                 {Requires: []string{"VK_KHR_spirv_1_4", "VK_KHR_acceleration_structure"}},
+	"VK_KHR_ray_query":                            {Requires: []string{"VK_KHR_spirv_1_4", "VK_KHR_acceleration_structure"}},
+	"VK_KHR_fragment_shading_rate":                {Requires: []string{"VK_KHR_create_renderpass2"}, Instance: []string{instProperties2}},
+	"VK_KHR_portability_subset":                   {Instance: []string{instProperties2}},
+	"VK_KHR_maintenance5":                         {MinVersion: v11, Requires: []string{"VK_KHR_dynamic_rendering"}},
+	"VK_EXT_mesh_shader":                          {Requires: []string{"VK_KHR_spirv_1_4"}},
+	"VK_EXT_memory_budget":                        {Instance: []string{instProperties2}},
+	"VK_EXT_memory_priority":                      {Instance: []string{instProperties2}},
+	"VK_EXT_robustness2":                          {Instance: []string{instProperties2}},
+	"VK_EXT_conservative_rasterization":           {Instance: []string{instProperties2}},
+	"VK_EXT_descriptor_buffer":                    {Requires: []string{"VK_KHR_buffer_device_address", "VK_KHR_synchronization2", "VK_EXT_descriptor_indexing"}},
+	"VK_EXT_full_screen_exclusive":                {Requires: []string{vulkan.VK_KHR_SWAPCHAIN_EXTENSION_NAME}, Instance: []string{instProperties2, "VK_KHR_surface_capabilities2"}},
+	"VK_EXT_hdr_metadata":                         {Requires: []string{vulkan.VK_KHR_SWAPCHAIN_EXTENSION_NAME}},
+	"VK_EXT_calibrated_timestamps":                {},
+	"VK_EXT_shader_object":                        {Requires: []string{"VK_KHR_dynamic_rendering"}},
+	"VK_EXT_vertex_input_dynamic_state":           {Instance: []string{instProperties2}},
+	"VK_EXT_extended_dynamic_state3":              {Instance: []string{instProperties2}},
+	"VK_EXT_graphics_pipeline_library":            {Requires: []string{"VK_KHR_pipeline_library"}},
+	"VK_KHR_shader_subgroup_uniform_control_flow": {MinVersion: v11},
+}
@@ -0,0 +1,400 @@
+package bootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	"example.com/vk_tutor/vulkan"
+)
+
+// ValidationLayer is the Khronos validation layer.
+const ValidationLayer = "VK_LAYER_KHRONOS_validation"
+
+// Optional is a requested layer, extension or feature and whether it was
+// enabled.
+type Optional struct {
+	Kind    string // "layer", "extension" or "feature"
+	Name    string
+	Enabled bool
+}
+
+// Report formats the optional items, one per line.
+func Report(items []Optional) string {
+
+	var sb strings.Builder
+
+	for _, o := range items {
+		var status = "enabled"
+		if !o.Enabled {
+			status = "not available"
+		}
+		fmt.Fprintf(&sb, "%v %v: %v\n", o.Kind, o.Name, status)
+	}
+
+	return sb.String()
+}
+
+type request struct {
+	name     string
+	required bool
+}
+
+// InstanceBuilder collects the parameters of a VkInstance, see
+// NewInstanceBuilder.
+type InstanceBuilder struct {
+	appName       string
+	appVersion    uint32
+	engineName    string
+	engineVersion uint32
+
+	minAPIVersion     uint32
+	desiredAPIVersion uint32
+
+	layers     []request
+	extensions []request
+
+	debug         bool
+	debugSeverity vulkan.VkDebugUtilsMessageSeverityFlagsEXT
+	debugType     vulkan.VkDebugUtilsMessageTypeFlagsEXT
+	debugCallback vulkan.PFN_vkDebugUtilsMessengerCallbackEXT
+}
+
+// NewInstanceBuilder returns a builder for a Vulkan 1.0 instance without
+// layers or extensions.
+func NewInstanceBuilder() *InstanceBuilder {
+	return &InstanceBuilder{
+		appVersion:    vulkan.VK_MAKE_VERSION(1, 0, 0),
+		engineName:    "No Engine",
+		engineVersion: vulkan.VK_MAKE_VERSION(1, 0, 0),
+		minAPIVersion: vulkan.VK_API_VERSION_1_0,
+	}
+}
+
+// AppName sets the application name and version of VkApplicationInfo.
+func (o *InstanceBuilder) AppName(name string, version uint32) *InstanceBuilder {
+	o.appName = name
+	o.appVersion = version
+	return o
+}
+
+// EngineName sets the engine name and version of VkApplicationInfo.
+func (o *InstanceBuilder) EngineName(name string, version uint32) *InstanceBuilder {
+	o.engineName = name
+	o.engineVersion = version
+	return o
+}
+
+// RequireAPIVersion fails Build when the loader supports less than version.
+func (o *InstanceBuilder) RequireAPIVersion(version uint32) *InstanceBuilder {
+	o.minAPIVersion = version
+	return o
+}
+
+// DesireAPIVersion asks for version, or the highest version supported by
+// the loader if lower, but never less than the required version.
+func (o *InstanceBuilder) DesireAPIVersion(version uint32) *InstanceBuilder {
+	o.desiredAPIVersion = version
+	return o
+}
+
+// RequireLayers adds layers that must be available.
+func (o *InstanceBuilder) RequireLayers(names ...string) *InstanceBuilder {
+	o.layers = addRequests(o.layers, names, true)
+	return o
+}
+
+// RequestLayers adds layers that are enabled only if available.
+func (o *InstanceBuilder) RequestLayers(names ...string) *InstanceBuilder {
+	o.layers = addRequests(o.layers, names, false)
+	return o
+}
+
+// RequireExtensions adds extensions that must be available, e.g. the ones
+// returned by SDL_Vulkan_GetInstanceExtensions.
+func (o *InstanceBuilder) RequireExtensions(names ...string) *InstanceBuilder {
+	o.extensions = addRequests(o.extensions, names, true)
+	return o
+}
+
+// RequestExtensions adds extensions that are enabled only if available.
+func (o *InstanceBuilder) RequestExtensions(names ...string) *InstanceBuilder {
+	o.extensions = addRequests(o.extensions, names, false)
+	return o
+}
+
+// RequestValidation requests the validation layer and a debug messenger
+// printing warnings and errors with the standard logger.
+func (o *InstanceBuilder) RequestValidation() *InstanceBuilder {
+	o.RequestLayers(ValidationLayer)
+	return o.DebugMessenger(DefaultSeverity, DefaultMessageType, nil)
+}
+
+// DebugMessenger requests VK_EXT_debug_utils and a messenger for the given
+// severities and message types. A nil callback logs the messages with the
+// standard logger.
+func (o *InstanceBuilder) DebugMessenger(
+	severity vulkan.VkDebugUtilsMessageSeverityFlagsEXT,
+	types vulkan.VkDebugUtilsMessageTypeFlagsEXT,
+	callback vulkan.PFN_vkDebugUtilsMessengerCallbackEXT,
+) *InstanceBuilder {
+
+	o.RequestExtensions(vulkan.VK_EXT_DEBUG_UTILS_EXTENSION_NAME)
+
+	o.debug = true
+	o.debugSeverity = severity
+	o.debugType = types
+	o.debugCallback = callback
+
+	return o
+}
+
+// addRequests appends names not already in a, a name both requested and
+// required becomes required.
+func addRequests(a []request, names []string, required bool) []request {
+
+next:
+	for _, name := range names {
+		for i := range a {
+			if name == a[i].name {
+				a[i].required = a[i].required || required
+				continue next
+			}
+		}
+		a = append(a, request{name, required})
+	} // for
+
+	return a
+}
+
+// Instance is a created VkInstance with what was enabled on it.
+type Instance struct {
+	Handle     vulkan.VkInstance
+	APIVersion uint32 // Passed in VkApplicationInfo
+	Layers     []string
+	Extensions []string
+	Optional   []Optional
+
+	Messenger    vulkan.VkDebugUtilsMessengerEXT
+	HasMessenger bool
+}
+
+// Destroy destroys the debug messenger and the instance.
+func (o *Instance) Destroy() {
+
+	if o.HasMessenger {
+		vulkan.VkDestroyDebugUtilsMessengerEXT(o.Handle, o.Messenger, nil)
+		o.HasMessenger = false
+	}
+
+	vulkan.VkDestroyInstance(o.Handle, nil)
+}
+
+// Enabled reports whether the layer or extension name is enabled.
+func (o *Instance) Enabled(name string) bool {
+	return contains(o.Layers, name) || contains(o.Extensions, name)
+}
+
+// Build checks the availability of every layer and extension and creates
+// the instance. The error lists all missing required items at once.
+func (o *InstanceBuilder) Build() (*Instance, error) {
+
+	var loader uint32
+	if res := vulkan.VkEnumerateInstanceVersion(&loader); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkEnumerateInstanceVersion failed: %v", res)
+	}
+
+	var missing []string
+
+	if loader < o.minAPIVersion {
+		missing = append(missing, fmt.Sprintf("Vulkan %v (loader supports %v)", vulkan.Version(o.minAPIVersion), vulkan.Version(loader)))
+	}
+
+	var r = &Instance{APIVersion: negotiate(loader, o.minAPIVersion, o.desiredAPIVersion)}
+
+	// Layers -----
+
+	var availLayers, err = instanceLayers()
+	if nil != err {
+		return nil, err
+	}
+
+	for _, l := range o.layers {
+		var ok = availLayers[l.name]
+		if ok {
+			r.Layers = append(r.Layers, l.name)
+		} else if l.required {
+			missing = append(missing, "layer "+l.name)
+		}
+		if !l.required {
+			r.Optional = append(r.Optional, Optional{"layer", l.name, ok})
+		}
+	} // for
+
+	// Extensions, including the ones provided by the enabled layers -----
+
+	availExts, err := instanceExtensions(r.Layers)
+	if nil != err {
+		return nil, err
+	}
+
+	for _, e := range o.extensions {
+		var ok = availExts[e.name]
+		if ok {
+			r.Extensions = append(r.Extensions, e.name)
+		} else if e.required {
+			missing = append(missing, "extension "+e.name)
+		}
+		if !e.required {
+			r.Optional = append(r.Optional, Optional{"extension", e.name, ok})
+		}
+	} // for
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing instance requirements: %v", strings.Join(missing, ", "))
+	}
+
+	// Create -----
+
+	var app_info = vulkan.VkApplicationInfo{
+		ApplicationVersion: o.appVersion,
+		PEngineName:        &o.engineName,
+		EngineVersion:      o.engineVersion,
+		ApiVersion:         r.APIVersion,
+	}
+	if "" != o.appName {
+		app_info.PApplicationName = &o.appName
+	}
+
+	var create_info = vulkan.VkInstanceCreateInfo{
+		PApplicationInfo:        &app_info,
+		EnabledLayerCount:       len(r.Layers),
+		PpEnabledLayerNames:     r.Layers,
+		EnabledExtensionCount:   len(r.Extensions),
+		PpEnabledExtensionNames: r.Extensions,
+	}
+
+	// Portability drivers, e.g. MoltenVK, are only listed when asked for
+	if r.Enabled(vulkan.VK_KHR_PORTABILITY_ENUMERATION_EXTENSION_NAME) {
+		create_info.Flags = vulkan.VkInstanceCreateFlags(vulkan.VK_INSTANCE_CREATE_ENUMERATE_PORTABILITY_BIT_KHR)
+	}
+
+	var debug = o.debug && r.Enabled(vulkan.VK_EXT_DEBUG_UTILS_EXTENSION_NAME)
+
+	var callback = o.debugCallback
+	if nil == callback {
+		callback = LogMessage
+	}
+
+	var messenger_info = vulkan.VkDebugUtilsMessengerCreateInfoEXT{
+		MessageSeverity: o.debugSeverity,
+		MessageType:     o.debugType,
+		PfnUserCallback: callback,
+	}
+
+	// The messenger created below only exists once there is an instance;
+	// chaining the same create info also reports on vkCreateInstance and
+	// vkDestroyInstance.
+	if debug {
+		create_info.PNext = &messenger_info
+	}
+
+	if res := vulkan.VkCreateInstance(&create_info, nil, &r.Handle); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkCreateInstance failed: %v", res)
+	}
+
+	// Debug messenger -----
+
+	if debug {
+
+		if res := vulkan.VkCreateDebugUtilsMessengerEXT(r.Handle, &messenger_info, nil, &r.Messenger); vulkan.VK_SUCCESS != res {
+			vulkan.VkDestroyInstance(r.Handle, nil)
+			return nil, fmt.Errorf("vkCreateDebugUtilsMessengerEXT failed: %v", res)
+		}
+
+		r.HasMessenger = true
+	}
+
+	return r, nil
+}
+
+// negotiate picks the apiVersion of VkApplicationInfo. A Vulkan 1.0 loader
+// rejects anything above 1.0, later ones accept any version.
+func negotiate(loader, min, desired uint32) uint32 {
+
+	var v = desired
+	if v > loader {
+		v = loader
+	}
+	if v < min {
+		v = min
+	}
+
+	return v
+}
+
+func instanceLayers() (map[string]bool, error) {
+
+	var cnt uint32
+	if res := vulkan.VkEnumerateInstanceLayerProperties(&cnt, nil); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkEnumerateInstanceLayerProperties failed: %v", res)
+	}
+
+	var props = make([]vulkan.VkLayerProperties, cnt)
+	if res := vulkan.VkEnumerateInstanceLayerProperties(&cnt, props); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkEnumerateInstanceLayerProperties failed: %v", res)
+	}
+
+	var r = make(map[string]bool)
+	for _, p := range props[:cnt] {
+		r[p.LayerName] = true
+	}
+
+	return r, nil
+}
+
+// instanceExtensions lists the extensions of the implementation and of the
+// given layers.
+func instanceExtensions(layers []string) (map[string]bool, error) {
+
+	var r = make(map[string]bool)
+
+	var query = func(layer *string) error {
+
+		var cnt uint32
+		if res := vulkan.VkEnumerateInstanceExtensionProperties(layer, &cnt, nil); vulkan.VK_SUCCESS != res {
+			return fmt.Errorf("vkEnumerateInstanceExtensionProperties failed: %v", res)
+		}
+
+		var props = make([]vulkan.VkExtensionProperties, cnt)
+		if res := vulkan.VkEnumerateInstanceExtensionProperties(layer, &cnt, props); vulkan.VK_SUCCESS != res {
+			return fmt.Errorf("vkEnumerateInstanceExtensionProperties failed: %v", res)
+		}
+
+		for _, p := range props[:cnt] {
+			r[p.ExtensionName] = true
+		}
+
+		return nil
+	}
+
+	if err := query(nil); nil != err {
+		return nil, err
+	}
+
+	for i := range layers {
+		if err := query(&layers[i]); nil != err {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+func contains(a []string, s string) bool {
+	for _, v := range a {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
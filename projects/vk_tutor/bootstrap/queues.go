@@ -0,0 +1,310 @@
+package bootstrap
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+
+	"example.com/vk_tutor/selector"
+	"example.com/vk_tutor/vulkan"
+)
+
+// QueueVideoEncode is VK_QUEUE_VIDEO_ENCODE_BIT_KHR, only defined by the
+// headers with VK_ENABLE_BETA_EXTENSIONS.
+const QueueVideoEncode vulkan.VkQueueFlags = 0x00000040
+
+// Separation is how far a queue family is from the graphics family.
+type Separation int
+
+const (
+	Shared    Separation = iota // Any family with the flags
+	Separate                    // A family without graphics, e.g. async compute
+	Dedicated                   // A family without any other work capability, e.g. a DMA transfer queue
+)
+
+func (o Separation) String() string {
+	switch o {
+	case Shared:
+		return "shared"
+	case Separate:
+		return "separate"
+	case Dedicated:
+		return "dedicated"
+	} // switch
+	return fmt.Sprintf("Separation(%d)", int(o))
+}
+
+// Capabilities looked at by Separate and Dedicated
+const workFlags = vulkan.VkQueueFlags(vulkan.VK_QUEUE_GRAPHICS_BIT|vulkan.VK_QUEUE_COMPUTE_BIT|
+	vulkan.VK_QUEUE_TRANSFER_BIT|vulkan.VK_QUEUE_VIDEO_DECODE_BIT_KHR|vulkan.VK_QUEUE_OPTICAL_FLOW_BIT_NV) | QueueVideoEncode
+
+// QueueRequest asks for the queues of one logical role.
+type QueueRequest struct {
+	Role     string              // Name the queues are looked up by, e.g. "graphics"
+	Flags    vulkan.VkQueueFlags // Required capabilities, including sparse binding and video
+	Present  bool                // The family must present to the surface
+	Prefer   Separation          // Best separation looked for
+	Require  Separation          // Worst separation accepted
+	Count    int                 // Number of queues, 0 for 1
+	Priority float32             // Priority of the queues, 0 for 1
+	Optional bool                // Leave the role out instead of failing
+
+	// Exclusive roles get queues of their own while the family has some
+	// left, others reuse the queues of the roles before them in the family.
+	Exclusive bool
+}
+
+// Common requests
+var (
+	GraphicsQueue = QueueRequest{Role: "graphics", Flags: vulkan.VkQueueFlags(vulkan.VK_QUEUE_GRAPHICS_BIT)}
+	PresentQueue  = QueueRequest{Role: "present", Present: true}
+
+	AsyncComputeQueue = QueueRequest{Role: "compute", Flags: vulkan.VkQueueFlags(vulkan.VK_QUEUE_COMPUTE_BIT),
+		Prefer: Dedicated, Require: Shared, Exclusive: true}
+
+	TransferQueue = QueueRequest{Role: "transfer", Flags: vulkan.VkQueueFlags(vulkan.VK_QUEUE_TRANSFER_BIT),
+		Prefer: Dedicated, Require: Shared, Exclusive: true}
+)
+
+// QueueAssignment is where the queues of a role live.
+type QueueAssignment struct {
+	Role       string
+	Family     int
+	Indices    []int // Queue indices in the family
+	Separation Separation
+}
+
+// QueuePlan is the outcome of PlanQueues.
+type QueuePlan struct {
+	Assignments []QueueAssignment // In request order
+	Skipped     []string          // Optional roles without a matching family
+
+	priorities map[int][]float32 // Family to queue priorities
+}
+
+// PlanQueues assigns a queue family and queue indices to every request, in
+// order. Roles of the same family share queues unless Exclusive, families
+// never get more queues than they have.
+func PlanQueues(d *selector.Device, requests []QueueRequest) (*QueuePlan, error) {
+
+	var r = &QueuePlan{priorities: make(map[int][]float32)}
+
+	// Queues handed out per family, shared roles use the first ones
+	var used = make(map[int]int)
+
+	var missing []string
+
+	for _, req := range requests {
+
+		var family, sep = pickFamily(d, &req, used)
+		if family < 0 {
+			if req.Optional {
+				r.Skipped = append(r.Skipped, req.Role)
+			} else {
+				missing = append(missing, describeRequest(&req))
+			}
+			continue
+		}
+
+		var count = req.Count
+		if count <= 0 {
+			count = 1
+		}
+
+		var prio = req.Priority
+		if prio <= 0 {
+			prio = 1
+		}
+
+		var avail = int(d.QueueFamilies[family].QueueCount)
+		var first = 0
+		if req.Exclusive && used[family] < avail {
+			first = used[family]
+		}
+
+		var a = QueueAssignment{Role: req.Role, Family: family, Separation: sep}
+
+		for i := 0; i < count; i++ {
+			// Wrap around on the queues of the family when short
+			a.Indices = append(a.Indices, (first+i)%avail)
+		}
+
+		var prios = r.priorities[family]
+		for _, idx := range a.Indices {
+			for len(prios) <= idx {
+				prios = append(prios, 0)
+			}
+			if prio > prios[idx] {
+				prios[idx] = prio
+			}
+		}
+		r.priorities[family] = prios
+
+		if n := len(prios); n > used[family] {
+			used[family] = n
+		}
+
+		r.Assignments = append(r.Assignments, a)
+	} // for
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("no queue family on %v for %v", d, strings.Join(missing, ", "))
+	}
+
+	return r, nil
+}
+
+// pickFamily returns the best family for req and its separation, -1 if
+// none qualifies. Shared requests favour families already in use, the
+// others the ones with the fewest extra capabilities.
+func pickFamily(d *selector.Device, req *QueueRequest, used map[int]int) (int, Separation) {
+
+	var best, bestSep, bestExtra = -1, Shared, 0
+
+	for i, fp := range d.QueueFamilies {
+
+		var flags = fp.QueueFlags
+		if 0 == fp.QueueCount {
+			continue
+		}
+
+		// Graphics and compute queues implicitly support transfers
+		if 0 != flags&vulkan.VkQueueFlags(vulkan.VK_QUEUE_GRAPHICS_BIT|vulkan.VK_QUEUE_COMPUTE_BIT) {
+			flags |= vulkan.VkQueueFlags(vulkan.VK_QUEUE_TRANSFER_BIT)
+		}
+
+		if req.Flags != flags&req.Flags {
+			continue
+		}
+		if req.Present && !containsInt(d.PresentFamilies, i) {
+			continue
+		}
+
+		var sep = separation(flags, req.Flags)
+		if sep > req.Prefer {
+			sep = req.Prefer
+		}
+		if sep < req.Require {
+			continue
+		}
+
+		var extra = bits.OnesCount32(uint32(flags &^ req.Flags))
+		if Shared == req.Prefer && used[i] > 0 {
+			extra = -1
+		}
+
+		if best < 0 || sep > bestSep || (sep == bestSep && extra < bestExtra) {
+			best, bestSep, bestExtra = i, sep, extra
+		}
+	} // for
+
+	return best, bestSep
+}
+
+// separation of a family with flags for a request of want.
+func separation(flags, want vulkan.VkQueueFlags) Separation {
+
+	var other = flags & workFlags &^ want
+
+	switch {
+	case 0 == other:
+		return Dedicated
+	case 0 == other&vulkan.VkQueueFlags(vulkan.VK_QUEUE_GRAPHICS_BIT):
+		return Separate
+	} // switch
+
+	return Shared
+}
+
+func describeRequest(req *QueueRequest) string {
+
+	var s = req.Role
+	if req.Require > Shared {
+		s = fmt.Sprintf("%v (%v)", s, req.Require)
+	}
+	if req.Present {
+		s += " with present"
+	}
+
+	return s
+}
+
+func containsInt(a []int, v int) bool {
+	for _, x := range a {
+		if v == x {
+			return true
+		}
+	}
+	return false
+}
+
+// Family returns the family of role, -1 if not planned.
+func (o *QueuePlan) Family(role string) int {
+	for _, a := range o.Assignments {
+		if role == a.Role {
+			return a.Family
+		}
+	}
+	return -1
+}
+
+// Families lists the distinct families of the plan, ascending.
+func (o *QueuePlan) Families() []int {
+
+	var r []int
+	for f := range o.priorities {
+		r = append(r, f)
+	}
+	sort.Ints(r)
+
+	return r
+}
+
+// CreateInfos returns one VkDeviceQueueCreateInfo per family.
+func (o *QueuePlan) CreateInfos() []vulkan.VkDeviceQueueCreateInfo {
+
+	var r []vulkan.VkDeviceQueueCreateInfo
+
+	for _, f := range o.Families() {
+		var prios = o.priorities[f]
+		r = append(r, vulkan.VkDeviceQueueCreateInfo{
+			QueueFamilyIndex: f,
+			QueueCount:       len(prios),
+			PQueuePriorities: prios,
+		})
+	} // for
+
+	return r
+}
+
+// Resolve looks up the queues of every role on device, which must have been
+// created with CreateInfos.
+func (o *QueuePlan) Resolve(device vulkan.VkDevice) map[string][]vulkan.VkQueue {
+
+	var r = make(map[string][]vulkan.VkQueue)
+
+	for _, a := range o.Assignments {
+		var queues = make([]vulkan.VkQueue, len(a.Indices))
+		for i, idx := range a.Indices {
+			vulkan.VkGetDeviceQueue(device, a.Family, idx, &queues[i])
+		}
+		r[a.Role] = queues
+	} // for
+
+	return r
+}
+
+func (o *QueuePlan) String() string {
+
+	var sb strings.Builder
+
+	for _, a := range o.Assignments {
+		fmt.Fprintf(&sb, "%v: family %v, queues %v (%v)\n", a.Role, a.Family, a.Indices, a.Separation)
+	}
+	for _, role := range o.Skipped {
+		fmt.Fprintf(&sb, "%v: not available\n", role)
+	}
+
+	return sb.String()
+}
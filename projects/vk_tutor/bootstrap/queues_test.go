@@ -0,0 +1,163 @@
+package bootstrap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"example.com/vk_tutor/selector"
+	"example.com/vk_tutor/vulkan"
+)
+
+const (
+	qG = vulkan.VkQueueFlags(vulkan.VK_QUEUE_GRAPHICS_BIT)
+	qC = vulkan.VkQueueFlags(vulkan.VK_QUEUE_COMPUTE_BIT)
+	qT = vulkan.VkQueueFlags(vulkan.VK_QUEUE_TRANSFER_BIT)
+	qS = vulkan.VkQueueFlags(vulkan.VK_QUEUE_SPARSE_BINDING_BIT)
+)
+
+// queueDevice fabricates a device with the given families; counts[i] is the
+// queue count of family i.
+func queueDevice(flags []vulkan.VkQueueFlags, counts []uint32, present ...int) *selector.Device {
+
+	var d = &selector.Device{PresentFamilies: present}
+	d.Properties.DeviceName = "test"
+
+	for i := range flags {
+		d.QueueFamilies = append(d.QueueFamilies, vulkan.VkQueueFamilyProperties{
+			QueueFlags: flags[i],
+			QueueCount: counts[i],
+		})
+	}
+
+	return d
+}
+
+func TestPlanQueues(t *testing.T) {
+
+	var standard = []QueueRequest{GraphicsQueue, PresentQueue, AsyncComputeQueue, TransferQueue}
+
+	var computeShared = QueueRequest{Role: "compute", Flags: qC, Exclusive: true, Count: 2, Priority: 0.5}
+	var ui = QueueRequest{Role: "ui", Flags: qG, Priority: 0.25}
+
+	for _, c := range []struct {
+		name     string
+		device   *selector.Device
+		requests []QueueRequest
+		want     []QueueAssignment
+		infos    []vulkan.VkDeviceQueueCreateInfo
+	}{
+		{
+			// Integrated GPU, everything on one queue
+			"single family",
+			queueDevice([]vulkan.VkQueueFlags{qG | qC | qT}, []uint32{1}, 0),
+			standard,
+			[]QueueAssignment{
+				{"graphics", 0, []int{0}, Shared},
+				{"present", 0, []int{0}, Shared},
+				{"compute", 0, []int{0}, Shared},
+				{"transfer", 0, []int{0}, Shared},
+			},
+			[]vulkan.VkDeviceQueueCreateInfo{
+				{QueueFamilyIndex: 0, QueueCount: 1, PQueuePriorities: []float32{1}},
+			},
+		},
+		{
+			// Desktop GPU: graphics, transfer only, async compute
+			"three families",
+			queueDevice([]vulkan.VkQueueFlags{qG | qC | qT | qS, qT | qS, qC | qT | qS}, []uint32{16, 2, 8}, 0, 2),
+			standard,
+			[]QueueAssignment{
+				{"graphics", 0, []int{0}, Shared},
+				{"present", 0, []int{0}, Shared},
+				{"compute", 2, []int{0}, Separate},
+				{"transfer", 1, []int{0}, Dedicated},
+			},
+			[]vulkan.VkDeviceQueueCreateInfo{
+				{QueueFamilyIndex: 0, QueueCount: 1, PQueuePriorities: []float32{1}},
+				{QueueFamilyIndex: 1, QueueCount: 1, PQueuePriorities: []float32{1}},
+				{QueueFamilyIndex: 2, QueueCount: 1, PQueuePriorities: []float32{1}},
+			},
+		},
+		{
+			// Exclusive roles take the next queues, shared ones reuse the
+			// first and keep the highest priority
+			"exclusive indices",
+			queueDevice([]vulkan.VkQueueFlags{qG | qC | qT | qS, qT | qS, qC | qT | qS}, []uint32{16, 2, 8}, 0, 2),
+			[]QueueRequest{GraphicsQueue, computeShared, ui},
+			[]QueueAssignment{
+				{"graphics", 0, []int{0}, Shared},
+				{"compute", 0, []int{1, 2}, Shared},
+				{"ui", 0, []int{0}, Shared},
+			},
+			[]vulkan.VkDeviceQueueCreateInfo{
+				{QueueFamilyIndex: 0, QueueCount: 3, PQueuePriorities: []float32{1, 0.5, 0.5}},
+			},
+		},
+		{
+			// Too few queues for an exclusive role, indices wrap around
+			"two queues",
+			queueDevice([]vulkan.VkQueueFlags{qG | qC | qT}, []uint32{2}, 0),
+			[]QueueRequest{GraphicsQueue, {Role: "compute", Flags: qC, Exclusive: true, Count: 3}},
+			[]QueueAssignment{
+				{"graphics", 0, []int{0}, Shared},
+				{"compute", 0, []int{1, 0, 1}, Shared},
+			},
+			[]vulkan.VkDeviceQueueCreateInfo{
+				{QueueFamilyIndex: 0, QueueCount: 2, PQueuePriorities: []float32{1, 1}},
+			},
+		},
+		{
+			"one queue",
+			queueDevice([]vulkan.VkQueueFlags{qG | qC | qT}, []uint32{1}, 0),
+			[]QueueRequest{GraphicsQueue, {Role: "compute", Flags: qC, Exclusive: true, Count: 2}},
+			[]QueueAssignment{
+				{"graphics", 0, []int{0}, Shared},
+				{"compute", 0, []int{0, 0}, Shared},
+			},
+			[]vulkan.VkDeviceQueueCreateInfo{
+				{QueueFamilyIndex: 0, QueueCount: 1, PQueuePriorities: []float32{1}},
+			},
+		},
+	} {
+		var plan, err = PlanQueues(c.device, c.requests)
+		if nil != err {
+			t.Errorf("%v: %v", c.name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(c.want, plan.Assignments) {
+			t.Errorf("%v:\n got %+v\nwant %+v", c.name, plan.Assignments, c.want)
+		}
+		if infos := plan.CreateInfos(); !reflect.DeepEqual(c.infos, infos) {
+			t.Errorf("%v: CreateInfos\n got %+v\nwant %+v", c.name, infos, c.infos)
+		}
+	} // for
+}
+
+func TestPlanQueuesMissing(t *testing.T) {
+
+	var d = queueDevice([]vulkan.VkQueueFlags{qG | qC | qT, qT}, []uint32{1, 1}, 0)
+
+	var video = QueueRequest{Role: "video", Flags: vulkan.VkQueueFlags(vulkan.VK_QUEUE_VIDEO_DECODE_BIT_KHR), Optional: true}
+	var dedicated = QueueRequest{Role: "compute", Flags: qC, Prefer: Dedicated, Require: Separate}
+
+	var plan, err = PlanQueues(d, []QueueRequest{GraphicsQueue, video})
+	if nil != err {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([]string{"video"}, plan.Skipped) || -1 != plan.Family("video") {
+		t.Errorf("skipped %v, video family %v", plan.Skipped, plan.Family("video"))
+	}
+
+	// The only compute family also does graphics
+	if _, err = PlanQueues(d, []QueueRequest{GraphicsQueue, dedicated}); nil == err || !strings.Contains(err.Error(), "compute (separate)") {
+		t.Errorf("error %v, want one naming compute (separate)", err)
+	}
+
+	// No present family
+	d.PresentFamilies = nil
+	if _, err = PlanQueues(d, []QueueRequest{PresentQueue}); nil == err || !strings.Contains(err.Error(), "present with present") {
+		t.Errorf("error %v, want one naming present", err)
+	}
+}
@@ -0,0 +1,242 @@
+package bootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	"example.com/vk_tutor/selector"
+	"example.com/vk_tutor/vulkan"
+)
+
+// DeviceRequirements are the extensions and features asked of a device.
+type DeviceRequirements struct {
+	APIVersion         uint32 // Version the device is used with, 0 for the device's own
+	Extensions         []string
+	OptionalExtensions []string
+	Features           vulkan.FeatureSet
+	OptionalFeatures   vulkan.FeatureSet
+}
+
+// Resolution is the outcome of ResolveExtensions.
+type Resolution struct {
+	APIVersion uint32
+	Enable     []string // Device extensions to enable, dependencies first
+	Core       []string // Requested or needed but provided by APIVersion
+	Instance   []string // Instance extensions the enabled ones depend on
+	Missing    []string // Unusable required extensions, "name: reason"
+	Skipped    []string // Unusable optional extensions, "name: reason"
+}
+
+// ResolveExtensions works out which extensions to enable on a device with
+// the given extensions for apiVersion. Dependencies are added before the
+// extensions needing them, extensions promoted to apiVersion are not
+// enabled at all. An optional extension is only enabled, with its
+// dependencies, when all of them are usable.
+func ResolveExtensions(apiVersion uint32, available map[string]uint32, required, optional []string) *Resolution {
+
+	var o = resolver{
+		api:   apiVersion,
+		avail: available,
+		done:  make(map[string]bool),
+		r:     &Resolution{APIVersion: apiVersion},
+	}
+
+	for _, name := range required {
+		if why := o.reason(name, make(map[string]bool)); "" != why {
+			o.r.Missing = append(o.r.Missing, name+": "+why)
+			continue
+		}
+		o.add(name)
+	} // for
+
+	for _, name := range optional {
+		if why := o.reason(name, make(map[string]bool)); "" != why {
+			o.r.Skipped = append(o.r.Skipped, name+": "+why)
+			continue
+		}
+		o.add(name)
+	} // for
+
+	return o.r
+}
+
+type resolver struct {
+	api   uint32
+	avail map[string]uint32
+	done  map[string]bool
+	r     *Resolution
+}
+
+func (o *resolver) promoted(e *Extension) bool {
+	return 0 != e.Promoted && o.api >= e.Promoted
+}
+
+// reason tells why name cannot be enabled, "" if it can.
+func (o *resolver) reason(name string, seen map[string]bool) string {
+
+	var e, _ = LookupExtension(name)
+
+	if seen[name] || o.promoted(&e) {
+		return ""
+	}
+	seen[name] = true
+
+	if e.MinVersion > o.api {
+		return fmt.Sprintf("requires Vulkan %v", vulkan.Version(e.MinVersion))
+	}
+
+	if _, ok := o.avail[name]; !ok {
+		return "not supported"
+	}
+
+	for _, dep := range e.Requires {
+		if why := o.reason(dep, seen); "" != why {
+			return fmt.Sprintf("needs %v, %v", dep, why)
+		}
+	}
+
+	return ""
+}
+
+// add records name and its dependencies, reason must have accepted it.
+func (o *resolver) add(name string) {
+
+	if o.done[name] {
+		return
+	}
+	o.done[name] = true
+
+	var e, _ = LookupExtension(name)
+
+	if o.promoted(&e) {
+		o.r.Core = append(o.r.Core, name)
+		return
+	}
+
+	for _, dep := range e.Requires {
+		o.add(dep)
+	}
+
+	for _, inst := range e.Instance {
+		if v := instancePromoted[inst]; 0 != v && o.api >= v {
+			continue
+		}
+		if !contains(o.r.Instance, inst) {
+			o.r.Instance = append(o.r.Instance, inst)
+		}
+	} // for
+
+	o.r.Enable = append(o.r.Enable, name)
+}
+
+// Core versions of the instance extensions in the table
+var instancePromoted = map[string]uint32{
+	instProperties2: v11,
+	instDeviceGroup: v11,
+	instExtMemory:   v11,
+	instExtSema:     v11,
+	instExtFence:    v11,
+}
+
+// RequirementReport is the outcome of CheckDevice.
+type RequirementReport struct {
+	*Resolution
+	Features        vulkan.FeatureSet // To enable
+	MissingFeatures []string
+	SkippedFeatures []string
+	Optional        []Optional // Requested extensions and features, enabled or not
+}
+
+// OK reports whether every required extension and feature is available.
+func (o *RequirementReport) OK() bool {
+	return 0 == len(o.Missing) && 0 == len(o.MissingFeatures)
+}
+
+// Err returns nil if OK, else an error listing what is missing.
+func (o *RequirementReport) Err() error {
+
+	if o.OK() {
+		return nil
+	}
+
+	var a = append([]string(nil), o.Missing...)
+	for _, f := range o.MissingFeatures {
+		a = append(a, "feature "+f)
+	}
+
+	return fmt.Errorf("missing device requirements: %v", strings.Join(a, "; "))
+}
+
+// String is a diff of the requirements against the device: "+" enabled,
+// "=" provided by the core version, "-" missing, "?" optional but missing.
+func (o *RequirementReport) String() string {
+
+	var sb strings.Builder
+
+	for _, name := range o.Enable {
+		fmt.Fprintf(&sb, "+ %v\n", name)
+	}
+	for _, name := range o.Core {
+		var e, _ = LookupExtension(name)
+		fmt.Fprintf(&sb, "= %v (core in Vulkan %v)\n", name, vulkan.Version(e.Promoted))
+	}
+	for _, name := range o.Features.Names() {
+		fmt.Fprintf(&sb, "+ feature %v\n", name)
+	}
+	for _, s := range o.Missing {
+		fmt.Fprintf(&sb, "- %v\n", s)
+	}
+	for _, name := range o.MissingFeatures {
+		fmt.Fprintf(&sb, "- feature %v: not supported\n", name)
+	}
+	for _, s := range o.Skipped {
+		fmt.Fprintf(&sb, "? %v\n", s)
+	}
+	for _, name := range o.SkippedFeatures {
+		fmt.Fprintf(&sb, "? feature %v: not supported\n", name)
+	}
+	for _, name := range o.Instance {
+		fmt.Fprintf(&sb, "  needs instance extension %v\n", name)
+	}
+
+	return sb.String()
+}
+
+// CheckDevice resolves the extensions of req on d and compares the
+// requested features with the supported ones.
+func CheckDevice(d *selector.Device, req *DeviceRequirements) *RequirementReport {
+
+	var api = d.Properties.ApiVersion
+	if 0 != req.APIVersion && req.APIVersion < api {
+		api = req.APIVersion
+	}
+
+	var have = d.Features.FeatureSet()
+
+	// Only what is available is enabled, or the create call fails
+	var r = &RequirementReport{
+		Resolution:      ResolveExtensions(api, d.Extensions, req.Extensions, req.OptionalExtensions),
+		Features:        req.Features.Intersect(have),
+		MissingFeatures: req.Features.Difference(have).Names(),
+	}
+
+	for _, name := range req.OptionalExtensions {
+		var skipped = false
+		for _, s := range r.Skipped {
+			skipped = skipped || strings.HasPrefix(s, name+":")
+		}
+		r.Optional = append(r.Optional, Optional{"extension", name, !skipped})
+	} // for
+
+	for _, f := range req.OptionalFeatures.Difference(req.Features).Features() {
+		var ok = have.Has(f)
+		if ok {
+			r.Features.Add(f)
+		} else {
+			r.SkippedFeatures = append(r.SkippedFeatures, f.String())
+		}
+		r.Optional = append(r.Optional, Optional{"feature", f.String(), ok})
+	} // for
+
+	return r
+}
@@ -0,0 +1,172 @@
+package bootstrap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"example.com/vk_tutor/selector"
+	"example.com/vk_tutor/vulkan"
+)
+
+func TestResolveExtensions(t *testing.T) {
+
+	const (
+		swapchain    = vulkan.VK_KHR_SWAPCHAIN_EXTENSION_NAME
+		dynRendering = "VK_KHR_dynamic_rendering"
+		dsResolve    = "VK_KHR_depth_stencil_resolve"
+		renderpass2  = "VK_KHR_create_renderpass2"
+		multiview    = "VK_KHR_multiview"
+		maintenance2 = "VK_KHR_maintenance2"
+		maintenance4 = "VK_KHR_maintenance4"
+		presentWait  = "VK_KHR_present_wait"
+	)
+
+	var avail = map[string]uint32{
+		swapchain:    70,
+		dynRendering: 1,
+		dsResolve:    1,
+		renderpass2:  1,
+		multiview:    1,
+		maintenance2: 1,
+		maintenance4: 2,
+		presentWait:  1,
+	}
+
+	var v10, v11, v12, v13 = vulkan.VK_API_VERSION_1_0, vulkan.VK_API_VERSION_1_1, vulkan.VK_API_VERSION_1_2, vulkan.VK_API_VERSION_1_3
+
+	for _, c := range []struct {
+		name     string
+		api      uint32
+		avail    map[string]uint32
+		required []string
+		optional []string
+		want     Resolution
+	}{
+		{
+			"dynamic rendering 1.0", v10, avail, []string{swapchain, dynRendering}, nil,
+			Resolution{
+				Enable:   []string{swapchain, multiview, maintenance2, renderpass2, dsResolve, dynRendering},
+				Instance: []string{instSurface, instProperties2},
+			},
+		},
+		{
+			"dynamic rendering 1.1", v11, avail, []string{swapchain, dynRendering}, nil,
+			Resolution{
+				Enable:   []string{swapchain, renderpass2, dsResolve, dynRendering},
+				Core:     []string{multiview, maintenance2},
+				Instance: []string{instSurface},
+			},
+		},
+		{
+			"dynamic rendering 1.2", v12, avail, []string{swapchain, dynRendering}, nil,
+			Resolution{
+				Enable:   []string{swapchain, dynRendering},
+				Core:     []string{dsResolve},
+				Instance: []string{instSurface},
+			},
+		},
+		{
+			// Core in 1.3, the device need not list the extension
+			"dynamic rendering 1.3", v13, map[string]uint32{swapchain: 70}, []string{swapchain, dynRendering}, nil,
+			Resolution{
+				Enable:   []string{swapchain},
+				Core:     []string{dynRendering},
+				Instance: []string{instSurface},
+			},
+		},
+		{
+			"min version 1.0", v10, avail, []string{maintenance4}, nil,
+			Resolution{Missing: []string{maintenance4 + ": requires Vulkan 1.1.0"}},
+		},
+		{
+			"min version 1.1", v11, avail, []string{maintenance4}, nil,
+			Resolution{Enable: []string{maintenance4}},
+		},
+		{
+			"not supported", v12, map[string]uint32{}, []string{swapchain}, nil,
+			Resolution{Missing: []string{swapchain + ": not supported"}},
+		},
+		{
+			// present_wait needs present_id, which the device lacks
+			"optional dependency", v12, avail, []string{swapchain}, []string{presentWait, "VK_EXT_memory_budget"},
+			Resolution{
+				Enable:   []string{swapchain},
+				Instance: []string{instSurface},
+				Skipped: []string{
+					presentWait + ": needs VK_KHR_present_id, not supported",
+					"VK_EXT_memory_budget: not supported",
+				},
+			},
+		},
+		{
+			"missing dependency", v10, map[string]uint32{dynRendering: 1, dsResolve: 1}, []string{dynRendering}, nil,
+			Resolution{Missing: []string{dynRendering + ": needs " + dsResolve + ", needs " + renderpass2 + ", not supported"}},
+		},
+	} {
+		c.want.APIVersion = c.api
+
+		var got = ResolveExtensions(c.api, c.avail, c.required, c.optional)
+		if !reflect.DeepEqual(&c.want, got) {
+			t.Errorf("%v:\n got %+v\nwant %+v", c.name, got, &c.want)
+		}
+	} // for
+}
+
+func TestCheckDevice(t *testing.T) {
+
+	var d = &selector.Device{
+		Extensions: map[string]uint32{
+			vulkan.VK_KHR_SWAPCHAIN_EXTENSION_NAME: 70,
+		},
+	}
+	d.Properties.ApiVersion = vulkan.VK_API_VERSION_1_3
+	d.Features.SamplerAnisotropy = true
+	d.Features.FillModeNonSolid = true
+
+	var r = CheckDevice(d, &DeviceRequirements{
+		APIVersion:         vulkan.VK_API_VERSION_1_2,
+		Extensions:         []string{vulkan.VK_KHR_SWAPCHAIN_EXTENSION_NAME, "VK_KHR_dynamic_rendering"},
+		OptionalExtensions: []string{"VK_KHR_timeline_semaphore", "VK_EXT_memory_budget"},
+		Features:           vulkan.MustParseFeatureSet("samplerAnisotropy geometryShader"),
+		OptionalFeatures:   vulkan.MustParseFeatureSet("fillModeNonSolid wideLines"),
+	})
+
+	// The device is used as 1.2, where dynamic rendering is an extension
+	if vulkan.VK_API_VERSION_1_2 != r.APIVersion {
+		t.Errorf("API version %v, want 1.2", vulkan.Version(r.APIVersion))
+	}
+	if !reflect.DeepEqual([]string{"VK_KHR_dynamic_rendering: not supported"}, r.Missing) {
+		t.Errorf("missing %v", r.Missing)
+	}
+	if !reflect.DeepEqual([]string{"VK_KHR_timeline_semaphore"}, r.Core) {
+		t.Errorf("core %v", r.Core)
+	}
+
+	if want := vulkan.MustParseFeatureSet("samplerAnisotropy fillModeNonSolid"); !reflect.DeepEqual(want, r.Features) {
+		t.Errorf("features %v, want %v", r.Features, want)
+	}
+	if !reflect.DeepEqual([]string{"geometryShader"}, r.MissingFeatures) {
+		t.Errorf("missing features %v", r.MissingFeatures)
+	}
+	if !reflect.DeepEqual([]string{"wideLines"}, r.SkippedFeatures) {
+		t.Errorf("skipped features %v", r.SkippedFeatures)
+	}
+
+	var want = []Optional{
+		{"extension", "VK_KHR_timeline_semaphore", true},
+		{"extension", "VK_EXT_memory_budget", false},
+		{"feature", "fillModeNonSolid", true},
+		{"feature", "wideLines", false},
+	}
+	if !reflect.DeepEqual(want, r.Optional) {
+		t.Errorf("optional\n got %v\nwant %v", r.Optional, want)
+	}
+
+	if r.OK() {
+		t.Error("OK with missing requirements")
+	}
+	if err := r.Err(); nil == err || !strings.Contains(err.Error(), "feature geometryShader") {
+		t.Errorf("Err() = %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+// Command shaderc compiles GLSL/HLSL shaders to SPIR-V and writes a Go file
+// embedding the results, for use with go generate:
+//
+//	//go:generate go run example.com/vk_tutor/cmd/shaderc -o shaders.go shaders/shader.vert shaders/shader.frag
+//
+// Each shader becomes a []byte variable named after -prefix and the file name,
+// e.g. spvShaderVert. Compiler diagnostics are printed as file:line messages.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"example.com/vk_tutor/shaderc"
+	"example.com/vk_tutor/spirv"
+)
+
+type listFlag []string
+
+func (o *listFlag) String() string {
+	return strings.Join(*o, ",")
+}
+
+func (o *listFlag) Set(s string) error {
+	*o = append(*o, s)
+	return nil
+}
+
+func main() {
+
+	var opts shaderc.Options
+	var includes, defines listFlag
+
+	var out = flag.String("o", "shaders.go", "output Go file")
+	var pkg = flag.String("pkg", os.Getenv("GOPACKAGE"), "package of the output file, default $GOPACKAGE or main")
+	var prefix = flag.String("prefix", "spv", "variable name prefix")
+	var strip = flag.Bool("strip", false, "remove debug instructions from the SPIR-V")
+	var cache = flag.String("cache", shaderc.DefaultCacheDir(), "cache directory, empty to disable")
+	flag.Var(&includes, "I", "include directory, repeatable")
+	flag.Var(&defines, "D", "preprocessor define NAME or NAME=VALUE, repeatable")
+	flag.StringVar(&opts.TargetEnv, "target-env", "vulkan1.0", "target environment, e.g. vulkan1.2")
+	flag.StringVar(&opts.Compiler, "compiler", "", "glslc or glslangValidator, default first found in PATH")
+	flag.StringVar(&opts.Stage, "stage", "", "shader stage, default inferred from each file name")
+	flag.StringVar(&opts.EntryPoint, "entry", "main", "entry point (HLSL)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: shaderc [flags] shader...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if 0 == flag.NArg() {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if "" == *pkg {
+		*pkg = "main"
+	}
+
+	opts.IncludeDirs = includes
+	opts.Defines = defines
+	opts.CacheDir = *cache
+
+	var shaders []shaderc.Shader
+	var failed bool
+
+	for _, path := range flag.Args() {
+
+		var r, err = shaderc.Compile(path, &opts)
+		if nil != err {
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+			continue
+		}
+
+		var code = r.SPIRV
+		if *strip {
+			var m, err = spirv.Parse(code)
+			if nil != err {
+				fmt.Fprintf(os.Stderr, "%v: %v\n", path, err)
+				failed = true
+				continue
+			}
+			code = m.Strip().Bytes()
+		}
+
+		shaders = append(shaders, shaderc.Shader{
+			Name:   shaderc.VarName(*prefix, path),
+			Source: path,
+			Hash:   r.Hash,
+			SPIRV:  code,
+		})
+	} // for
+
+	if failed {
+		os.Exit(1)
+	}
+
+	var b bytes.Buffer
+	if err := shaderc.WriteGo(&b, *pkg, shaders); nil != err {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// Leave the file untouched when nothing changed, keeps build caches warm
+	if old, err := os.ReadFile(*out); nil == err && bytes.Equal(old, b.Bytes()) {
+		return
+	}
+
+	if err := os.WriteFile(*out, b.Bytes(), 0o644); nil != err {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,159 @@
+// Command spvdis disassembles and inspects SPIR-V binaries.
+//
+//	spvdis shader.spv                 disassembly with friendly names
+//	spvdis -summary shader.spv        capabilities, entry points, interfaces
+//	spvdis -strip -o out.spv in.spv   remove debug instructions
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"example.com/vk_tutor/spirv"
+)
+
+func main() {
+
+	var summary = flag.Bool("summary", false, "print a summary instead of the disassembly")
+	var strip = flag.Bool("strip", false, "write the module without debug instructions to -o")
+	var out = flag.String("o", "", "output file, default stdout")
+	var raw = flag.Bool("raw-ids", false, "print numeric IDs instead of friendly names")
+	var comments = flag.Bool("comments", true, "annotate declarations with their type and decorations")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: spvdis [flags] file.spv\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if 1 != flag.NArg() {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var in = flag.Arg(0)
+
+	var b, err = os.ReadFile(in)
+	if nil != err {
+		fatal(err)
+	}
+
+	m, err := spirv.Parse(b)
+	if nil != err {
+		fatal(fmt.Errorf("%v: %w", in, err))
+	}
+
+	if *strip {
+		var r = m.Strip().Bytes()
+		if "" == *out {
+			if _, err = os.Stdout.Write(r); nil != err {
+				fatal(err)
+			}
+			return
+		}
+		if err = os.WriteFile(*out, r, 0o644); nil != err {
+			fatal(err)
+		}
+		fmt.Fprintf(os.Stderr, "%v: %v -> %v bytes\n", *out, len(b), len(r))
+		return
+	}
+
+	var w io.Writer = os.Stdout
+	if "" != *out {
+		var f *os.File
+		if f, err = os.Create(*out); nil != err {
+			fatal(err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if *summary {
+		err = writeSummary(w, m)
+	} else {
+		err = m.Disassemble(w, &spirv.DisasmOptions{RawIDs: *raw, Comments: *comments})
+	}
+
+	if nil != err {
+		fatal(err)
+	}
+}
+
+func writeSummary(w io.Writer, m *spirv.Module) error {
+
+	var s, err = m.Summary()
+	if nil != err {
+		return err
+	}
+
+	var bw = bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "SPIR-V %v.%v, %v, bound %v\n", s.Major, s.Minor, s.Generator, m.Bound)
+	fmt.Fprintf(bw, "Memory model: %v %v\n", s.AddressingModel, s.MemoryModel)
+
+	var caps []string
+	for _, c := range s.Capabilities {
+		caps = append(caps, c.String())
+	}
+	fmt.Fprintf(bw, "Capabilities: %v\n", list(caps))
+	fmt.Fprintf(bw, "Extensions: %v\n", list(s.Extensions))
+	fmt.Fprintf(bw, "Extended instruction sets: %v\n", list(s.ExtInstImports))
+
+	for _, e := range s.EntryPoints {
+
+		fmt.Fprintf(bw, "\nEntry point %q (%v)\n", e.Name, e.Model)
+
+		for _, mode := range e.Modes {
+			fmt.Fprintf(bw, "  mode %v\n", mode)
+		}
+
+		for _, v := range e.Interface {
+			fmt.Fprintf(bw, "  %-8v %-14v %-24v %v\n", v.StorageClass, where(v), name(v), v.Type)
+		}
+	} // for
+
+	if len(s.Resources) > 0 {
+		fmt.Fprintf(bw, "\nResources\n")
+		for _, v := range s.Resources {
+			fmt.Fprintf(bw, "  %-16v %-14v %-24v %v\n", v.StorageClass, where(v), name(v), v.Type)
+		}
+	}
+
+	return bw.Flush()
+}
+
+func list(s []string) string {
+	if 0 == len(s) {
+		return "none"
+	}
+	return strings.Join(s, ", ")
+}
+
+func name(v *spirv.Variable) string {
+	if "" != v.Name {
+		return v.Name
+	}
+	return fmt.Sprintf("%%%v", v.ID)
+}
+
+func where(v *spirv.Variable) string {
+	switch {
+	case v.Location >= 0:
+		return fmt.Sprintf("location %v", v.Location)
+	case v.Set >= 0 || v.Binding >= 0:
+		return fmt.Sprintf("set %v binding %v", v.Set, v.Binding)
+	case v.BuiltIn:
+		return "builtin"
+	case nil != v.Type && len(v.Type.Members) > 0 && v.Type.Members[0].BuiltIn:
+		return "builtin block"
+	}
+	return ""
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "spvdis:", err)
+	os.Exit(1)
+}
@@ -0,0 +1,168 @@
+// Command vkreport prints what the installed Vulkan implementation offers,
+// in the spirit of vulkaninfo. It does not open a window and works with
+// any installed ICD, including software ones.
+//
+//	vkreport                     text report of the instance and every device
+//	vkreport -device 1           only the second device
+//	vkreport -json -o gpu.json   the same as JSON, stable across runs
+//	vkreport -formats=false      leave out the format support tables
+//
+// With a Vulkan Profiles JSON file it checks the devices against a profile,
+// or exports the capabilities of a device as a profile:
+//
+//	vkreport -check baseline.json -profile VP_GAME_baseline
+//	vkreport -device 0 -export -profile VP_GAME_mygpu -o mygpu.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"example.com/vk_tutor/bootstrap"
+	"example.com/vk_tutor/profiles"
+	"example.com/vk_tutor/selector"
+	"example.com/vk_tutor/vulkan"
+)
+
+func main() {
+
+	var asJSON = flag.Bool("json", false, "print JSON instead of text")
+	var out = flag.String("o", "", "output file, default stdout")
+	var device = flag.Int("device", -1, "only report the device with this index")
+	var formats = flag.Bool("formats", true, "include format support")
+	var check = flag.String("check", "", "check the devices against a Vulkan Profiles JSON file")
+	var export = flag.Bool("export", false, "write a Vulkan Profiles JSON file of the device instead of the report")
+	var profile = flag.String("profile", "", "profile of -check, needed when the file has several, or name of the -export one")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: vkreport [flags]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if 0 != flag.NArg() {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var instance, err = bootstrap.NewInstanceBuilder().
+		AppName("vkreport", vulkan.VK_MAKE_VERSION(1, 0, 0)).
+		DesireAPIVersion(vulkan.VK_API_VERSION_1_3).
+		RequestExtensions(vulkan.VK_KHR_PORTABILITY_ENUMERATION_EXTENSION_NAME).
+		Build()
+	if nil != err {
+		fatal(err)
+	}
+	defer instance.Destroy()
+
+	// No surface, so no presentation support is queried
+	var surface vulkan.VkSurfaceKHR
+
+	devices, err := selector.Enumerate(instance.Handle, surface)
+	if nil != err {
+		fatal(err)
+	}
+
+	var selected []*selector.Device
+	for _, d := range devices {
+		if *device < 0 || *device == d.Index {
+			selected = append(selected, d)
+		}
+	}
+
+	if *device >= 0 && 0 == len(selected) {
+		fatal(fmt.Errorf("no device %v, found %v", *device, len(devices)))
+	}
+
+	var w io.Writer = os.Stdout
+	if "" != *out {
+		var f *os.File
+		if f, err = os.Create(*out); nil != err {
+			fatal(err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	switch {
+
+	case "" != *check:
+		var ok bool
+		if ok, err = checkProfile(w, *check, *profile, selected); nil == err && !ok {
+			os.Exit(1)
+		}
+
+	case *export:
+		if 1 != len(selected) {
+			fatal(fmt.Errorf("-export needs a single device, choose one with -device"))
+		}
+		var name = *profile
+		if "" == name {
+			name = "VP_VKREPORT_device"
+		}
+		err = profiles.FromDevice(selected[0], name).Write(w)
+
+	default:
+		var r *Report
+		if r, err = collectInstance(); nil != err {
+			fatal(err)
+		}
+		for _, d := range selected {
+			r.Devices = append(r.Devices, collectDevice(d, *formats))
+		}
+		if *asJSON {
+			var enc = json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			err = enc.Encode(r)
+		} else {
+			err = writeText(w, r)
+		}
+	} // switch
+
+	if nil != err {
+		fatal(err)
+	}
+}
+
+// checkProfile prints whether each device meets the profile, ok when all
+// do.
+func checkProfile(w io.Writer, path, name string, devices []*selector.Device) (bool, error) {
+
+	var f, err = profiles.LoadFile(path)
+	if nil != err {
+		return false, err
+	}
+
+	p, err := f.Profile(name)
+	if nil != err {
+		return false, err
+	}
+
+	for _, s := range p.Ignored {
+		fmt.Fprintf(w, "not checked: %v\n", s)
+	}
+
+	var ok = true
+
+	for _, d := range devices {
+		var failures = p.Check(d)
+		if 0 == len(failures) {
+			fmt.Fprintf(w, "%v: meets %v\n", d, p.Name)
+			continue
+		}
+		ok = false
+		fmt.Fprintf(w, "%v: does not meet %v\n", d, p.Name)
+		for _, f := range failures {
+			fmt.Fprintf(w, "\t%v\n", f)
+		}
+	} // for
+
+	return ok, nil
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "vkreport:", err)
+	os.Exit(1)
+}
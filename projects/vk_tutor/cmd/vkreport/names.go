@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"example.com/vk_tutor/vulkan"
+)
+
+type flagBit struct {
+	bit  uint32
+	name string
+}
+
+// flagNames lists the names of the bits set in flags, unknown bits in hex.
+func flagNames(flags uint32, bits []flagBit) []string {
+
+	var r = []string{}
+	for _, b := range bits {
+		if 0 != flags&b.bit {
+			r = append(r, b.name)
+			flags &^= b.bit
+		}
+	}
+
+	if 0 != flags {
+		r = append(r, fmt.Sprintf("0x%x", flags))
+	}
+
+	return r
+}
+
+func joinFlags(names []string) string {
+	if 0 == len(names) {
+		return "none"
+	}
+	return strings.Join(names, " | ")
+}
+
+var memoryHeapFlagBits = []flagBit{
+	{uint32(vulkan.VK_MEMORY_HEAP_DEVICE_LOCAL_BIT), "VK_MEMORY_HEAP_DEVICE_LOCAL_BIT"},
+	{uint32(vulkan.VK_MEMORY_HEAP_MULTI_INSTANCE_BIT), "VK_MEMORY_HEAP_MULTI_INSTANCE_BIT"},
+}
+
+var memoryPropertyFlagBits = []flagBit{
+	{uint32(vulkan.VK_MEMORY_PROPERTY_DEVICE_LOCAL_BIT), "VK_MEMORY_PROPERTY_DEVICE_LOCAL_BIT"},
+	{uint32(vulkan.VK_MEMORY_PROPERTY_HOST_VISIBLE_BIT), "VK_MEMORY_PROPERTY_HOST_VISIBLE_BIT"},
+	{uint32(vulkan.VK_MEMORY_PROPERTY_HOST_COHERENT_BIT), "VK_MEMORY_PROPERTY_HOST_COHERENT_BIT"},
+	{uint32(vulkan.VK_MEMORY_PROPERTY_HOST_CACHED_BIT), "VK_MEMORY_PROPERTY_HOST_CACHED_BIT"},
+	{uint32(vulkan.VK_MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT), "VK_MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT"},
+	{uint32(vulkan.VK_MEMORY_PROPERTY_PROTECTED_BIT), "VK_MEMORY_PROPERTY_PROTECTED_BIT"},
+	{uint32(vulkan.VK_MEMORY_PROPERTY_DEVICE_COHERENT_BIT_AMD), "VK_MEMORY_PROPERTY_DEVICE_COHERENT_BIT_AMD"},
+	{uint32(vulkan.VK_MEMORY_PROPERTY_DEVICE_UNCACHED_BIT_AMD), "VK_MEMORY_PROPERTY_DEVICE_UNCACHED_BIT_AMD"},
+	{uint32(vulkan.VK_MEMORY_PROPERTY_RDMA_CAPABLE_BIT_NV), "VK_MEMORY_PROPERTY_RDMA_CAPABLE_BIT_NV"},
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+
+	"example.com/vk_tutor/profiles"
+	"example.com/vk_tutor/selector"
+	"example.com/vk_tutor/vulkan"
+)
+
+// Report is everything printed. Lists are sorted or in Vulkan order, so the
+// JSON of the same system does not change between runs.
+type Report struct {
+	APIVersion string       `json:"apiVersion"` // Of the loader
+	Layers     []Layer      `json:"layers"`
+	Extensions []Extension  `json:"extensions"`
+	Devices    []DeviceInfo `json:"devices"`
+}
+
+type Layer struct {
+	Name                  string      `json:"name"`
+	Description           string      `json:"description"`
+	SpecVersion           string      `json:"specVersion"`
+	ImplementationVersion uint32      `json:"implementationVersion"`
+	Extensions            []Extension `json:"extensions"`
+}
+
+type Extension struct {
+	Name        string `json:"name"`
+	SpecVersion uint32 `json:"specVersion"`
+}
+
+type DeviceInfo struct {
+	Index             int           `json:"index"`
+	Name              string        `json:"name"`
+	Type              string        `json:"type"`
+	APIVersion        string        `json:"apiVersion"`
+	DriverVersion     uint32        `json:"driverVersion"`
+	DriverVersionName string        `json:"driverVersionName"` // Decoded the vendor's way
+	VendorID          uint32        `json:"vendorID"`
+	Vendor            string        `json:"vendor"`
+	DeviceID          uint32        `json:"deviceID"`
+	PipelineCacheUUID string        `json:"pipelineCacheUUID"`
+	Limits            Fields        `json:"limits"`
+	SparseProperties  Fields        `json:"sparseProperties"`
+	Features          Fields        `json:"features"`
+	Extensions        []Extension   `json:"extensions"`
+	QueueFamilies     []QueueFamily `json:"queueFamilies"`
+	MemoryHeaps       []MemoryHeap  `json:"memoryHeaps"`
+	MemoryTypes       []MemoryType  `json:"memoryTypes"`
+	Formats           []Format      `json:"formats,omitempty"` // Supported ones only
+}
+
+type QueueFamily struct {
+	Index                       int       `json:"index"`
+	Flags                       []string  `json:"flags"`
+	QueueCount                  uint32    `json:"queueCount"`
+	TimestampValidBits          uint32    `json:"timestampValidBits"`
+	MinImageTransferGranularity [3]uint32 `json:"minImageTransferGranularity"`
+}
+
+type MemoryHeap struct {
+	Index int      `json:"index"`
+	Size  uint64   `json:"size"`
+	Flags []string `json:"flags"`
+}
+
+type MemoryType struct {
+	Index     int      `json:"index"`
+	HeapIndex uint32   `json:"heapIndex"`
+	Flags     []string `json:"flags"`
+}
+
+type Format struct {
+	Name    string   `json:"name"`
+	Linear  []string `json:"linearTiling"`
+	Optimal []string `json:"optimalTiling"`
+	Buffer  []string `json:"buffer"`
+}
+
+// Field is a member of a Vulkan struct, named as in the specification.
+type Field struct {
+	Name  string
+	Value interface{}
+}
+
+// Fields keep the order of the struct, in text and JSON.
+type Fields []Field
+
+func (o Fields) MarshalJSON() ([]byte, error) {
+
+	var b bytes.Buffer
+	b.WriteByte('{')
+
+	for i, f := range o {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		var k, err = json.Marshal(f.Name)
+		if nil != err {
+			return nil, err
+		}
+		v, err := json.Marshal(f.Value)
+		if nil != err {
+			return nil, err
+		}
+		b.Write(k)
+		b.WriteByte(':')
+		b.Write(v)
+	} // for
+
+	b.WriteByte('}')
+
+	return b.Bytes(), nil
+}
+
+func collectInstance() (*Report, error) {
+
+	var r = &Report{Layers: []Layer{}, Devices: []DeviceInfo{}}
+
+	var version uint32
+	if res := vulkan.VkEnumerateInstanceVersion(&version); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkEnumerateInstanceVersion failed: %v", res)
+	}
+	r.APIVersion = vulkan.Version(version).String()
+
+	var cnt uint32
+	if res := vulkan.VkEnumerateInstanceLayerProperties(&cnt, nil); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkEnumerateInstanceLayerProperties failed: %v", res)
+	}
+
+	var props = make([]vulkan.VkLayerProperties, cnt)
+	if res := vulkan.VkEnumerateInstanceLayerProperties(&cnt, props); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkEnumerateInstanceLayerProperties failed: %v", res)
+	}
+
+	var err error
+
+	if r.Extensions, err = instanceExtensions(nil); nil != err {
+		return nil, err
+	}
+
+	for _, p := range props[:cnt] {
+
+		var l = Layer{
+			Name:                  p.LayerName,
+			Description:           p.Description,
+			SpecVersion:           vulkan.Version(p.SpecVersion).String(),
+			ImplementationVersion: p.ImplementationVersion,
+		}
+
+		var name = p.LayerName
+		if l.Extensions, err = instanceExtensions(&name); nil != err {
+			return nil, err
+		}
+
+		r.Layers = append(r.Layers, l)
+	} // for
+
+	sort.Slice(r.Layers, func(i, j int) bool { return r.Layers[i].Name < r.Layers[j].Name })
+
+	return r, nil
+}
+
+func instanceExtensions(layer *string) ([]Extension, error) {
+
+	var cnt uint32
+	if res := vulkan.VkEnumerateInstanceExtensionProperties(layer, &cnt, nil); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkEnumerateInstanceExtensionProperties failed: %v", res)
+	}
+
+	var props = make([]vulkan.VkExtensionProperties, cnt)
+	if res := vulkan.VkEnumerateInstanceExtensionProperties(layer, &cnt, props); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkEnumerateInstanceExtensionProperties failed: %v", res)
+	}
+
+	var r = []Extension{}
+	for _, p := range props[:cnt] {
+		r = append(r, Extension{p.ExtensionName, p.SpecVersion})
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i].Name < r[j].Name })
+
+	return r, nil
+}
+
+func collectDevice(d *selector.Device, formats bool) DeviceInfo {
+
+	var p = &d.Properties
+
+	var r = DeviceInfo{
+		Index:             d.Index,
+		Name:              p.DeviceName,
+		Type:              selector.DeviceTypeName(p.DeviceType),
+		APIVersion:        vulkan.Version(p.ApiVersion).String(),
+		DriverVersion:     p.DriverVersion,
+		DriverVersionName: vulkan.DriverVersionString(p.VendorID, p.DriverVersion),
+		VendorID:          p.VendorID,
+		Vendor:            vulkan.VendorName(p.VendorID),
+		DeviceID:          p.DeviceID,
+		PipelineCacheUUID: hex.EncodeToString(p.PipelineCacheUUID[:]),
+		Limits:            fields(&p.Limits),
+		SparseProperties:  fields(&p.SparseProperties),
+		Features:          fields(&d.Features),
+		Extensions:        []Extension{},
+	}
+
+	for name, v := range d.Extensions {
+		r.Extensions = append(r.Extensions, Extension{name, v})
+	}
+	sort.Slice(r.Extensions, func(i, j int) bool { return r.Extensions[i].Name < r.Extensions[j].Name })
+
+	for i, q := range d.QueueFamilies {
+		var g = q.MinImageTransferGranularity
+		r.QueueFamilies = append(r.QueueFamilies, QueueFamily{
+			Index:                       i,
+			Flags:                       profiles.QueueFlagNames(q.QueueFlags),
+			QueueCount:                  q.QueueCount,
+			TimestampValidBits:          q.TimestampValidBits,
+			MinImageTransferGranularity: [3]uint32{g.Width, g.Height, g.Depth},
+		})
+	} // for
+
+	var mem vulkan.VkPhysicalDeviceMemoryProperties
+	vulkan.VkGetPhysicalDeviceMemoryProperties(d.Handle, &mem)
+
+	for i, h := range mem.MemoryHeaps[:mem.MemoryHeapCount] {
+		r.MemoryHeaps = append(r.MemoryHeaps, MemoryHeap{i, uint64(h.Size), flagNames(uint32(h.Flags), memoryHeapFlagBits)})
+	}
+	for i, t := range mem.MemoryTypes[:mem.MemoryTypeCount] {
+		r.MemoryTypes = append(r.MemoryTypes, MemoryType{i, t.HeapIndex, flagNames(uint32(t.PropertyFlags), memoryPropertyFlagBits)})
+	}
+
+	if !formats {
+		return r
+	}
+
+	for _, f := range profiles.Formats() {
+		var fp vulkan.VkFormatProperties
+		vulkan.VkGetPhysicalDeviceFormatProperties(d.Handle, f, &fp)
+		if 0 == fp.LinearTilingFeatures|fp.OptimalTilingFeatures|fp.BufferFeatures {
+			continue
+		}
+		r.Formats = append(r.Formats, Format{
+			Name:    profiles.FormatName(f),
+			Linear:  profiles.FormatFeatureNames(fp.LinearTilingFeatures),
+			Optimal: profiles.FormatFeatureNames(fp.OptimalTilingFeatures),
+			Buffer:  profiles.FormatFeatureNames(fp.BufferFeatures),
+		})
+	} // for
+
+	return r
+}
+
+var sampleCountType = reflect.TypeOf(vulkan.VkSampleCountFlags(0))
+
+// fields lists the members of the struct p points to. Sample count masks
+// are decoded to the list of counts.
+func fields(p interface{}) Fields {
+
+	var r Fields
+	var v = reflect.ValueOf(p).Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+
+		var f = v.Field(i)
+		var value interface{}
+
+		switch {
+		case sampleCountType == f.Type():
+			var counts = []int{}
+			for bits := uint32(f.Uint()); 0 != bits; bits &= bits - 1 {
+				counts = append(counts, int(bits&-bits))
+			}
+			value = counts
+		case reflect.Uint64 == f.Kind():
+			value = f.Uint() // VkDeviceSize
+		default:
+			value = f.Interface()
+		} // switch
+
+		r = append(r, Field{specName(v.Type().Field(i).Name), value})
+	} // for
+
+	return r
+}
+
+// specName turns a Go field name back into the member name, e.g.
+// MaxImageDimension1D into maxImageDimension1D.
+func specName(s string) string {
+	var c, n = utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(c)) + s[n:]
+}
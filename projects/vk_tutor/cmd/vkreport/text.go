@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+func writeText(w io.Writer, r *Report) error {
+
+	var b = bufio.NewWriter(w)
+
+	fmt.Fprintf(b, "Vulkan instance version: %v\n\n", r.APIVersion)
+
+	fmt.Fprintf(b, "Instance extensions (%v):\n", len(r.Extensions))
+	writeExtensions(b, r.Extensions, "\t")
+
+	fmt.Fprintf(b, "\nLayers (%v):\n", len(r.Layers))
+	for _, l := range r.Layers {
+		fmt.Fprintf(b, "\t%v (%v) Vulkan %v, layer version %v\n", l.Name, l.Description, l.SpecVersion, l.ImplementationVersion)
+		writeExtensions(b, l.Extensions, "\t\t")
+	}
+
+	for i := range r.Devices {
+		writeDevice(b, &r.Devices[i])
+	}
+
+	return b.Flush()
+}
+
+func writeDevice(b *bufio.Writer, d *DeviceInfo) {
+
+	var title = fmt.Sprintf("GPU%v: %v", d.Index, d.Name)
+	fmt.Fprintf(b, "\n%v\n%v\n", title, strings.Repeat("=", len(title)))
+
+	fmt.Fprintf(b, "\nProperties:\n")
+	fmt.Fprintf(b, "\tapiVersion        = %v\n", d.APIVersion)
+	fmt.Fprintf(b, "\tdriverVersion     = %v (0x%08x)\n", d.DriverVersionName, d.DriverVersion)
+	fmt.Fprintf(b, "\tvendorID          = 0x%04x (%v)\n", d.VendorID, d.Vendor)
+	fmt.Fprintf(b, "\tdeviceID          = 0x%04x\n", d.DeviceID)
+	fmt.Fprintf(b, "\tdeviceType        = %v\n", d.Type)
+	fmt.Fprintf(b, "\tpipelineCacheUUID = %v\n", d.PipelineCacheUUID)
+
+	fmt.Fprintf(b, "\nLimits:\n")
+	writeFields(b, d.Limits)
+
+	fmt.Fprintf(b, "\nSparse properties:\n")
+	writeFields(b, d.SparseProperties)
+
+	fmt.Fprintf(b, "\nFeatures:\n")
+	writeFields(b, d.Features)
+
+	fmt.Fprintf(b, "\nDevice extensions (%v):\n", len(d.Extensions))
+	writeExtensions(b, d.Extensions, "\t")
+
+	fmt.Fprintf(b, "\nQueue families (%v):\n", len(d.QueueFamilies))
+	for _, q := range d.QueueFamilies {
+		var g = q.MinImageTransferGranularity
+		fmt.Fprintf(b, "\t%v: %v queues, %v timestamp bits, granularity %vx%vx%v\n", q.Index, q.QueueCount, q.TimestampValidBits, g[0], g[1], g[2])
+		fmt.Fprintf(b, "\t\t%v\n", joinFlags(q.Flags))
+	}
+
+	fmt.Fprintf(b, "\nMemory heaps (%v):\n", len(d.MemoryHeaps))
+	for _, h := range d.MemoryHeaps {
+		fmt.Fprintf(b, "\t%v: %v, %v\n", h.Index, sizeString(h.Size), joinFlags(h.Flags))
+	}
+
+	fmt.Fprintf(b, "\nMemory types (%v):\n", len(d.MemoryTypes))
+	for _, t := range d.MemoryTypes {
+		fmt.Fprintf(b, "\t%v: heap %v, %v\n", t.Index, t.HeapIndex, joinFlags(t.Flags))
+	}
+
+	if 0 == len(d.Formats) {
+		return
+	}
+
+	fmt.Fprintf(b, "\nFormats (%v supported):\n", len(d.Formats))
+	for _, f := range d.Formats {
+		fmt.Fprintf(b, "\t%v\n", f.Name)
+		fmt.Fprintf(b, "\t\tlinear:  %v\n", joinFlags(f.Linear))
+		fmt.Fprintf(b, "\t\toptimal: %v\n", joinFlags(f.Optimal))
+		fmt.Fprintf(b, "\t\tbuffer:  %v\n", joinFlags(f.Buffer))
+	}
+}
+
+func writeExtensions(b *bufio.Writer, exts []Extension, indent string) {
+	for _, e := range exts {
+		fmt.Fprintf(b, "%v%-50v revision %v\n", indent, e.Name, e.SpecVersion)
+	}
+}
+
+func writeFields(b *bufio.Writer, fields Fields) {
+
+	var width = 0
+	for _, f := range fields {
+		if len(f.Name) > width {
+			width = len(f.Name)
+		}
+	}
+
+	for _, f := range fields {
+		fmt.Fprintf(b, "\t%-*v = %v\n", width, f.Name, f.Value)
+	}
+}
+
+func sizeString(n uint64) string {
+
+	const unit = 1024
+
+	if n < unit {
+		return fmt.Sprintf("%v B", n)
+	}
+
+	var div, exp = uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.2f %ciB (%v)", float64(n)/float64(div), "KMGTPE"[exp], n)
+}
@@ -0,0 +1,39 @@
+// File helpers shared by the packages of the module
+package fsutil
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// WriteFileAtomic writes data to a temporary file next to path and renames
+// it over path, so readers see the old or the new content, never a part.
+// Missing directories are created.
+func WriteFileAtomic(path string, data []byte) error {
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); nil != err {
+		return err
+	}
+
+	var f, err = os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if nil != err {
+		return err
+	}
+
+	if _, err = f.Write(data); nil == err {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); nil == err {
+		err = cerr
+	}
+
+	if nil == err {
+		err = os.Rename(f.Name(), path)
+	}
+
+	if nil != err {
+		os.Remove(f.Name())
+	}
+
+	return err
+}
@@ -0,0 +1,29 @@
+package fsutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+
+	var dir = t.TempDir()
+	var path = filepath.Join(dir, "sub", "file")
+
+	for _, data := range []string{"first", "second"} {
+
+		if err := WriteFileAtomic(path, []byte(data)); nil != err {
+			t.Fatal(err)
+		}
+
+		if b, err := os.ReadFile(path); nil != err || data != string(b) {
+			t.Fatalf("read %q, %v, want %q", b, err, data)
+		}
+	} // for
+
+	// No temporary files are left
+	if entries, err := os.ReadDir(filepath.Dir(path)); nil != err || 1 != len(entries) {
+		t.Errorf("directory has %v entries, %v, want the file only", len(entries), err)
+	}
+}
@@ -0,0 +1,188 @@
+package layout
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"example.com/vk_tutor/spirv"
+)
+
+// FieldError is one difference between a Go type and a SPIR-V block.
+type FieldError struct {
+	Field  string // Go path as listed by Fields, e.g. "Lights[].Color"
+	Member string // SPIR-V path, e.g. "lights[].color"
+	Msg    string
+}
+
+func (o *FieldError) Error() string {
+	if "" == o.Field {
+		return fmt.Sprintf("layout: %v", o.Msg)
+	}
+	if "" == o.Member || o.Member == o.Field {
+		return fmt.Sprintf("layout: %v: %v", o.Field, o.Msg)
+	}
+	return fmt.Sprintf("layout: %v (%v): %v", o.Field, o.Member, o.Msg)
+}
+
+// Check compares the layout of v under the given rules with a block type from
+// SPIR-V reflection. It reports every mismatching field; the returned error
+// unwraps to a list of *FieldError.
+func Check(rules Rules, v any, block *spirv.Type) error {
+
+	var rv = reflect.Indirect(reflect.ValueOf(v))
+	if !rv.IsValid() {
+		return fmt.Errorf("layout: Check(nil)")
+	}
+
+	return CheckType(rules, rv.Type(), block)
+}
+
+// CheckType is Check for a reflect.Type.
+func CheckType(rules Rules, t reflect.Type, block *spirv.Type) error {
+
+	var n, err = nodeOf(rules, t)
+	if nil != err {
+		return err
+	}
+
+	if nil == block {
+		return fmt.Errorf("layout: %v: no SPIR-V block", t)
+	}
+
+	var c checker
+	c.compare(n, block, "", "")
+
+	return errors.Join(c.errs...)
+}
+
+type checker struct {
+	errs []error
+}
+
+func (o *checker) fail(field, member string, format string, a ...any) {
+	o.errs = append(o.errs, &FieldError{
+		Field:  field,
+		Member: member,
+		Msg:    fmt.Sprintf(format, a...),
+	})
+}
+
+func (o *checker) compare(n *node, t *spirv.Type, field, member string) {
+
+	switch n.kind {
+
+	case kindScalar:
+		o.compareScalar(n.typ, t, field, member)
+
+	case kindVector:
+		if spirv.KindVector != t.Kind {
+			o.fail(field, member, "Go %v, SPIR-V %v", n.typ, t)
+			return
+		}
+		if n.len != t.Len {
+			o.fail(field, member, "Go %v has %v components, SPIR-V %v has %v", n.typ, n.len, t, t.Len)
+			return
+		}
+		o.compareScalar(n.elem.typ, t.Elem, field, member)
+
+	case kindMatrix:
+		if spirv.KindMatrix != t.Kind {
+			o.fail(field, member, "Go %v, SPIR-V %v", n.typ, t)
+			return
+		}
+		if n.len != t.Len || n.elem.len != t.Elem.Len {
+			o.fail(field, member, "Go %v, SPIR-V %v", n.typ, t)
+			return
+		}
+		o.compareScalar(n.elem.elem.typ, t.Elem.Elem, field, member)
+
+	case kindArray, kindSlice:
+		var want = spirv.KindArray
+		if kindSlice == n.kind {
+			want = spirv.KindRuntimeArray
+		}
+		if want != t.Kind {
+			o.fail(field, member, "Go %v, SPIR-V %v", n.typ, t)
+			return
+		}
+		if kindArray == n.kind && n.len != t.Len {
+			o.fail(field, member, "Go array length %v, SPIR-V %v", n.len, t.Len)
+		}
+		if 0 != t.ArrayStride && n.stride != t.ArrayStride {
+			o.fail(field, member, "array stride %v in Go, %v in SPIR-V", n.stride, t.ArrayStride)
+		}
+		o.compare(n.elem, t.Elem, field+"[]", member+"[]")
+
+	case kindStruct:
+		if spirv.KindStruct != t.Kind {
+			o.fail(field, member, "Go %v, SPIR-V %v", n.typ, t)
+			return
+		}
+
+		if len(n.fields) != len(t.Members) {
+			o.fail(field, member, "Go struct has %v fields, SPIR-V block has %v members", len(n.fields), len(t.Members))
+		}
+
+		for i := 0; i < len(n.fields) && i < len(t.Members); i++ {
+
+			var f = &n.fields[i]
+			var m = &t.Members[i]
+
+			var field1 = join(field, f.name)
+			var member1 = join(member, m.Name)
+
+			if "" != f.glsl && "" != m.Name && f.glsl != m.Name {
+				o.fail(field1, member1, "tagged %q, SPIR-V member is %q", f.glsl, m.Name)
+			}
+
+			if m.Offset >= 0 && f.offset != m.Offset {
+				o.fail(field1, member1, "offset %v in Go, %v in SPIR-V", f.offset, m.Offset)
+			}
+
+			if kindMatrix == f.node.kind {
+				if m.RowMajor {
+					o.fail(field1, member1, "row_major matrices are not supported")
+				} else if 0 != m.MatrixStride && f.node.stride != m.MatrixStride {
+					o.fail(field1, member1, "matrix stride %v in Go, %v in SPIR-V", f.node.stride, m.MatrixStride)
+				}
+			}
+
+			o.compare(f.node, m.Type, field1, member1)
+		} // for
+	}
+}
+
+func (o *checker) compareScalar(g reflect.Type, t *spirv.Type, field, member string) {
+
+	var ok bool
+
+	switch g.Kind() {
+	case reflect.Float32:
+		ok = spirv.KindFloat == t.Kind && 32 == t.Width
+	case reflect.Float64:
+		ok = spirv.KindFloat == t.Kind && 64 == t.Width
+	case reflect.Int32:
+		ok = spirv.KindInt == t.Kind && 32 == t.Width && t.Signed
+	case reflect.Int64:
+		ok = spirv.KindInt == t.Kind && 64 == t.Width && t.Signed
+	case reflect.Uint32:
+		ok = spirv.KindInt == t.Kind && 32 == t.Width && !t.Signed
+	case reflect.Uint64:
+		ok = spirv.KindInt == t.Kind && 64 == t.Width && !t.Signed
+	case reflect.Bool:
+		// GLSL bool members are stored as 32-bit integers
+		ok = spirv.KindBool == t.Kind || (spirv.KindInt == t.Kind && 32 == t.Width)
+	}
+
+	if !ok {
+		o.fail(field, member, "Go %v, SPIR-V %v", g, t)
+	}
+}
+
+func join(path, name string) string {
+	if "" == path {
+		return name
+	}
+	return path + "." + name
+}
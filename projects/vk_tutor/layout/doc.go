@@ -0,0 +1,11 @@
+// Buffer layout encoding for GLSL std140, std430 and scalar blocks
+//
+// Go structs are laid out field by field following the block layout rules of
+// the Vulkan specification ("Offset and Stride Assignment"). GLSL vectors and
+// matrices are written with the named types of this package, e.g. Vec3 or
+// Mat4; plain Go arrays are GLSL arrays. Matrices are column major, Mat2x3 is
+// two columns of Vec3, as in GLSL.
+//
+// A slice is only accepted as the last field of the top level struct, where
+// it maps to a runtime-sized array of a storage buffer.
+package layout
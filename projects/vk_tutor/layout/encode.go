@@ -0,0 +1,171 @@
+package layout
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Marshal encodes v, a struct, array, slice or pointer to one of them, into a
+// new buffer laid out by the given rules. Padding bytes are zero.
+func Marshal(rules Rules, v any) ([]byte, error) {
+
+	var rv = reflect.Indirect(reflect.ValueOf(v))
+	if !rv.IsValid() {
+		return nil, fmt.Errorf("layout: Marshal(nil)")
+	}
+
+	var n, err = nodeOf(rules, rv.Type())
+	if nil != err {
+		return nil, err
+	}
+
+	var b = make([]byte, n.sizeOf(rv))
+	n.encode(b, 0, rv)
+
+	return b, nil
+}
+
+// MarshalTo encodes v into dst, which is typically a mapped buffer, and
+// returns the number of bytes written. Bytes between fields are left
+// unchanged.
+func MarshalTo(dst []byte, rules Rules, v any) (int, error) {
+
+	var rv = reflect.Indirect(reflect.ValueOf(v))
+	if !rv.IsValid() {
+		return 0, fmt.Errorf("layout: MarshalTo(nil)")
+	}
+
+	var n, err = nodeOf(rules, rv.Type())
+	if nil != err {
+		return 0, err
+	}
+
+	var size = n.sizeOf(rv)
+	if size > len(dst) {
+		return 0, fmt.Errorf("layout: %v needs %v bytes, destination has %v", rv.Type(), size, len(dst))
+	}
+
+	n.encode(dst, 0, rv)
+
+	return size, nil
+}
+
+// Unmarshal decodes data laid out by the given rules into v, which must be a
+// pointer. A trailing slice is resized to the number of whole elements left
+// in data.
+func Unmarshal(rules Rules, data []byte, v any) error {
+
+	var rv = reflect.ValueOf(v)
+	if reflect.Pointer != rv.Kind() || rv.IsNil() {
+		return fmt.Errorf("layout: Unmarshal(non-pointer %T)", v)
+	}
+	rv = rv.Elem()
+
+	var n, err = nodeOf(rules, rv.Type())
+	if nil != err {
+		return err
+	}
+
+	if n.size > len(data) {
+		return fmt.Errorf("layout: %v needs %v bytes, data has %v", rv.Type(), n.size, len(data))
+	}
+
+	n.decode(data, 0, rv)
+
+	return nil
+}
+
+func (o *node) encode(b []byte, offset int, v reflect.Value) {
+
+	switch o.kind {
+
+	case kindScalar:
+		switch v.Kind() {
+		case reflect.Float32:
+			binary.LittleEndian.PutUint32(b[offset:], math.Float32bits(float32(v.Float())))
+		case reflect.Float64:
+			binary.LittleEndian.PutUint64(b[offset:], math.Float64bits(v.Float()))
+		case reflect.Int32:
+			binary.LittleEndian.PutUint32(b[offset:], uint32(v.Int()))
+		case reflect.Int64:
+			binary.LittleEndian.PutUint64(b[offset:], uint64(v.Int()))
+		case reflect.Uint32:
+			binary.LittleEndian.PutUint32(b[offset:], uint32(v.Uint()))
+		case reflect.Uint64:
+			binary.LittleEndian.PutUint64(b[offset:], v.Uint())
+		case reflect.Bool:
+			var u uint32
+			if v.Bool() {
+				u = 1
+			}
+			binary.LittleEndian.PutUint32(b[offset:], u)
+		}
+
+	case kindVector:
+		for i := 0; i < o.len; i++ {
+			o.elem.encode(b, offset+i*o.elem.size, v.Index(i))
+		}
+
+	case kindMatrix, kindArray, kindSlice:
+		for i := 0; i < v.Len(); i++ {
+			o.elem.encode(b, offset+i*o.stride, v.Index(i))
+		}
+
+	case kindStruct:
+		for _, f := range o.fields {
+			f.node.encode(b, offset+f.offset, v.Field(f.index))
+		}
+	}
+}
+
+func (o *node) decode(b []byte, offset int, v reflect.Value) {
+
+	switch o.kind {
+
+	case kindScalar:
+		switch v.Kind() {
+		case reflect.Float32:
+			v.SetFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(b[offset:]))))
+		case reflect.Float64:
+			v.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(b[offset:])))
+		case reflect.Int32:
+			v.SetInt(int64(int32(binary.LittleEndian.Uint32(b[offset:]))))
+		case reflect.Int64:
+			v.SetInt(int64(binary.LittleEndian.Uint64(b[offset:])))
+		case reflect.Uint32:
+			v.SetUint(uint64(binary.LittleEndian.Uint32(b[offset:])))
+		case reflect.Uint64:
+			v.SetUint(binary.LittleEndian.Uint64(b[offset:]))
+		case reflect.Bool:
+			v.SetBool(0 != binary.LittleEndian.Uint32(b[offset:]))
+		}
+
+	case kindVector:
+		for i := 0; i < o.len; i++ {
+			o.elem.decode(b, offset+i*o.elem.size, v.Index(i))
+		}
+
+	case kindMatrix, kindArray:
+		for i := 0; i < v.Len(); i++ {
+			o.elem.decode(b, offset+i*o.stride, v.Index(i))
+		}
+
+	case kindSlice:
+		// The last element only needs its own size, not a full stride
+		var cnt = 0
+		if len(b) >= offset+o.elem.size {
+			cnt = (len(b)-offset-o.elem.size)/o.stride + 1
+		}
+		v.Set(reflect.MakeSlice(v.Type(), cnt, cnt))
+		for i := 0; i < cnt; i++ {
+			o.elem.decode(b, offset+i*o.stride, v.Index(i))
+		}
+
+	case kindStruct:
+		for _, f := range o.fields {
+			f.node.decode(b, offset+f.offset, v.Field(f.index))
+		}
+	}
+}
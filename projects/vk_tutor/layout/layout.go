@@ -0,0 +1,366 @@
+package layout
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Rules selects the block layout.
+type Rules int
+
+const (
+	Std140 Rules = iota // Uniform buffers
+	Std430              // Storage buffers and push constants
+	Scalar              // VK_EXT_scalar_block_layout, core in Vulkan 1.2
+)
+
+func (o Rules) String() string {
+	switch o {
+	case Std140:
+		return "std140"
+	case Std430:
+		return "std430"
+	case Scalar:
+		return "scalar"
+	}
+	return fmt.Sprintf("Rules(%d)", int(o))
+}
+
+// GLSL vector types
+type (
+	Vec2 [2]float32
+	Vec3 [3]float32
+	Vec4 [4]float32
+
+	DVec2 [2]float64
+	DVec3 [3]float64
+	DVec4 [4]float64
+
+	IVec2 [2]int32
+	IVec3 [3]int32
+	IVec4 [4]int32
+
+	UVec2 [2]uint32
+	UVec3 [3]uint32
+	UVec4 [4]uint32
+)
+
+// GLSL matrix types, MatCxR has C columns and R rows
+type (
+	Mat2 [2]Vec2
+	Mat3 [3]Vec3
+	Mat4 [4]Vec4
+
+	Mat2x3 [2]Vec3
+	Mat2x4 [2]Vec4
+	Mat3x2 [3]Vec2
+	Mat3x4 [3]Vec4
+	Mat4x2 [4]Vec2
+	Mat4x3 [4]Vec3
+)
+
+var vectorTypes = map[reflect.Type]bool{
+	reflect.TypeOf(Vec2{}):  true,
+	reflect.TypeOf(Vec3{}):  true,
+	reflect.TypeOf(Vec4{}):  true,
+	reflect.TypeOf(DVec2{}): true,
+	reflect.TypeOf(DVec3{}): true,
+	reflect.TypeOf(DVec4{}): true,
+	reflect.TypeOf(IVec2{}): true,
+	reflect.TypeOf(IVec3{}): true,
+	reflect.TypeOf(IVec4{}): true,
+	reflect.TypeOf(UVec2{}): true,
+	reflect.TypeOf(UVec3{}): true,
+	reflect.TypeOf(UVec4{}): true,
+}
+
+var matrixTypes = map[reflect.Type]bool{
+	reflect.TypeOf(Mat2{}):   true,
+	reflect.TypeOf(Mat3{}):   true,
+	reflect.TypeOf(Mat4{}):   true,
+	reflect.TypeOf(Mat2x3{}): true,
+	reflect.TypeOf(Mat2x4{}): true,
+	reflect.TypeOf(Mat3x2{}): true,
+	reflect.TypeOf(Mat3x4{}): true,
+	reflect.TypeOf(Mat4x2{}): true,
+	reflect.TypeOf(Mat4x3{}): true,
+}
+
+type nodeKind int
+
+const (
+	kindScalar nodeKind = iota
+	kindVector
+	kindMatrix
+	kindArray
+	kindSlice
+	kindStruct
+)
+
+// node is the layout of one Go type under one set of rules.
+type node struct {
+	kind   nodeKind
+	typ    reflect.Type
+	size   int // Excludes a trailing runtime array
+	align  int
+	len    int   // Vector components, matrix columns, array length
+	elem   *node // Vector component, matrix column, array element
+	stride int   // Array stride, matrix column stride
+	fields []field
+}
+
+type field struct {
+	name   string // Go field name
+	glsl   string // Block member name from the layout tag, may be empty
+	index  int
+	offset int
+	node   *node
+}
+
+// Field is the placement of one struct field, as reported by Fields.
+type Field struct {
+	Path   string // Go path, e.g. "Lights[].Color"
+	Offset int
+	Size   int
+	Stride int // Array or matrix column stride, 0 otherwise
+}
+
+type cacheKey struct {
+	rules Rules
+	typ   reflect.Type
+}
+
+var cache sync.Map // cacheKey -> *node
+
+func roundUp(n, align int) int {
+	return (n + align - 1) / align * align
+}
+
+func nodeOf(rules Rules, t reflect.Type) (*node, error) {
+
+	if Std140 != rules && Std430 != rules && Scalar != rules {
+		return nil, fmt.Errorf("layout: unknown rules %v", rules)
+	}
+
+	var key = cacheKey{rules, t}
+	if n, ok := cache.Load(key); ok {
+		return n.(*node), nil
+	}
+
+	var n, err = build(rules, t, t.String(), true)
+	if nil != err {
+		return nil, err
+	}
+
+	cache.Store(key, n)
+	return n, nil
+}
+
+func build(rules Rules, t reflect.Type, path string, top bool) (*node, error) {
+
+	if vectorTypes[t] {
+		var comp, _ = build(rules, t.Elem(), path, false)
+		var n = &node{kind: kindVector, typ: t, len: t.Len(), elem: comp}
+		n.size = n.len * comp.size
+		switch {
+		case Scalar == rules:
+			n.align = comp.align
+		case 2 == n.len:
+			n.align = 2 * comp.size
+		default:
+			n.align = 4 * comp.size
+		}
+		return n, nil
+	}
+
+	if matrixTypes[t] {
+		var col, _ = build(rules, t.Elem(), path, false)
+		var n = &node{kind: kindMatrix, typ: t, len: t.Len(), elem: col}
+		switch rules {
+		case Std140:
+			n.align = roundUp(col.align, 16)
+			n.stride = n.align
+		case Std430:
+			n.align = col.align
+			n.stride = col.align
+		case Scalar:
+			n.align = col.align
+			n.stride = col.size
+		}
+		n.size = n.len * n.stride
+		return n, nil
+	}
+
+	switch t.Kind() {
+
+	case reflect.Float32, reflect.Int32, reflect.Uint32, reflect.Bool:
+		return &node{kind: kindScalar, typ: t, size: 4, align: 4}, nil
+
+	case reflect.Float64, reflect.Int64, reflect.Uint64:
+		return &node{kind: kindScalar, typ: t, size: 8, align: 8}, nil
+
+	case reflect.Array, reflect.Slice:
+
+		if reflect.Slice == t.Kind() && !top {
+			return nil, fmt.Errorf("layout: %v: slice is only allowed as the last field of the block", path)
+		}
+
+		var elem, err = build(rules, t.Elem(), path+"[]", false)
+		if nil != err {
+			return nil, err
+		}
+
+		var n = &node{kind: kindArray, typ: t, elem: elem}
+		switch rules {
+		case Std140:
+			n.align = roundUp(elem.align, 16)
+			n.stride = roundUp(elem.size, n.align)
+		case Std430:
+			n.align = elem.align
+			n.stride = roundUp(elem.size, elem.align)
+		case Scalar:
+			n.align = elem.align
+			n.stride = elem.size
+		}
+
+		if reflect.Slice == t.Kind() {
+			n.kind = kindSlice
+		} else {
+			n.len = t.Len()
+			n.size = n.len * n.stride
+		}
+
+		return n, nil
+
+	case reflect.Struct:
+
+		var n = &node{kind: kindStruct, typ: t, align: 1}
+		var offset = 0
+
+		for i := 0; i < t.NumField(); i++ {
+
+			var sf = t.Field(i)
+			var tag = sf.Tag.Get("layout")
+
+			if !sf.IsExported() || "-" == tag {
+				continue
+			}
+
+			// Only the block itself may end in a runtime array
+			var last = i == t.NumField()-1 && reflect.Slice == sf.Type.Kind()
+			var f, err = build(rules, sf.Type, path+"."+sf.Name, top && last)
+			if nil != err {
+				return nil, err
+			}
+
+			offset = roundUp(offset, f.align)
+			n.fields = append(n.fields, field{
+				name:   sf.Name,
+				glsl:   tag,
+				index:  i,
+				offset: offset,
+				node:   f,
+			})
+			offset += f.size
+
+			if f.align > n.align {
+				n.align = f.align
+			}
+		} // for
+
+		if 0 == len(n.fields) {
+			return nil, fmt.Errorf("layout: %v: struct has no exported fields", path)
+		}
+
+		if Std140 == rules {
+			n.align = roundUp(n.align, 16)
+		}
+
+		n.size = roundUp(offset, n.align)
+		return n, nil
+	}
+
+	return nil, fmt.Errorf("layout: %v: unsupported Go type %v", path, t)
+}
+
+// Sizeof returns the size in bytes of v under the given rules. A trailing
+// runtime array is counted with its current length.
+func Sizeof(rules Rules, v any) (int, error) {
+
+	var rv = reflect.Indirect(reflect.ValueOf(v))
+	if !rv.IsValid() {
+		return 0, fmt.Errorf("layout: Sizeof(nil)")
+	}
+
+	var n, err = nodeOf(rules, rv.Type())
+	if nil != err {
+		return 0, err
+	}
+
+	return n.sizeOf(rv), nil
+}
+
+func (o *node) sizeOf(v reflect.Value) int {
+
+	switch o.kind {
+
+	case kindSlice:
+		return v.Len() * o.stride
+
+	case kindStruct:
+		if 0 == len(o.fields) {
+			return o.size
+		}
+		var last = &o.fields[len(o.fields)-1]
+		if kindSlice == last.node.kind {
+			return last.offset + last.node.sizeOf(v.Field(last.index))
+		}
+	}
+
+	return o.size
+}
+
+// Fields lists the placement of every leaf field of t under the given rules.
+// Array elements are listed once, at the offsets of the first element.
+func Fields(rules Rules, t reflect.Type) ([]Field, error) {
+
+	var n, err = nodeOf(rules, t)
+	if nil != err {
+		return nil, err
+	}
+
+	var r []Field
+	n.walk("", 0, func(path string, offset int, n *node) {
+		r = append(r, Field{
+			Path:   strings.TrimPrefix(path, "."),
+			Offset: offset,
+			Size:   n.size,
+			Stride: n.stride,
+		})
+	})
+
+	return r, nil
+}
+
+func (o *node) walk(path string, offset int, f func(string, int, *node)) {
+
+	switch o.kind {
+
+	case kindStruct:
+		for _, fd := range o.fields {
+			fd.node.walk(path+"."+fd.name, offset+fd.offset, f)
+		}
+
+	case kindArray, kindSlice:
+		if kindStruct == o.elem.kind {
+			o.elem.walk(path+"[]", offset, f)
+		} else {
+			f(path, offset, o)
+		}
+
+	default:
+		f(path, offset, o)
+	}
+}
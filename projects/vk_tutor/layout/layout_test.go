@@ -0,0 +1,220 @@
+package layout
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"example.com/vk_tutor/spirv"
+)
+
+type testLight struct {
+	Position Vec3
+	Color    Vec3
+}
+
+type testBlock struct {
+	Count  int32
+	Lights [2]testLight
+}
+
+type testUniforms struct {
+	A float32
+	B Vec3
+	C float32
+	M Mat3
+	F [3]float32
+	V Vec2
+	D []Vec3
+}
+
+func TestFields(t *testing.T) {
+
+	for _, c := range []struct {
+		rules Rules
+		typ   reflect.Type
+		want  []Field
+	}{
+		{Std140, reflect.TypeOf(testUniforms{}), []Field{
+			{"A", 0, 4, 0},
+			{"B", 16, 12, 0},
+			{"C", 28, 4, 0},
+			{"M", 32, 48, 16},
+			{"F", 80, 48, 16},
+			{"V", 128, 8, 0},
+			{"D", 144, 0, 16},
+		}},
+		{Std430, reflect.TypeOf(testUniforms{}), []Field{
+			{"A", 0, 4, 0},
+			{"B", 16, 12, 0},
+			{"C", 28, 4, 0},
+			{"M", 32, 48, 16},
+			{"F", 80, 12, 4},
+			{"V", 96, 8, 0},
+			{"D", 112, 0, 16},
+		}},
+		{Scalar, reflect.TypeOf(testUniforms{}), []Field{
+			{"A", 0, 4, 0},
+			{"B", 4, 12, 0},
+			{"C", 16, 4, 0},
+			{"M", 20, 36, 12},
+			{"F", 56, 12, 4},
+			{"V", 68, 8, 0},
+			{"D", 76, 0, 12},
+		}},
+		{Std140, reflect.TypeOf(testBlock{}), []Field{
+			{"Count", 0, 4, 0},
+			{"Lights[].Position", 16, 12, 0},
+			{"Lights[].Color", 32, 12, 0},
+		}},
+		{Std430, reflect.TypeOf(testBlock{}), []Field{
+			{"Count", 0, 4, 0},
+			{"Lights[].Position", 16, 12, 0},
+			{"Lights[].Color", 32, 12, 0},
+		}},
+		{Scalar, reflect.TypeOf(testBlock{}), []Field{
+			{"Count", 0, 4, 0},
+			{"Lights[].Position", 4, 12, 0},
+			{"Lights[].Color", 16, 12, 0},
+		}},
+	} {
+		var got, err = Fields(c.rules, c.typ)
+		if nil != err {
+			t.Errorf("%v %v: %v", c.rules, c.typ, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%v %v:\n got %+v\nwant %+v", c.rules, c.typ, got, c.want)
+		}
+	} // for
+}
+
+func TestSizeof(t *testing.T) {
+
+	var u = testUniforms{D: make([]Vec3, 2)}
+
+	for _, c := range []struct {
+		rules Rules
+		v     any
+		want  int
+	}{
+		{Std140, testUniforms{}, 144},
+		{Std430, testUniforms{}, 112},
+		{Scalar, testUniforms{}, 76},
+		// A trailing runtime array adds its current length
+		{Std140, &u, 144 + 2*16},
+		{Std430, &u, 112 + 2*16},
+		{Scalar, &u, 76 + 2*12},
+		// Struct array elements are rounded up to their alignment
+		{Std140, testBlock{}, 16 + 2*32},
+		{Std430, testBlock{}, 16 + 2*32},
+		{Scalar, testBlock{}, 4 + 2*24},
+	} {
+		var got, err = Sizeof(c.rules, c.v)
+		if nil != err {
+			t.Errorf("%v %T: %v", c.rules, c.v, err)
+		} else if got != c.want {
+			t.Errorf("Sizeof(%v, %T) = %v, want %v", c.rules, c.v, got, c.want)
+		}
+	} // for
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+
+	var in = testUniforms{
+		A: 1,
+		B: Vec3{2, 3, 4},
+		C: 5,
+		M: Mat3{{6, 7, 8}, {9, 10, 11}, {12, 13, 14}},
+		F: [3]float32{15, 16, 17},
+		V: Vec2{18, 19},
+		D: []Vec3{{20, 21, 22}, {23, 24, 25}},
+	}
+
+	for _, rules := range []Rules{Std140, Std430, Scalar} {
+
+		var b, err = Marshal(rules, &in)
+		if nil != err {
+			t.Fatalf("%v: %v", rules, err)
+		}
+
+		var size, _ = Sizeof(rules, &in)
+		if size != len(b) {
+			t.Errorf("%v: Marshal wrote %v bytes, Sizeof is %v", rules, len(b), size)
+		}
+
+		// The second matrix column starts one column stride in
+		var fields, _ = Fields(rules, reflect.TypeOf(in))
+		var m = fields[3]
+		if got := math.Float32frombits(binary.LittleEndian.Uint32(b[m.Offset+m.Stride:])); 9 != got {
+			t.Errorf("%v: M[1][0] = %v, want 9", rules, got)
+		}
+
+		var out testUniforms
+		if err = Unmarshal(rules, b, &out); nil != err {
+			t.Fatalf("%v: %v", rules, err)
+		}
+		if !reflect.DeepEqual(in, out) {
+			t.Errorf("%v: round trip\n got %+v\nwant %+v", rules, out, in)
+		}
+	} // for
+}
+
+func TestNil(t *testing.T) {
+
+	var block = &spirv.Type{Kind: spirv.KindStruct}
+
+	for _, v := range []any{nil, (*testBlock)(nil)} {
+
+		if err := Check(Std140, v, block); nil == err {
+			t.Errorf("Check(%#v) returned no error", v)
+		}
+
+		if _, err := Sizeof(Std140, v); nil == err {
+			t.Errorf("Sizeof(%#v) returned no error", v)
+		}
+	} // for
+}
+
+func TestPaths(t *testing.T) {
+
+	var fields, err = Fields(Std140, reflect.TypeOf(testBlock{}))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var paths = map[string]int{}
+	for _, f := range fields {
+		paths[f.Path] = f.Offset
+	}
+
+	if offset, ok := paths["Lights[].Color"]; !ok || 32 != offset {
+		t.Fatalf("Fields = %+v, want Lights[].Color at 32", fields)
+	}
+
+	// The same field placed at 28 in SPIR-V
+	var float = &spirv.Type{Kind: spirv.KindFloat, Width: 32}
+	var vec3 = &spirv.Type{Kind: spirv.KindVector, Elem: float, Len: 3}
+	var light = &spirv.Type{Kind: spirv.KindStruct, Members: []spirv.Member{
+		{Name: "position", Type: vec3, Offset: 0},
+		{Name: "color", Type: vec3, Offset: 12},
+	}}
+	var block = &spirv.Type{Kind: spirv.KindStruct, Members: []spirv.Member{
+		{Name: "count", Type: &spirv.Type{Kind: spirv.KindInt, Width: 32, Signed: true}, Offset: 0},
+		{Name: "lights", Type: &spirv.Type{Kind: spirv.KindArray, Elem: light, Len: 2, ArrayStride: 32}, Offset: 16},
+	}}
+
+	var fe *FieldError
+	if !errors.As(Check(Std140, testBlock{}, block), &fe) {
+		t.Fatal("Check found no mismatch")
+	}
+
+	if _, ok := paths[fe.Field]; !ok {
+		t.Errorf("FieldError path %q is not a path of Fields", fe.Field)
+	}
+	if "lights[].color" != fe.Member {
+		t.Errorf("FieldError member %q, want lights[].color", fe.Member)
+	}
+}
@@ -0,0 +1,119 @@
+package pipelinecache
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"example.com/vk_tutor/internal/fsutil"
+	"example.com/vk_tutor/vulkan"
+)
+
+// Cache is a VkPipelineCache backed by a file.
+type Cache struct {
+	Handle   vulkan.VkPipelineCache
+	Path     string
+	Loaded   int   // Bytes handed to the driver at creation
+	Rejected error // Why the file on disk was not used, nil if it was or there was none
+
+	device vulkan.VkDevice
+	props  vulkan.VkPhysicalDeviceProperties
+}
+
+// DefaultDir returns the per-user directory for pipeline cache files.
+func DefaultDir() string {
+
+	var dir, err = os.UserCacheDir()
+	if nil != err {
+		return ""
+	}
+
+	return filepath.Join(dir, "vk_tutor", "pipelines")
+}
+
+// FileName returns the cache file name of a device, its pipelineCacheUUID in
+// hex.
+func FileName(props *vulkan.VkPhysicalDeviceProperties) string {
+	return hex.EncodeToString(props.PipelineCacheUUID[:]) + ".bin"
+}
+
+// Open creates a pipeline cache for device, initialized from the file of the
+// device in dir when it exists and its header matches props. A missing or
+// rejected file is not an error; see Rejected.
+func Open(device vulkan.VkDevice, props *vulkan.VkPhysicalDeviceProperties, dir string) (*Cache, error) {
+
+	var c = &Cache{
+		Path:   filepath.Join(dir, FileName(props)),
+		device: device,
+		props:  *props,
+	}
+
+	var data, err = os.ReadFile(c.Path)
+	if nil == err {
+		if err = Validate(data, props); nil != err {
+			c.Rejected = fmt.Errorf("%v: %w", c.Path, err)
+			data = nil
+		}
+	} else if !os.IsNotExist(err) {
+		c.Rejected = err
+	}
+
+	var create_info = vulkan.VkPipelineCacheCreateInfo{
+		InitialDataSize: len(data),
+		PInitialData:    data,
+	}
+
+	if res := vulkan.VkCreatePipelineCache(device, &create_info, nil, &c.Handle); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkCreatePipelineCache failed: %v", res)
+	}
+
+	c.Loaded = len(data)
+
+	return c, nil
+}
+
+// Save writes the current cache data to Path, replacing the file atomically.
+func (o *Cache) Save() error {
+
+	var data []byte
+
+	// The cache may grow between the size query and the copy when other
+	// threads create pipelines; retry on VK_INCOMPLETE.
+	for {
+		var size int
+		if res := vulkan.VkGetPipelineCacheData(o.device, o.Handle, &size, nil); vulkan.VK_SUCCESS != res {
+			return fmt.Errorf("vkGetPipelineCacheData failed: %v", res)
+		}
+
+		data = make([]byte, size)
+		var res = vulkan.VkGetPipelineCacheData(o.device, o.Handle, &size, data)
+		if vulkan.VK_INCOMPLETE == res {
+			continue
+		}
+		if vulkan.VK_SUCCESS != res {
+			return fmt.Errorf("vkGetPipelineCacheData failed: %v", res)
+		}
+
+		data = data[:size]
+		break
+	} // for
+
+	return o.write(data)
+}
+
+// write persists cache data queried from the driver.
+func (o *Cache) write(data []byte) error {
+
+	// Never persist something Open would reject
+	if err := Validate(data, &o.props); nil != err {
+		return err
+	}
+
+	return fsutil.WriteFileAtomic(o.Path, data)
+}
+
+// Destroy destroys the VkPipelineCache without saving it.
+func (o *Cache) Destroy() {
+	vulkan.VkDestroyPipelineCache(o.device, o.Handle, nil)
+}
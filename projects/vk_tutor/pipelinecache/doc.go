@@ -0,0 +1,8 @@
+// Pipeline cache persistence
+//
+// Cache data is stored in one file per physical device, named after its
+// pipelineCacheUUID. The VkPipelineCacheHeaderVersionOne header of a file is
+// checked against the device properties before the data reaches the driver,
+// so a cache written by another GPU or driver version starts empty instead.
+// Files are replaced atomically.
+package pipelinecache
@@ -0,0 +1,62 @@
+package pipelinecache
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"example.com/vk_tutor/vulkan"
+)
+
+// Size of VkPipelineCacheHeaderVersionOne.
+const HeaderSize = 16 + vulkan.VK_UUID_SIZE
+
+// ParseHeader decodes the header at the start of pipeline cache data. The
+// header is little-endian on every platform we run on.
+func ParseHeader(data []byte) (*vulkan.VkPipelineCacheHeaderVersionOne, error) {
+
+	if len(data) < HeaderSize {
+		return nil, fmt.Errorf("pipelinecache: %v bytes is too small for a header", len(data))
+	}
+
+	var h = &vulkan.VkPipelineCacheHeaderVersionOne{
+		HeaderSize:    binary.LittleEndian.Uint32(data[0:]),
+		HeaderVersion: vulkan.VkPipelineCacheHeaderVersion(binary.LittleEndian.Uint32(data[4:])),
+		VendorID:      binary.LittleEndian.Uint32(data[8:]),
+		DeviceID:      binary.LittleEndian.Uint32(data[12:]),
+	}
+	copy(h.PipelineCacheUUID[:], data[16:])
+
+	if int(h.HeaderSize) < HeaderSize || int(h.HeaderSize) > len(data) {
+		return nil, fmt.Errorf("pipelinecache: bad header size %v", h.HeaderSize)
+	}
+
+	if vulkan.VK_PIPELINE_CACHE_HEADER_VERSION_ONE != h.HeaderVersion {
+		return nil, fmt.Errorf("pipelinecache: unsupported header version %v", h.HeaderVersion)
+	}
+
+	return h, nil
+}
+
+// Validate checks that cache data was written for the device described by
+// props.
+func Validate(data []byte, props *vulkan.VkPhysicalDeviceProperties) error {
+
+	var h, err = ParseHeader(data)
+	if nil != err {
+		return err
+	}
+
+	if h.VendorID != props.VendorID {
+		return fmt.Errorf("pipelinecache: vendor ID 0x%04x, device has 0x%04x", h.VendorID, props.VendorID)
+	}
+
+	if h.DeviceID != props.DeviceID {
+		return fmt.Errorf("pipelinecache: device ID 0x%04x, device has 0x%04x", h.DeviceID, props.DeviceID)
+	}
+
+	if h.PipelineCacheUUID != props.PipelineCacheUUID {
+		return fmt.Errorf("pipelinecache: cache UUID %x, device has %x", h.PipelineCacheUUID, props.PipelineCacheUUID)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package pipelinecache
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"example.com/vk_tutor/vulkan"
+)
+
+var testProps = vulkan.VkPhysicalDeviceProperties{
+	VendorID:          0x10de,
+	DeviceID:          0x2484,
+	PipelineCacheUUID: [vulkan.VK_UUID_SIZE]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+}
+
+// testBlob builds cache data for testProps, a header followed by payload,
+// then lets edit change it.
+func testBlob(payload int, edit func(b []byte)) []byte {
+
+	var b = make([]byte, HeaderSize+payload)
+
+	binary.LittleEndian.PutUint32(b[0:], uint32(HeaderSize))
+	binary.LittleEndian.PutUint32(b[4:], uint32(vulkan.VK_PIPELINE_CACHE_HEADER_VERSION_ONE))
+	binary.LittleEndian.PutUint32(b[8:], testProps.VendorID)
+	binary.LittleEndian.PutUint32(b[12:], testProps.DeviceID)
+	copy(b[16:], testProps.PipelineCacheUUID[:])
+
+	for i := HeaderSize; i < len(b); i++ {
+		b[i] = byte(i)
+	}
+
+	if nil != edit {
+		edit(b)
+	}
+
+	return b
+}
+
+func TestValidate(t *testing.T) {
+
+	for _, c := range []struct {
+		name string
+		data []byte
+		want string // Part of the error, "" to accept
+	}{
+		{"accepted", testBlob(64, nil), ""},
+		{"header only", testBlob(0, nil), ""},
+		{"empty", nil, "too small"},
+		{"short header", testBlob(0, nil)[:HeaderSize-1], "too small"},
+		{"header size too small", testBlob(64, func(b []byte) {
+			binary.LittleEndian.PutUint32(b[0:], uint32(HeaderSize-4))
+		}), "bad header size"},
+		{"header size past the end", testBlob(4, func(b []byte) {
+			binary.LittleEndian.PutUint32(b[0:], uint32(HeaderSize+8))
+		}), "bad header size"},
+		{"header version", testBlob(64, func(b []byte) {
+			binary.LittleEndian.PutUint32(b[4:], 2)
+		}), "header version"},
+		{"vendor ID", testBlob(64, func(b []byte) {
+			binary.LittleEndian.PutUint32(b[8:], 0x1002)
+		}), "vendor ID"},
+		{"device ID", testBlob(64, func(b []byte) {
+			binary.LittleEndian.PutUint32(b[12:], 0x2485)
+		}), "device ID"},
+		{"pipeline cache UUID", testBlob(64, func(b []byte) {
+			b[16+vulkan.VK_UUID_SIZE-1] ^= 0xff
+		}), "cache UUID"},
+	} {
+		var err = Validate(c.data, &testProps)
+		switch {
+		case "" == c.want && nil != err:
+			t.Errorf("%v: %v", c.name, err)
+		case "" != c.want && nil == err:
+			t.Errorf("%v: accepted", c.name)
+		case "" != c.want && !strings.Contains(err.Error(), c.want):
+			t.Errorf("%v: %v, want %q", c.name, err, c.want)
+		}
+	} // for
+}
+
+func TestParseHeader(t *testing.T) {
+
+	var h, err = ParseHeader(testBlob(8, nil))
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if uint32(HeaderSize) != h.HeaderSize || vulkan.VK_PIPELINE_CACHE_HEADER_VERSION_ONE != h.HeaderVersion ||
+		testProps.VendorID != h.VendorID || testProps.DeviceID != h.DeviceID ||
+		testProps.PipelineCacheUUID != h.PipelineCacheUUID {
+		t.Errorf("ParseHeader = %+v", h)
+	}
+}
+
+func TestSaveValidate(t *testing.T) {
+
+	var c = &Cache{
+		Path:  filepath.Join(t.TempDir(), FileName(&testProps)),
+		props: testProps,
+	}
+
+	var blob = testBlob(100, nil)
+	if err := c.write(blob); nil != err {
+		t.Fatal(err)
+	}
+
+	var data, err = os.ReadFile(c.Path)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(blob, data) {
+		t.Error("file differs from the saved data")
+	}
+	if err = Validate(data, &testProps); nil != err {
+		t.Error(err)
+	}
+
+	// Data of another device is never written
+	var other = testBlob(100, func(b []byte) { b[16] ^= 0xff })
+	if err = c.write(other); nil == err {
+		t.Error("saved data of another device")
+	}
+	if data, _ = os.ReadFile(c.Path); !bytes.Equal(blob, data) {
+		t.Error("rejected data replaced the file")
+	}
+}
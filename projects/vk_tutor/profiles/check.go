@@ -0,0 +1,328 @@
+package profiles
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"example.com/vk_tutor/selector"
+	"example.com/vk_tutor/vulkan"
+)
+
+// Failure is one requirement of a profile a device does not meet.
+type Failure struct {
+	Capability string // Capability block, "" for the API version
+	Kind       string // "api version", "extension", "feature", "limit", "sparse property", "format" or "queue family"
+	Name       string
+	Reason     string
+}
+
+func (o Failure) String() string {
+	return fmt.Sprintf("%v %v: %v", o.Kind, o.Name, o.Reason)
+}
+
+// Check returns every requirement of the profile d does not meet, nil if d
+// meets the profile. When no alternative of a list is met, the failures of
+// all of them are returned.
+func (o *Profile) Check(d *selector.Device) []Failure {
+
+	var r []Failure
+
+	if o.APIVersion > d.Properties.ApiVersion {
+		r = append(r, Failure{"", "api version", vulkan.Version(d.Properties.ApiVersion).String(),
+			fmt.Sprintf("profile requires %v", vulkan.Version(o.APIVersion))})
+	}
+
+	for _, group := range o.groups {
+
+		var failures []Failure
+
+		for _, c := range group {
+			var f = c.check(d)
+			if 0 == len(f) {
+				failures = nil
+				break
+			}
+			failures = append(failures, f...)
+		} // for
+
+		r = append(r, failures...)
+	} // for
+
+	return r
+}
+
+// Reasons is Check as strings, for selector.Requirements.Checks.
+func (o *Profile) Reasons(d *selector.Device) []string {
+
+	var r []string
+	for _, f := range o.Check(d) {
+		r = append(r, fmt.Sprintf("profile %v: %v", o.Name, f))
+	}
+
+	return r
+}
+
+func (o *capability) check(d *selector.Device) []Failure {
+
+	var r []Failure
+
+	var fail = func(kind, name, format string, args ...interface{}) {
+		r = append(r, Failure{o.name, kind, name, fmt.Sprintf(format, args...)})
+	}
+
+	for _, name := range sortedKeys(o.extensions) {
+		var have, ok = d.Extensions[name]
+		switch {
+		case !ok:
+			fail("extension", name, "not supported")
+		case have < o.extensions[name]:
+			fail("extension", name, "spec version %v, profile requires %v", have, o.extensions[name])
+		} // switch
+	} // for
+
+	for _, f := range o.features.Difference(d.Features.FeatureSet()).Features() {
+		fail("feature", f.String(), "not supported")
+	}
+
+	var limits, have = reflect.ValueOf(&o.limits).Elem(), reflect.ValueOf(&d.Properties.Limits).Elem()
+	for _, name := range o.limitSet {
+		if why := compareLimit(limitType(name), have.FieldByName(name), limits.FieldByName(name)); "" != why {
+			fail("limit", specName(name), why)
+		}
+	}
+
+	var sparse, haveSparse = reflect.ValueOf(&o.sparse).Elem(), reflect.ValueOf(&d.Properties.SparseProperties).Elem()
+	for _, name := range o.sparseSet {
+		if sparse.FieldByName(name).Bool() && !haveSparse.FieldByName(name).Bool() {
+			fail("sparse property", specName(name), "not supported")
+		}
+	}
+
+	for _, req := range o.formats {
+
+		var fp vulkan.VkFormatProperties
+		vulkan.VkGetPhysicalDeviceFormatProperties(d.Handle, req.format, &fp)
+
+		var tilings = []struct {
+			name       string
+			want, have vulkan.VkFormatFeatureFlags
+		}{
+			{"linear tiling", req.props.LinearTilingFeatures, fp.LinearTilingFeatures},
+			{"optimal tiling", req.props.OptimalTilingFeatures, fp.OptimalTilingFeatures},
+			{"buffer", req.props.BufferFeatures, fp.BufferFeatures},
+		}
+
+		for _, t := range tilings {
+			if missing := t.want &^ t.have; 0 != missing {
+				fail("format", FormatName(req.format), "%v lacks %v", t.name, strings.Join(FormatFeatureNames(missing), ", "))
+			}
+		}
+	} // for
+
+	if missing := matchQueueFamilies(o.queueFamilies, d.QueueFamilies); 0 != len(missing) {
+		for _, i := range missing {
+			var q = &o.queueFamilies[i]
+			fail("queue family", fmt.Sprint(i), "no distinct device family with %v, %v queues, %v timestamp bits",
+				strings.Join(QueueFlagNames(q.QueueFlags), "|"), q.QueueCount, q.TimestampValidBits)
+		}
+	}
+
+	return r
+}
+
+// matchQueueFamilies assigns a distinct device family to every profile
+// family. It returns the profile families left without one, nil if all
+// got one.
+func matchQueueFamilies(want, have []vulkan.VkQueueFamilyProperties) []int {
+
+	var used = make([]bool, len(have))
+
+	var assign func(i int) bool
+	assign = func(i int) bool {
+		if i == len(want) {
+			return true
+		}
+		for j := range have {
+			if !used[j] && queueFamilyMeets(&have[j], &want[i]) {
+				used[j] = true
+				if assign(i + 1) {
+					return true
+				}
+				used[j] = false
+			}
+		}
+		return false
+	}
+
+	if assign(0) {
+		return nil
+	}
+
+	// Report the families no device family meets at all, else all of them
+	var r, all []int
+	for i := range want {
+		all = append(all, i)
+		var ok = false
+		for j := range have {
+			ok = ok || queueFamilyMeets(&have[j], &want[i])
+		}
+		if !ok {
+			r = append(r, i)
+		}
+	} // for
+
+	if 0 == len(r) {
+		return all
+	}
+
+	return r
+}
+
+func queueFamilyMeets(have, want *vulkan.VkQueueFamilyProperties) bool {
+
+	if want.QueueFlags != have.QueueFlags&want.QueueFlags ||
+		have.QueueCount < want.QueueCount ||
+		have.TimestampValidBits < want.TimestampValidBits {
+		return false
+	}
+
+	// (0,0,0) is the coarsest granularity, whole images only
+	var g, w = have.MinImageTransferGranularity, want.MinImageTransferGranularity
+	if 0 == w.Width && 0 == w.Height && 0 == w.Depth {
+		return true
+	}
+	if 0 == g.Width && 0 == g.Height && 0 == g.Depth {
+		return false
+	}
+
+	return g.Width <= w.Width && g.Height <= w.Height && g.Depth <= w.Depth
+}
+
+// How limits compare, from the limittype attributes of vk.xml
+const (
+	limitMax    = "max"    // The device value must be at least the profile value
+	limitMin    = "min"    // The device value must be at most the profile value
+	limitRange  = "range"  // The device range must include the profile range
+	limitBits   = "bits"   // The device flags must include the profile flags
+	limitExact  = "exact"  // The values must be equal
+	limitNoAuto = "noauto" // Not compared
+)
+
+// Limits not following the naming, the others are "max" for the maxXxx
+// ones, "min" for the minXxx ones, and "bits" for sample counts
+var limitTypes = map[string]string{
+	"BufferImageGranularity":             limitMin,
+	"SparseAddressSpaceSize":             limitMax,
+	"SubPixelPrecisionBits":              limitMax,
+	"SubTexelPrecisionBits":              limitMax,
+	"MipmapPrecisionBits":                limitMax,
+	"ViewportBoundsRange":                limitRange,
+	"ViewportSubPixelBits":               limitMax,
+	"SubPixelInterpolationOffsetBits":    limitMax,
+	"TimestampComputeAndGraphics":        limitMax,
+	"TimestampPeriod":                    limitNoAuto,
+	"DiscreteQueuePriorities":            limitMax,
+	"PointSizeRange":                     limitRange,
+	"LineWidthRange":                     limitRange,
+	"PointSizeGranularity":               limitMin,
+	"LineWidthGranularity":               limitMin,
+	"StrictLines":                        limitExact,
+	"StandardSampleLocations":            limitExact,
+	"OptimalBufferCopyOffsetAlignment":   limitMin,
+	"OptimalBufferCopyRowPitchAlignment": limitMin,
+	"NonCoherentAtomSize":                limitMin,
+}
+
+func limitType(name string) string {
+
+	if t, ok := limitTypes[name]; ok {
+		return t
+	}
+
+	var f, _ = reflect.TypeOf(vulkan.VkPhysicalDeviceLimits{}).FieldByName(name)
+
+	switch {
+	case sampleCountType == f.Type:
+		return limitBits
+	case strings.HasPrefix(name, "Max"):
+		return limitMax
+	case strings.HasPrefix(name, "Min"):
+		return limitMin
+	} // switch
+
+	return limitExact
+}
+
+// compareLimit tells why have does not meet want, "" if it does.
+func compareLimit(t string, have, want reflect.Value) string {
+
+	var ok = true
+
+	switch t {
+	case limitNoAuto:
+		return ""
+	case limitBits:
+		if missing := want.Uint() &^ have.Uint(); 0 != missing {
+			return fmt.Sprintf("lacks %v", strings.Join(SampleCountNames(vulkan.VkSampleCountFlags(missing)), ", "))
+		}
+		return ""
+	case limitRange:
+		ok = compare(have.Index(0), want.Index(0)) <= 0 && compare(have.Index(1), want.Index(1)) >= 0
+	case limitMax, limitMin, limitExact:
+		var n = 1
+		if reflect.Array == have.Kind() {
+			n = have.Len()
+		}
+		for i := 0; i < n; i++ {
+			var h, w = have, want
+			if reflect.Array == have.Kind() {
+				h, w = have.Index(i), want.Index(i)
+			}
+			var c = compare(h, w)
+			ok = ok && ((limitMax == t && c >= 0) || (limitMin == t && c <= 0) || (limitExact == t && 0 == c))
+		}
+	} // switch
+
+	if ok {
+		return ""
+	}
+
+	return fmt.Sprintf("%v, profile requires %v %v", have.Interface(), limitWords[t], want.Interface())
+}
+
+var limitWords = map[string]string{
+	limitMax:   "at least",
+	limitMin:   "at most",
+	limitRange: "a range including",
+	limitExact: "exactly",
+}
+
+// compare returns -1, 0 or 1 as a is less than, equal to or greater than b.
+// Booleans compare false before true.
+func compare(a, b reflect.Value) int {
+
+	var less, greater bool
+
+	switch a.Kind() {
+	case reflect.Bool:
+		less, greater = !a.Bool() && b.Bool(), a.Bool() && !b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		less, greater = a.Int() < b.Int(), a.Int() > b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		less, greater = a.Uint() < b.Uint(), a.Uint() > b.Uint()
+	case reflect.Float32, reflect.Float64:
+		less, greater = a.Float() < b.Float(), a.Float() > b.Float()
+	default:
+		panic(fmt.Sprintf("profiles: cannot compare %v", a.Type()))
+	} // switch
+
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	} // switch
+
+	return 0
+}
@@ -0,0 +1,163 @@
+package profiles
+
+import (
+	"reflect"
+	"testing"
+
+	"example.com/vk_tutor/selector"
+	"example.com/vk_tutor/vulkan"
+)
+
+func loadProfile(t *testing.T, name string) *Profile {
+
+	var f, err = LoadFile("testdata/profiles.json")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var p *Profile
+	if p, err = f.Profile(name); nil != err {
+		t.Fatal(err)
+	}
+
+	return p
+}
+
+// testDevice fabricates a device meeting TEST_baseline.
+func testDevice() *selector.Device {
+
+	var d = &selector.Device{
+		Extensions: map[string]uint32{
+			"VK_KHR_swapchain":         70,
+			"VK_KHR_maintenance4":      2,
+			"VK_KHR_dynamic_rendering": 1,
+			"VK_EXT_mesh_shader":       1,
+		},
+	}
+
+	d.Properties.ApiVersion = vulkan.VK_MAKE_API_VERSION(0, 1, 3, 250)
+	d.Features.SamplerAnisotropy = true
+	d.Features.GeometryShader = true
+
+	var l = &d.Properties.Limits
+	l.MaxImageDimension2D = 32768
+	l.MaxComputeWorkGroupCount = [3]uint32{65535, 65535, 65535}
+	l.BufferImageGranularity = 1024
+	l.MinUniformBufferOffsetAlignment = 64
+	l.FramebufferColorSampleCounts = vulkan.VkSampleCountFlags(vulkan.VK_SAMPLE_COUNT_1_BIT | vulkan.VK_SAMPLE_COUNT_2_BIT |
+		vulkan.VK_SAMPLE_COUNT_4_BIT | vulkan.VK_SAMPLE_COUNT_8_BIT)
+	l.TimestampPeriod = 52
+	l.PointSizeRange = [2]float32{1, 2047}
+	l.LineWidthRange = [2]float32{0.5, 8}
+	l.StrictLines = true
+
+	d.Properties.SparseProperties.ResidencyStandard2DBlockShape = true
+
+	return d
+}
+
+func TestCheck(t *testing.T) {
+
+	var p = loadProfile(t, "TEST_baseline")
+
+	if want := []string{"baseline: VkPhysicalDeviceVulkan12Features"}; !reflect.DeepEqual(want, p.Ignored) {
+		t.Errorf("ignored %v, want %v", p.Ignored, want)
+	}
+
+	// Larger max limits, smaller min limits, wider ranges and extra sample counts
+	// all meet the profile; the timestamp period is not compared
+	var d = testDevice()
+	if f := p.Check(d); nil != f {
+		t.Errorf("device meeting the profile fails %v", f)
+	}
+
+	d.Properties.ApiVersion = vulkan.VK_API_VERSION_1_2
+	d.Extensions = map[string]uint32{"VK_KHR_swapchain": 70, "VK_KHR_maintenance4": 1}
+	d.Features.GeometryShader = false
+
+	var l = &d.Properties.Limits
+	l.MaxImageDimension2D = 8192
+	l.MaxComputeWorkGroupCount[2] = 1024
+	l.BufferImageGranularity = 65536
+	l.FramebufferColorSampleCounts = vulkan.VkSampleCountFlags(vulkan.VK_SAMPLE_COUNT_1_BIT | vulkan.VK_SAMPLE_COUNT_4_BIT)
+	l.PointSizeRange = [2]float32{1, 63.375}
+	l.StrictLines = false
+
+	d.Properties.SparseProperties.ResidencyStandard2DBlockShape = false
+
+	var want = []Failure{
+		{"", "api version", "1.2.0", "profile requires 1.3.204"},
+		{"baseline", "extension", "VK_KHR_dynamic_rendering", "not supported"},
+		{"baseline", "extension", "VK_KHR_maintenance4", "spec version 1, profile requires 2"},
+		{"baseline", "feature", "geometryShader", "not supported"},
+		{"baseline", "limit", "maxImageDimension2D", "8192, profile requires at least 16384"},
+		{"baseline", "limit", "bufferImageGranularity", "65536, profile requires at most 1024"},
+		{"baseline", "limit", "maxComputeWorkGroupCount", "[65535 65535 1024], profile requires at least [65535 65535 65535]"},
+		{"baseline", "limit", "framebufferColorSampleCounts", "lacks VK_SAMPLE_COUNT_8_BIT"},
+		{"baseline", "limit", "pointSizeRange", "[1 63.375], profile requires a range including [1 64]"},
+		{"baseline", "limit", "strictLines", "false, profile requires exactly true"},
+		{"baseline", "sparse property", "residencyStandard2DBlockShape", "not supported"},
+		// Neither alternative is met, both are reported; the third group
+		// is met by present
+		{"ray_query", "extension", "VK_KHR_ray_query", "not supported"},
+		{"mesh_shader", "extension", "VK_EXT_mesh_shader", "not supported"},
+	}
+
+	var got = p.Check(d)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Check\n got %v\nwant %v", got, want)
+	}
+
+	if r := p.Reasons(d); len(want) != len(r) || "profile TEST_baseline: feature geometryShader: not supported" != r[3] {
+		t.Errorf("Reasons %q", r)
+	}
+}
+
+func TestCheckQueueFamilies(t *testing.T) {
+
+	const (
+		qG = vulkan.VkQueueFlags(vulkan.VK_QUEUE_GRAPHICS_BIT)
+		qC = vulkan.VkQueueFlags(vulkan.VK_QUEUE_COMPUTE_BIT)
+		qT = vulkan.VkQueueFlags(vulkan.VK_QUEUE_TRANSFER_BIT)
+	)
+
+	var p = loadProfile(t, "TEST_queues")
+
+	for _, c := range []struct {
+		name     string
+		families []vulkan.VkQueueFamilyProperties
+		want     []Failure
+	}{
+		{
+			// Compute first takes family 0, graphics then needs it, so
+			// compute has to move to family 1
+			"backtracking",
+			[]vulkan.VkQueueFamilyProperties{{QueueFlags: qG | qC | qT, QueueCount: 16}, {QueueFlags: qC | qT, QueueCount: 8}},
+			nil,
+		},
+		{
+			// Each profile family is met, but not by distinct families
+			"one family",
+			[]vulkan.VkQueueFamilyProperties{{QueueFlags: qG | qC | qT, QueueCount: 16}},
+			[]Failure{
+				{"queues", "queue family", "0", "no distinct device family with VK_QUEUE_COMPUTE_BIT, 1 queues, 0 timestamp bits"},
+				{"queues", "queue family", "1", "no distinct device family with VK_QUEUE_GRAPHICS_BIT|VK_QUEUE_COMPUTE_BIT, 1 queues, 0 timestamp bits"},
+			},
+		},
+		{
+			// Only the family no device family meets is reported
+			"no graphics",
+			[]vulkan.VkQueueFamilyProperties{{QueueFlags: qC | qT, QueueCount: 8}, {QueueFlags: qT, QueueCount: 2}},
+			[]Failure{
+				{"queues", "queue family", "1", "no distinct device family with VK_QUEUE_GRAPHICS_BIT|VK_QUEUE_COMPUTE_BIT, 1 queues, 0 timestamp bits"},
+			},
+		},
+	} {
+		var d = &selector.Device{QueueFamilies: c.families}
+		d.Properties.ApiVersion = vulkan.VK_API_VERSION_1_0
+
+		if got := p.Check(d); !reflect.DeepEqual(c.want, got) {
+			t.Errorf("%v:\n got %v\nwant %v", c.name, got, c.want)
+		}
+	} // for
+}
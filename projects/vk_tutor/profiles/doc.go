@@ -0,0 +1,17 @@
+// Vulkan Profiles JSON
+//
+// Reads and writes capability profiles in the Khronos Vulkan Profiles JSON
+// schema. A profile names capability blocks listing the extensions,
+// features, limits, formats and queue families a device must offer; Check
+// compares a device against all of them and returns every unmet
+// requirement. Limits are compared with the semantics of the registry:
+// "max" limits must be at least, "min" limits at most the profile value,
+// ranges must include the profile range and sample counts must include the
+// profile bits. FromDevice exports what a device offers as a profile, to be
+// compared against a baseline later.
+//
+// Only the Vulkan 1.0 structures are checked: VkPhysicalDeviceFeatures,
+// the limits and sparse properties of VkPhysicalDeviceProperties,
+// VkFormatProperties and VkQueueFamilyProperties, also when wrapped in
+// their "2" variants. Other structures are listed by Profile.Ignored.
+package profiles
@@ -0,0 +1,118 @@
+package profiles
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"example.com/vk_tutor/selector"
+	"example.com/vk_tutor/vulkan"
+)
+
+// FromDevice returns a file with the single profile name, requiring what d
+// offers: its API version, extensions, features, limits, sparse
+// properties, format support and queue families. Checking d against it
+// reports no failures.
+func FromDevice(d *selector.Device, name string) *File {
+
+	var p = &d.Properties
+
+	var caps = &Capabilities{
+		Extensions: make(map[string]uint32),
+		Features: map[string]json.RawMessage{
+			"VkPhysicalDeviceFeatures": encodeStruct(&d.Features, nil),
+		},
+		Properties: map[string]json.RawMessage{
+			"VkPhysicalDeviceProperties": mustMarshal(map[string]json.RawMessage{
+				"limits":           encodeStruct(&p.Limits, func(name string) bool { return limitNoAuto != limitType(name) }),
+				"sparseProperties": encodeStruct(&p.SparseProperties, nil),
+			}),
+		},
+		Formats: make(map[string]map[string]json.RawMessage),
+	}
+
+	for e, v := range d.Extensions {
+		caps.Extensions[e] = v
+	}
+
+	for _, f := range Formats() {
+
+		var fp vulkan.VkFormatProperties
+		vulkan.VkGetPhysicalDeviceFormatProperties(d.Handle, f, &fp)
+		if 0 == fp.LinearTilingFeatures|fp.OptimalTilingFeatures|fp.BufferFeatures {
+			continue
+		}
+
+		caps.Formats[FormatName(f)] = map[string]json.RawMessage{
+			"VkFormatProperties": mustMarshal(map[string][]string{
+				"linearTilingFeatures":  FormatFeatureNames(fp.LinearTilingFeatures),
+				"optimalTilingFeatures": FormatFeatureNames(fp.OptimalTilingFeatures),
+				"bufferFeatures":        FormatFeatureNames(fp.BufferFeatures),
+			}),
+		}
+	} // for
+
+	for _, q := range d.QueueFamilies {
+		var g = q.MinImageTransferGranularity
+		caps.QueueFamilies = append(caps.QueueFamilies, map[string]json.RawMessage{
+			"VkQueueFamilyProperties": mustMarshal(map[string]interface{}{
+				"queueFlags":                  QueueFlagNames(q.QueueFlags),
+				"queueCount":                  q.QueueCount,
+				"timestampValidBits":          q.TimestampValidBits,
+				"minImageTransferGranularity": map[string]uint32{"width": g.Width, "height": g.Height, "depth": g.Depth},
+			}),
+		})
+	} // for
+
+	var capsName = name + "_device"
+	var description = fmt.Sprintf("Exported from %v, %v device 0x%04x, driver %v",
+		p.DeviceName, vulkan.VendorName(p.VendorID), p.DeviceID, vulkan.DriverVersionString(p.VendorID, p.DriverVersion))
+
+	return &File{
+		Schema:       Schema,
+		Capabilities: map[string]*Capabilities{capsName: caps},
+		Profiles: map[string]*ProfileInfo{
+			name: {
+				Version:      1,
+				APIVersion:   vulkan.Version(p.ApiVersion).String(),
+				Label:        p.DeviceName,
+				Description:  description,
+				Capabilities: []Alternatives{{capsName}},
+			},
+		},
+	}
+}
+
+// encodeStruct is the reverse of decodeStruct, for the fields keep accepts,
+// all when nil.
+func encodeStruct(p interface{}, keep func(name string) bool) json.RawMessage {
+
+	var r = make(map[string]interface{})
+	var v = reflect.ValueOf(p).Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+
+		var name = v.Type().Field(i).Name
+		if nil != keep && !keep(name) {
+			continue
+		}
+
+		var f = v.Field(i)
+		if sampleCountType == f.Type() {
+			r[specName(name)] = SampleCountNames(vulkan.VkSampleCountFlags(f.Uint()))
+		} else {
+			r[specName(name)] = f.Interface()
+		}
+	} // for
+
+	return mustMarshal(r)
+}
+
+// mustMarshal marshals values that cannot fail to.
+func mustMarshal(v interface{}) json.RawMessage {
+	var b, err = json.Marshal(v)
+	if nil != err {
+		panic(err)
+	}
+	return b
+}
@@ -0,0 +1,410 @@
+package profiles
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"example.com/vk_tutor/vulkan"
+)
+
+type flagBit struct {
+	bit  uint32
+	name string
+}
+
+// flagNames lists the names of the bits set in flags, unknown bits in hex.
+func flagNames(flags uint32, bits []flagBit) []string {
+
+	var r = []string{}
+	for _, b := range bits {
+		if 0 != flags&b.bit {
+			r = append(r, b.name)
+			flags &^= b.bit
+		}
+	}
+
+	if 0 != flags {
+		r = append(r, fmt.Sprintf("0x%x", flags))
+	}
+
+	return r
+}
+
+// parseFlags is the reverse of flagNames.
+func parseFlags(names []string, bits []flagBit) (uint32, error) {
+
+	var r uint32
+
+next:
+	for _, name := range names {
+		for _, b := range bits {
+			if name == b.name {
+				r |= b.bit
+				continue next
+			}
+		}
+		if strings.HasPrefix(name, "0x") {
+			if v, err := strconv.ParseUint(name[2:], 16, 32); nil == err {
+				r |= uint32(v)
+				continue
+			}
+		}
+		return 0, fmt.Errorf("unknown flag %v", name)
+	} // for
+
+	return r, nil
+}
+
+// QueueFlagNames returns e.g. ["VK_QUEUE_GRAPHICS_BIT", "VK_QUEUE_COMPUTE_BIT"].
+func QueueFlagNames(flags vulkan.VkQueueFlags) []string {
+	return flagNames(uint32(flags), queueFlagBits)
+}
+
+// FormatFeatureNames returns e.g. ["VK_FORMAT_FEATURE_SAMPLED_IMAGE_BIT"].
+func FormatFeatureNames(flags vulkan.VkFormatFeatureFlags) []string {
+	return flagNames(uint32(flags), formatFeatureFlagBits)
+}
+
+// SampleCountNames returns e.g. ["VK_SAMPLE_COUNT_1_BIT", "VK_SAMPLE_COUNT_4_BIT"].
+func SampleCountNames(flags vulkan.VkSampleCountFlags) []string {
+	return flagNames(uint32(flags), sampleCountFlagBits)
+}
+
+// FormatName returns e.g. "VK_FORMAT_R8G8B8A8_UNORM", the number for
+// formats not in the table.
+func FormatName(f vulkan.VkFormat) string {
+	for _, n := range formatNames {
+		if f == n.format {
+			return n.name
+		}
+	}
+	return strconv.Itoa(int(f))
+}
+
+// LookupFormat is the reverse of FormatName.
+func LookupFormat(name string) (vulkan.VkFormat, bool) {
+	for _, n := range formatNames {
+		if name == n.name {
+			return n.format, true
+		}
+	}
+	return 0, false
+}
+
+// Formats lists the formats with a name, in header order.
+func Formats() []vulkan.VkFormat {
+
+	var r = make([]vulkan.VkFormat, len(formatNames))
+	for i, n := range formatNames {
+		r[i] = n.format
+	}
+
+	return r
+}
+
+var queueFlagBits = []flagBit{
+	{uint32(vulkan.VK_QUEUE_GRAPHICS_BIT), "VK_QUEUE_GRAPHICS_BIT"},
+	{uint32(vulkan.VK_QUEUE_COMPUTE_BIT), "VK_QUEUE_COMPUTE_BIT"},
+	{uint32(vulkan.VK_QUEUE_TRANSFER_BIT), "VK_QUEUE_TRANSFER_BIT"},
+	{uint32(vulkan.VK_QUEUE_SPARSE_BINDING_BIT), "VK_QUEUE_SPARSE_BINDING_BIT"},
+	{uint32(vulkan.VK_QUEUE_PROTECTED_BIT), "VK_QUEUE_PROTECTED_BIT"},
+	{uint32(vulkan.VK_QUEUE_VIDEO_DECODE_BIT_KHR), "VK_QUEUE_VIDEO_DECODE_BIT_KHR"},
+	{uint32(vulkan.VK_QUEUE_OPTICAL_FLOW_BIT_NV), "VK_QUEUE_OPTICAL_FLOW_BIT_NV"},
+}
+
+var formatFeatureFlagBits = []flagBit{
+	{uint32(vulkan.VK_FORMAT_FEATURE_SAMPLED_IMAGE_BIT), "VK_FORMAT_FEATURE_SAMPLED_IMAGE_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_STORAGE_IMAGE_BIT), "VK_FORMAT_FEATURE_STORAGE_IMAGE_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_STORAGE_IMAGE_ATOMIC_BIT), "VK_FORMAT_FEATURE_STORAGE_IMAGE_ATOMIC_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_UNIFORM_TEXEL_BUFFER_BIT), "VK_FORMAT_FEATURE_UNIFORM_TEXEL_BUFFER_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_BIT), "VK_FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_ATOMIC_BIT), "VK_FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_ATOMIC_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_VERTEX_BUFFER_BIT), "VK_FORMAT_FEATURE_VERTEX_BUFFER_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_COLOR_ATTACHMENT_BIT), "VK_FORMAT_FEATURE_COLOR_ATTACHMENT_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_COLOR_ATTACHMENT_BLEND_BIT), "VK_FORMAT_FEATURE_COLOR_ATTACHMENT_BLEND_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_DEPTH_STENCIL_ATTACHMENT_BIT), "VK_FORMAT_FEATURE_DEPTH_STENCIL_ATTACHMENT_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_BLIT_SRC_BIT), "VK_FORMAT_FEATURE_BLIT_SRC_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_BLIT_DST_BIT), "VK_FORMAT_FEATURE_BLIT_DST_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_LINEAR_BIT), "VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_LINEAR_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_TRANSFER_SRC_BIT), "VK_FORMAT_FEATURE_TRANSFER_SRC_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_TRANSFER_DST_BIT), "VK_FORMAT_FEATURE_TRANSFER_DST_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_MIDPOINT_CHROMA_SAMPLES_BIT), "VK_FORMAT_FEATURE_MIDPOINT_CHROMA_SAMPLES_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT), "VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT), "VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT), "VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT), "VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_DISJOINT_BIT), "VK_FORMAT_FEATURE_DISJOINT_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT), "VK_FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_MINMAX_BIT), "VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_MINMAX_BIT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_VIDEO_DECODE_OUTPUT_BIT_KHR), "VK_FORMAT_FEATURE_VIDEO_DECODE_OUTPUT_BIT_KHR"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_VIDEO_DECODE_DPB_BIT_KHR), "VK_FORMAT_FEATURE_VIDEO_DECODE_DPB_BIT_KHR"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_ACCELERATION_STRUCTURE_VERTEX_BUFFER_BIT_KHR), "VK_FORMAT_FEATURE_ACCELERATION_STRUCTURE_VERTEX_BUFFER_BIT_KHR"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_CUBIC_BIT_EXT), "VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_CUBIC_BIT_EXT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_FRAGMENT_DENSITY_MAP_BIT_EXT), "VK_FORMAT_FEATURE_FRAGMENT_DENSITY_MAP_BIT_EXT"},
+	{uint32(vulkan.VK_FORMAT_FEATURE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR), "VK_FORMAT_FEATURE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR"},
+}
+
+var sampleCountFlagBits = []flagBit{
+	{uint32(vulkan.VK_SAMPLE_COUNT_1_BIT), "VK_SAMPLE_COUNT_1_BIT"},
+	{uint32(vulkan.VK_SAMPLE_COUNT_2_BIT), "VK_SAMPLE_COUNT_2_BIT"},
+	{uint32(vulkan.VK_SAMPLE_COUNT_4_BIT), "VK_SAMPLE_COUNT_4_BIT"},
+	{uint32(vulkan.VK_SAMPLE_COUNT_8_BIT), "VK_SAMPLE_COUNT_8_BIT"},
+	{uint32(vulkan.VK_SAMPLE_COUNT_16_BIT), "VK_SAMPLE_COUNT_16_BIT"},
+	{uint32(vulkan.VK_SAMPLE_COUNT_32_BIT), "VK_SAMPLE_COUNT_32_BIT"},
+	{uint32(vulkan.VK_SAMPLE_COUNT_64_BIT), "VK_SAMPLE_COUNT_64_BIT"},
+}
+
+// Every format with a value of its own in the headers
+var formatNames = []struct {
+	format vulkan.VkFormat
+	name   string
+}{
+	{vulkan.VK_FORMAT_R4G4_UNORM_PACK8, "VK_FORMAT_R4G4_UNORM_PACK8"},
+	{vulkan.VK_FORMAT_R4G4B4A4_UNORM_PACK16, "VK_FORMAT_R4G4B4A4_UNORM_PACK16"},
+	{vulkan.VK_FORMAT_B4G4R4A4_UNORM_PACK16, "VK_FORMAT_B4G4R4A4_UNORM_PACK16"},
+	{vulkan.VK_FORMAT_R5G6B5_UNORM_PACK16, "VK_FORMAT_R5G6B5_UNORM_PACK16"},
+	{vulkan.VK_FORMAT_B5G6R5_UNORM_PACK16, "VK_FORMAT_B5G6R5_UNORM_PACK16"},
+	{vulkan.VK_FORMAT_R5G5B5A1_UNORM_PACK16, "VK_FORMAT_R5G5B5A1_UNORM_PACK16"},
+	{vulkan.VK_FORMAT_B5G5R5A1_UNORM_PACK16, "VK_FORMAT_B5G5R5A1_UNORM_PACK16"},
+	{vulkan.VK_FORMAT_A1R5G5B5_UNORM_PACK16, "VK_FORMAT_A1R5G5B5_UNORM_PACK16"},
+	{vulkan.VK_FORMAT_R8_UNORM, "VK_FORMAT_R8_UNORM"},
+	{vulkan.VK_FORMAT_R8_SNORM, "VK_FORMAT_R8_SNORM"},
+	{vulkan.VK_FORMAT_R8_USCALED, "VK_FORMAT_R8_USCALED"},
+	{vulkan.VK_FORMAT_R8_SSCALED, "VK_FORMAT_R8_SSCALED"},
+	{vulkan.VK_FORMAT_R8_UINT, "VK_FORMAT_R8_UINT"},
+	{vulkan.VK_FORMAT_R8_SINT, "VK_FORMAT_R8_SINT"},
+	{vulkan.VK_FORMAT_R8_SRGB, "VK_FORMAT_R8_SRGB"},
+	{vulkan.VK_FORMAT_R8G8_UNORM, "VK_FORMAT_R8G8_UNORM"},
+	{vulkan.VK_FORMAT_R8G8_SNORM, "VK_FORMAT_R8G8_SNORM"},
+	{vulkan.VK_FORMAT_R8G8_USCALED, "VK_FORMAT_R8G8_USCALED"},
+	{vulkan.VK_FORMAT_R8G8_SSCALED, "VK_FORMAT_R8G8_SSCALED"},
+	{vulkan.VK_FORMAT_R8G8_UINT, "VK_FORMAT_R8G8_UINT"},
+	{vulkan.VK_FORMAT_R8G8_SINT, "VK_FORMAT_R8G8_SINT"},
+	{vulkan.VK_FORMAT_R8G8_SRGB, "VK_FORMAT_R8G8_SRGB"},
+	{vulkan.VK_FORMAT_R8G8B8_UNORM, "VK_FORMAT_R8G8B8_UNORM"},
+	{vulkan.VK_FORMAT_R8G8B8_SNORM, "VK_FORMAT_R8G8B8_SNORM"},
+	{vulkan.VK_FORMAT_R8G8B8_USCALED, "VK_FORMAT_R8G8B8_USCALED"},
+	{vulkan.VK_FORMAT_R8G8B8_SSCALED, "VK_FORMAT_R8G8B8_SSCALED"},
+	{vulkan.VK_FORMAT_R8G8B8_UINT, "VK_FORMAT_R8G8B8_UINT"},
+	{vulkan.VK_FORMAT_R8G8B8_SINT, "VK_FORMAT_R8G8B8_SINT"},
+	{vulkan.VK_FORMAT_R8G8B8_SRGB, "VK_FORMAT_R8G8B8_SRGB"},
+	{vulkan.VK_FORMAT_B8G8R8_UNORM, "VK_FORMAT_B8G8R8_UNORM"},
+	{vulkan.VK_FORMAT_B8G8R8_SNORM, "VK_FORMAT_B8G8R8_SNORM"},
+	{vulkan.VK_FORMAT_B8G8R8_USCALED, "VK_FORMAT_B8G8R8_USCALED"},
+	{vulkan.VK_FORMAT_B8G8R8_SSCALED, "VK_FORMAT_B8G8R8_SSCALED"},
+	{vulkan.VK_FORMAT_B8G8R8_UINT, "VK_FORMAT_B8G8R8_UINT"},
+	{vulkan.VK_FORMAT_B8G8R8_SINT, "VK_FORMAT_B8G8R8_SINT"},
+	{vulkan.VK_FORMAT_B8G8R8_SRGB, "VK_FORMAT_B8G8R8_SRGB"},
+	{vulkan.VK_FORMAT_R8G8B8A8_UNORM, "VK_FORMAT_R8G8B8A8_UNORM"},
+	{vulkan.VK_FORMAT_R8G8B8A8_SNORM, "VK_FORMAT_R8G8B8A8_SNORM"},
+	{vulkan.VK_FORMAT_R8G8B8A8_USCALED, "VK_FORMAT_R8G8B8A8_USCALED"},
+	{vulkan.VK_FORMAT_R8G8B8A8_SSCALED, "VK_FORMAT_R8G8B8A8_SSCALED"},
+	{vulkan.VK_FORMAT_R8G8B8A8_UINT, "VK_FORMAT_R8G8B8A8_UINT"},
+	{vulkan.VK_FORMAT_R8G8B8A8_SINT, "VK_FORMAT_R8G8B8A8_SINT"},
+	{vulkan.VK_FORMAT_R8G8B8A8_SRGB, "VK_FORMAT_R8G8B8A8_SRGB"},
+	{vulkan.VK_FORMAT_B8G8R8A8_UNORM, "VK_FORMAT_B8G8R8A8_UNORM"},
+	{vulkan.VK_FORMAT_B8G8R8A8_SNORM, "VK_FORMAT_B8G8R8A8_SNORM"},
+	{vulkan.VK_FORMAT_B8G8R8A8_USCALED, "VK_FORMAT_B8G8R8A8_USCALED"},
+	{vulkan.VK_FORMAT_B8G8R8A8_SSCALED, "VK_FORMAT_B8G8R8A8_SSCALED"},
+	{vulkan.VK_FORMAT_B8G8R8A8_UINT, "VK_FORMAT_B8G8R8A8_UINT"},
+	{vulkan.VK_FORMAT_B8G8R8A8_SINT, "VK_FORMAT_B8G8R8A8_SINT"},
+	{vulkan.VK_FORMAT_B8G8R8A8_SRGB, "VK_FORMAT_B8G8R8A8_SRGB"},
+	{vulkan.VK_FORMAT_A8B8G8R8_UNORM_PACK32, "VK_FORMAT_A8B8G8R8_UNORM_PACK32"},
+	{vulkan.VK_FORMAT_A8B8G8R8_SNORM_PACK32, "VK_FORMAT_A8B8G8R8_SNORM_PACK32"},
+	{vulkan.VK_FORMAT_A8B8G8R8_USCALED_PACK32, "VK_FORMAT_A8B8G8R8_USCALED_PACK32"},
+	{vulkan.VK_FORMAT_A8B8G8R8_SSCALED_PACK32, "VK_FORMAT_A8B8G8R8_SSCALED_PACK32"},
+	{vulkan.VK_FORMAT_A8B8G8R8_UINT_PACK32, "VK_FORMAT_A8B8G8R8_UINT_PACK32"},
+	{vulkan.VK_FORMAT_A8B8G8R8_SINT_PACK32, "VK_FORMAT_A8B8G8R8_SINT_PACK32"},
+	{vulkan.VK_FORMAT_A8B8G8R8_SRGB_PACK32, "VK_FORMAT_A8B8G8R8_SRGB_PACK32"},
+	{vulkan.VK_FORMAT_A2R10G10B10_UNORM_PACK32, "VK_FORMAT_A2R10G10B10_UNORM_PACK32"},
+	{vulkan.VK_FORMAT_A2R10G10B10_SNORM_PACK32, "VK_FORMAT_A2R10G10B10_SNORM_PACK32"},
+	{vulkan.VK_FORMAT_A2R10G10B10_USCALED_PACK32, "VK_FORMAT_A2R10G10B10_USCALED_PACK32"},
+	{vulkan.VK_FORMAT_A2R10G10B10_SSCALED_PACK32, "VK_FORMAT_A2R10G10B10_SSCALED_PACK32"},
+	{vulkan.VK_FORMAT_A2R10G10B10_UINT_PACK32, "VK_FORMAT_A2R10G10B10_UINT_PACK32"},
+	{vulkan.VK_FORMAT_A2R10G10B10_SINT_PACK32, "VK_FORMAT_A2R10G10B10_SINT_PACK32"},
+	{vulkan.VK_FORMAT_A2B10G10R10_UNORM_PACK32, "VK_FORMAT_A2B10G10R10_UNORM_PACK32"},
+	{vulkan.VK_FORMAT_A2B10G10R10_SNORM_PACK32, "VK_FORMAT_A2B10G10R10_SNORM_PACK32"},
+	{vulkan.VK_FORMAT_A2B10G10R10_USCALED_PACK32, "VK_FORMAT_A2B10G10R10_USCALED_PACK32"},
+	{vulkan.VK_FORMAT_A2B10G10R10_SSCALED_PACK32, "VK_FORMAT_A2B10G10R10_SSCALED_PACK32"},
+	{vulkan.VK_FORMAT_A2B10G10R10_UINT_PACK32, "VK_FORMAT_A2B10G10R10_UINT_PACK32"},
+	{vulkan.VK_FORMAT_A2B10G10R10_SINT_PACK32, "VK_FORMAT_A2B10G10R10_SINT_PACK32"},
+	{vulkan.VK_FORMAT_R16_UNORM, "VK_FORMAT_R16_UNORM"},
+	{vulkan.VK_FORMAT_R16_SNORM, "VK_FORMAT_R16_SNORM"},
+	{vulkan.VK_FORMAT_R16_USCALED, "VK_FORMAT_R16_USCALED"},
+	{vulkan.VK_FORMAT_R16_SSCALED, "VK_FORMAT_R16_SSCALED"},
+	{vulkan.VK_FORMAT_R16_UINT, "VK_FORMAT_R16_UINT"},
+	{vulkan.VK_FORMAT_R16_SINT, "VK_FORMAT_R16_SINT"},
+	{vulkan.VK_FORMAT_R16_SFLOAT, "VK_FORMAT_R16_SFLOAT"},
+	{vulkan.VK_FORMAT_R16G16_UNORM, "VK_FORMAT_R16G16_UNORM"},
+	{vulkan.VK_FORMAT_R16G16_SNORM, "VK_FORMAT_R16G16_SNORM"},
+	{vulkan.VK_FORMAT_R16G16_USCALED, "VK_FORMAT_R16G16_USCALED"},
+	{vulkan.VK_FORMAT_R16G16_SSCALED, "VK_FORMAT_R16G16_SSCALED"},
+	{vulkan.VK_FORMAT_R16G16_UINT, "VK_FORMAT_R16G16_UINT"},
+	{vulkan.VK_FORMAT_R16G16_SINT, "VK_FORMAT_R16G16_SINT"},
+	{vulkan.VK_FORMAT_R16G16_SFLOAT, "VK_FORMAT_R16G16_SFLOAT"},
+	{vulkan.VK_FORMAT_R16G16B16_UNORM, "VK_FORMAT_R16G16B16_UNORM"},
+	{vulkan.VK_FORMAT_R16G16B16_SNORM, "VK_FORMAT_R16G16B16_SNORM"},
+	{vulkan.VK_FORMAT_R16G16B16_USCALED, "VK_FORMAT_R16G16B16_USCALED"},
+	{vulkan.VK_FORMAT_R16G16B16_SSCALED, "VK_FORMAT_R16G16B16_SSCALED"},
+	{vulkan.VK_FORMAT_R16G16B16_UINT, "VK_FORMAT_R16G16B16_UINT"},
+	{vulkan.VK_FORMAT_R16G16B16_SINT, "VK_FORMAT_R16G16B16_SINT"},
+	{vulkan.VK_FORMAT_R16G16B16_SFLOAT, "VK_FORMAT_R16G16B16_SFLOAT"},
+	{vulkan.VK_FORMAT_R16G16B16A16_UNORM, "VK_FORMAT_R16G16B16A16_UNORM"},
+	{vulkan.VK_FORMAT_R16G16B16A16_SNORM, "VK_FORMAT_R16G16B16A16_SNORM"},
+	{vulkan.VK_FORMAT_R16G16B16A16_USCALED, "VK_FORMAT_R16G16B16A16_USCALED"},
+	{vulkan.VK_FORMAT_R16G16B16A16_SSCALED, "VK_FORMAT_R16G16B16A16_SSCALED"},
+	{vulkan.VK_FORMAT_R16G16B16A16_UINT, "VK_FORMAT_R16G16B16A16_UINT"},
+	{vulkan.VK_FORMAT_R16G16B16A16_SINT, "VK_FORMAT_R16G16B16A16_SINT"},
+	{vulkan.VK_FORMAT_R16G16B16A16_SFLOAT, "VK_FORMAT_R16G16B16A16_SFLOAT"},
+	{vulkan.VK_FORMAT_R32_UINT, "VK_FORMAT_R32_UINT"},
+	{vulkan.VK_FORMAT_R32_SINT, "VK_FORMAT_R32_SINT"},
+	{vulkan.VK_FORMAT_R32_SFLOAT, "VK_FORMAT_R32_SFLOAT"},
+	{vulkan.VK_FORMAT_R32G32_UINT, "VK_FORMAT_R32G32_UINT"},
+	{vulkan.VK_FORMAT_R32G32_SINT, "VK_FORMAT_R32G32_SINT"},
+	{vulkan.VK_FORMAT_R32G32_SFLOAT, "VK_FORMAT_R32G32_SFLOAT"},
+	{vulkan.VK_FORMAT_R32G32B32_UINT, "VK_FORMAT_R32G32B32_UINT"},
+	{vulkan.VK_FORMAT_R32G32B32_SINT, "VK_FORMAT_R32G32B32_SINT"},
+	{vulkan.VK_FORMAT_R32G32B32_SFLOAT, "VK_FORMAT_R32G32B32_SFLOAT"},
+	{vulkan.VK_FORMAT_R32G32B32A32_UINT, "VK_FORMAT_R32G32B32A32_UINT"},
+	{vulkan.VK_FORMAT_R32G32B32A32_SINT, "VK_FORMAT_R32G32B32A32_SINT"},
+	{vulkan.VK_FORMAT_R32G32B32A32_SFLOAT, "VK_FORMAT_R32G32B32A32_SFLOAT"},
+	{vulkan.VK_FORMAT_R64_UINT, "VK_FORMAT_R64_UINT"},
+	{vulkan.VK_FORMAT_R64_SINT, "VK_FORMAT_R64_SINT"},
+	{vulkan.VK_FORMAT_R64_SFLOAT, "VK_FORMAT_R64_SFLOAT"},
+	{vulkan.VK_FORMAT_R64G64_UINT, "VK_FORMAT_R64G64_UINT"},
+	{vulkan.VK_FORMAT_R64G64_SINT, "VK_FORMAT_R64G64_SINT"},
+	{vulkan.VK_FORMAT_R64G64_SFLOAT, "VK_FORMAT_R64G64_SFLOAT"},
+	{vulkan.VK_FORMAT_R64G64B64_UINT, "VK_FORMAT_R64G64B64_UINT"},
+	{vulkan.VK_FORMAT_R64G64B64_SINT, "VK_FORMAT_R64G64B64_SINT"},
+	{vulkan.VK_FORMAT_R64G64B64_SFLOAT, "VK_FORMAT_R64G64B64_SFLOAT"},
+	{vulkan.VK_FORMAT_R64G64B64A64_UINT, "VK_FORMAT_R64G64B64A64_UINT"},
+	{vulkan.VK_FORMAT_R64G64B64A64_SINT, "VK_FORMAT_R64G64B64A64_SINT"},
+	{vulkan.VK_FORMAT_R64G64B64A64_SFLOAT, "VK_FORMAT_R64G64B64A64_SFLOAT"},
+	{vulkan.VK_FORMAT_B10G11R11_UFLOAT_PACK32, "VK_FORMAT_B10G11R11_UFLOAT_PACK32"},
+	{vulkan.VK_FORMAT_E5B9G9R9_UFLOAT_PACK32, "VK_FORMAT_E5B9G9R9_UFLOAT_PACK32"},
+	{vulkan.VK_FORMAT_D16_UNORM, "VK_FORMAT_D16_UNORM"},
+	{vulkan.VK_FORMAT_X8_D24_UNORM_PACK32, "VK_FORMAT_X8_D24_UNORM_PACK32"},
+	{vulkan.VK_FORMAT_D32_SFLOAT, "VK_FORMAT_D32_SFLOAT"},
+	{vulkan.VK_FORMAT_S8_UINT, "VK_FORMAT_S8_UINT"},
+	{vulkan.VK_FORMAT_D16_UNORM_S8_UINT, "VK_FORMAT_D16_UNORM_S8_UINT"},
+	{vulkan.VK_FORMAT_D24_UNORM_S8_UINT, "VK_FORMAT_D24_UNORM_S8_UINT"},
+	{vulkan.VK_FORMAT_D32_SFLOAT_S8_UINT, "VK_FORMAT_D32_SFLOAT_S8_UINT"},
+	{vulkan.VK_FORMAT_BC1_RGB_UNORM_BLOCK, "VK_FORMAT_BC1_RGB_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_BC1_RGB_SRGB_BLOCK, "VK_FORMAT_BC1_RGB_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_BC1_RGBA_UNORM_BLOCK, "VK_FORMAT_BC1_RGBA_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_BC1_RGBA_SRGB_BLOCK, "VK_FORMAT_BC1_RGBA_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_BC2_UNORM_BLOCK, "VK_FORMAT_BC2_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_BC2_SRGB_BLOCK, "VK_FORMAT_BC2_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_BC3_UNORM_BLOCK, "VK_FORMAT_BC3_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_BC3_SRGB_BLOCK, "VK_FORMAT_BC3_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_BC4_UNORM_BLOCK, "VK_FORMAT_BC4_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_BC4_SNORM_BLOCK, "VK_FORMAT_BC4_SNORM_BLOCK"},
+	{vulkan.VK_FORMAT_BC5_UNORM_BLOCK, "VK_FORMAT_BC5_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_BC5_SNORM_BLOCK, "VK_FORMAT_BC5_SNORM_BLOCK"},
+	{vulkan.VK_FORMAT_BC6H_UFLOAT_BLOCK, "VK_FORMAT_BC6H_UFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_BC6H_SFLOAT_BLOCK, "VK_FORMAT_BC6H_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_BC7_UNORM_BLOCK, "VK_FORMAT_BC7_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_BC7_SRGB_BLOCK, "VK_FORMAT_BC7_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ETC2_R8G8B8_UNORM_BLOCK, "VK_FORMAT_ETC2_R8G8B8_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ETC2_R8G8B8_SRGB_BLOCK, "VK_FORMAT_ETC2_R8G8B8_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ETC2_R8G8B8A1_UNORM_BLOCK, "VK_FORMAT_ETC2_R8G8B8A1_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ETC2_R8G8B8A1_SRGB_BLOCK, "VK_FORMAT_ETC2_R8G8B8A1_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ETC2_R8G8B8A8_UNORM_BLOCK, "VK_FORMAT_ETC2_R8G8B8A8_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ETC2_R8G8B8A8_SRGB_BLOCK, "VK_FORMAT_ETC2_R8G8B8A8_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_EAC_R11_UNORM_BLOCK, "VK_FORMAT_EAC_R11_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_EAC_R11_SNORM_BLOCK, "VK_FORMAT_EAC_R11_SNORM_BLOCK"},
+	{vulkan.VK_FORMAT_EAC_R11G11_UNORM_BLOCK, "VK_FORMAT_EAC_R11G11_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_EAC_R11G11_SNORM_BLOCK, "VK_FORMAT_EAC_R11G11_SNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_4x4_UNORM_BLOCK, "VK_FORMAT_ASTC_4x4_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_4x4_SRGB_BLOCK, "VK_FORMAT_ASTC_4x4_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_5x4_UNORM_BLOCK, "VK_FORMAT_ASTC_5x4_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_5x4_SRGB_BLOCK, "VK_FORMAT_ASTC_5x4_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_5x5_UNORM_BLOCK, "VK_FORMAT_ASTC_5x5_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_5x5_SRGB_BLOCK, "VK_FORMAT_ASTC_5x5_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_6x5_UNORM_BLOCK, "VK_FORMAT_ASTC_6x5_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_6x5_SRGB_BLOCK, "VK_FORMAT_ASTC_6x5_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_6x6_UNORM_BLOCK, "VK_FORMAT_ASTC_6x6_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_6x6_SRGB_BLOCK, "VK_FORMAT_ASTC_6x6_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_8x5_UNORM_BLOCK, "VK_FORMAT_ASTC_8x5_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_8x5_SRGB_BLOCK, "VK_FORMAT_ASTC_8x5_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_8x6_UNORM_BLOCK, "VK_FORMAT_ASTC_8x6_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_8x6_SRGB_BLOCK, "VK_FORMAT_ASTC_8x6_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_8x8_UNORM_BLOCK, "VK_FORMAT_ASTC_8x8_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_8x8_SRGB_BLOCK, "VK_FORMAT_ASTC_8x8_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_10x5_UNORM_BLOCK, "VK_FORMAT_ASTC_10x5_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_10x5_SRGB_BLOCK, "VK_FORMAT_ASTC_10x5_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_10x6_UNORM_BLOCK, "VK_FORMAT_ASTC_10x6_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_10x6_SRGB_BLOCK, "VK_FORMAT_ASTC_10x6_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_10x8_UNORM_BLOCK, "VK_FORMAT_ASTC_10x8_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_10x8_SRGB_BLOCK, "VK_FORMAT_ASTC_10x8_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_10x10_UNORM_BLOCK, "VK_FORMAT_ASTC_10x10_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_10x10_SRGB_BLOCK, "VK_FORMAT_ASTC_10x10_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_12x10_UNORM_BLOCK, "VK_FORMAT_ASTC_12x10_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_12x10_SRGB_BLOCK, "VK_FORMAT_ASTC_12x10_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_12x12_UNORM_BLOCK, "VK_FORMAT_ASTC_12x12_UNORM_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_12x12_SRGB_BLOCK, "VK_FORMAT_ASTC_12x12_SRGB_BLOCK"},
+	{vulkan.VK_FORMAT_G8B8G8R8_422_UNORM, "VK_FORMAT_G8B8G8R8_422_UNORM"},
+	{vulkan.VK_FORMAT_B8G8R8G8_422_UNORM, "VK_FORMAT_B8G8R8G8_422_UNORM"},
+	{vulkan.VK_FORMAT_G8_B8_R8_3PLANE_420_UNORM, "VK_FORMAT_G8_B8_R8_3PLANE_420_UNORM"},
+	{vulkan.VK_FORMAT_G8_B8R8_2PLANE_420_UNORM, "VK_FORMAT_G8_B8R8_2PLANE_420_UNORM"},
+	{vulkan.VK_FORMAT_G8_B8_R8_3PLANE_422_UNORM, "VK_FORMAT_G8_B8_R8_3PLANE_422_UNORM"},
+	{vulkan.VK_FORMAT_G8_B8R8_2PLANE_422_UNORM, "VK_FORMAT_G8_B8R8_2PLANE_422_UNORM"},
+	{vulkan.VK_FORMAT_G8_B8_R8_3PLANE_444_UNORM, "VK_FORMAT_G8_B8_R8_3PLANE_444_UNORM"},
+	{vulkan.VK_FORMAT_R10X6_UNORM_PACK16, "VK_FORMAT_R10X6_UNORM_PACK16"},
+	{vulkan.VK_FORMAT_R10X6G10X6_UNORM_2PACK16, "VK_FORMAT_R10X6G10X6_UNORM_2PACK16"},
+	{vulkan.VK_FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16, "VK_FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16"},
+	{vulkan.VK_FORMAT_G10X6B10X6G10X6R10X6_422_UNORM_4PACK16, "VK_FORMAT_G10X6B10X6G10X6R10X6_422_UNORM_4PACK16"},
+	{vulkan.VK_FORMAT_B10X6G10X6R10X6G10X6_422_UNORM_4PACK16, "VK_FORMAT_B10X6G10X6R10X6G10X6_422_UNORM_4PACK16"},
+	{vulkan.VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_420_UNORM_3PACK16, "VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_420_UNORM_3PACK16"},
+	{vulkan.VK_FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16, "VK_FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16"},
+	{vulkan.VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_422_UNORM_3PACK16, "VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_422_UNORM_3PACK16"},
+	{vulkan.VK_FORMAT_G10X6_B10X6R10X6_2PLANE_422_UNORM_3PACK16, "VK_FORMAT_G10X6_B10X6R10X6_2PLANE_422_UNORM_3PACK16"},
+	{vulkan.VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_444_UNORM_3PACK16, "VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_444_UNORM_3PACK16"},
+	{vulkan.VK_FORMAT_R12X4_UNORM_PACK16, "VK_FORMAT_R12X4_UNORM_PACK16"},
+	{vulkan.VK_FORMAT_R12X4G12X4_UNORM_2PACK16, "VK_FORMAT_R12X4G12X4_UNORM_2PACK16"},
+	{vulkan.VK_FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16, "VK_FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16"},
+	{vulkan.VK_FORMAT_G12X4B12X4G12X4R12X4_422_UNORM_4PACK16, "VK_FORMAT_G12X4B12X4G12X4R12X4_422_UNORM_4PACK16"},
+	{vulkan.VK_FORMAT_B12X4G12X4R12X4G12X4_422_UNORM_4PACK16, "VK_FORMAT_B12X4G12X4R12X4G12X4_422_UNORM_4PACK16"},
+	{vulkan.VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_420_UNORM_3PACK16, "VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_420_UNORM_3PACK16"},
+	{vulkan.VK_FORMAT_G12X4_B12X4R12X4_2PLANE_420_UNORM_3PACK16, "VK_FORMAT_G12X4_B12X4R12X4_2PLANE_420_UNORM_3PACK16"},
+	{vulkan.VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_422_UNORM_3PACK16, "VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_422_UNORM_3PACK16"},
+	{vulkan.VK_FORMAT_G12X4_B12X4R12X4_2PLANE_422_UNORM_3PACK16, "VK_FORMAT_G12X4_B12X4R12X4_2PLANE_422_UNORM_3PACK16"},
+	{vulkan.VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_444_UNORM_3PACK16, "VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_444_UNORM_3PACK16"},
+	{vulkan.VK_FORMAT_G16B16G16R16_422_UNORM, "VK_FORMAT_G16B16G16R16_422_UNORM"},
+	{vulkan.VK_FORMAT_B16G16R16G16_422_UNORM, "VK_FORMAT_B16G16R16G16_422_UNORM"},
+	{vulkan.VK_FORMAT_G16_B16_R16_3PLANE_420_UNORM, "VK_FORMAT_G16_B16_R16_3PLANE_420_UNORM"},
+	{vulkan.VK_FORMAT_G16_B16R16_2PLANE_420_UNORM, "VK_FORMAT_G16_B16R16_2PLANE_420_UNORM"},
+	{vulkan.VK_FORMAT_G16_B16_R16_3PLANE_422_UNORM, "VK_FORMAT_G16_B16_R16_3PLANE_422_UNORM"},
+	{vulkan.VK_FORMAT_G16_B16R16_2PLANE_422_UNORM, "VK_FORMAT_G16_B16R16_2PLANE_422_UNORM"},
+	{vulkan.VK_FORMAT_G16_B16_R16_3PLANE_444_UNORM, "VK_FORMAT_G16_B16_R16_3PLANE_444_UNORM"},
+	{vulkan.VK_FORMAT_G8_B8R8_2PLANE_444_UNORM, "VK_FORMAT_G8_B8R8_2PLANE_444_UNORM"},
+	{vulkan.VK_FORMAT_G10X6_B10X6R10X6_2PLANE_444_UNORM_3PACK16, "VK_FORMAT_G10X6_B10X6R10X6_2PLANE_444_UNORM_3PACK16"},
+	{vulkan.VK_FORMAT_G12X4_B12X4R12X4_2PLANE_444_UNORM_3PACK16, "VK_FORMAT_G12X4_B12X4R12X4_2PLANE_444_UNORM_3PACK16"},
+	{vulkan.VK_FORMAT_G16_B16R16_2PLANE_444_UNORM, "VK_FORMAT_G16_B16R16_2PLANE_444_UNORM"},
+	{vulkan.VK_FORMAT_A4R4G4B4_UNORM_PACK16, "VK_FORMAT_A4R4G4B4_UNORM_PACK16"},
+	{vulkan.VK_FORMAT_A4B4G4R4_UNORM_PACK16, "VK_FORMAT_A4B4G4R4_UNORM_PACK16"},
+	{vulkan.VK_FORMAT_ASTC_4x4_SFLOAT_BLOCK, "VK_FORMAT_ASTC_4x4_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_5x4_SFLOAT_BLOCK, "VK_FORMAT_ASTC_5x4_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_5x5_SFLOAT_BLOCK, "VK_FORMAT_ASTC_5x5_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_6x5_SFLOAT_BLOCK, "VK_FORMAT_ASTC_6x5_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_6x6_SFLOAT_BLOCK, "VK_FORMAT_ASTC_6x6_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_8x5_SFLOAT_BLOCK, "VK_FORMAT_ASTC_8x5_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_8x6_SFLOAT_BLOCK, "VK_FORMAT_ASTC_8x6_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_8x8_SFLOAT_BLOCK, "VK_FORMAT_ASTC_8x8_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_10x5_SFLOAT_BLOCK, "VK_FORMAT_ASTC_10x5_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_10x6_SFLOAT_BLOCK, "VK_FORMAT_ASTC_10x6_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_10x8_SFLOAT_BLOCK, "VK_FORMAT_ASTC_10x8_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_10x10_SFLOAT_BLOCK, "VK_FORMAT_ASTC_10x10_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_12x10_SFLOAT_BLOCK, "VK_FORMAT_ASTC_12x10_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_ASTC_12x12_SFLOAT_BLOCK, "VK_FORMAT_ASTC_12x12_SFLOAT_BLOCK"},
+	{vulkan.VK_FORMAT_PVRTC1_2BPP_UNORM_BLOCK_IMG, "VK_FORMAT_PVRTC1_2BPP_UNORM_BLOCK_IMG"},
+	{vulkan.VK_FORMAT_PVRTC1_4BPP_UNORM_BLOCK_IMG, "VK_FORMAT_PVRTC1_4BPP_UNORM_BLOCK_IMG"},
+	{vulkan.VK_FORMAT_PVRTC2_2BPP_UNORM_BLOCK_IMG, "VK_FORMAT_PVRTC2_2BPP_UNORM_BLOCK_IMG"},
+	{vulkan.VK_FORMAT_PVRTC2_4BPP_UNORM_BLOCK_IMG, "VK_FORMAT_PVRTC2_4BPP_UNORM_BLOCK_IMG"},
+	{vulkan.VK_FORMAT_PVRTC1_2BPP_SRGB_BLOCK_IMG, "VK_FORMAT_PVRTC1_2BPP_SRGB_BLOCK_IMG"},
+	{vulkan.VK_FORMAT_PVRTC1_4BPP_SRGB_BLOCK_IMG, "VK_FORMAT_PVRTC1_4BPP_SRGB_BLOCK_IMG"},
+	{vulkan.VK_FORMAT_PVRTC2_2BPP_SRGB_BLOCK_IMG, "VK_FORMAT_PVRTC2_2BPP_SRGB_BLOCK_IMG"},
+	{vulkan.VK_FORMAT_PVRTC2_4BPP_SRGB_BLOCK_IMG, "VK_FORMAT_PVRTC2_4BPP_SRGB_BLOCK_IMG"},
+	{vulkan.VK_FORMAT_R16G16_S10_5_NV, "VK_FORMAT_R16G16_S10_5_NV"},
+}
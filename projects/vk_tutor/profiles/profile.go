@@ -0,0 +1,485 @@
+package profiles
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"example.com/vk_tutor/vulkan"
+)
+
+// Schema is the $schema written by FromDevice.
+const Schema = "https://schema.khronos.org/vulkan/profiles-0.8-latest.json#"
+
+// File is a profiles document as found in JSON.
+type File struct {
+	Schema       string                   `json:"$schema"`
+	Capabilities map[string]*Capabilities `json:"capabilities"`
+	Profiles     map[string]*ProfileInfo  `json:"profiles"`
+}
+
+// Capabilities is one named block of requirements. Structures are kept as
+// JSON until a profile using them is compiled.
+type Capabilities struct {
+	Extensions    map[string]uint32                     `json:"extensions,omitempty"` // Name to spec version
+	Features      map[string]json.RawMessage            `json:"features,omitempty"`
+	Properties    map[string]json.RawMessage            `json:"properties,omitempty"`
+	Formats       map[string]map[string]json.RawMessage `json:"formats,omitempty"`
+	QueueFamilies []map[string]json.RawMessage          `json:"queueFamiliesProperties,omitempty"`
+}
+
+// ProfileInfo describes a profile and lists its capability blocks.
+type ProfileInfo struct {
+	Version      int            `json:"version"`
+	APIVersion   string         `json:"api-version"`
+	Label        string         `json:"label"`
+	Description  string         `json:"description"`
+	Capabilities []Alternatives `json:"capabilities"`
+}
+
+// Alternatives are capability block names of which one must be met. In
+// JSON a single name is a string, several are an array.
+type Alternatives []string
+
+func (o *Alternatives) UnmarshalJSON(b []byte) error {
+
+	var s string
+	if nil == json.Unmarshal(b, &s) {
+		*o = Alternatives{s}
+		return nil
+	}
+
+	var a []string
+	if err := json.Unmarshal(b, &a); nil != err {
+		return fmt.Errorf("capabilities: want a name or a list of names: %w", err)
+	}
+	*o = a
+
+	return nil
+}
+
+func (o Alternatives) MarshalJSON() ([]byte, error) {
+	if 1 == len(o) {
+		return json.Marshal(o[0])
+	}
+	return json.Marshal([]string(o))
+}
+
+// Load reads a profiles document.
+func Load(r io.Reader) (*File, error) {
+
+	var f File
+
+	var dec = json.NewDecoder(r)
+	if err := dec.Decode(&f); nil != err {
+		return nil, fmt.Errorf("profiles: %w", err)
+	}
+
+	if 0 == len(f.Profiles) {
+		return nil, fmt.Errorf("profiles: no profiles in file")
+	}
+
+	return &f, nil
+}
+
+// LoadFile reads the profiles document at path.
+func LoadFile(path string) (*File, error) {
+
+	var f, err = os.Open(path)
+	if nil != err {
+		return nil, err
+	}
+	defer f.Close()
+
+	r, err := Load(f)
+	if nil != err {
+		return nil, fmt.Errorf("%v: %w", path, err)
+	}
+
+	return r, nil
+}
+
+// Names lists the profiles of the file, sorted.
+func (o *File) Names() []string {
+
+	var r []string
+	for name := range o.Profiles {
+		r = append(r, name)
+	}
+	sort.Strings(r)
+
+	return r
+}
+
+// Write writes the file as indented JSON.
+func (o *File) Write(w io.Writer) error {
+	var enc = json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	return enc.Encode(o)
+}
+
+// Profile is a compiled profile, ready to Check devices against.
+type Profile struct {
+	Name        string
+	Version     int
+	APIVersion  uint32 // 0 when not given
+	Label       string
+	Description string
+	Ignored     []string // Structures of the file that are not checked
+
+	groups [][]*capability // One capability of each group must be met
+}
+
+// capability is a compiled Capabilities block.
+type capability struct {
+	name          string
+	extensions    map[string]uint32
+	features      vulkan.FeatureSet
+	limits        vulkan.VkPhysicalDeviceLimits
+	sparse        vulkan.VkPhysicalDeviceSparseProperties
+	formats       []formatRequirement
+	queueFamilies []vulkan.VkQueueFamilyProperties
+
+	// Members given in the file, by Go field name and in struct order
+	limitSet, sparseSet []string
+}
+
+type formatRequirement struct {
+	format vulkan.VkFormat
+	props  vulkan.VkFormatProperties
+}
+
+// Profile compiles the profile name, which may be "" when the file has only
+// one profile.
+func (o *File) Profile(name string) (*Profile, error) {
+
+	if "" == name {
+		if 1 != len(o.Profiles) {
+			return nil, fmt.Errorf("profiles: file has %v profiles, choose one of %v", len(o.Profiles), strings.Join(o.Names(), ", "))
+		}
+		name = o.Names()[0]
+	}
+
+	var info, ok = o.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profiles: no profile %v, have %v", name, strings.Join(o.Names(), ", "))
+	}
+
+	var r = &Profile{
+		Name:        name,
+		Version:     info.Version,
+		Label:       info.Label,
+		Description: info.Description,
+	}
+
+	if "" != info.APIVersion {
+		var v, err = vulkan.ParseVersion(info.APIVersion)
+		if nil != err {
+			return nil, fmt.Errorf("profiles: %v: %w", name, err)
+		}
+		r.APIVersion = uint32(v)
+	}
+
+	// Blocks shared by alternatives are only compiled once
+	var compiled = make(map[string]*capability)
+
+	for _, alts := range info.Capabilities {
+
+		if 0 == len(alts) {
+			return nil, fmt.Errorf("profiles: %v: empty list of alternatives", name)
+		}
+
+		var group []*capability
+
+		for _, c := range alts {
+			if nil == compiled[c] {
+				var caps, ok = o.Capabilities[c]
+				if !ok {
+					return nil, fmt.Errorf("profiles: %v: no capabilities %v", name, c)
+				}
+				var cc, err = compile(c, caps, &r.Ignored)
+				if nil != err {
+					return nil, fmt.Errorf("profiles: %v: capabilities %v: %w", name, c, err)
+				}
+				compiled[c] = cc
+			}
+			group = append(group, compiled[c])
+		} // for
+
+		r.groups = append(r.groups, group)
+	} // for
+
+	return r, nil
+}
+
+func compile(name string, c *Capabilities, ignored *[]string) (*capability, error) {
+
+	var r = &capability{name: name, extensions: c.Extensions}
+
+	var ignore = func(what string) {
+		*ignored = append(*ignored, name+": "+what)
+	}
+
+	// Features -----
+
+	for _, s := range sortedKeys(c.Features) {
+		var raw = c.Features[s]
+		switch s {
+		case "VkPhysicalDeviceFeatures2":
+			var wrap struct{ Features json.RawMessage }
+			if err := json.Unmarshal(raw, &wrap); nil != err {
+				return nil, fmt.Errorf("%v: %w", s, err)
+			}
+			raw = wrap.Features
+			fallthrough
+		case "VkPhysicalDeviceFeatures":
+			var f vulkan.VkPhysicalDeviceFeatures
+			if _, err := decodeStruct(raw, &f, nil); nil != err {
+				return nil, fmt.Errorf("%v: %w", s, err)
+			}
+			r.features = r.features.Union(f.FeatureSet())
+		default:
+			ignore(s)
+		} // switch
+	} // for
+
+	// Properties -----
+
+	for _, s := range sortedKeys(c.Properties) {
+		var raw = c.Properties[s]
+		switch s {
+		case "VkPhysicalDeviceProperties2":
+			var wrap struct{ Properties json.RawMessage }
+			if err := json.Unmarshal(raw, &wrap); nil != err {
+				return nil, fmt.Errorf("%v: %w", s, err)
+			}
+			raw = wrap.Properties
+			fallthrough
+		case "VkPhysicalDeviceProperties":
+			var members map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &members); nil != err {
+				return nil, fmt.Errorf("%v: %w", s, err)
+			}
+			for _, m := range sortedKeys(members) {
+				var err error
+				switch m {
+				case "limits":
+					r.limitSet, err = decodeStruct(members[m], &r.limits, sampleCountFlagBits)
+				case "sparseProperties":
+					r.sparseSet, err = decodeStruct(members[m], &r.sparse, nil)
+				default:
+					ignore("VkPhysicalDeviceProperties." + m)
+				} // switch
+				if nil != err {
+					return nil, fmt.Errorf("%v.%v: %w", s, m, err)
+				}
+			} // for
+		default:
+			ignore(s)
+		} // switch
+	} // for
+
+	// Formats -----
+
+	for _, fname := range sortedKeys(c.Formats) {
+
+		var f, ok = LookupFormat(fname)
+		if !ok {
+			return nil, fmt.Errorf("unknown format %v", fname)
+		}
+
+		var req = formatRequirement{format: f}
+		var found = false
+
+		for _, s := range sortedKeys(c.Formats[fname]) {
+			var raw = c.Formats[fname][s]
+			switch s {
+			case "VkFormatProperties2":
+				var wrap struct{ FormatProperties json.RawMessage }
+				if err := json.Unmarshal(raw, &wrap); nil != err {
+					return nil, fmt.Errorf("%v: %v: %w", fname, s, err)
+				}
+				raw = wrap.FormatProperties
+				fallthrough
+			case "VkFormatProperties":
+				if err := decodeFormatProperties(raw, &req.props); nil != err {
+					return nil, fmt.Errorf("%v: %v: %w", fname, s, err)
+				}
+				found = true
+			default:
+				ignore(fname + " " + s)
+			} // switch
+		} // for
+
+		if found {
+			r.formats = append(r.formats, req)
+		}
+	} // for
+
+	// Queue families -----
+
+	for i, q := range c.QueueFamilies {
+		for _, s := range sortedKeys(q) {
+			var raw = q[s]
+			switch s {
+			case "VkQueueFamilyProperties2":
+				var wrap struct{ QueueFamilyProperties json.RawMessage }
+				if err := json.Unmarshal(raw, &wrap); nil != err {
+					return nil, fmt.Errorf("queue family %v: %v: %w", i, s, err)
+				}
+				raw = wrap.QueueFamilyProperties
+				fallthrough
+			case "VkQueueFamilyProperties":
+				var p, err = decodeQueueFamily(raw)
+				if nil != err {
+					return nil, fmt.Errorf("queue family %v: %v: %w", i, s, err)
+				}
+				r.queueFamilies = append(r.queueFamilies, p)
+			default:
+				ignore(fmt.Sprintf("queue family %v %v", i, s))
+			} // switch
+		} // for
+	} // for
+
+	return r, nil
+}
+
+var sampleCountType = reflect.TypeOf(vulkan.VkSampleCountFlags(0))
+
+// decodeStruct sets the members of the struct p points to from a JSON
+// object keyed by member name. Flag members are lists of bit names. It
+// returns the Go names of the fields set, in struct order.
+func decodeStruct(raw json.RawMessage, p interface{}, bits []flagBit) ([]string, error) {
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &members); nil != err {
+		return nil, err
+	}
+
+	var v = reflect.ValueOf(p).Elem()
+
+	for name, m := range members {
+
+		var f = v.FieldByName(goName(name))
+		if !f.IsValid() {
+			return nil, fmt.Errorf("unknown member %v", name)
+		}
+
+		if sampleCountType == f.Type() {
+			var names []string
+			if err := json.Unmarshal(m, &names); nil != err {
+				return nil, fmt.Errorf("%v: %w", name, err)
+			}
+			var flags, err = parseFlags(names, bits)
+			if nil != err {
+				return nil, fmt.Errorf("%v: %w", name, err)
+			}
+			f.SetUint(uint64(flags))
+			continue
+		}
+
+		if err := json.Unmarshal(m, f.Addr().Interface()); nil != err {
+			return nil, fmt.Errorf("%v: %w", name, err)
+		}
+	} // for
+
+	var r []string
+	for i := 0; i < v.NumField(); i++ {
+		var name = v.Type().Field(i).Name
+		if _, ok := members[specName(name)]; ok {
+			r = append(r, name)
+		}
+	}
+
+	return r, nil
+}
+
+func decodeFormatProperties(raw json.RawMessage, p *vulkan.VkFormatProperties) error {
+
+	var j struct {
+		LinearTilingFeatures  []string
+		OptimalTilingFeatures []string
+		BufferFeatures        []string
+	}
+	if err := json.Unmarshal(raw, &j); nil != err {
+		return err
+	}
+
+	var linear, err = parseFlags(j.LinearTilingFeatures, formatFeatureFlagBits)
+	if nil != err {
+		return fmt.Errorf("linearTilingFeatures: %w", err)
+	}
+	optimal, err := parseFlags(j.OptimalTilingFeatures, formatFeatureFlagBits)
+	if nil != err {
+		return fmt.Errorf("optimalTilingFeatures: %w", err)
+	}
+	buffer, err := parseFlags(j.BufferFeatures, formatFeatureFlagBits)
+	if nil != err {
+		return fmt.Errorf("bufferFeatures: %w", err)
+	}
+
+	p.LinearTilingFeatures |= vulkan.VkFormatFeatureFlags(linear)
+	p.OptimalTilingFeatures |= vulkan.VkFormatFeatureFlags(optimal)
+	p.BufferFeatures |= vulkan.VkFormatFeatureFlags(buffer)
+
+	return nil
+}
+
+func decodeQueueFamily(raw json.RawMessage) (vulkan.VkQueueFamilyProperties, error) {
+
+	var r vulkan.VkQueueFamilyProperties
+
+	var j struct {
+		QueueFlags                  []string
+		QueueCount                  uint32
+		TimestampValidBits          uint32
+		MinImageTransferGranularity vulkan.VkExtent3D
+	}
+	if err := json.Unmarshal(raw, &j); nil != err {
+		return r, err
+	}
+
+	var flags, err = parseFlags(j.QueueFlags, queueFlagBits)
+	if nil != err {
+		return r, fmt.Errorf("queueFlags: %w", err)
+	}
+
+	r.QueueFlags = vulkan.VkQueueFlags(flags)
+	r.QueueCount = j.QueueCount
+	r.TimestampValidBits = j.TimestampValidBits
+	r.MinImageTransferGranularity = j.MinImageTransferGranularity
+
+	return r, nil
+}
+
+// goName turns a member name into the Go field name, e.g.
+// maxImageDimension1D into MaxImageDimension1D.
+func goName(s string) string {
+	if "" == s {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// specName is the reverse of goName.
+func specName(s string) string {
+	if "" == s {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+
+	var r = make([]string, 0, len(m))
+	for k := range m {
+		r = append(r, k)
+	}
+	sort.Strings(r)
+
+	return r
+}
@@ -1,2 +1,5 @@
 // SDL2 API
+//
+// On Windows the package links the static SDL2 of lib, on Linux the system
+// SDL2 found with pkg-config sdl2, which must be 2.26 or newer.
 package sdl2
@@ -0,0 +1,173 @@
+package sdl2
+
+import "sync"
+
+// EventLoop dispatches SDL events without spinning: while idle it blocks in
+// SDL_WaitEvent, else it drains the queue and calls Frame. It is idle
+// without a Frame, after SetIdle(true) and while the last window event
+// minimized or hid a window.
+//
+// Run must be called on the thread that initialized the video subsystem,
+// usually the main thread locked with runtime.LockOSThread. Wake and Post
+// may be called from any goroutine.
+type EventLoop struct {
+	// Event is called for every event but the wake-ups of the loop. Run
+	// returns when it returns false. Without Event, Run returns on SDL_QUIT.
+	Event func(e Event) bool
+
+	// Frame is called once the queue is drained while not idle, e.g. to
+	// draw. Run returns when it returns false.
+	Frame func() bool
+
+	// LiveResize calls Frame from an event watch while a window is resized,
+	// for platforms where the system blocks SDL_PollEvent in a modal loop
+	// meanwhile, e.g. Windows. Only watches called on the thread of Run draw.
+	LiveResize bool
+
+	wakeType  uint32
+	idle      bool
+	minimized bool
+	stopped   bool
+
+	mu     sync.Mutex
+	posted []func()
+}
+
+// NewEventLoop registers the user event type used to wake the loop. SDL
+// must be initialized.
+func NewEventLoop() (*EventLoop, error) {
+
+	var t, err = SDL_RegisterEvents(1)
+	if nil != err {
+		return nil, err
+	}
+
+	return &EventLoop{wakeType: t}, nil
+}
+
+// SetIdle makes the loop wait for events instead of calling Frame, e.g.
+// while paused. Call it from Event, Frame or a posted function.
+func (o *EventLoop) SetIdle(idle bool) {
+	o.idle = idle
+}
+
+// Idle reports whether the loop waits for events.
+func (o *EventLoop) Idle() bool {
+	return o.idle || o.minimized || nil == o.Frame
+}
+
+// Wake makes a waiting loop check for work, e.g. after a goroutine has
+// finished loading something Frame picks up.
+func (o *EventLoop) Wake() error {
+
+	var e = SDL_UserEvent{SDL_CommonEvent: SDL_CommonEvent{Type: SDL_EventType(o.wakeType)}}
+
+	var _, err = SDL_PushEvent(e.Encode())
+
+	return err
+}
+
+// Post runs f on the thread of the loop and wakes it. f runs even if the
+// wake-up cannot be queued, with the next event.
+func (o *EventLoop) Post(f func()) error {
+
+	o.mu.Lock()
+	o.posted = append(o.posted, f)
+	o.mu.Unlock()
+
+	return o.Wake()
+}
+
+// Run dispatches events until Event or Frame returns false. It fails when
+// SDL_WaitEvent does.
+func (o *EventLoop) Run() error {
+
+	var e SDL_Event
+
+	o.stopped = false
+
+	if o.LiveResize {
+		var watch = SDL_AddEventWatch(o.resizeWatch(SDL_ThreadID()))
+		defer SDL_DelEventWatch(watch)
+	}
+
+	for {
+
+		var got = 1
+		if o.Idle() {
+			if err := SDL_WaitEvent(&e); nil != err {
+				return err
+			}
+		} else {
+			got = SDL_PollEvent(&e)
+		}
+
+		switch {
+		case o.stopped:
+			return nil
+		case 0 != got:
+			if !o.dispatch(&e) {
+				return nil
+			}
+		case !o.Frame():
+			return nil
+		} // switch
+	} // for
+}
+
+// resizeWatch returns the watch of LiveResize, run is the thread of Run.
+func (o *EventLoop) resizeWatch(run SDL_threadID) SDL_EventFilter {
+
+	return func(e *SDL_Event) bool {
+
+		if SDL_WINDOWEVENT != e.Type() || SDL_ThreadID() != run || o.stopped || o.Idle() {
+			return true
+		}
+
+		switch e.Decode().(*SDL_WindowEvent).Event {
+		case SDL_WINDOWEVENT_SIZE_CHANGED, SDL_WINDOWEVENT_EXPOSED:
+			o.stopped = !o.Frame()
+		} // switch
+
+		return true
+	}
+}
+
+func (o *EventLoop) dispatch(raw *SDL_Event) bool {
+
+	o.runPosted()
+
+	if uint32(raw.Type()) == o.wakeType {
+		return true
+	}
+
+	var e = raw.Decode()
+	raw.Free()
+
+	if w, ok := e.(*SDL_WindowEvent); ok {
+		switch w.Event {
+		case SDL_WINDOWEVENT_MINIMIZED, SDL_WINDOWEVENT_HIDDEN:
+			o.minimized = true
+		case SDL_WINDOWEVENT_RESTORED, SDL_WINDOWEVENT_MAXIMIZED, SDL_WINDOWEVENT_SHOWN, SDL_WINDOWEVENT_EXPOSED:
+			o.minimized = false
+		} // switch
+	}
+
+	if nil == o.Event {
+		return SDL_QUIT != e.Common().Type
+	}
+
+	return o.Event(e)
+}
+
+func (o *EventLoop) runPosted() {
+
+	o.mu.Lock()
+	var posted = o.posted
+	o.posted = nil
+	o.mu.Unlock()
+
+	for _, f := range posted {
+		f()
+	}
+}
@@ -0,0 +1,92 @@
+package sdl2_test
+
+import (
+	"testing"
+
+	"example.com/vk_tutor/sdl2"
+	"example.com/vk_tutor/sdl2/sdltest"
+)
+
+func TestEventLoopIdle(t *testing.T) {
+
+	sdltest.Init(t, sdl2.SDL_INIT_VIDEO)
+
+	var loop, err = sdl2.NewEventLoop()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var types []sdl2.SDL_EventType
+	loop.Event = func(e sdl2.Event) bool {
+		types = append(types, e.Common().Type)
+		return sdl2.SDL_QUIT != e.Common().Type
+	}
+
+	sdltest.Events()
+
+	// Posted from another goroutine, the function runs on the thread of Run
+	var run = sdl2.SDL_ThreadID()
+	var posted sdl2.SDL_threadID
+	go func() {
+		var err = loop.Post(func() {
+			posted = sdl2.SDL_ThreadID()
+			sdltest.Push(t, &sdl2.SDL_QuitEvent{SDL_CommonEvent: sdl2.SDL_CommonEvent{Type: sdl2.SDL_QUIT}})
+		})
+		if nil != err {
+			t.Error(err)
+		}
+	}()
+
+	if err = loop.Run(); nil != err {
+		t.Fatal(err)
+	}
+
+	if run != posted {
+		t.Errorf("posted function ran on thread %d, want %d", posted, run)
+	}
+
+	// The wake-up of Post is not passed to Event
+	if 1 != len(types) || sdl2.SDL_QUIT != types[0] {
+		t.Errorf("Event got %v, want [%v]", types, sdl2.SDL_QUIT)
+	}
+}
+
+func TestEventLoopFrame(t *testing.T) {
+
+	sdltest.Init(t, sdl2.SDL_INIT_VIDEO)
+
+	var loop, err = sdl2.NewEventLoop()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	sdltest.Events()
+
+	var keys int
+	loop.Event = func(e sdl2.Event) bool {
+		if sdl2.SDL_KEYDOWN == e.Common().Type {
+			keys++
+		}
+		return true
+	}
+
+	var frames int
+	loop.Frame = func() bool {
+		frames++
+		if 1 == frames {
+			sdltest.Push(t, &sdl2.SDL_KeyboardEvent{SDL_CommonEvent: sdl2.SDL_CommonEvent{Type: sdl2.SDL_KEYDOWN}})
+		}
+		return frames < 3
+	}
+
+	if err = loop.Run(); nil != err {
+		t.Fatal(err)
+	}
+
+	if 3 != frames {
+		t.Errorf("frames = %d, want 3", frames)
+	}
+	if 1 != keys {
+		t.Errorf("key events = %d, want 1", keys)
+	}
+}
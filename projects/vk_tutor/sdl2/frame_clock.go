@@ -0,0 +1,208 @@
+package sdl2
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// FrameClock times the frames of a loop with the performance counter. Call
+// Tick once at the start of every frame, e.g. in EventLoop.Frame, then
+// update with its result for a variable timestep:
+//
+//	var dt = clock.Tick()
+//	update(dt)
+//
+// or with FixedStep set, for a fixed timestep:
+//
+//	clock.Tick()
+//	for clock.Update() {
+//		update(clock.FixedStep)
+//	}
+//	draw(clock.Alpha())
+type FrameClock struct {
+	// MaxFPS caps the frame rate: Tick sleeps with SDL_Delay until a
+	// deadline that advances 1/MaxFPS a frame. SDL_Delay sleeps whole
+	// milliseconds, the rounding of a frame carries over to the next, so
+	// the rate holds. After a stall of more than a frame the deadline
+	// starts over. 0 does not cap.
+	MaxFPS float64
+
+	// FixedStep is the time an update of Update advances. 0 disables
+	// Update.
+	FixedStep time.Duration
+
+	// MaxSteps bounds the updates of a frame, the time beyond is dropped
+	// so the loop catches up after a stall instead of falling further
+	// behind. 0 means 5.
+	MaxSteps int
+
+	freq     uint64
+	start    uint64 // Counter at the start of the frame
+	deadline uint64 // Counter MaxFPS sleeps until, 0 before the first
+	delta    time.Duration
+	acc      time.Duration // Not yet updated time of FixedStep
+	avg      time.Duration // Smoothed frame time
+	frames   uint64
+
+	times []time.Duration // Last frame times, a ring at frames
+}
+
+// frameTimes is the number of frame times kept for Percentile.
+const frameTimes = 240
+
+// NewFrameClock starts a clock, the first frame starts now.
+func NewFrameClock() *FrameClock {
+	return &FrameClock{
+		freq:  SDL_GetPerformanceFrequency(),
+		start: SDL_GetPerformanceCounter(),
+		times: make([]time.Duration, 0, frameTimes),
+	}
+}
+
+// Tick ends the previous frame and starts the next, after sleeping for
+// MaxFPS. It returns the time of the previous frame.
+func (o *FrameClock) Tick() time.Duration {
+
+	if o.MaxFPS > 0 {
+		if ms := o.pace(SDL_GetPerformanceCounter()) / time.Millisecond; ms > 0 {
+			SDL_Delay(uint32(ms))
+		}
+	}
+
+	return o.tick(SDL_GetPerformanceCounter())
+}
+
+// tick starts a frame at the counter now.
+func (o *FrameClock) tick(now uint64) time.Duration {
+
+	o.delta = o.since(now)
+	o.start = now
+
+	if o.FixedStep > 0 {
+		var steps = o.MaxSteps
+		if steps <= 0 {
+			steps = 5
+		}
+		o.acc += o.delta
+		if max := time.Duration(steps) * o.FixedStep; o.acc > max {
+			o.acc = max
+		}
+	}
+
+	// Exponential moving average, about the last 10 frames
+	if 0 == o.frames {
+		o.avg = o.delta
+	} else {
+		o.avg += (o.delta - o.avg) / 10
+	}
+
+	if len(o.times) < cap(o.times) {
+		o.times = append(o.times, o.delta)
+	} else if len(o.times) > 0 {
+		o.times[o.frames%uint64(len(o.times))] = o.delta
+	}
+
+	o.frames++
+
+	return o.delta
+}
+
+// pace advances the deadline of MaxFPS by a frame and returns the time
+// from the counter now until it.
+func (o *FrameClock) pace(now uint64) time.Duration {
+
+	var frame = uint64(float64(o.freq) / o.MaxFPS)
+
+	if 0 == o.deadline {
+		o.deadline = o.start
+	}
+	o.deadline += frame
+
+	if now >= o.deadline {
+		if now-o.deadline > frame {
+			o.deadline = now
+		}
+		return 0
+	}
+
+	return o.duration(o.deadline - now)
+}
+
+// since returns the time from the start of the frame to the counter now.
+func (o *FrameClock) since(now uint64) time.Duration {
+	if now < o.start {
+		return 0
+	}
+	return o.duration(now - o.start)
+}
+
+// duration converts n counts of the performance counter.
+func (o *FrameClock) duration(n uint64) time.Duration {
+	if 0 == o.freq {
+		return 0
+	}
+	return time.Duration(float64(n) * float64(time.Second) / float64(o.freq))
+}
+
+// Update reports whether an update of FixedStep is due and consumes it.
+func (o *FrameClock) Update() bool {
+
+	if o.FixedStep <= 0 || o.acc < o.FixedStep {
+		return false
+	}
+
+	o.acc -= o.FixedStep
+
+	return true
+}
+
+// Alpha returns how far the time is between the last update and the next,
+// from 0 to 1, to interpolate the drawn state.
+func (o *FrameClock) Alpha() float64 {
+	if o.FixedStep <= 0 {
+		return 0
+	}
+	return float64(o.acc) / float64(o.FixedStep)
+}
+
+// Delta returns the time of the previous frame.
+func (o *FrameClock) Delta() time.Duration {
+	return o.delta
+}
+
+// Frames returns the number of Ticks.
+func (o *FrameClock) Frames() uint64 {
+	return o.frames
+}
+
+// FPS returns the frame rate of the smoothed frame time.
+func (o *FrameClock) FPS() float64 {
+	if o.avg <= 0 {
+		return 0
+	}
+	return float64(time.Second) / float64(o.avg)
+}
+
+// Percentile returns the frame time p percent of the recent frames do not
+// exceed, e.g. 99 for the 1% slowest frames. It is 0 before the first Tick.
+func (o *FrameClock) Percentile(p float64) time.Duration {
+
+	if 0 == len(o.times) {
+		return 0
+	}
+
+	var sorted = append([]time.Duration(nil), o.times...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	// Nearest rank
+	var i = int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	switch {
+	case i < 0:
+		i = 0
+	case i >= len(sorted):
+		i = len(sorted) - 1
+	} // switch
+
+	return sorted[i]
+}
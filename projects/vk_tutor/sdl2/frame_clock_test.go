@@ -0,0 +1,146 @@
+package sdl2
+
+import (
+	"testing"
+	"time"
+)
+
+// testClock is a clock whose counter counts microseconds, started at 0.
+func testClock() *FrameClock {
+	return &FrameClock{
+		freq:  1000000,
+		times: make([]time.Duration, 0, frameTimes),
+	}
+}
+
+// ms is a millisecond of the counter of testClock.
+const ms = uint64(time.Millisecond / time.Microsecond)
+
+func TestFrameClockFixedStep(t *testing.T) {
+
+	var updates = func(c *FrameClock) int {
+		var n int
+		for c.Update() {
+			n++
+		}
+		return n
+	}
+
+	var c = testClock()
+	c.FixedStep = 10 * time.Millisecond
+
+	if d := c.tick(25 * ms); 25*time.Millisecond != d || c.Delta() != d {
+		t.Errorf("tick = %v, Delta %v, want 25ms", d, c.Delta())
+	}
+	if n := updates(c); 2 != n {
+		t.Errorf("%v updates, want 2", n)
+	}
+	if a := c.Alpha(); 0.5 != a {
+		t.Errorf("Alpha() = %v, want 0.5", a)
+	}
+
+	// The rest accumulates with the next frame
+	c.tick(28 * ms)
+	if n, a := updates(c), c.Alpha(); 0 != n || 0.8 != a {
+		t.Errorf("%v updates, Alpha %v, want 0, 0.8", n, a)
+	}
+	c.tick(31 * ms)
+	if n, a := updates(c), c.Alpha(); 1 != n || 0.1 != a {
+		t.Errorf("%v updates, Alpha %v, want 1, 0.1", n, a)
+	}
+
+	// A stall is clamped to MaxSteps updates, 5 by default
+	for _, c1 := range []struct {
+		maxSteps, want int
+	}{
+		{0, 5},
+		{3, 3},
+	} {
+		c.MaxSteps = c1.maxSteps
+		c.tick(c.start + 1000*ms)
+		if n := updates(c); c1.want != n || 0 != c.Alpha() {
+			t.Errorf("MaxSteps %v: %v updates, Alpha %v, want %v, 0", c1.maxSteps, n, c.Alpha(), c1.want)
+		}
+	} // for
+
+	// Without FixedStep there are no updates
+	c = testClock()
+	c.tick(25 * ms)
+	if c.Update() || 0 != c.Alpha() {
+		t.Error("update without FixedStep")
+	}
+}
+
+func TestFrameClockTimes(t *testing.T) {
+
+	var c = testClock()
+	if 0 != c.Percentile(50) || 0 != c.FPS() {
+		t.Errorf("Percentile %v, FPS %v before the first frame", c.Percentile(50), c.FPS())
+	}
+
+	// Steady 16ms frames
+	var now uint64
+	for i := 0; i < 20; i++ {
+		now += 16 * ms
+		c.tick(now)
+	}
+	if 62.5 != c.FPS() {
+		t.Errorf("FPS() = %v, want 62.5", c.FPS())
+	}
+
+	// Frames of 1ms to 300ms, only the last frameTimes are kept
+	c = testClock()
+	now = 0
+	for i := 1; i <= 300; i++ {
+		now += uint64(i) * ms
+		c.tick(now)
+	}
+
+	if 300 != c.Frames() || frameTimes != len(c.times) {
+		t.Fatalf("%v frames, %v times", c.Frames(), len(c.times))
+	}
+
+	for _, c1 := range []struct {
+		p    float64
+		want time.Duration
+	}{
+		{0, 61 * time.Millisecond},
+		{1, 63 * time.Millisecond},   // Rank ceil(2.4) = 3
+		{50, 180 * time.Millisecond}, // Rank 120
+		{99, 298 * time.Millisecond}, // Rank ceil(237.6) = 238
+		{100, 300 * time.Millisecond},
+		{150, 300 * time.Millisecond},
+	} {
+		if got := c.Percentile(c1.p); c1.want != got {
+			t.Errorf("Percentile(%v) = %v, want %v", c1.p, got, c1.want)
+		}
+	} // for
+}
+
+func TestFrameClockPace(t *testing.T) {
+
+	var c = testClock()
+	c.MaxFPS = 50 // 20ms frames
+	c.tick(1000 * ms)
+
+	for _, c1 := range []struct {
+		now  uint64 // Counter when Tick starts to sleep
+		want time.Duration
+		wake uint64 // Counter after the sleep
+	}{
+		{1005 * ms, 15 * time.Millisecond, 1019*ms + 400},
+		// Woke early, the deadline is still 40ms after the first frame
+		{1024 * ms, 16 * time.Millisecond, 1040 * ms},
+		// Late by less than a frame, no sleep, the deadline stays
+		{1065 * ms, 0, 1065 * ms},
+		{1070 * ms, 10 * time.Millisecond, 1080 * ms},
+		// Stalled, the deadline starts over at the stall
+		{1200 * ms, 0, 1200 * ms},
+		{1201 * ms, 19 * time.Millisecond, 1220 * ms},
+	} {
+		if got := c.pace(c1.now); c1.want != got {
+			t.Errorf("pace(%v) = %v, want %v", c1.now, got, c1.want)
+		}
+		c.tick(c1.wake)
+	} // for
+}
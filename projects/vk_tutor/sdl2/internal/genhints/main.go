@@ -0,0 +1,143 @@
+// Command genhints rebuilds the SDL_HINT_ constants of sdl_hints.go from
+// SDL_hints.h, for use with go generate in package sdl2:
+//
+//	//go:generate go run ./internal/genhints include/SDL2/SDL_hints.h sdl_hints.go
+//
+// Every #define SDL_HINT_ of the header becomes a constant of type SDL_Hint
+// with the comment block above it and the #define line as comment. The const
+// block following "type SDL_Hint string" is replaced, the rest of the file is
+// kept.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var defineRe = regexp.MustCompile(`^#define\s+(SDL_HINT_\w+)\s+("[^"]*")\s*$`)
+
+const blockStart = "type SDL_Hint string\n\nconst (\n"
+
+func main() {
+
+	if 3 != len(os.Args) {
+		fmt.Fprintln(os.Stderr, "usage: genhints SDL_hints.h sdl_hints.go")
+		os.Exit(2)
+	}
+
+	if err := run(os.Args[1], os.Args[2]); nil != err {
+		fmt.Fprintln(os.Stderr, "genhints:", err)
+		os.Exit(1)
+	}
+}
+
+func run(header, target string) error {
+
+	var h, err = os.ReadFile(header)
+	if nil != err {
+		return err
+	}
+
+	consts, err := hintConsts(h)
+	if nil != err {
+		return fmt.Errorf("%v: %w", header, err)
+	}
+
+	src, err := os.ReadFile(target)
+	if nil != err {
+		return err
+	}
+
+	var start = bytes.Index(src, []byte(blockStart))
+	if start < 0 {
+		return fmt.Errorf("%v: no %q", target, "type SDL_Hint string")
+	}
+	start += len(blockStart)
+
+	var end = bytes.Index(src[start:], []byte("\n)\n"))
+	if end < 0 {
+		return fmt.Errorf("%v: const block of SDL_Hint not closed", target)
+	}
+	end += start + 1
+
+	var b bytes.Buffer
+	b.Write(src[:start])
+	b.WriteString(consts)
+	b.Write(src[end:])
+
+	out, err := format.Source(b.Bytes())
+	if nil != err {
+		return fmt.Errorf("%v: %w", target, err)
+	}
+
+	if bytes.Equal(src, out) {
+		return nil
+	}
+
+	return os.WriteFile(target, out, 0o644)
+}
+
+// hintConsts returns the body of the const block: per #define SDL_HINT_ the
+// comment block directly above it, the #define line and the constant.
+func hintConsts(header []byte) (string, error) {
+
+	var b strings.Builder
+	var comment []string
+	var inComment bool
+	var n int
+	var follows bool // The line is right after a #define, as a group
+
+	var sc = bufio.NewScanner(bytes.NewReader(header))
+	for sc.Scan() {
+
+		var l = strings.TrimRight(sc.Text(), " \t\r")
+		var t = strings.TrimSpace(l)
+		var define = strings.HasPrefix(t, "#define SDL_HINT_")
+
+		switch {
+		case inComment:
+			comment = append(comment, l)
+			inComment = !strings.Contains(t, "*/")
+
+		case strings.HasPrefix(t, "/*"):
+			comment = append(comment[:0], l)
+			inComment = !strings.Contains(t[2:], "*/")
+
+		case define:
+			var m = defineRe.FindStringSubmatch(t)
+			if nil == m {
+				return "", fmt.Errorf("cannot parse %q", t)
+			}
+			if n > 0 && !follows {
+				b.WriteString("\n")
+			}
+			for _, c := range comment {
+				fmt.Fprintf(&b, "\t%v\n", c)
+			}
+			fmt.Fprintf(&b, "\t// %v\n", t)
+			fmt.Fprintf(&b, "\t%v SDL_Hint = %v\n", m[1], m[2])
+			comment = comment[:0]
+			n++
+
+		case "" != t:
+			comment = comment[:0]
+		} // switch
+
+		follows = define
+	} // for
+
+	if err := sc.Err(); nil != err {
+		return "", err
+	}
+
+	if 0 == n {
+		return "", fmt.Errorf("no #define SDL_HINT_")
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestHintConsts(t *testing.T) {
+
+	var header = `#ifndef SDL_hints_h_
+#define SDL_hints_h_
+
+/**
+ *  \brief  First hint.
+ */
+#define SDL_HINT_FIRST   "SDL_FIRST"
+
+/* Not a hint's comment */
+extern int x;
+#define SDL_HINT_SECOND "SDL_SECOND"
+#define SDL_HINT_THIRD  "SDL_THIRD"
+
+#endif
+`
+
+	var want = `	/**
+	 *  \brief  First hint.
+	 */
+	// #define SDL_HINT_FIRST   "SDL_FIRST"
+	SDL_HINT_FIRST SDL_Hint = "SDL_FIRST"
+
+	// #define SDL_HINT_SECOND "SDL_SECOND"
+	SDL_HINT_SECOND SDL_Hint = "SDL_SECOND"
+	// #define SDL_HINT_THIRD  "SDL_THIRD"
+	SDL_HINT_THIRD SDL_Hint = "SDL_THIRD"
+`
+
+	var got, err = hintConsts([]byte(header))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if want != got {
+		t.Errorf("hintConsts =\n%v\nwant\n%v", got, want)
+	}
+
+	if _, err = hintConsts([]byte("#define SDL_HINT_BAD SDL_OTHER\n")); nil == err {
+		t.Error("unquoted value accepted")
+	}
+}
@@ -0,0 +1,59 @@
+//go:build go1.21
+
+// log/slog is new in Go 1.21 while the module still declares Go 1.20, older
+// toolchains build the package without the bridge.
+
+package sdl2
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogToSlog sends the SDL log to logger, slog.Default() for nil, see
+// SlogOutput. Messages below the priorities of SDL_LogSetPriority never
+// reach it, e.g. SDL_LogSetAllPriority(SDL_LOG_PRIORITY_WARN) passes SDL's
+// own warnings on.
+//
+// Vulkan validation messages join the same log through a debug messenger of
+// bootstrap.SlogMessenger.
+func LogToSlog(logger *slog.Logger) {
+	SDL_LogSetOutputFunction(SlogOutput(logger))
+}
+
+// SlogOutput returns an output function logging the messages to logger,
+// slog.Default() for nil, at SlogLevel of the priority with the attribute
+// "category", e.g. category=video.
+func SlogOutput(logger *slog.Logger) SDL_LogOutputFunction {
+
+	return func(category SDL_LogCategory, priority SDL_LogPriority, message string) {
+
+		var l = logger
+		if nil == l {
+			l = slog.Default()
+		}
+
+		l.LogAttrs(context.Background(), SlogLevel(priority), message, slog.String("category", category.String()))
+	}
+}
+
+// SlogLevel maps a priority to a level: SDL_LOG_PRIORITY_DEBUG, INFO, WARN
+// and ERROR to the levels of the same name, VERBOSE below slog.LevelDebug
+// and CRITICAL above slog.LevelError.
+func SlogLevel(priority SDL_LogPriority) slog.Level {
+
+	switch priority {
+	case SDL_LOG_PRIORITY_VERBOSE:
+		return slog.LevelDebug - 4
+	case SDL_LOG_PRIORITY_DEBUG:
+		return slog.LevelDebug
+	case SDL_LOG_PRIORITY_INFO:
+		return slog.LevelInfo
+	case SDL_LOG_PRIORITY_WARN:
+		return slog.LevelWarn
+	case SDL_LOG_PRIORITY_ERROR:
+		return slog.LevelError
+	} // switch
+
+	return slog.LevelError + 4
+}
@@ -0,0 +1,113 @@
+//go:build go1.21
+
+package sdl2
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+)
+
+// recorder is a slog.Handler keeping the records of every level.
+type recorder struct {
+	records []slog.Record
+}
+
+func (o *recorder) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (o *recorder) Handle(_ context.Context, r slog.Record) error {
+	o.records = append(o.records, r.Clone())
+	return nil
+}
+
+func (o *recorder) WithAttrs([]slog.Attr) slog.Handler {
+	return o
+}
+
+func (o *recorder) WithGroup(string) slog.Handler {
+	return o
+}
+
+// attrs returns the attributes of r by key.
+func attrs(r slog.Record) map[string]string {
+
+	var m = make(map[string]string)
+	r.Attrs(func(a slog.Attr) bool {
+		m[a.Key] = a.Value.String()
+		return true
+	})
+
+	return m
+}
+
+func TestSlogOutput(t *testing.T) {
+
+	var h = &recorder{}
+	var out = SlogOutput(slog.New(h))
+
+	for _, c := range []struct {
+		priority SDL_LogPriority
+		level    slog.Level
+	}{
+		{SDL_LOG_PRIORITY_VERBOSE, slog.LevelDebug - 4},
+		{SDL_LOG_PRIORITY_DEBUG, slog.LevelDebug},
+		{SDL_LOG_PRIORITY_INFO, slog.LevelInfo},
+		{SDL_LOG_PRIORITY_WARN, slog.LevelWarn},
+		{SDL_LOG_PRIORITY_ERROR, slog.LevelError},
+		{SDL_LOG_PRIORITY_CRITICAL, slog.LevelError + 4},
+	} {
+		h.records = nil
+		out(SDL_LOG_CATEGORY_VIDEO, c.priority, "message")
+
+		if 1 != len(h.records) {
+			t.Errorf("priority %v: %v records", c.priority, len(h.records))
+			continue
+		}
+
+		var r = h.records[0]
+		if c.level != r.Level || "message" != r.Message {
+			t.Errorf("priority %v: %v %q, want %v", c.priority, r.Level, r.Message, c.level)
+		}
+		if a := attrs(r); 1 != len(a) || "video" != a["category"] {
+			t.Errorf("priority %v: attributes %v", c.priority, a)
+		}
+	} // for
+
+	for _, c := range []struct {
+		category SDL_LogCategory
+		want     string
+	}{
+		{SDL_LOG_CATEGORY_APPLICATION, "application"},
+		{SDL_LOG_CATEGORY_TEST, "test"},
+		{SDL_LOG_CATEGORY_RESERVED1, "reserved+0"},
+		{SDL_LOG_CATEGORY_RESERVED10, "reserved+9"},
+		{SDL_LOG_CATEGORY_CUSTOM, "custom+0"},
+		{SDL_LOG_CATEGORY_CUSTOM + 3, "custom+3"},
+		{-1, "SDL_LogCategory(-1)"},
+	} {
+		h.records = nil
+		out(c.category, SDL_LOG_PRIORITY_INFO, "message")
+
+		if 1 != len(h.records) || c.want != attrs(h.records[0])["category"] {
+			t.Errorf("category %d: records %v, want category=%v", int(c.category), h.records, c.want)
+		}
+	} // for
+}
+
+func TestSlogOutputDefault(t *testing.T) {
+
+	var h = &recorder{}
+
+	var saved = slog.Default()
+	slog.SetDefault(slog.New(h))
+	defer slog.SetDefault(saved)
+
+	// The default logger at the time of the message
+	SlogOutput(nil)(SDL_LOG_CATEGORY_AUDIO, SDL_LOG_PRIORITY_WARN, "underrun")
+
+	if 1 != len(h.records) || slog.LevelWarn != h.records[0].Level || "audio" != attrs(h.records[0])["category"] {
+		t.Errorf("records %v", h.records)
+	}
+}
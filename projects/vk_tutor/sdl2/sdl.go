@@ -1,8 +1,10 @@
 package sdl2
 
-// #cgo CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2 -DSDL_MAIN_HANDLED
-// #cgo LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
-// #cgo LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
 // #include "SDL.h"
 import "C"
 
@@ -96,8 +98,8 @@ const (
  * \sa SDL_WasInit
  */
 // extern DECLSPEC int SDLCALL SDL_Init(Uint32 flags);
-func SDL_Init(flags uint32) int {
-	return int(C.SDL_Init(C.Uint32(flags)))
+func SDL_Init(flags uint32) error {
+	return checkError("SDL_Init", C.SDL_Init(C.Uint32(flags)))
 }
 
 /**
@@ -181,3 +183,10 @@ func SDL_Init(flags uint32) int {
 func SDL_Quit() {
 	C.SDL_Quit()
 }
+
+func sdlBool(b bool) C.SDL_bool {
+	if b {
+		return C.SDL_TRUE
+	}
+	return C.SDL_FALSE
+}
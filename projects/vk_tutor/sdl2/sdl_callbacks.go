@@ -0,0 +1,66 @@
+package sdl2
+
+// The Go ends of SDL's callbacks. The static trampolines passing their
+// handles are in the preambles of the bindings, e.g. _SDL_AddEventWatch in
+// sdl_events.go, this preamble may only declare because of //export.
+
+// #include "SDL.h"
+import "C"
+
+import (
+	"runtime/cgo"
+	"unsafe"
+)
+
+//export goHitTest
+func goHitTest(win *C.SDL_Window, area *C.SDL_Point, data unsafe.Pointer) C.SDL_HitTestResult {
+
+	var f, ok = cgo.Handle(uintptr(data)).Value().(SDL_HitTest)
+	if !ok || nil == f {
+		return C.SDL_HITTEST_NORMAL
+	}
+
+	var area1 SDL_Point
+	area1.copyFromCObj(unsafe.Pointer(area))
+
+	return C.SDL_HitTestResult(f(wrapWindow(win), &area1))
+}
+
+//export goEventFilter
+func goEventFilter(userdata unsafe.Pointer, event *C.SDL_Event) C.int {
+
+	var f, ok = cgo.Handle(uintptr(userdata)).Value().(SDL_EventFilter)
+	if !ok || nil == f {
+		return 1
+	}
+
+	var e SDL_Event
+	e.cObjData = *(*[C.sizeof_SDL_Event]byte)(unsafe.Pointer(event))
+
+	if f(&e) {
+		return 1
+	}
+
+	return 0
+}
+
+//export goHintCallback
+func goHintCallback(userdata unsafe.Pointer, name, oldValue, newValue *C.char) {
+
+	var f, ok = cgo.Handle(uintptr(userdata)).Value().(SDL_HintCallback)
+	if !ok || nil == f {
+		return
+	}
+
+	f(SDL_Hint(C.GoString(name)), C.GoString(oldValue), C.GoString(newValue))
+}
+
+//export goTimerCallback
+func goTimerCallback(interval C.Uint32, param unsafe.Pointer) C.Uint32 {
+	return C.Uint32(timerCallback(uint32(interval), uintptr(param)))
+}
+
+//export goLogOutput
+func goLogOutput(category C.int, priority C.SDL_LogPriority, message *C.char) {
+	logMessage(SDL_LogCategory(category), SDL_LogPriority(priority), C.GoString(message))
+}
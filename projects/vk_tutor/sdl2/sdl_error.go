@@ -1,11 +1,19 @@
 package sdl2
 
-// #cgo CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2 -DSDL_MAIN_HANDLED
-// #cgo LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
-// #cgo LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
 // #include "SDL.h"
+//
+// static int _SDL_SetError(const char *msg) { return SDL_SetError("%s", msg); }
 import "C"
-import "unsafe"
+
+import (
+	"fmt"
+	"unsafe"
+)
 
 /**
  *  \file SDL_error.h
@@ -40,6 +48,16 @@ import "unsafe"
  * \sa SDL_GetError
  */
 // extern DECLSPEC int SDLCALL SDL_SetError(SDL_PRINTF_FORMAT_STRING const char *fmt, ...) SDL_PRINTF_VARARG_FUNC(1);
+//
+// The message is formatted with fmt.Sprintf, e.g. to report a Go error from
+// a callback SDL calls.
+func SDL_SetError(format string, a ...interface{}) int {
+
+	var msg_c = C.CString(fmt.Sprintf(format, a...))
+	defer C.free(unsafe.Pointer(msg_c))
+
+	return int(C._SDL_SetError(msg_c))
+}
 
 /**
  * Retrieve a message about the last error that occurred on the current
@@ -162,3 +180,29 @@ func SDL_Error(code SDL_errorcode) int {
 	C.SDL_Error(C.SDL_errorcode(code))
 	return -1
 }
+
+// SDLError is the error of a failed call of an SDL function, with the message
+// SDL_GetError returned right after it. Errors of the sdl2 package are
+// *SDLError, possibly wrapped.
+type SDLError struct {
+	Func string // e.g. "SDL_CreateWindow"
+	Msg  string
+}
+
+func (e *SDLError) Error() string {
+	return e.Func + " failed: " + e.Msg
+}
+
+// lastError returns the error of the failed call of the SDL function fn, with
+// the message of SDL_GetError.
+func lastError(fn string) error {
+	return &SDLError{fn, SDL_GetError()}
+}
+
+// checkError returns the error of fn when res, its result, is negative.
+func checkError(fn string, res C.int) error {
+	if res < 0 {
+		return lastError(fn)
+	}
+	return nil
+}
@@ -1,12 +1,38 @@
 package sdl2
 
-// #cgo CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2 -DSDL_MAIN_HANDLED
-// #cgo LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
-// #cgo LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
 // #include "SDL.h"
+//
+// extern int goEventFilter(void*, SDL_Event*);
+//
+// static int SDLCALL _SDL_EventFilter(void *userdata, SDL_Event *event) {
+//     return goEventFilter(userdata, event);
+// }
+//
+// static void _SDL_SetEventFilter(uintptr_t h) {
+//     SDL_SetEventFilter(0 != h ? _SDL_EventFilter : NULL, (void*)h);
+// }
+//
+// static void _SDL_AddEventWatch(uintptr_t h) {
+//     SDL_AddEventWatch(_SDL_EventFilter, (void*)h);
+// }
+//
+// static void _SDL_DelEventWatch(uintptr_t h) {
+//     SDL_DelEventWatch(_SDL_EventFilter, (void*)h);
+// }
+//
+// static void _SDL_FilterEvents(uintptr_t h) {
+//     SDL_FilterEvents(_SDL_EventFilter, (void*)h);
+// }
 import "C"
 
 import (
+	"runtime/cgo"
+	"sync"
 	"unsafe"
 )
 
@@ -19,6 +45,10 @@ import (
 /* General keyboard/mouse state definitions */
 // #define SDL_RELEASED    0
 // #define SDL_PRESSED 1
+const (
+	SDL_RELEASED uint8 = C.SDL_RELEASED
+	SDL_PRESSED  uint8 = C.SDL_PRESSED
+)
 
 /**
  * The types of events that can be delivered.
@@ -151,523 +181,1083 @@ type SDL_EventType uint32
 const (
 	SDL_FIRSTEVENT SDL_EventType = C.SDL_FIRSTEVENT /**< Unused (do not remove) */
 
-	//     /* Application events */
-	SDL_QUIT SDL_EventType = C.SDL_QUIT /**< User-requested quit */
-
-//     /* These application events have special meaning on iOS, see README-ios.md for details */
-//     SDL_APP_TERMINATING,        /**< The application is being terminated by the OS
-//                                      Called on iOS in applicationWillTerminate()
-//                                      Called on Android in onDestroy()
-//                                 */
-//     SDL_APP_LOWMEMORY,          /**< The application is low on memory, free memory if possible.
-//                                      Called on iOS in applicationDidReceiveMemoryWarning()
-//                                      Called on Android in onLowMemory()
-//                                 */
-//     SDL_APP_WILLENTERBACKGROUND, /**< The application is about to enter the background
-//                                      Called on iOS in applicationWillResignActive()
-//                                      Called on Android in onPause()
-//                                 */
-//     SDL_APP_DIDENTERBACKGROUND, /**< The application did enter the background and may not get CPU for some time
-//                                      Called on iOS in applicationDidEnterBackground()
-//                                      Called on Android in onPause()
-//                                 */
-//     SDL_APP_WILLENTERFOREGROUND, /**< The application is about to enter the foreground
-//                                      Called on iOS in applicationWillEnterForeground()
-//                                      Called on Android in onResume()
-//                                 */
-//     SDL_APP_DIDENTERFOREGROUND, /**< The application is now interactive
-//                                      Called on iOS in applicationDidBecomeActive()
-//                                      Called on Android in onResume()
-//                                 */
+	// Application events
+	SDL_QUIT                    SDL_EventType = C.SDL_QUIT                    /**< User-requested quit */
+	SDL_APP_TERMINATING         SDL_EventType = C.SDL_APP_TERMINATING         /**< The application is being terminated by the OS */
+	SDL_APP_LOWMEMORY           SDL_EventType = C.SDL_APP_LOWMEMORY           /**< The application is low on memory, free memory if possible. */
+	SDL_APP_WILLENTERBACKGROUND SDL_EventType = C.SDL_APP_WILLENTERBACKGROUND /**< The application is about to enter the background */
+	SDL_APP_DIDENTERBACKGROUND  SDL_EventType = C.SDL_APP_DIDENTERBACKGROUND  /**< The application did enter the background and may not get CPU for some time */
+	SDL_APP_WILLENTERFOREGROUND SDL_EventType = C.SDL_APP_WILLENTERFOREGROUND /**< The application is about to enter the foreground */
+	SDL_APP_DIDENTERFOREGROUND  SDL_EventType = C.SDL_APP_DIDENTERFOREGROUND  /**< The application is now interactive */
+	SDL_LOCALECHANGED           SDL_EventType = C.SDL_LOCALECHANGED           /**< The user's locale preferences have changed. */
+
+	// Display events
+	SDL_DISPLAYEVENT SDL_EventType = C.SDL_DISPLAYEVENT /**< Display state change */
+
+	// Window events
+	SDL_WINDOWEVENT SDL_EventType = C.SDL_WINDOWEVENT /**< Window state change */
+	SDL_SYSWMEVENT  SDL_EventType = C.SDL_SYSWMEVENT  /**< System specific event */
+
+	// Keyboard events
+	SDL_KEYDOWN         SDL_EventType = C.SDL_KEYDOWN         /**< Key pressed */
+	SDL_KEYUP           SDL_EventType = C.SDL_KEYUP           /**< Key released */
+	SDL_TEXTEDITING     SDL_EventType = C.SDL_TEXTEDITING     /**< Keyboard text editing (composition) */
+	SDL_TEXTINPUT       SDL_EventType = C.SDL_TEXTINPUT       /**< Keyboard text input */
+	SDL_KEYMAPCHANGED   SDL_EventType = C.SDL_KEYMAPCHANGED   /**< Keymap changed due to a system event such as an input language or keyboard layout change. */
+	SDL_TEXTEDITING_EXT SDL_EventType = C.SDL_TEXTEDITING_EXT /**< Extended keyboard text editing (composition) */
+
+	// Mouse events
+	SDL_MOUSEMOTION     SDL_EventType = C.SDL_MOUSEMOTION     /**< Mouse moved */
+	SDL_MOUSEBUTTONDOWN SDL_EventType = C.SDL_MOUSEBUTTONDOWN /**< Mouse button pressed */
+	SDL_MOUSEBUTTONUP   SDL_EventType = C.SDL_MOUSEBUTTONUP   /**< Mouse button released */
+	SDL_MOUSEWHEEL      SDL_EventType = C.SDL_MOUSEWHEEL      /**< Mouse wheel motion */
+
+	// Joystick events
+	SDL_JOYAXISMOTION     SDL_EventType = C.SDL_JOYAXISMOTION     /**< Joystick axis motion */
+	SDL_JOYBALLMOTION     SDL_EventType = C.SDL_JOYBALLMOTION     /**< Joystick trackball motion */
+	SDL_JOYHATMOTION      SDL_EventType = C.SDL_JOYHATMOTION      /**< Joystick hat position change */
+	SDL_JOYBUTTONDOWN     SDL_EventType = C.SDL_JOYBUTTONDOWN     /**< Joystick button pressed */
+	SDL_JOYBUTTONUP       SDL_EventType = C.SDL_JOYBUTTONUP       /**< Joystick button released */
+	SDL_JOYDEVICEADDED    SDL_EventType = C.SDL_JOYDEVICEADDED    /**< A new joystick has been inserted into the system */
+	SDL_JOYDEVICEREMOVED  SDL_EventType = C.SDL_JOYDEVICEREMOVED  /**< An opened joystick has been removed */
+	SDL_JOYBATTERYUPDATED SDL_EventType = C.SDL_JOYBATTERYUPDATED /**< Joystick battery level change */
+
+	// Game controller events
+	SDL_CONTROLLERAXISMOTION     SDL_EventType = C.SDL_CONTROLLERAXISMOTION     /**< Game controller axis motion */
+	SDL_CONTROLLERBUTTONDOWN     SDL_EventType = C.SDL_CONTROLLERBUTTONDOWN     /**< Game controller button pressed */
+	SDL_CONTROLLERBUTTONUP       SDL_EventType = C.SDL_CONTROLLERBUTTONUP       /**< Game controller button released */
+	SDL_CONTROLLERDEVICEADDED    SDL_EventType = C.SDL_CONTROLLERDEVICEADDED    /**< A new Game controller has been inserted into the system */
+	SDL_CONTROLLERDEVICEREMOVED  SDL_EventType = C.SDL_CONTROLLERDEVICEREMOVED  /**< An opened Game controller has been removed */
+	SDL_CONTROLLERDEVICEREMAPPED SDL_EventType = C.SDL_CONTROLLERDEVICEREMAPPED /**< The controller mapping was updated */
+	SDL_CONTROLLERTOUCHPADDOWN   SDL_EventType = C.SDL_CONTROLLERTOUCHPADDOWN   /**< Game controller touchpad was touched */
+	SDL_CONTROLLERTOUCHPADMOTION SDL_EventType = C.SDL_CONTROLLERTOUCHPADMOTION /**< Game controller touchpad finger was moved */
+	SDL_CONTROLLERTOUCHPADUP     SDL_EventType = C.SDL_CONTROLLERTOUCHPADUP     /**< Game controller touchpad finger was lifted */
+	SDL_CONTROLLERSENSORUPDATE   SDL_EventType = C.SDL_CONTROLLERSENSORUPDATE   /**< Game controller sensor was updated */
+
+	// Touch events
+	SDL_FINGERDOWN   SDL_EventType = C.SDL_FINGERDOWN
+	SDL_FINGERUP     SDL_EventType = C.SDL_FINGERUP
+	SDL_FINGERMOTION SDL_EventType = C.SDL_FINGERMOTION
+
+	// Gesture events
+	SDL_DOLLARGESTURE SDL_EventType = C.SDL_DOLLARGESTURE
+	SDL_DOLLARRECORD  SDL_EventType = C.SDL_DOLLARRECORD
+	SDL_MULTIGESTURE  SDL_EventType = C.SDL_MULTIGESTURE
+
+	// Clipboard events
+	SDL_CLIPBOARDUPDATE SDL_EventType = C.SDL_CLIPBOARDUPDATE /**< The clipboard or primary selection changed */
+
+	// Drag and drop events
+	SDL_DROPFILE     SDL_EventType = C.SDL_DROPFILE     /**< The system requests a file open */
+	SDL_DROPTEXT     SDL_EventType = C.SDL_DROPTEXT     /**< text/plain drag-and-drop event */
+	SDL_DROPBEGIN    SDL_EventType = C.SDL_DROPBEGIN    /**< A new set of drops is beginning (NULL filename) */
+	SDL_DROPCOMPLETE SDL_EventType = C.SDL_DROPCOMPLETE /**< Current set of drops is now complete (NULL filename) */
+
+	// Audio hotplug events
+	SDL_AUDIODEVICEADDED   SDL_EventType = C.SDL_AUDIODEVICEADDED   /**< A new audio device is available */
+	SDL_AUDIODEVICEREMOVED SDL_EventType = C.SDL_AUDIODEVICEREMOVED /**< An audio device has been removed. */
+
+	// Sensor events
+	SDL_SENSORUPDATE SDL_EventType = C.SDL_SENSORUPDATE /**< A sensor was updated */
+
+	// Render events
+	SDL_RENDER_TARGETS_RESET SDL_EventType = C.SDL_RENDER_TARGETS_RESET /**< The render targets have been reset and their contents need to be updated */
+	SDL_RENDER_DEVICE_RESET  SDL_EventType = C.SDL_RENDER_DEVICE_RESET  /**< The device has been reset and all textures need to be recreated */
+
+	// Internal events
+	SDL_POLLSENTINEL SDL_EventType = C.SDL_POLLSENTINEL /**< Signals the end of an event poll cycle */
+
+	// Events SDL_USEREVENT through SDL_LASTEVENT are for your use, and should be allocated with SDL_RegisterEvents()
+	SDL_USEREVENT SDL_EventType = C.SDL_USEREVENT
+
+	SDL_LASTEVENT SDL_EventType = C.SDL_LASTEVENT /**< This last event is only for bounding internal arrays */
+)
 
-//     SDL_LOCALECHANGED,  /**< The user's locale preferences have changed. */
+/**
+ *  \brief Fields shared by every event
+ */
+// typedef struct SDL_CommonEvent
+// {
+//     Uint32 type;
+//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+// } SDL_CommonEvent;
+//
+// The Go events embed SDL_CommonEvent, with the window ID of the events
+// having one, 0 for the others.
+type SDL_CommonEvent struct {
+	Type      SDL_EventType
+	Timestamp uint32 // In milliseconds, see SDL_GetTicks
+	WindowID  uint32
+}
 
-//     /* Display events */
-//     SDL_DISPLAYEVENT   = 0x150,  /**< Display state change */
+func (o *SDL_CommonEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_CommonEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+}
 
-//     /* Window events */
-//     SDL_WINDOWEVENT    = 0x200, /**< Window state change */
-//     SDL_SYSWMEVENT,             /**< System specific event */
+// Common returns the fields shared by every event.
+func (o *SDL_CommonEvent) Common() *SDL_CommonEvent {
+	return o
+}
 
-//     /* Keyboard events */
-//     SDL_KEYDOWN        = 0x300, /**< Key pressed */
-//     SDL_KEYUP,                  /**< Key released */
-//     SDL_TEXTEDITING,            /**< Keyboard text editing (composition) */
-//     SDL_TEXTINPUT,              /**< Keyboard text input */
-//     SDL_KEYMAPCHANGED,          /**< Keymap changed due to a system event such as an
-//                                      input language or keyboard layout change.
-//                                 */
-//     SDL_TEXTEDITING_EXT,       /**< Extended keyboard text editing (composition) */
+// Event is a typed event returned by SDL_Event.Decode, e.g.
+// *SDL_KeyboardEvent.
+type Event interface {
+	Common() *SDL_CommonEvent
+	copyFromCObj(p unsafe.Pointer)
+}
 
-//     /* Mouse events */
-//     SDL_MOUSEMOTION    = 0x400, /**< Mouse moved */
-//     SDL_MOUSEBUTTONDOWN,        /**< Mouse button pressed */
-//     SDL_MOUSEBUTTONUP,          /**< Mouse button released */
-//     SDL_MOUSEWHEEL,             /**< Mouse wheel motion */
+/**
+ *  \brief Display state change event data (event.display.*)
+ */
+// typedef struct SDL_DisplayEvent
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_DISPLAYEVENT */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Uint32 display;     /**< The associated display index */
+//	    Uint8 event;        /**< ::SDL_DisplayEventID */
+//	    Uint8 padding1;
+//	    Uint8 padding2;
+//	    Uint8 padding3;
+//	    Sint32 data1;       /**< event dependent data */
+//	} SDL_DisplayEvent;
+type SDL_DisplayEvent struct {
+	SDL_CommonEvent
+	Display uint32
+	Event   SDL_DisplayEventID
+	Data1   int32
+}
 
-//     /* Joystick events */
-//     SDL_JOYAXISMOTION  = 0x600, /**< Joystick axis motion */
-//     SDL_JOYBALLMOTION,          /**< Joystick trackball motion */
-//     SDL_JOYHATMOTION,           /**< Joystick hat position change */
-//     SDL_JOYBUTTONDOWN,          /**< Joystick button pressed */
-//     SDL_JOYBUTTONUP,            /**< Joystick button released */
-//     SDL_JOYDEVICEADDED,         /**< A new joystick has been inserted into the system */
-//     SDL_JOYDEVICEREMOVED,       /**< An opened joystick has been removed */
-//     SDL_JOYBATTERYUPDATED,      /**< Joystick battery level change */
+func (o *SDL_DisplayEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_DisplayEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Display = uint32(p1.display)
+	o.Event = SDL_DisplayEventID(p1.event)
+	o.Data1 = int32(p1.data1)
+}
 
-//     /* Game controller events */
-//     SDL_CONTROLLERAXISMOTION  = 0x650, /**< Game controller axis motion */
-//     SDL_CONTROLLERBUTTONDOWN,          /**< Game controller button pressed */
-//     SDL_CONTROLLERBUTTONUP,            /**< Game controller button released */
-//     SDL_CONTROLLERDEVICEADDED,         /**< A new Game controller has been inserted into the system */
-//     SDL_CONTROLLERDEVICEREMOVED,       /**< An opened Game controller has been removed */
-//     SDL_CONTROLLERDEVICEREMAPPED,      /**< The controller mapping was updated */
-//     SDL_CONTROLLERTOUCHPADDOWN,        /**< Game controller touchpad was touched */
-//     SDL_CONTROLLERTOUCHPADMOTION,      /**< Game controller touchpad finger was moved */
-//     SDL_CONTROLLERTOUCHPADUP,          /**< Game controller touchpad finger was lifted */
-//     SDL_CONTROLLERSENSORUPDATE,        /**< Game controller sensor was updated */
+/**
+ *  \brief Window state change event data (event.window.*)
+ */
+// typedef struct SDL_WindowEvent
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_WINDOWEVENT */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Uint32 windowID;    /**< The associated window */
+//	    Uint8 event;        /**< ::SDL_WindowEventID */
+//	    Uint8 padding1;
+//	    Uint8 padding2;
+//	    Uint8 padding3;
+//	    Sint32 data1;       /**< event dependent data */
+//	    Sint32 data2;       /**< event dependent data */
+//	} SDL_WindowEvent;
+type SDL_WindowEvent struct {
+	SDL_CommonEvent
+	Event SDL_WindowEventID
+	Data1 int32
+	Data2 int32
+}
 
-//     /* Touch events */
-//     SDL_FINGERDOWN      = 0x700,
-//     SDL_FINGERUP,
-//     SDL_FINGERMOTION,
+func (o *SDL_WindowEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_WindowEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.WindowID = uint32(p1.windowID)
+	o.Event = SDL_WindowEventID(p1.event)
+	o.Data1 = int32(p1.data1)
+	o.Data2 = int32(p1.data2)
+}
 
-//     /* Gesture events */
-//     SDL_DOLLARGESTURE   = 0x800,
-//     SDL_DOLLARRECORD,
-//     SDL_MULTIGESTURE,
+func (o *SDL_WindowEvent) copyToCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_WindowEvent)(p)
+	p1._type = C.Uint32(o.Type)
+	p1.timestamp = C.Uint32(o.Timestamp)
+	p1.windowID = C.Uint32(o.WindowID)
+	p1.event = C.Uint8(o.Event)
+	p1.data1 = C.Sint32(o.Data1)
+	p1.data2 = C.Sint32(o.Data2)
+}
 
-//     /* Clipboard events */
-//     SDL_CLIPBOARDUPDATE = 0x900, /**< The clipboard or primary selection changed */
+// Encode returns the event for SDL_PushEvent, e.g. to inject input in tests.
+func (o *SDL_WindowEvent) Encode() *SDL_Event {
+	var r = new(SDL_Event)
+	o.copyToCObj(unsafe.Pointer(&r.cObjData[0]))
+	return r
+}
 
-//     /* Drag and drop events */
-//     SDL_DROPFILE        = 0x1000, /**< The system requests a file open */
-//     SDL_DROPTEXT,                 /**< text/plain drag-and-drop event */
-//     SDL_DROPBEGIN,                /**< A new set of drops is beginning (NULL filename) */
-//     SDL_DROPCOMPLETE,             /**< Current set of drops is now complete (NULL filename) */
+/**
+ *  \brief Keyboard button event structure (event.key.*)
+ */
+// typedef struct SDL_KeyboardEvent
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_KEYDOWN or ::SDL_KEYUP */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Uint32 windowID;    /**< The window with keyboard focus, if any */
+//	    Uint8 state;        /**< ::SDL_PRESSED or ::SDL_RELEASED */
+//	    Uint8 repeat;       /**< Non-zero if this is a key repeat */
+//	    Uint8 padding2;
+//	    Uint8 padding3;
+//	    SDL_Keysym keysym;  /**< The key that was pressed or released */
+//	} SDL_KeyboardEvent;
+type SDL_KeyboardEvent struct {
+	SDL_CommonEvent
+	State  uint8
+	Repeat uint8
+	Keysym SDL_Keysym
+}
 
-//     /* Audio hotplug events */
-//     SDL_AUDIODEVICEADDED = 0x1100, /**< A new audio device is available */
-//     SDL_AUDIODEVICEREMOVED,        /**< An audio device has been removed. */
+func (o *SDL_KeyboardEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_KeyboardEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.WindowID = uint32(p1.windowID)
+	o.State = uint8(p1.state)
+	o.Repeat = uint8(p1.repeat)
+	o.Keysym.copyFromCObj(unsafe.Pointer(&p1.keysym))
+}
 
-//     /* Sensor events */
-//     SDL_SENSORUPDATE = 0x1200,     /**< A sensor was updated */
+func (o *SDL_KeyboardEvent) copyToCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_KeyboardEvent)(p)
+	p1._type = C.Uint32(o.Type)
+	p1.timestamp = C.Uint32(o.Timestamp)
+	p1.windowID = C.Uint32(o.WindowID)
+	p1.state = C.Uint8(o.State)
+	p1.repeat = C.Uint8(o.Repeat)
+	o.Keysym.copyToCObj(unsafe.Pointer(&p1.keysym))
+}
 
-//     /* Render events */
-//     SDL_RENDER_TARGETS_RESET = 0x2000, /**< The render targets have been reset and their contents need to be updated */
-//     SDL_RENDER_DEVICE_RESET, /**< The device has been reset and all textures need to be recreated */
+// Encode returns the event for SDL_PushEvent, e.g. to inject input in tests.
+func (o *SDL_KeyboardEvent) Encode() *SDL_Event {
+	var r = new(SDL_Event)
+	o.copyToCObj(unsafe.Pointer(&r.cObjData[0]))
+	return r
+}
 
-//     /* Internal events */
-//     SDL_POLLSENTINEL = 0x7F00, /**< Signals the end of an event poll cycle */
+// #define SDL_TEXTEDITINGEVENT_TEXT_SIZE (32)
+/**
+ *  \brief Keyboard text editing event structure (event.edit.*)
+ */
+// typedef struct SDL_TextEditingEvent
+//
+//	{
+//	    Uint32 type;                                /**< ::SDL_TEXTEDITING */
+//	    Uint32 timestamp;                           /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Uint32 windowID;                            /**< The window with keyboard focus, if any */
+//	    char text[SDL_TEXTEDITINGEVENT_TEXT_SIZE];  /**< The editing text */
+//	    Sint32 start;                               /**< The start cursor of selected editing text */
+//	    Sint32 length;                              /**< The length of selected editing text */
+//	} SDL_TextEditingEvent;
+type SDL_TextEditingEvent struct {
+	SDL_CommonEvent
+	Text   string
+	Start  int32
+	Length int32
+}
 
-//     /** Events ::SDL_USEREVENT through ::SDL_LASTEVENT are for your use,
-//      *  and should be allocated with SDL_RegisterEvents()
-//      */
-//     SDL_USEREVENT    = 0x8000,
+func (o *SDL_TextEditingEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_TextEditingEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.WindowID = uint32(p1.windowID)
+	o.Text = C.GoString(&p1.text[0])
+	o.Start = int32(p1.start)
+	o.Length = int32(p1.length)
+}
 
-// /**
-//   - This last event is only for bounding internal arrays
-//     */
+/**
+ *  \brief Extended keyboard text editing event structure (event.editExt.*) when text would be
+ *  truncated if stored in the text buffer SDL_TextEditingEvent
+ */
+// typedef struct SDL_TextEditingExtEvent
 //
-// SDL_LASTEVENT    = 0xFFFF
-)
+//	{
+//	    Uint32 type;                                /**< ::SDL_TEXTEDITING_EXT */
+//	    Uint32 timestamp;                           /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Uint32 windowID;                            /**< The window with keyboard focus, if any */
+//	    char* text;                                 /**< The editing text, which should be freed with SDL_free(), and will not be NULL */
+//	    Sint32 start;                               /**< The start cursor of selected editing text */
+//	    Sint32 length;                              /**< The length of selected editing text */
+//	} SDL_TextEditingExtEvent;
+type SDL_TextEditingExtEvent struct {
+	SDL_CommonEvent
+	Text   string
+	Start  int32
+	Length int32
+}
+
+func (o *SDL_TextEditingExtEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_TextEditingExtEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.WindowID = uint32(p1.windowID)
+	if nil != p1.text {
+		o.Text = C.GoString(p1.text)
+	}
+	o.Start = int32(p1.start)
+	o.Length = int32(p1.length)
+}
 
+// #define SDL_TEXTINPUTEVENT_TEXT_SIZE (32)
 /**
- *  \brief Fields shared by every event
+ *  \brief Keyboard text input event structure (event.text.*)
  */
-// typedef struct SDL_CommonEvent
-// {
-//     Uint32 type;
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-// } SDL_CommonEvent;
+// typedef struct SDL_TextInputEvent
+//
+//	{
+//	    Uint32 type;                              /**< ::SDL_TEXTINPUT */
+//	    Uint32 timestamp;                         /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Uint32 windowID;                          /**< The window with keyboard focus, if any */
+//	    char text[SDL_TEXTINPUTEVENT_TEXT_SIZE];  /**< The input text */
+//	} SDL_TextInputEvent;
+type SDL_TextInputEvent struct {
+	SDL_CommonEvent
+	Text string
+}
 
-// /**
-//  *  \brief Display state change event data (event.display.*)
-//  */
-// typedef struct SDL_DisplayEvent
-// {
-//     Uint32 type;        /**< ::SDL_DISPLAYEVENT */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     Uint32 display;     /**< The associated display index */
-//     Uint8 event;        /**< ::SDL_DisplayEventID */
-//     Uint8 padding1;
-//     Uint8 padding2;
-//     Uint8 padding3;
-//     Sint32 data1;       /**< event dependent data */
-// } SDL_DisplayEvent;
+func (o *SDL_TextInputEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_TextInputEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.WindowID = uint32(p1.windowID)
+	o.Text = C.GoString(&p1.text[0])
+}
 
-// /**
-//  *  \brief Window state change event data (event.window.*)
-//  */
-// typedef struct SDL_WindowEvent
-// {
-//     Uint32 type;        /**< ::SDL_WINDOWEVENT */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     Uint32 windowID;    /**< The associated window */
-//     Uint8 event;        /**< ::SDL_WindowEventID */
-//     Uint8 padding1;
-//     Uint8 padding2;
-//     Uint8 padding3;
-//     Sint32 data1;       /**< event dependent data */
-//     Sint32 data2;       /**< event dependent data */
-// } SDL_WindowEvent;
+/**
+ *  \brief Mouse motion event structure (event.motion.*)
+ */
+// typedef struct SDL_MouseMotionEvent
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_MOUSEMOTION */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Uint32 windowID;    /**< The window with mouse focus, if any */
+//	    Uint32 which;       /**< The mouse instance id, or SDL_TOUCH_MOUSEID */
+//	    Uint32 state;       /**< The current button state */
+//	    Sint32 x;           /**< X coordinate, relative to window */
+//	    Sint32 y;           /**< Y coordinate, relative to window */
+//	    Sint32 xrel;        /**< The relative motion in the X direction */
+//	    Sint32 yrel;        /**< The relative motion in the Y direction */
+//	} SDL_MouseMotionEvent;
+type SDL_MouseMotionEvent struct {
+	SDL_CommonEvent
+	Which uint32
+	State SDL_MouseButtonFlags
+	X     int32
+	Y     int32
+	Xrel  int32
+	Yrel  int32
+}
 
-// /**
-//  *  \brief Keyboard button event structure (event.key.*)
-//  */
-// typedef struct SDL_KeyboardEvent
-// {
-//     Uint32 type;        /**< ::SDL_KEYDOWN or ::SDL_KEYUP */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     Uint32 windowID;    /**< The window with keyboard focus, if any */
-//     Uint8 state;        /**< ::SDL_PRESSED or ::SDL_RELEASED */
-//     Uint8 repeat;       /**< Non-zero if this is a key repeat */
-//     Uint8 padding2;
-//     Uint8 padding3;
-//     SDL_Keysym keysym;  /**< The key that was pressed or released */
-// } SDL_KeyboardEvent;
+func (o *SDL_MouseMotionEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_MouseMotionEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.WindowID = uint32(p1.windowID)
+	o.Which = uint32(p1.which)
+	o.State = SDL_MouseButtonFlags(p1.state)
+	o.X = int32(p1.x)
+	o.Y = int32(p1.y)
+	o.Xrel = int32(p1.xrel)
+	o.Yrel = int32(p1.yrel)
+}
 
-// #define SDL_TEXTEDITINGEVENT_TEXT_SIZE (32)
-// /**
-//  *  \brief Keyboard text editing event structure (event.edit.*)
-//  */
-// typedef struct SDL_TextEditingEvent
-// {
-//     Uint32 type;                                /**< ::SDL_TEXTEDITING */
-//     Uint32 timestamp;                           /**< In milliseconds, populated using SDL_GetTicks() */
-//     Uint32 windowID;                            /**< The window with keyboard focus, if any */
-//     char text[SDL_TEXTEDITINGEVENT_TEXT_SIZE];  /**< The editing text */
-//     Sint32 start;                               /**< The start cursor of selected editing text */
-//     Sint32 length;                              /**< The length of selected editing text */
-// } SDL_TextEditingEvent;
+func (o *SDL_MouseMotionEvent) copyToCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_MouseMotionEvent)(p)
+	p1._type = C.Uint32(o.Type)
+	p1.timestamp = C.Uint32(o.Timestamp)
+	p1.windowID = C.Uint32(o.WindowID)
+	p1.which = C.Uint32(o.Which)
+	p1.state = C.Uint32(o.State)
+	p1.x = C.Sint32(o.X)
+	p1.y = C.Sint32(o.Y)
+	p1.xrel = C.Sint32(o.Xrel)
+	p1.yrel = C.Sint32(o.Yrel)
+}
 
-// /**
-//  *  \brief Extended keyboard text editing event structure (event.editExt.*) when text would be
-//  *  truncated if stored in the text buffer SDL_TextEditingEvent
-//  */
-// typedef struct SDL_TextEditingExtEvent
-// {
-//     Uint32 type;                                /**< ::SDL_TEXTEDITING_EXT */
-//     Uint32 timestamp;                           /**< In milliseconds, populated using SDL_GetTicks() */
-//     Uint32 windowID;                            /**< The window with keyboard focus, if any */
-//     char* text;                                 /**< The editing text, which should be freed with SDL_free(), and will not be NULL */
-//     Sint32 start;                               /**< The start cursor of selected editing text */
-//     Sint32 length;                              /**< The length of selected editing text */
-// } SDL_TextEditingExtEvent;
+// Encode returns the event for SDL_PushEvent, e.g. to inject input in tests.
+func (o *SDL_MouseMotionEvent) Encode() *SDL_Event {
+	var r = new(SDL_Event)
+	o.copyToCObj(unsafe.Pointer(&r.cObjData[0]))
+	return r
+}
 
-// #define SDL_TEXTINPUTEVENT_TEXT_SIZE (32)
-// /**
-//  *  \brief Keyboard text input event structure (event.text.*)
-//  */
-// typedef struct SDL_TextInputEvent
-// {
-//     Uint32 type;                              /**< ::SDL_TEXTINPUT */
-//     Uint32 timestamp;                         /**< In milliseconds, populated using SDL_GetTicks() */
-//     Uint32 windowID;                          /**< The window with keyboard focus, if any */
-//     char text[SDL_TEXTINPUTEVENT_TEXT_SIZE];  /**< The input text */
-// } SDL_TextInputEvent;
+/**
+ *  \brief Mouse button event structure (event.button.*)
+ */
+// typedef struct SDL_MouseButtonEvent
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_MOUSEBUTTONDOWN or ::SDL_MOUSEBUTTONUP */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Uint32 windowID;    /**< The window with mouse focus, if any */
+//	    Uint32 which;       /**< The mouse instance id, or SDL_TOUCH_MOUSEID */
+//	    Uint8 button;       /**< The mouse button index */
+//	    Uint8 state;        /**< ::SDL_PRESSED or ::SDL_RELEASED */
+//	    Uint8 clicks;       /**< 1 for single-click, 2 for double-click, etc. */
+//	    Uint8 padding1;
+//	    Sint32 x;           /**< X coordinate, relative to window */
+//	    Sint32 y;           /**< Y coordinate, relative to window */
+//	} SDL_MouseButtonEvent;
+type SDL_MouseButtonEvent struct {
+	SDL_CommonEvent
+	Which  uint32
+	Button uint8
+	State  uint8
+	Clicks uint8
+	X      int32
+	Y      int32
+}
 
-// /**
-//  *  \brief Mouse motion event structure (event.motion.*)
-//  */
-// typedef struct SDL_MouseMotionEvent
-// {
-//     Uint32 type;        /**< ::SDL_MOUSEMOTION */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     Uint32 windowID;    /**< The window with mouse focus, if any */
-//     Uint32 which;       /**< The mouse instance id, or SDL_TOUCH_MOUSEID */
-//     Uint32 state;       /**< The current button state */
-//     Sint32 x;           /**< X coordinate, relative to window */
-//     Sint32 y;           /**< Y coordinate, relative to window */
-//     Sint32 xrel;        /**< The relative motion in the X direction */
-//     Sint32 yrel;        /**< The relative motion in the Y direction */
-// } SDL_MouseMotionEvent;
+func (o *SDL_MouseButtonEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_MouseButtonEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.WindowID = uint32(p1.windowID)
+	o.Which = uint32(p1.which)
+	o.Button = uint8(p1.button)
+	o.State = uint8(p1.state)
+	o.Clicks = uint8(p1.clicks)
+	o.X = int32(p1.x)
+	o.Y = int32(p1.y)
+}
 
-// /**
-//  *  \brief Mouse button event structure (event.button.*)
-//  */
-// typedef struct SDL_MouseButtonEvent
-// {
-//     Uint32 type;        /**< ::SDL_MOUSEBUTTONDOWN or ::SDL_MOUSEBUTTONUP */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     Uint32 windowID;    /**< The window with mouse focus, if any */
-//     Uint32 which;       /**< The mouse instance id, or SDL_TOUCH_MOUSEID */
-//     Uint8 button;       /**< The mouse button index */
-//     Uint8 state;        /**< ::SDL_PRESSED or ::SDL_RELEASED */
-//     Uint8 clicks;       /**< 1 for single-click, 2 for double-click, etc. */
-//     Uint8 padding1;
-//     Sint32 x;           /**< X coordinate, relative to window */
-//     Sint32 y;           /**< Y coordinate, relative to window */
-// } SDL_MouseButtonEvent;
+func (o *SDL_MouseButtonEvent) copyToCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_MouseButtonEvent)(p)
+	p1._type = C.Uint32(o.Type)
+	p1.timestamp = C.Uint32(o.Timestamp)
+	p1.windowID = C.Uint32(o.WindowID)
+	p1.which = C.Uint32(o.Which)
+	p1.button = C.Uint8(o.Button)
+	p1.state = C.Uint8(o.State)
+	p1.clicks = C.Uint8(o.Clicks)
+	p1.x = C.Sint32(o.X)
+	p1.y = C.Sint32(o.Y)
+}
 
-// /**
-//  *  \brief Mouse wheel event structure (event.wheel.*)
-//  */
+// Encode returns the event for SDL_PushEvent, e.g. to inject input in tests.
+func (o *SDL_MouseButtonEvent) Encode() *SDL_Event {
+	var r = new(SDL_Event)
+	o.copyToCObj(unsafe.Pointer(&r.cObjData[0]))
+	return r
+}
+
+/**
+ *  \brief Mouse wheel event structure (event.wheel.*)
+ */
 // typedef struct SDL_MouseWheelEvent
-// {
-//     Uint32 type;        /**< ::SDL_MOUSEWHEEL */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     Uint32 windowID;    /**< The window with mouse focus, if any */
-//     Uint32 which;       /**< The mouse instance id, or SDL_TOUCH_MOUSEID */
-//     Sint32 x;           /**< The amount scrolled horizontally, positive to the right and negative to the left */
-//     Sint32 y;           /**< The amount scrolled vertically, positive away from the user and negative toward the user */
-//     Uint32 direction;   /**< Set to one of the SDL_MOUSEWHEEL_* defines. When FLIPPED the values in X and Y will be opposite. Multiply by -1 to change them back */
-//     float preciseX;     /**< The amount scrolled horizontally, positive to the right and negative to the left, with float precision (added in 2.0.18) */
-//     float preciseY;     /**< The amount scrolled vertically, positive away from the user and negative toward the user, with float precision (added in 2.0.18) */
-//     Sint32 mouseX;      /**< X coordinate, relative to window (added in 2.26.0) */
-//     Sint32 mouseY;      /**< Y coordinate, relative to window (added in 2.26.0) */
-// } SDL_MouseWheelEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_MOUSEWHEEL */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Uint32 windowID;    /**< The window with mouse focus, if any */
+//	    Uint32 which;       /**< The mouse instance id, or SDL_TOUCH_MOUSEID */
+//	    Sint32 x;           /**< The amount scrolled horizontally, positive to the right and negative to the left */
+//	    Sint32 y;           /**< The amount scrolled vertically, positive away from the user and negative toward the user */
+//	    Uint32 direction;   /**< Set to one of the SDL_MOUSEWHEEL_* defines. When FLIPPED the values in X and Y will be opposite. Multiply by -1 to change them back */
+//	    float preciseX;     /**< The amount scrolled horizontally, positive to the right and negative to the left, with float precision (added in 2.0.18) */
+//	    float preciseY;     /**< The amount scrolled vertically, positive away from the user and negative toward the user, with float precision (added in 2.0.18) */
+//	    Sint32 mouseX;      /**< X coordinate, relative to window (added in 2.26.0) */
+//	    Sint32 mouseY;      /**< Y coordinate, relative to window (added in 2.26.0) */
+//	} SDL_MouseWheelEvent;
+type SDL_MouseWheelEvent struct {
+	SDL_CommonEvent
+	Which     uint32
+	X         int32
+	Y         int32
+	Direction SDL_MouseWheelDirection
+	PreciseX  float32
+	PreciseY  float32
+	MouseX    int32
+	MouseY    int32
+}
 
-// /**
-//  *  \brief Joystick axis motion event structure (event.jaxis.*)
-//  */
+func (o *SDL_MouseWheelEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_MouseWheelEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.WindowID = uint32(p1.windowID)
+	o.Which = uint32(p1.which)
+	o.X = int32(p1.x)
+	o.Y = int32(p1.y)
+	o.Direction = SDL_MouseWheelDirection(p1.direction)
+	o.PreciseX = float32(p1.preciseX)
+	o.PreciseY = float32(p1.preciseY)
+	o.MouseX = int32(p1.mouseX)
+	o.MouseY = int32(p1.mouseY)
+}
+
+/**
+ *  \brief Joystick axis motion event structure (event.jaxis.*)
+ */
 // typedef struct SDL_JoyAxisEvent
-// {
-//     Uint32 type;        /**< ::SDL_JOYAXISMOTION */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     SDL_JoystickID which; /**< The joystick instance id */
-//     Uint8 axis;         /**< The joystick axis index */
-//     Uint8 padding1;
-//     Uint8 padding2;
-//     Uint8 padding3;
-//     Sint16 value;       /**< The axis value (range: -32768 to 32767) */
-//     Uint16 padding4;
-// } SDL_JoyAxisEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_JOYAXISMOTION */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    SDL_JoystickID which; /**< The joystick instance id */
+//	    Uint8 axis;         /**< The joystick axis index */
+//	    Uint8 padding1;
+//	    Uint8 padding2;
+//	    Uint8 padding3;
+//	    Sint16 value;       /**< The axis value (range: -32768 to 32767) */
+//	    Uint16 padding4;
+//	} SDL_JoyAxisEvent;
+type SDL_JoyAxisEvent struct {
+	SDL_CommonEvent
+	Which SDL_JoystickID
+	Axis  uint8
+	Value int16
+}
 
-// /**
-//  *  \brief Joystick trackball motion event structure (event.jball.*)
-//  */
+func (o *SDL_JoyAxisEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_JoyAxisEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = SDL_JoystickID(p1.which)
+	o.Axis = uint8(p1.axis)
+	o.Value = int16(p1.value)
+}
+
+/**
+ *  \brief Joystick trackball motion event structure (event.jball.*)
+ */
 // typedef struct SDL_JoyBallEvent
-// {
-//     Uint32 type;        /**< ::SDL_JOYBALLMOTION */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     SDL_JoystickID which; /**< The joystick instance id */
-//     Uint8 ball;         /**< The joystick trackball index */
-//     Uint8 padding1;
-//     Uint8 padding2;
-//     Uint8 padding3;
-//     Sint16 xrel;        /**< The relative motion in the X direction */
-//     Sint16 yrel;        /**< The relative motion in the Y direction */
-// } SDL_JoyBallEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_JOYBALLMOTION */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    SDL_JoystickID which; /**< The joystick instance id */
+//	    Uint8 ball;         /**< The joystick trackball index */
+//	    Uint8 padding1;
+//	    Uint8 padding2;
+//	    Uint8 padding3;
+//	    Sint16 xrel;        /**< The relative motion in the X direction */
+//	    Sint16 yrel;        /**< The relative motion in the Y direction */
+//	} SDL_JoyBallEvent;
+type SDL_JoyBallEvent struct {
+	SDL_CommonEvent
+	Which SDL_JoystickID
+	Ball  uint8
+	Xrel  int16
+	Yrel  int16
+}
 
-// /**
-//  *  \brief Joystick hat position change event structure (event.jhat.*)
-//  */
-// typedef struct SDL_JoyHatEvent
-// {
-//     Uint32 type;        /**< ::SDL_JOYHATMOTION */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     SDL_JoystickID which; /**< The joystick instance id */
-//     Uint8 hat;          /**< The joystick hat index */
-//     Uint8 value;        /**< The hat position value.
-//                          *   \sa ::SDL_HAT_LEFTUP ::SDL_HAT_UP ::SDL_HAT_RIGHTUP
-//                          *   \sa ::SDL_HAT_LEFT ::SDL_HAT_CENTERED ::SDL_HAT_RIGHT
-//                          *   \sa ::SDL_HAT_LEFTDOWN ::SDL_HAT_DOWN ::SDL_HAT_RIGHTDOWN
-//                          *
-//                          *   Note that zero means the POV is centered.
-//                          */
-//     Uint8 padding1;
-//     Uint8 padding2;
-// } SDL_JoyHatEvent;
+func (o *SDL_JoyBallEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_JoyBallEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = SDL_JoystickID(p1.which)
+	o.Ball = uint8(p1.ball)
+	o.Xrel = int16(p1.xrel)
+	o.Yrel = int16(p1.yrel)
+}
 
-// /**
-//  *  \brief Joystick button event structure (event.jbutton.*)
-//  */
+/**
+ *  \brief Joystick hat position change event structure (event.jhat.*)
+ */
+// typedef struct SDL_JoyHatEvent
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_JOYHATMOTION */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    SDL_JoystickID which; /**< The joystick instance id */
+//	    Uint8 hat;          /**< The joystick hat index */
+//	    Uint8 value;        /**< The hat position value.
+//	                         *   \sa ::SDL_HAT_LEFTUP ::SDL_HAT_UP ::SDL_HAT_RIGHTUP
+//	                         *   \sa ::SDL_HAT_LEFT ::SDL_HAT_CENTERED ::SDL_HAT_RIGHT
+//	                         *   \sa ::SDL_HAT_LEFTDOWN ::SDL_HAT_DOWN ::SDL_HAT_RIGHTDOWN
+//	                         *
+//	                         *   Note that zero means the POV is centered.
+//	                         */
+//	    Uint8 padding1;
+//	    Uint8 padding2;
+//	} SDL_JoyHatEvent;
+type SDL_JoyHatEvent struct {
+	SDL_CommonEvent
+	Which SDL_JoystickID
+	Hat   uint8
+	Value uint8
+}
+
+func (o *SDL_JoyHatEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_JoyHatEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = SDL_JoystickID(p1.which)
+	o.Hat = uint8(p1.hat)
+	o.Value = uint8(p1.value)
+}
+
+/**
+ *  \brief Joystick button event structure (event.jbutton.*)
+ */
 // typedef struct SDL_JoyButtonEvent
-// {
-//     Uint32 type;        /**< ::SDL_JOYBUTTONDOWN or ::SDL_JOYBUTTONUP */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     SDL_JoystickID which; /**< The joystick instance id */
-//     Uint8 button;       /**< The joystick button index */
-//     Uint8 state;        /**< ::SDL_PRESSED or ::SDL_RELEASED */
-//     Uint8 padding1;
-//     Uint8 padding2;
-// } SDL_JoyButtonEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_JOYBUTTONDOWN or ::SDL_JOYBUTTONUP */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    SDL_JoystickID which; /**< The joystick instance id */
+//	    Uint8 button;       /**< The joystick button index */
+//	    Uint8 state;        /**< ::SDL_PRESSED or ::SDL_RELEASED */
+//	    Uint8 padding1;
+//	    Uint8 padding2;
+//	} SDL_JoyButtonEvent;
+type SDL_JoyButtonEvent struct {
+	SDL_CommonEvent
+	Which  SDL_JoystickID
+	Button uint8
+	State  uint8
+}
 
-// /**
-//  *  \brief Joystick device event structure (event.jdevice.*)
-//  */
+func (o *SDL_JoyButtonEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_JoyButtonEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = SDL_JoystickID(p1.which)
+	o.Button = uint8(p1.button)
+	o.State = uint8(p1.state)
+}
+
+/**
+ *  \brief Joystick device event structure (event.jdevice.*)
+ */
 // typedef struct SDL_JoyDeviceEvent
-// {
-//     Uint32 type;        /**< ::SDL_JOYDEVICEADDED or ::SDL_JOYDEVICEREMOVED */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     Sint32 which;       /**< The joystick device index for the ADDED event, instance id for the REMOVED event */
-// } SDL_JoyDeviceEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_JOYDEVICEADDED or ::SDL_JOYDEVICEREMOVED */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Sint32 which;       /**< The joystick device index for the ADDED event, instance id for the REMOVED event */
+//	} SDL_JoyDeviceEvent;
+type SDL_JoyDeviceEvent struct {
+	SDL_CommonEvent
+	Which int32
+}
 
-// /**
-//  *  \brief Joysick battery level change event structure (event.jbattery.*)
-//  */
+func (o *SDL_JoyDeviceEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_JoyDeviceEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = int32(p1.which)
+}
+
+/**
+ *  \brief Joysick battery level change event structure (event.jbattery.*)
+ */
 // typedef struct SDL_JoyBatteryEvent
-// {
-//     Uint32 type;        /**< ::SDL_JOYBATTERYUPDATED */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     SDL_JoystickID which; /**< The joystick instance id */
-//     SDL_JoystickPowerLevel level; /**< The joystick battery level */
-// } SDL_JoyBatteryEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_JOYBATTERYUPDATED */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    SDL_JoystickID which; /**< The joystick instance id */
+//	    SDL_JoystickPowerLevel level; /**< The joystick battery level */
+//	} SDL_JoyBatteryEvent;
+type SDL_JoyBatteryEvent struct {
+	SDL_CommonEvent
+	Which SDL_JoystickID
+	Level SDL_JoystickPowerLevel
+}
 
-// /**
-//  *  \brief Game controller axis motion event structure (event.caxis.*)
-//  */
+func (o *SDL_JoyBatteryEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_JoyBatteryEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = SDL_JoystickID(p1.which)
+	o.Level = SDL_JoystickPowerLevel(p1.level)
+}
+
+/**
+ *  \brief Game controller axis motion event structure (event.caxis.*)
+ */
 // typedef struct SDL_ControllerAxisEvent
-// {
-//     Uint32 type;        /**< ::SDL_CONTROLLERAXISMOTION */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     SDL_JoystickID which; /**< The joystick instance id */
-//     Uint8 axis;         /**< The controller axis (SDL_GameControllerAxis) */
-//     Uint8 padding1;
-//     Uint8 padding2;
-//     Uint8 padding3;
-//     Sint16 value;       /**< The axis value (range: -32768 to 32767) */
-//     Uint16 padding4;
-// } SDL_ControllerAxisEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_CONTROLLERAXISMOTION */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    SDL_JoystickID which; /**< The joystick instance id */
+//	    Uint8 axis;         /**< The controller axis (SDL_GameControllerAxis) */
+//	    Uint8 padding1;
+//	    Uint8 padding2;
+//	    Uint8 padding3;
+//	    Sint16 value;       /**< The axis value (range: -32768 to 32767) */
+//	    Uint16 padding4;
+//	} SDL_ControllerAxisEvent;
+type SDL_ControllerAxisEvent struct {
+	SDL_CommonEvent
+	Which SDL_JoystickID
+	Axis  uint8
+	Value int16
+}
 
-// /**
-//  *  \brief Game controller button event structure (event.cbutton.*)
-//  */
+func (o *SDL_ControllerAxisEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_ControllerAxisEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = SDL_JoystickID(p1.which)
+	o.Axis = uint8(p1.axis)
+	o.Value = int16(p1.value)
+}
+
+/**
+ *  \brief Game controller button event structure (event.cbutton.*)
+ */
 // typedef struct SDL_ControllerButtonEvent
-// {
-//     Uint32 type;        /**< ::SDL_CONTROLLERBUTTONDOWN or ::SDL_CONTROLLERBUTTONUP */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     SDL_JoystickID which; /**< The joystick instance id */
-//     Uint8 button;       /**< The controller button (SDL_GameControllerButton) */
-//     Uint8 state;        /**< ::SDL_PRESSED or ::SDL_RELEASED */
-//     Uint8 padding1;
-//     Uint8 padding2;
-// } SDL_ControllerButtonEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_CONTROLLERBUTTONDOWN or ::SDL_CONTROLLERBUTTONUP */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    SDL_JoystickID which; /**< The joystick instance id */
+//	    Uint8 button;       /**< The controller button (SDL_GameControllerButton) */
+//	    Uint8 state;        /**< ::SDL_PRESSED or ::SDL_RELEASED */
+//	    Uint8 padding1;
+//	    Uint8 padding2;
+//	} SDL_ControllerButtonEvent;
+type SDL_ControllerButtonEvent struct {
+	SDL_CommonEvent
+	Which  SDL_JoystickID
+	Button uint8
+	State  uint8
+}
 
-// /**
-//  *  \brief Controller device event structure (event.cdevice.*)
-//  */
+func (o *SDL_ControllerButtonEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_ControllerButtonEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = SDL_JoystickID(p1.which)
+	o.Button = uint8(p1.button)
+	o.State = uint8(p1.state)
+}
+
+/**
+ *  \brief Controller device event structure (event.cdevice.*)
+ */
 // typedef struct SDL_ControllerDeviceEvent
-// {
-//     Uint32 type;        /**< ::SDL_CONTROLLERDEVICEADDED, ::SDL_CONTROLLERDEVICEREMOVED, or ::SDL_CONTROLLERDEVICEREMAPPED */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     Sint32 which;       /**< The joystick device index for the ADDED event, instance id for the REMOVED or REMAPPED event */
-// } SDL_ControllerDeviceEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_CONTROLLERDEVICEADDED, ::SDL_CONTROLLERDEVICEREMOVED, or ::SDL_CONTROLLERDEVICEREMAPPED */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Sint32 which;       /**< The joystick device index for the ADDED event, instance id for the REMOVED or REMAPPED event */
+//	} SDL_ControllerDeviceEvent;
+type SDL_ControllerDeviceEvent struct {
+	SDL_CommonEvent
+	Which int32
+}
 
-// /**
-//  *  \brief Game controller touchpad event structure (event.ctouchpad.*)
-//  */
+func (o *SDL_ControllerDeviceEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_ControllerDeviceEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = int32(p1.which)
+}
+
+/**
+ *  \brief Game controller touchpad event structure (event.ctouchpad.*)
+ */
 // typedef struct SDL_ControllerTouchpadEvent
-// {
-//     Uint32 type;        /**< ::SDL_CONTROLLERTOUCHPADDOWN or ::SDL_CONTROLLERTOUCHPADMOTION or ::SDL_CONTROLLERTOUCHPADUP */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     SDL_JoystickID which; /**< The joystick instance id */
-//     Sint32 touchpad;    /**< The index of the touchpad */
-//     Sint32 finger;      /**< The index of the finger on the touchpad */
-//     float x;            /**< Normalized in the range 0...1 with 0 being on the left */
-//     float y;            /**< Normalized in the range 0...1 with 0 being at the top */
-//     float pressure;     /**< Normalized in the range 0...1 */
-// } SDL_ControllerTouchpadEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_CONTROLLERTOUCHPADDOWN or ::SDL_CONTROLLERTOUCHPADMOTION or ::SDL_CONTROLLERTOUCHPADUP */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    SDL_JoystickID which; /**< The joystick instance id */
+//	    Sint32 touchpad;    /**< The index of the touchpad */
+//	    Sint32 finger;      /**< The index of the finger on the touchpad */
+//	    float x;            /**< Normalized in the range 0...1 with 0 being on the left */
+//	    float y;            /**< Normalized in the range 0...1 with 0 being at the top */
+//	    float pressure;     /**< Normalized in the range 0...1 */
+//	} SDL_ControllerTouchpadEvent;
+type SDL_ControllerTouchpadEvent struct {
+	SDL_CommonEvent
+	Which    SDL_JoystickID
+	Touchpad int32
+	Finger   int32
+	X        float32
+	Y        float32
+	Pressure float32
+}
 
-// /**
-//  *  \brief Game controller sensor event structure (event.csensor.*)
-//  */
+func (o *SDL_ControllerTouchpadEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_ControllerTouchpadEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = SDL_JoystickID(p1.which)
+	o.Touchpad = int32(p1.touchpad)
+	o.Finger = int32(p1.finger)
+	o.X = float32(p1.x)
+	o.Y = float32(p1.y)
+	o.Pressure = float32(p1.pressure)
+}
+
+/**
+ *  \brief Game controller sensor event structure (event.csensor.*)
+ */
 // typedef struct SDL_ControllerSensorEvent
-// {
-//     Uint32 type;        /**< ::SDL_CONTROLLERSENSORUPDATE */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     SDL_JoystickID which; /**< The joystick instance id */
-//     Sint32 sensor;      /**< The type of the sensor, one of the values of ::SDL_SensorType */
-//     float data[3];      /**< Up to 3 values from the sensor, as defined in SDL_sensor.h */
-//     Uint64 timestamp_us; /**< The timestamp of the sensor reading in microseconds, if the hardware provides this information. */
-// } SDL_ControllerSensorEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_CONTROLLERSENSORUPDATE */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    SDL_JoystickID which; /**< The joystick instance id */
+//	    Sint32 sensor;      /**< The type of the sensor, one of the values of ::SDL_SensorType */
+//	    float data[3];      /**< Up to 3 values from the sensor, as defined in SDL_sensor.h */
+//	    Uint64 timestamp_us; /**< The timestamp of the sensor reading in microseconds, if the hardware provides this information. */
+//	} SDL_ControllerSensorEvent;
+type SDL_ControllerSensorEvent struct {
+	SDL_CommonEvent
+	Which       SDL_JoystickID
+	Sensor      int32
+	Data        [3]float32
+	TimestampUs uint64
+}
 
-// /**
-//  *  \brief Audio device event structure (event.adevice.*)
-//  */
+func (o *SDL_ControllerSensorEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_ControllerSensorEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = SDL_JoystickID(p1.which)
+	o.Sensor = int32(p1.sensor)
+	for i := range o.Data {
+		o.Data[i] = float32(p1.data[i])
+	}
+	o.TimestampUs = uint64(p1.timestamp_us)
+}
+
+/**
+ *  \brief Audio device event structure (event.adevice.*)
+ */
 // typedef struct SDL_AudioDeviceEvent
-// {
-//     Uint32 type;        /**< ::SDL_AUDIODEVICEADDED, or ::SDL_AUDIODEVICEREMOVED */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     Uint32 which;       /**< The audio device index for the ADDED event (valid until next SDL_GetNumAudioDevices() call), SDL_AudioDeviceID for the REMOVED event */
-//     Uint8 iscapture;    /**< zero if an output device, non-zero if a capture device. */
-//     Uint8 padding1;
-//     Uint8 padding2;
-//     Uint8 padding3;
-// } SDL_AudioDeviceEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_AUDIODEVICEADDED, or ::SDL_AUDIODEVICEREMOVED */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Uint32 which;       /**< The audio device index for the ADDED event (valid until next SDL_GetNumAudioDevices() call), SDL_AudioDeviceID for the REMOVED event */
+//	    Uint8 iscapture;    /**< zero if an output device, non-zero if a capture device. */
+//	    Uint8 padding1;
+//	    Uint8 padding2;
+//	    Uint8 padding3;
+//	} SDL_AudioDeviceEvent;
+type SDL_AudioDeviceEvent struct {
+	SDL_CommonEvent
+	Which     uint32
+	IsCapture bool
+}
 
-// /**
-//  *  \brief Touch finger event structure (event.tfinger.*)
-//  */
+func (o *SDL_AudioDeviceEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_AudioDeviceEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = uint32(p1.which)
+	o.IsCapture = 0 != p1.iscapture
+}
+
+/**
+ *  \brief Touch finger event structure (event.tfinger.*)
+ */
 // typedef struct SDL_TouchFingerEvent
-// {
-//     Uint32 type;        /**< ::SDL_FINGERMOTION or ::SDL_FINGERDOWN or ::SDL_FINGERUP */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     SDL_TouchID touchId; /**< The touch device id */
-//     SDL_FingerID fingerId;
-//     float x;            /**< Normalized in the range 0...1 */
-//     float y;            /**< Normalized in the range 0...1 */
-//     float dx;           /**< Normalized in the range -1...1 */
-//     float dy;           /**< Normalized in the range -1...1 */
-//     float pressure;     /**< Normalized in the range 0...1 */
-//     Uint32 windowID;    /**< The window underneath the finger, if any */
-// } SDL_TouchFingerEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_FINGERMOTION or ::SDL_FINGERDOWN or ::SDL_FINGERUP */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    SDL_TouchID touchId; /**< The touch device id */
+//	    SDL_FingerID fingerId;
+//	    float x;            /**< Normalized in the range 0...1 */
+//	    float y;            /**< Normalized in the range 0...1 */
+//	    float dx;           /**< Normalized in the range -1...1 */
+//	    float dy;           /**< Normalized in the range -1...1 */
+//	    float pressure;     /**< Normalized in the range 0...1 */
+//	    Uint32 windowID;    /**< The window underneath the finger, if any */
+//	} SDL_TouchFingerEvent;
+type SDL_TouchFingerEvent struct {
+	SDL_CommonEvent
+	TouchId  SDL_TouchID
+	FingerId SDL_FingerID
+	X        float32
+	Y        float32
+	Dx       float32
+	Dy       float32
+	Pressure float32
+}
 
-// /**
-//  *  \brief Multiple Finger Gesture Event (event.mgesture.*)
-//  */
+func (o *SDL_TouchFingerEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_TouchFingerEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.WindowID = uint32(p1.windowID)
+	o.TouchId = SDL_TouchID(p1.touchId)
+	o.FingerId = SDL_FingerID(p1.fingerId)
+	o.X = float32(p1.x)
+	o.Y = float32(p1.y)
+	o.Dx = float32(p1.dx)
+	o.Dy = float32(p1.dy)
+	o.Pressure = float32(p1.pressure)
+}
+
+/**
+ *  \brief Multiple Finger Gesture Event (event.mgesture.*)
+ */
 // typedef struct SDL_MultiGestureEvent
-// {
-//     Uint32 type;        /**< ::SDL_MULTIGESTURE */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     SDL_TouchID touchId; /**< The touch device id */
-//     float dTheta;
-//     float dDist;
-//     float x;
-//     float y;
-//     Uint16 numFingers;
-//     Uint16 padding;
-// } SDL_MultiGestureEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_MULTIGESTURE */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    SDL_TouchID touchId; /**< The touch device id */
+//	    float dTheta;
+//	    float dDist;
+//	    float x;
+//	    float y;
+//	    Uint16 numFingers;
+//	    Uint16 padding;
+//	} SDL_MultiGestureEvent;
+type SDL_MultiGestureEvent struct {
+	SDL_CommonEvent
+	TouchId    SDL_TouchID
+	DTheta     float32
+	DDist      float32
+	X          float32
+	Y          float32
+	NumFingers uint16
+}
 
-// /**
-//  * \brief Dollar Gesture Event (event.dgesture.*)
-//  */
+func (o *SDL_MultiGestureEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_MultiGestureEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.TouchId = SDL_TouchID(p1.touchId)
+	o.DTheta = float32(p1.dTheta)
+	o.DDist = float32(p1.dDist)
+	o.X = float32(p1.x)
+	o.Y = float32(p1.y)
+	o.NumFingers = uint16(p1.numFingers)
+}
+
+/**
+ *  \brief Dollar Gesture Event (event.dgesture.*)
+ */
 // typedef struct SDL_DollarGestureEvent
-// {
-//     Uint32 type;        /**< ::SDL_DOLLARGESTURE or ::SDL_DOLLARRECORD */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     SDL_TouchID touchId; /**< The touch device id */
-//     SDL_GestureID gestureId;
-//     Uint32 numFingers;
-//     float error;
-//     float x;            /**< Normalized center of gesture */
-//     float y;            /**< Normalized center of gesture */
-// } SDL_DollarGestureEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_DOLLARGESTURE or ::SDL_DOLLARRECORD */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    SDL_TouchID touchId; /**< The touch device id */
+//	    SDL_GestureID gestureId;
+//	    Uint32 numFingers;
+//	    float error;
+//	    float x;            /**< Normalized center of gesture */
+//	    float y;            /**< Normalized center of gesture */
+//	} SDL_DollarGestureEvent;
+type SDL_DollarGestureEvent struct {
+	SDL_CommonEvent
+	TouchId    SDL_TouchID
+	GestureId  SDL_GestureID
+	NumFingers uint32
+	Error      float32
+	X          float32
+	Y          float32
+}
 
-// /**
-//  *  \brief An event used to request a file open by the system (event.drop.*)
-//  *         This event is enabled by default, you can disable it with SDL_EventState().
-//  *  \note If this event is enabled, you must free the filename in the event.
-//  */
+func (o *SDL_DollarGestureEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_DollarGestureEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.TouchId = SDL_TouchID(p1.touchId)
+	o.GestureId = SDL_GestureID(p1.gestureId)
+	o.NumFingers = uint32(p1.numFingers)
+	o.Error = float32(p1.error)
+	o.X = float32(p1.x)
+	o.Y = float32(p1.y)
+}
+
+/**
+ *  \brief An event used to request a file open by the system (event.drop.*)
+ *  This event is enabled by default, you can disable it with SDL_EventState().
+ *  \note If this event is enabled, you must free the filename in the event.
+ */
 // typedef struct SDL_DropEvent
-// {
-//     Uint32 type;        /**< ::SDL_DROPBEGIN or ::SDL_DROPFILE or ::SDL_DROPTEXT or ::SDL_DROPCOMPLETE */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     char *file;         /**< The file name, which should be freed with SDL_free(), is NULL on begin/complete */
-//     Uint32 windowID;    /**< The window that was dropped on, if any */
-// } SDL_DropEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_DROPBEGIN or ::SDL_DROPFILE or ::SDL_DROPTEXT or ::SDL_DROPCOMPLETE */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    char *file;         /**< The file name, which should be freed with SDL_free(), is NULL on begin/complete */
+//	    Uint32 windowID;    /**< The window that was dropped on, if any */
+//	} SDL_DropEvent;
+type SDL_DropEvent struct {
+	SDL_CommonEvent
+	File string
+}
 
-// /**
-//  *  \brief Sensor event structure (event.sensor.*)
-//  */
+func (o *SDL_DropEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_DropEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.WindowID = uint32(p1.windowID)
+	if nil != p1.file {
+		o.File = C.GoString(p1.file)
+	}
+}
+
+/**
+ *  \brief Sensor event structure (event.sensor.*)
+ */
 // typedef struct SDL_SensorEvent
-// {
-//     Uint32 type;        /**< ::SDL_SENSORUPDATE */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     Sint32 which;       /**< The instance ID of the sensor */
-//     float data[6];      /**< Up to 6 values from the sensor - additional values can be queried using SDL_SensorGetData() */
-//     Uint64 timestamp_us; /**< The timestamp of the sensor reading in microseconds, if the hardware provides this information. */
-// } SDL_SensorEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_SENSORUPDATE */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Sint32 which;       /**< The instance ID of the sensor */
+//	    float data[6];      /**< Up to 6 values from the sensor - additional values can be queried using SDL_SensorGetData() */
+//	    Uint64 timestamp_us; /**< The timestamp of the sensor reading in microseconds, if the hardware provides this information. */
+//	} SDL_SensorEvent;
+type SDL_SensorEvent struct {
+	SDL_CommonEvent
+	Which       int32
+	Data        [6]float32
+	TimestampUs uint64
+}
 
-// /**
-//  *  \brief The "quit requested" event
-//  */
+func (o *SDL_SensorEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_SensorEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.Which = int32(p1.which)
+	for i := range o.Data {
+		o.Data[i] = float32(p1.data[i])
+	}
+	o.TimestampUs = uint64(p1.timestamp_us)
+}
+
+/**
+ *  \brief The "quit requested" event
+ */
 // typedef struct SDL_QuitEvent
-// {
-//     Uint32 type;        /**< ::SDL_QUIT */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-// } SDL_QuitEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_QUIT */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	} SDL_QuitEvent;
+type SDL_QuitEvent struct {
+	SDL_CommonEvent
+}
+
+func (o *SDL_QuitEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_QuitEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+}
+
+func (o *SDL_QuitEvent) copyToCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_QuitEvent)(p)
+	p1._type = C.Uint32(o.Type)
+	p1.timestamp = C.Uint32(o.Timestamp)
+}
+
+// Encode returns the event for SDL_PushEvent, e.g. to inject input in tests.
+func (o *SDL_QuitEvent) Encode() *SDL_Event {
+	var r = new(SDL_Event)
+	o.copyToCObj(unsafe.Pointer(&r.cObjData[0]))
+	return r
+}
 
 // /**
 //  *  \brief OS Specific event
@@ -678,18 +1268,54 @@ const (
 //     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
 // } SDL_OSEvent;
 
-// /**
-//  *  \brief A user-defined event type (event.user.*)
-//  */
+/**
+ *  \brief A user-defined event type (event.user.*)
+ */
 // typedef struct SDL_UserEvent
-// {
-//     Uint32 type;        /**< ::SDL_USEREVENT through ::SDL_LASTEVENT-1 */
-//     Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
-//     Uint32 windowID;    /**< The associated window if any */
-//     Sint32 code;        /**< User defined event code */
-//     void *data1;        /**< User defined data pointer */
-//     void *data2;        /**< User defined data pointer */
-// } SDL_UserEvent;
+//
+//	{
+//	    Uint32 type;        /**< ::SDL_USEREVENT through ::SDL_LASTEVENT-1 */
+//	    Uint32 timestamp;   /**< In milliseconds, populated using SDL_GetTicks() */
+//	    Uint32 windowID;    /**< The associated window if any */
+//	    Sint32 code;        /**< User defined event code */
+//	    void *data1;        /**< User defined data pointer */
+//	    void *data2;        /**< User defined data pointer */
+//	} SDL_UserEvent;
+type SDL_UserEvent struct {
+	SDL_CommonEvent
+	Code  int32
+	Data1 uintptr
+	Data2 uintptr
+}
+
+func (o *SDL_UserEvent) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_UserEvent)(p)
+	o.Type = SDL_EventType(p1._type)
+	o.Timestamp = uint32(p1.timestamp)
+	o.WindowID = uint32(p1.windowID)
+	o.Code = int32(p1.code)
+	o.Data1 = uintptr(p1.data1)
+	o.Data2 = uintptr(p1.data2)
+}
+
+func (o *SDL_UserEvent) copyToCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_UserEvent)(p)
+	p1._type = C.Uint32(o.Type)
+	p1.timestamp = C.Uint32(o.Timestamp)
+	p1.windowID = C.Uint32(o.WindowID)
+	p1.code = C.Sint32(o.Code)
+	// Not unsafe.Pointer(o.Data1), the data are not Go pointers
+	*(*uintptr)(unsafe.Pointer(&p1.data1)) = o.Data1
+	*(*uintptr)(unsafe.Pointer(&p1.data2)) = o.Data2
+}
+
+// Encode returns the event for SDL_PushEvent. Type must be one of the types
+// of SDL_RegisterEvents.
+func (o *SDL_UserEvent) Encode() *SDL_Event {
+	var r = new(SDL_Event)
+	o.copyToCObj(unsafe.Pointer(&r.cObjData[0]))
+	return r
+}
 
 // struct SDL_SysWMmsg;
 // typedef struct SDL_SysWMmsg SDL_SysWMmsg;
@@ -771,163 +1397,309 @@ func (o *SDL_Event) Type() SDL_EventType {
 	return SDL_EventType(*p)
 }
 
+// Free releases the text of SDL_TEXTEDITING_EXT and the file of drop events
+// with SDL_free. Events taken out of the queue own them: call Free once done
+// with an event of SDL_PollEvent, SDL_WaitEvent, SDL_WaitEventTimeout or
+// SDL_PeepEvents with SDL_GETEVENT. Never free the events of filters, watches
+// or SDL_PEEKEVENT, SDL still holds those in its queue.
+func (o *SDL_Event) Free() {
+
+	switch o.Type() {
+	case SDL_TEXTEDITING_EXT:
+		var p = (*C.SDL_TextEditingExtEvent)(unsafe.Pointer(&o.cObjData[0]))
+		C.SDL_free(unsafe.Pointer(p.text))
+		p.text = nil
+	case SDL_DROPFILE, SDL_DROPTEXT, SDL_DROPBEGIN, SDL_DROPCOMPLETE:
+		var p = (*C.SDL_DropEvent)(unsafe.Pointer(&o.cObjData[0]))
+		C.SDL_free(unsafe.Pointer(p.file))
+		p.file = nil
+	} // switch
+}
+
+// cObj returns the C event, NULL for a nil o.
+func (o *SDL_Event) cObj() *C.SDL_Event {
+	if nil == o {
+		return nil
+	}
+	return (*C.SDL_Event)(unsafe.Pointer(&o.cObjData[0]))
+}
+
+// Decode copies o into the Go event of its type, e.g. *SDL_KeyboardEvent for
+// SDL_KEYDOWN, or *SDL_CommonEvent for types without one. The result does
+// not refer to o and stays valid after the next poll.
+//
+// Decode does not free the text of SDL_TEXTEDITING_EXT and the file of drop
+// events, see Free.
+func (o *SDL_Event) Decode() Event {
+
+	var r Event
+
+	switch t := o.Type(); t {
+	case SDL_QUIT:
+		r = new(SDL_QuitEvent)
+	case SDL_DISPLAYEVENT:
+		r = new(SDL_DisplayEvent)
+	case SDL_WINDOWEVENT:
+		r = new(SDL_WindowEvent)
+	case SDL_KEYDOWN, SDL_KEYUP:
+		r = new(SDL_KeyboardEvent)
+	case SDL_TEXTEDITING:
+		r = new(SDL_TextEditingEvent)
+	case SDL_TEXTEDITING_EXT:
+		r = new(SDL_TextEditingExtEvent)
+	case SDL_TEXTINPUT:
+		r = new(SDL_TextInputEvent)
+	case SDL_MOUSEMOTION:
+		r = new(SDL_MouseMotionEvent)
+	case SDL_MOUSEBUTTONDOWN, SDL_MOUSEBUTTONUP:
+		r = new(SDL_MouseButtonEvent)
+	case SDL_MOUSEWHEEL:
+		r = new(SDL_MouseWheelEvent)
+	case SDL_JOYAXISMOTION:
+		r = new(SDL_JoyAxisEvent)
+	case SDL_JOYBALLMOTION:
+		r = new(SDL_JoyBallEvent)
+	case SDL_JOYHATMOTION:
+		r = new(SDL_JoyHatEvent)
+	case SDL_JOYBUTTONDOWN, SDL_JOYBUTTONUP:
+		r = new(SDL_JoyButtonEvent)
+	case SDL_JOYDEVICEADDED, SDL_JOYDEVICEREMOVED:
+		r = new(SDL_JoyDeviceEvent)
+	case SDL_JOYBATTERYUPDATED:
+		r = new(SDL_JoyBatteryEvent)
+	case SDL_CONTROLLERAXISMOTION:
+		r = new(SDL_ControllerAxisEvent)
+	case SDL_CONTROLLERBUTTONDOWN, SDL_CONTROLLERBUTTONUP:
+		r = new(SDL_ControllerButtonEvent)
+	case SDL_CONTROLLERDEVICEADDED, SDL_CONTROLLERDEVICEREMOVED, SDL_CONTROLLERDEVICEREMAPPED:
+		r = new(SDL_ControllerDeviceEvent)
+	case SDL_CONTROLLERTOUCHPADDOWN, SDL_CONTROLLERTOUCHPADMOTION, SDL_CONTROLLERTOUCHPADUP:
+		r = new(SDL_ControllerTouchpadEvent)
+	case SDL_CONTROLLERSENSORUPDATE:
+		r = new(SDL_ControllerSensorEvent)
+	case SDL_AUDIODEVICEADDED, SDL_AUDIODEVICEREMOVED:
+		r = new(SDL_AudioDeviceEvent)
+	case SDL_FINGERDOWN, SDL_FINGERUP, SDL_FINGERMOTION:
+		r = new(SDL_TouchFingerEvent)
+	case SDL_MULTIGESTURE:
+		r = new(SDL_MultiGestureEvent)
+	case SDL_DOLLARGESTURE, SDL_DOLLARRECORD:
+		r = new(SDL_DollarGestureEvent)
+	case SDL_DROPFILE, SDL_DROPTEXT, SDL_DROPBEGIN, SDL_DROPCOMPLETE:
+		r = new(SDL_DropEvent)
+	case SDL_SENSORUPDATE:
+		r = new(SDL_SensorEvent)
+	default:
+		if t >= SDL_USEREVENT && t < SDL_LASTEVENT {
+			r = new(SDL_UserEvent)
+		} else {
+			r = new(SDL_CommonEvent)
+		}
+	} // switch
+
+	r.copyFromCObj(unsafe.Pointer(&o.cObjData[0]))
+
+	return r
+}
+
 /* Function prototypes */
 
-// /**
-//  * Pump the event loop, gathering events from the input devices.
-//  *
-//  * This function updates the event queue and internal input device state.
-//  *
-//  * **WARNING**: This should only be run in the thread that initialized the
-//  * video subsystem, and for extra safety, you should consider only doing those
-//  * things on the main thread in any case.
-//  *
-//  * SDL_PumpEvents() gathers all the pending input information from devices and
-//  * places it in the event queue. Without calls to SDL_PumpEvents() no events
-//  * would ever be placed on the queue. Often the need for calls to
-//  * SDL_PumpEvents() is hidden from the user since SDL_PollEvent() and
-//  * SDL_WaitEvent() implicitly call SDL_PumpEvents(). However, if you are not
-//  * polling or waiting for events (e.g. you are filtering them), then you must
-//  * call SDL_PumpEvents() to force an event queue update.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_PollEvent
-//  * \sa SDL_WaitEvent
-//  */
+/**
+ * Pump the event loop, gathering events from the input devices.
+ *
+ * This function updates the event queue and internal input device state.
+ *
+ * **WARNING**: This should only be run in the thread that initialized the
+ * video subsystem, and for extra safety, you should consider only doing those
+ * things on the main thread in any case.
+ *
+ * SDL_PumpEvents() gathers all the pending input information from devices and
+ * places it in the event queue. Without calls to SDL_PumpEvents() no events
+ * would ever be placed on the queue. Often the need for calls to
+ * SDL_PumpEvents() is hidden from the user since SDL_PollEvent() and
+ * SDL_WaitEvent() implicitly call SDL_PumpEvents(). However, if you are not
+ * polling or waiting for events (e.g. you are filtering them), then you must
+ * call SDL_PumpEvents() to force an event queue update.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_PollEvent
+ * \sa SDL_WaitEvent
+ */
 // extern DECLSPEC void SDLCALL SDL_PumpEvents(void);
+func SDL_PumpEvents() {
+	C.SDL_PumpEvents()
+}
 
 // /* @{ */
 // typedef enum
-// {
-//     SDL_ADDEVENT,
-//     SDL_PEEKEVENT,
-//     SDL_GETEVENT
-// } SDL_eventaction;
+//
+//	{
+//	    SDL_ADDEVENT,
+//	    SDL_PEEKEVENT,
+//	    SDL_GETEVENT
+//	} SDL_eventaction;
+type SDL_eventaction int
 
-// /**
-//  * Check the event queue for messages and optionally return them.
-//  *
-//  * `action` may be any of the following:
-//  *
-//  * - `SDL_ADDEVENT`: up to `numevents` events will be added to the back of the
-//  *   event queue.
-//  * - `SDL_PEEKEVENT`: `numevents` events at the front of the event queue,
-//  *   within the specified minimum and maximum type, will be returned to the
-//  *   caller and will _not_ be removed from the queue.
-//  * - `SDL_GETEVENT`: up to `numevents` events at the front of the event queue,
-//  *   within the specified minimum and maximum type, will be returned to the
-//  *   caller and will be removed from the queue.
-//  *
-//  * You may have to call SDL_PumpEvents() before calling this function.
-//  * Otherwise, the events may not be ready to be filtered when you call
-//  * SDL_PeepEvents().
-//  *
-//  * This function is thread-safe.
-//  *
-//  * \param events destination buffer for the retrieved events
-//  * \param numevents if action is SDL_ADDEVENT, the number of events to add
-//  *                  back to the event queue; if action is SDL_PEEKEVENT or
-//  *                  SDL_GETEVENT, the maximum number of events to retrieve
-//  * \param action action to take; see [[#action|Remarks]] for details
-//  * \param minType minimum value of the event type to be considered;
-//  *                SDL_FIRSTEVENT is a safe choice
-//  * \param maxType maximum value of the event type to be considered;
-//  *                SDL_LASTEVENT is a safe choice
-//  * \returns the number of events actually stored or a negative error code on
-//  *          failure; call SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_PollEvent
-//  * \sa SDL_PumpEvents
-//  * \sa SDL_PushEvent
-//  */
+const (
+	SDL_ADDEVENT  SDL_eventaction = C.SDL_ADDEVENT
+	SDL_PEEKEVENT SDL_eventaction = C.SDL_PEEKEVENT
+	SDL_GETEVENT  SDL_eventaction = C.SDL_GETEVENT
+)
+
+/**
+ * Check the event queue for messages and optionally return them.
+ *
+ * `action` may be any of the following:
+ *
+ * - `SDL_ADDEVENT`: up to `numevents` events will be added to the back of the
+ *   event queue.
+ * - `SDL_PEEKEVENT`: `numevents` events at the front of the event queue,
+ *   within the specified minimum and maximum type, will be returned to the
+ *   caller and will _not_ be removed from the queue.
+ * - `SDL_GETEVENT`: up to `numevents` events at the front of the event queue,
+ *   within the specified minimum and maximum type, will be returned to the
+ *   caller and will be removed from the queue.
+ *
+ * You may have to call SDL_PumpEvents() before calling this function.
+ * Otherwise, the events may not be ready to be filtered when you call
+ * SDL_PeepEvents().
+ *
+ * This function is thread-safe.
+ *
+ * \param events destination buffer for the retrieved events
+ * \param numevents if action is SDL_ADDEVENT, the number of events to add
+ *                  back to the event queue; if action is SDL_PEEKEVENT or
+ *                  SDL_GETEVENT, the maximum number of events to retrieve
+ * \param action action to take; see [[#action|Remarks]] for details
+ * \param minType minimum value of the event type to be considered;
+ *                SDL_FIRSTEVENT is a safe choice
+ * \param maxType maximum value of the event type to be considered;
+ *                SDL_LASTEVENT is a safe choice
+ * \returns the number of events actually stored or a negative error code on
+ *          failure; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_PollEvent
+ * \sa SDL_PumpEvents
+ * \sa SDL_PushEvent
+ */
 // extern DECLSPEC int SDLCALL SDL_PeepEvents(SDL_Event * events, int numevents,
 //                                            SDL_eventaction action,
 //                                            Uint32 minType, Uint32 maxType);
+func SDL_PeepEvents(events []SDL_Event, numevents int, action SDL_eventaction, minType, maxType uint32) (int, error) {
+
+	var p *C.SDL_Event
+	if len(events) > 0 {
+		p = (*C.SDL_Event)(unsafe.Pointer(&events[0].cObjData[0]))
+	}
+	if numevents > len(events) {
+		numevents = len(events)
+	}
+
+	var n = C.SDL_PeepEvents(p, C.int(numevents), C.SDL_eventaction(action), C.Uint32(minType), C.Uint32(maxType))
+	if n < 0 {
+		return 0, lastError("SDL_PeepEvents")
+	}
+
+	return int(n), nil
+}
+
 // /* @} */
 
-// /**
-//  * Check for the existence of a certain event type in the event queue.
-//  *
-//  * If you need to check for a range of event types, use SDL_HasEvents()
-//  * instead.
-//  *
-//  * \param type the type of event to be queried; see SDL_EventType for details
-//  * \returns SDL_TRUE if events matching `type` are present, or SDL_FALSE if
-//  *          events matching `type` are not present.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_HasEvents
-//  */
+/**
+ * Check for the existence of a certain event type in the event queue.
+ *
+ * If you need to check for a range of event types, use SDL_HasEvents()
+ * instead.
+ *
+ * \param type the type of event to be queried; see SDL_EventType for details
+ * \returns SDL_TRUE if events matching `type` are present, or SDL_FALSE if
+ *          events matching `type` are not present.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_HasEvents
+ */
 // extern DECLSPEC SDL_bool SDLCALL SDL_HasEvent(Uint32 type);
+func SDL_HasEvent(_type uint32) bool {
+	return C.SDL_TRUE == C.SDL_HasEvent(C.Uint32(_type))
+}
 
-// /**
-//  * Check for the existence of certain event types in the event queue.
-//  *
-//  * If you need to check for a single event type, use SDL_HasEvent() instead.
-//  *
-//  * \param minType the low end of event type to be queried, inclusive; see
-//  *                SDL_EventType for details
-//  * \param maxType the high end of event type to be queried, inclusive; see
-//  *                SDL_EventType for details
-//  * \returns SDL_TRUE if events with type >= `minType` and <= `maxType` are
-//  *          present, or SDL_FALSE if not.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_HasEvents
-//  */
-// extern DECLSPEC SDL_bool SDLCALL SDL_HasEvents(Uint32 minType, Uint32 maxType);
-
-// /**
-//  * Clear events of a specific type from the event queue.
-//  *
-//  * This will unconditionally remove any events from the queue that match
-//  * `type`. If you need to remove a range of event types, use SDL_FlushEvents()
-//  * instead.
-//  *
-//  * It's also normal to just ignore events you don't care about in your event
-//  * loop without calling this function.
-//  *
-//  * This function only affects currently queued events. If you want to make
-//  * sure that all pending OS events are flushed, you can call SDL_PumpEvents()
-//  * on the main thread immediately before the flush call.
-//  *
-//  * \param type the type of event to be cleared; see SDL_EventType for details
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_FlushEvents
-//  */
+/**
+ * Check for the existence of certain event types in the event queue.
+ *
+ * If you need to check for a single event type, use SDL_HasEvent() instead.
+ *
+ * \param minType the low end of event type to be queried, inclusive; see
+ *                SDL_EventType for details
+ * \param maxType the high end of event type to be queried, inclusive; see
+ *                SDL_EventType for details
+ * \returns SDL_TRUE if events with type >= `minType` and <= `maxType` are
+ *          present, or SDL_FALSE if not.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_HasEvents
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_HasEvents(Uint32 minType, Uint32 maxType);
+func SDL_HasEvents(minType, maxType uint32) bool {
+	return C.SDL_TRUE == C.SDL_HasEvents(C.Uint32(minType), C.Uint32(maxType))
+}
+
+/**
+ * Clear events of a specific type from the event queue.
+ *
+ * This will unconditionally remove any events from the queue that match
+ * `type`. If you need to remove a range of event types, use SDL_FlushEvents()
+ * instead.
+ *
+ * It's also normal to just ignore events you don't care about in your event
+ * loop without calling this function.
+ *
+ * This function only affects currently queued events. If you want to make
+ * sure that all pending OS events are flushed, you can call SDL_PumpEvents()
+ * on the main thread immediately before the flush call.
+ *
+ * \param type the type of event to be cleared; see SDL_EventType for details
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_FlushEvents
+ */
 // extern DECLSPEC void SDLCALL SDL_FlushEvent(Uint32 type);
+func SDL_FlushEvent(_type uint32) {
+	C.SDL_FlushEvent(C.Uint32(_type))
+}
 
-// /**
-//  * Clear events of a range of types from the event queue.
-//  *
-//  * This will unconditionally remove any events from the queue that are in the
-//  * range of `minType` to `maxType`, inclusive. If you need to remove a single
-//  * event type, use SDL_FlushEvent() instead.
-//  *
-//  * It's also normal to just ignore events you don't care about in your event
-//  * loop without calling this function.
-//  *
-//  * This function only affects currently queued events. If you want to make
-//  * sure that all pending OS events are flushed, you can call SDL_PumpEvents()
-//  * on the main thread immediately before the flush call.
-//  *
-//  * \param minType the low end of event type to be cleared, inclusive; see
-//  *                SDL_EventType for details
-//  * \param maxType the high end of event type to be cleared, inclusive; see
-//  *                SDL_EventType for details
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_FlushEvent
-//  */
+/**
+ * Clear events of a range of types from the event queue.
+ *
+ * This will unconditionally remove any events from the queue that are in the
+ * range of `minType` to `maxType`, inclusive. If you need to remove a single
+ * event type, use SDL_FlushEvent() instead.
+ *
+ * It's also normal to just ignore events you don't care about in your event
+ * loop without calling this function.
+ *
+ * This function only affects currently queued events. If you want to make
+ * sure that all pending OS events are flushed, you can call SDL_PumpEvents()
+ * on the main thread immediately before the flush call.
+ *
+ * \param minType the low end of event type to be cleared, inclusive; see
+ *                SDL_EventType for details
+ * \param maxType the high end of event type to be cleared, inclusive; see
+ *                SDL_EventType for details
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_FlushEvent
+ */
 // extern DECLSPEC void SDLCALL SDL_FlushEvents(Uint32 minType, Uint32 maxType);
+func SDL_FlushEvents(minType, maxType uint32) {
+	C.SDL_FlushEvents(C.Uint32(minType), C.Uint32(maxType))
+}
 
 /**
  * Poll for currently pending events.
@@ -980,235 +1752,347 @@ func SDL_PollEvent(event *SDL_Event) int {
 	return int(C.SDL_PollEvent(p))
 }
 
-// /**
-//  * Wait indefinitely for the next available event.
-//  *
-//  * If `event` is not NULL, the next event is removed from the queue and stored
-//  * in the SDL_Event structure pointed to by `event`.
-//  *
-//  * As this function may implicitly call SDL_PumpEvents(), you can only call
-//  * this function in the thread that initialized the video subsystem.
-//  *
-//  * \param event the SDL_Event structure to be filled in with the next event
-//  *              from the queue, or NULL
-//  * \returns 1 on success or 0 if there was an error while waiting for events;
-//  *          call SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_PollEvent
-//  * \sa SDL_PumpEvents
-//  * \sa SDL_WaitEventTimeout
-//  */
+/**
+ * Wait indefinitely for the next available event.
+ *
+ * If `event` is not NULL, the next event is removed from the queue and stored
+ * in the SDL_Event structure pointed to by `event`.
+ *
+ * As this function may implicitly call SDL_PumpEvents(), you can only call
+ * this function in the thread that initialized the video subsystem.
+ *
+ * \param event the SDL_Event structure to be filled in with the next event
+ *              from the queue, or NULL
+ * \returns 1 on success or 0 if there was an error while waiting for events;
+ *          call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_PollEvent
+ * \sa SDL_PumpEvents
+ * \sa SDL_WaitEventTimeout
+ */
 // extern DECLSPEC int SDLCALL SDL_WaitEvent(SDL_Event * event);
+func SDL_WaitEvent(event *SDL_Event) error {
+	if 0 == C.SDL_WaitEvent(event.cObj()) {
+		return lastError("SDL_WaitEvent")
+	}
+	return nil
+}
 
-// /**
-//  * Wait until the specified timeout (in milliseconds) for the next available
-//  * event.
-//  *
-//  * If `event` is not NULL, the next event is removed from the queue and stored
-//  * in the SDL_Event structure pointed to by `event`.
-//  *
-//  * As this function may implicitly call SDL_PumpEvents(), you can only call
-//  * this function in the thread that initialized the video subsystem.
-//  *
-//  * \param event the SDL_Event structure to be filled in with the next event
-//  *              from the queue, or NULL
-//  * \param timeout the maximum number of milliseconds to wait for the next
-//  *                available event
-//  * \returns 1 on success or 0 if there was an error while waiting for events;
-//  *          call SDL_GetError() for more information. This also returns 0 if
-//  *          the timeout elapsed without an event arriving.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_PollEvent
-//  * \sa SDL_PumpEvents
-//  * \sa SDL_WaitEvent
-//  */
+/**
+ * Wait until the specified timeout (in milliseconds) for the next available
+ * event.
+ *
+ * If `event` is not NULL, the next event is removed from the queue and stored
+ * in the SDL_Event structure pointed to by `event`.
+ *
+ * As this function may implicitly call SDL_PumpEvents(), you can only call
+ * this function in the thread that initialized the video subsystem.
+ *
+ * \param event the SDL_Event structure to be filled in with the next event
+ *              from the queue, or NULL
+ * \param timeout the maximum number of milliseconds to wait for the next
+ *                available event
+ * \returns 1 on success or 0 if there was an error while waiting for events;
+ *          call SDL_GetError() for more information. This also returns 0 if
+ *          the timeout elapsed without an event arriving.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_PollEvent
+ * \sa SDL_PumpEvents
+ * \sa SDL_WaitEvent
+ */
 // extern DECLSPEC int SDLCALL SDL_WaitEventTimeout(SDL_Event * event,
 //                                                  int timeout);
+//
+// The Go form returns false without an error when the timeout elapsed. SDL
+// returns 0 for both, the error is told apart by SDL_GetError, which is
+// cleared before waiting.
+func SDL_WaitEventTimeout(event *SDL_Event, timeout int) (bool, error) {
 
-// /**
-//  * Add an event to the event queue.
-//  *
-//  * The event queue can actually be used as a two way communication channel.
-//  * Not only can events be read from the queue, but the user can also push
-//  * their own events onto it. `event` is a pointer to the event structure you
-//  * wish to push onto the queue. The event is copied into the queue, and the
-//  * caller may dispose of the memory pointed to after SDL_PushEvent() returns.
-//  *
-//  * Note: Pushing device input events onto the queue doesn't modify the state
-//  * of the device within SDL.
-//  *
-//  * This function is thread-safe, and can be called from other threads safely.
-//  *
-//  * Note: Events pushed onto the queue with SDL_PushEvent() get passed through
-//  * the event filter but events added with SDL_PeepEvents() do not.
-//  *
-//  * For pushing application-specific events, please use SDL_RegisterEvents() to
-//  * get an event type that does not conflict with other code that also wants
-//  * its own custom event types.
-//  *
-//  * \param event the SDL_Event to be added to the queue
-//  * \returns 1 on success, 0 if the event was filtered, or a negative error
-//  *          code on failure; call SDL_GetError() for more information. A
-//  *          common reason for error is the event queue being full.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_PeepEvents
-//  * \sa SDL_PollEvent
-//  * \sa SDL_RegisterEvents
-//  */
+	SDL_ClearError()
+
+	if 0 != C.SDL_WaitEventTimeout(event.cObj(), C.int(timeout)) {
+		return true, nil
+	}
+
+	if "" != SDL_GetError() {
+		return false, lastError("SDL_WaitEventTimeout")
+	}
+
+	return false, nil
+}
+
+/**
+ * Add an event to the event queue.
+ *
+ * The event queue can actually be used as a two way communication channel.
+ * Not only can events be read from the queue, but the user can also push
+ * their own events onto it. `event` is a pointer to the event structure you
+ * wish to push onto the queue. The event is copied into the queue, and the
+ * caller may dispose of the memory pointed to after SDL_PushEvent() returns.
+ *
+ * Note: Pushing device input events onto the queue doesn't modify the state
+ * of the device within SDL.
+ *
+ * This function is thread-safe, and can be called from other threads safely.
+ *
+ * Note: Events pushed onto the queue with SDL_PushEvent() get passed through
+ * the event filter but events added with SDL_PeepEvents() do not.
+ *
+ * For pushing application-specific events, please use SDL_RegisterEvents() to
+ * get an event type that does not conflict with other code that also wants
+ * its own custom event types.
+ *
+ * \param event the SDL_Event to be added to the queue
+ * \returns 1 on success, 0 if the event was filtered, or a negative error
+ *          code on failure; call SDL_GetError() for more information. A
+ *          common reason for error is the event queue being full.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_PeepEvents
+ * \sa SDL_PollEvent
+ * \sa SDL_RegisterEvents
+ */
 // extern DECLSPEC int SDLCALL SDL_PushEvent(SDL_Event * event);
+//
+// The result is false when the event was filtered.
+func SDL_PushEvent(event *SDL_Event) (bool, error) {
 
-// /**
-//  * A function pointer used for callbacks that watch the event queue.
-//  *
-//  * \param userdata what was passed as `userdata` to SDL_SetEventFilter()
-//  *        or SDL_AddEventWatch, etc
-//  * \param event the event that triggered the callback
-//  * \returns 1 to permit event to be added to the queue, and 0 to disallow
-//  *          it. When used with SDL_AddEventWatch, the return value is ignored.
-//  *
-//  * \sa SDL_SetEventFilter
-//  * \sa SDL_AddEventWatch
-//  */
+	var res = C.SDL_PushEvent(event.cObj())
+	if res < 0 {
+		return false, lastError("SDL_PushEvent")
+	}
+
+	return 1 == res, nil
+}
+
+/**
+ * A function pointer used for callbacks that watch the event queue.
+ *
+ * \param userdata what was passed as `userdata` to SDL_SetEventFilter()
+ *        or SDL_AddEventWatch, etc
+ * \param event the event that triggered the callback
+ * \returns 1 to permit event to be added to the queue, and 0 to disallow
+ *          it. When used with SDL_AddEventWatch, the return value is ignored.
+ *
+ * \sa SDL_SetEventFilter
+ * \sa SDL_AddEventWatch
+ */
 // typedef int (SDLCALL * SDL_EventFilter) (void *userdata, SDL_Event * event);
+//
+// Closures replace userdata. The event is a copy, valid during the call
+// only. Filters and watches may be called on other threads than the one
+// polling events, e.g. by SDL_PushEvent from a goroutine or by the system
+// during a live resize on some platforms, so they must be safe for
+// concurrent use with it.
+type SDL_EventFilter func(event *SDL_Event) bool
+
+// SDL_EventWatch identifies a watch added with SDL_AddEventWatch, Go
+// functions cannot be compared to find it.
+type SDL_EventWatch uintptr
+
+var eventFilter struct {
+	mu sync.Mutex
+	h  cgo.Handle
+}
 
-// /**
-//  * Set up a filter to process all events before they change internal state and
-//  * are posted to the internal event queue.
-//  *
-//  * If the filter function returns 1 when called, then the event will be added
-//  * to the internal queue. If it returns 0, then the event will be dropped from
-//  * the queue, but the internal state will still be updated. This allows
-//  * selective filtering of dynamically arriving events.
-//  *
-//  * **WARNING**: Be very careful of what you do in the event filter function,
-//  * as it may run in a different thread!
-//  *
-//  * On platforms that support it, if the quit event is generated by an
-//  * interrupt signal (e.g. pressing Ctrl-C), it will be delivered to the
-//  * application at the next event poll.
-//  *
-//  * There is one caveat when dealing with the ::SDL_QuitEvent event type. The
-//  * event filter is only called when the window manager desires to close the
-//  * application window. If the event filter returns 1, then the window will be
-//  * closed, otherwise the window will remain open if possible.
-//  *
-//  * Note: Disabled events never make it to the event filter function; see
-//  * SDL_EventState().
-//  *
-//  * Note: If you just want to inspect events without filtering, you should use
-//  * SDL_AddEventWatch() instead.
-//  *
-//  * Note: Events pushed onto the queue with SDL_PushEvent() get passed through
-//  * the event filter, but events pushed onto the queue with SDL_PeepEvents() do
-//  * not.
-//  *
-//  * \param filter An SDL_EventFilter function to call when an event happens
-//  * \param userdata a pointer that is passed to `filter`
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_AddEventWatch
-//  * \sa SDL_EventState
-//  * \sa SDL_GetEventFilter
-//  * \sa SDL_PeepEvents
-//  * \sa SDL_PushEvent
-//  */
+/**
+ * Set up a filter to process all events before they change internal state and
+ * are posted to the internal event queue.
+ *
+ * If the filter function returns 1 when called, then the event will be added
+ * to the internal queue. If it returns 0, then the event will be dropped from
+ * the queue, but the internal state will still be updated. This allows
+ * selective filtering of dynamically arriving events.
+ *
+ * **WARNING**: Be very careful of what you do in the event filter function,
+ * as it may run in a different thread!
+ *
+ * On platforms that support it, if the quit event is generated by an
+ * interrupt signal (e.g. pressing Ctrl-C), it will be delivered to the
+ * application at the next event poll.
+ *
+ * There is one caveat when dealing with the ::SDL_QuitEvent event type. The
+ * event filter is only called when the window manager desires to close the
+ * application window. If the event filter returns 1, then the window will be
+ * closed, otherwise the window will remain open if possible.
+ *
+ * Note: Disabled events never make it to the event filter function; see
+ * SDL_EventState().
+ *
+ * Note: If you just want to inspect events without filtering, you should use
+ * SDL_AddEventWatch() instead.
+ *
+ * Note: Events pushed onto the queue with SDL_PushEvent() get passed through
+ * the event filter, but events pushed onto the queue with SDL_PeepEvents() do
+ * not.
+ *
+ * \param filter An SDL_EventFilter function to call when an event happens
+ * \param userdata a pointer that is passed to `filter`
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_AddEventWatch
+ * \sa SDL_EventState
+ * \sa SDL_GetEventFilter
+ * \sa SDL_PeepEvents
+ * \sa SDL_PushEvent
+ */
 // extern DECLSPEC void SDLCALL SDL_SetEventFilter(SDL_EventFilter filter,
 //                                                 void *userdata);
+//
+// nil removes the filter.
+func SDL_SetEventFilter(filter SDL_EventFilter) {
 
-// /**
-//  * Query the current event filter.
-//  *
-//  * This function can be used to "chain" filters, by saving the existing filter
-//  * before replacing it with a function that will call that saved filter.
-//  *
-//  * \param filter the current callback function will be stored here
-//  * \param userdata the pointer that is passed to the current event filter will
-//  *                 be stored here
-//  * \returns SDL_TRUE on success or SDL_FALSE if there is no event filter set.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_SetEventFilter
-//  */
+	var h cgo.Handle
+	if nil != filter {
+		h = cgo.NewHandle(filter)
+	}
+
+	eventFilter.mu.Lock()
+	defer eventFilter.mu.Unlock()
+
+	// SDL holds its lock while calling the filter, the old one is no
+	// longer in use once SDL_SetEventFilter returns
+	C._SDL_SetEventFilter(C.uintptr_t(h))
+
+	if 0 != eventFilter.h {
+		eventFilter.h.Delete()
+	}
+	eventFilter.h = h
+}
+
+/**
+ * Query the current event filter.
+ *
+ * This function can be used to "chain" filters, by saving the existing filter
+ * before replacing it with a function that will call that saved filter.
+ *
+ * \param filter the current callback function will be stored here
+ * \param userdata the pointer that is passed to the current event filter will
+ *                 be stored here
+ * \returns SDL_TRUE on success or SDL_FALSE if there is no event filter set.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_SetEventFilter
+ */
 // extern DECLSPEC SDL_bool SDLCALL SDL_GetEventFilter(SDL_EventFilter * filter,
 //                                                     void **userdata);
+//
+// Filters set in C are not returned.
+func SDL_GetEventFilter() SDL_EventFilter {
 
-// /**
-//  * Add a callback to be triggered when an event is added to the event queue.
-//  *
-//  * `filter` will be called when an event happens, and its return value is
-//  * ignored.
-//  *
-//  * **WARNING**: Be very careful of what you do in the event filter function,
-//  * as it may run in a different thread!
-//  *
-//  * If the quit event is generated by a signal (e.g. SIGINT), it will bypass
-//  * the internal queue and be delivered to the watch callback immediately, and
-//  * arrive at the next event poll.
-//  *
-//  * Note: the callback is called for events posted by the user through
-//  * SDL_PushEvent(), but not for disabled events, nor for events by a filter
-//  * callback set with SDL_SetEventFilter(), nor for events posted by the user
-//  * through SDL_PeepEvents().
-//  *
-//  * \param filter an SDL_EventFilter function to call when an event happens.
-//  * \param userdata a pointer that is passed to `filter`
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_DelEventWatch
-//  * \sa SDL_SetEventFilter
-//  */
+	eventFilter.mu.Lock()
+	defer eventFilter.mu.Unlock()
+
+	if 0 == eventFilter.h {
+		return nil
+	}
+
+	return eventFilter.h.Value().(SDL_EventFilter)
+}
+
+/**
+ * Add a callback to be triggered when an event is added to the event queue.
+ *
+ * `filter` will be called when an event happens, and its return value is
+ * ignored.
+ *
+ * **WARNING**: Be very careful of what you do in the event filter function,
+ * as it may run in a different thread!
+ *
+ * If the quit event is generated by a signal (e.g. SIGINT), it will bypass
+ * the internal queue and be delivered to the watch callback immediately, and
+ * arrive at the next event poll.
+ *
+ * Note: the callback is called for events posted by the user through
+ * SDL_PushEvent(), but not for disabled events, nor for events by a filter
+ * callback set with SDL_SetEventFilter(), nor for events posted by the user
+ * through SDL_PeepEvents().
+ *
+ * \param filter an SDL_EventFilter function to call when an event happens.
+ * \param userdata a pointer that is passed to `filter`
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_DelEventWatch
+ * \sa SDL_SetEventFilter
+ */
 // extern DECLSPEC void SDLCALL SDL_AddEventWatch(SDL_EventFilter filter,
 //                                                void *userdata);
+func SDL_AddEventWatch(filter SDL_EventFilter) SDL_EventWatch {
+	var h = cgo.NewHandle(filter)
+	C._SDL_AddEventWatch(C.uintptr_t(h))
+	return SDL_EventWatch(h)
+}
 
-// /**
-//  * Remove an event watch callback added with SDL_AddEventWatch().
-//  *
-//  * This function takes the same input as SDL_AddEventWatch() to identify and
-//  * delete the corresponding callback.
-//  *
-//  * \param filter the function originally passed to SDL_AddEventWatch()
-//  * \param userdata the pointer originally passed to SDL_AddEventWatch()
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_AddEventWatch
-//  */
+/**
+ * Remove an event watch callback added with SDL_AddEventWatch().
+ *
+ * This function takes the same input as SDL_AddEventWatch() to identify and
+ * delete the corresponding callback.
+ *
+ * \param filter the function originally passed to SDL_AddEventWatch()
+ * \param userdata the pointer originally passed to SDL_AddEventWatch()
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_AddEventWatch
+ */
 // extern DECLSPEC void SDLCALL SDL_DelEventWatch(SDL_EventFilter filter,
 //                                                void *userdata);
+func SDL_DelEventWatch(watch SDL_EventWatch) {
 
-// /**
-//  * Run a specific filter function on the current event queue, removing any
-//  * events for which the filter returns 0.
-//  *
-//  * See SDL_SetEventFilter() for more information. Unlike SDL_SetEventFilter(),
-//  * this function does not change the filter permanently, it only uses the
-//  * supplied filter until this function returns.
-//  *
-//  * \param filter the SDL_EventFilter function to call when an event happens
-//  * \param userdata a pointer that is passed to `filter`
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetEventFilter
-//  * \sa SDL_SetEventFilter
-//  */
+	if 0 == watch {
+		return
+	}
+
+	C._SDL_DelEventWatch(C.uintptr_t(watch))
+	cgo.Handle(watch).Delete()
+}
+
+/**
+ * Run a specific filter function on the current event queue, removing any
+ * events for which the filter returns 0.
+ *
+ * See SDL_SetEventFilter() for more information. Unlike SDL_SetEventFilter(),
+ * this function does not change the filter permanently, it only uses the
+ * supplied filter until this function returns.
+ *
+ * \param filter the SDL_EventFilter function to call when an event happens
+ * \param userdata a pointer that is passed to `filter`
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetEventFilter
+ * \sa SDL_SetEventFilter
+ */
 // extern DECLSPEC void SDLCALL SDL_FilterEvents(SDL_EventFilter filter,
 //                                               void *userdata);
+func SDL_FilterEvents(filter SDL_EventFilter) {
+
+	var h = cgo.NewHandle(filter)
+	defer h.Delete()
+
+	C._SDL_FilterEvents(C.uintptr_t(h))
+}
 
 // /* @{ */
 // #define SDL_QUERY   -1
 // #define SDL_IGNORE   0
 // #define SDL_DISABLE  0
 // #define SDL_ENABLE   1
+const (
+	SDL_QUERY   = C.SDL_QUERY
+	SDL_IGNORE  = C.SDL_IGNORE
+	SDL_DISABLE = C.SDL_DISABLE
+	SDL_ENABLE  = C.SDL_ENABLE
+)
 
 // /**
 //  * Set the state of processing events by type.
@@ -1233,22 +2117,33 @@ func SDL_PollEvent(event *SDL_Event) int {
 // /* @} */
 // #define SDL_GetEventState(type) SDL_EventState(type, SDL_QUERY)
 
-// /**
-//  * Allocate a set of user-defined events, and return the beginning event
-//  * number for that set of events.
-//  *
-//  * Calling this function with `numevents` <= 0 is an error and will return
-//  * (Uint32)-1.
-//  *
-//  * Note, (Uint32)-1 means the maximum unsigned 32-bit integer value (or
-//  * 0xFFFFFFFF), but is clearer to write.
-//  *
-//  * \param numevents the number of events to be allocated
-//  * \returns the beginning event number, or (Uint32)-1 if there are not enough
-//  *          user-defined events left.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_PushEvent
-//  */
+/**
+ * Allocate a set of user-defined events, and return the beginning event
+ * number for that set of events.
+ *
+ * Calling this function with `numevents` <= 0 is an error and will return
+ * (Uint32)-1.
+ *
+ * Note, (Uint32)-1 means the maximum unsigned 32-bit integer value (or
+ * 0xFFFFFFFF), but is clearer to write.
+ *
+ * \param numevents the number of events to be allocated
+ * \returns the beginning event number, or (Uint32)-1 if there are not enough
+ *          user-defined events left.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_PushEvent
+ */
 // extern DECLSPEC Uint32 SDLCALL SDL_RegisterEvents(int numevents);
+//
+// SDL sets no error message when the events are used up, the error says so.
+func SDL_RegisterEvents(numevents int) (uint32, error) {
+
+	var t = uint32(C.SDL_RegisterEvents(C.int(numevents)))
+	if 0xFFFFFFFF == t {
+		return 0, &SDLError{"SDL_RegisterEvents", "not enough user-defined events left"}
+	}
+
+	return t, nil
+}
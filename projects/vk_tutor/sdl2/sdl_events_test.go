@@ -0,0 +1,231 @@
+package sdl2_test
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+
+	"example.com/vk_tutor/sdl2"
+	"example.com/vk_tutor/sdl2/sdltest"
+)
+
+func TestPushEvents(t *testing.T) {
+
+	sdltest.Init(t, sdl2.SDL_INIT_VIDEO)
+
+	var window = sdltest.Window(t, 320, 240, sdl2.SDL_WINDOW_HIDDEN)
+	var id, err = sdl2.SDL_GetWindowID(window)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	// The events of creating the window
+	sdltest.Events()
+
+	var want = []sdl2.Event{
+		&sdl2.SDL_KeyboardEvent{
+			SDL_CommonEvent: sdl2.SDL_CommonEvent{Type: sdl2.SDL_KEYDOWN, WindowID: id},
+			State:           sdl2.SDL_PRESSED,
+			Keysym:          sdl2.SDL_Keysym{Scancode: sdl2.SDL_SCANCODE_A, Sym: sdl2.SDLK_a, Mod: sdl2.KMOD_NONE},
+		},
+		&sdl2.SDL_MouseButtonEvent{
+			SDL_CommonEvent: sdl2.SDL_CommonEvent{Type: sdl2.SDL_MOUSEBUTTONDOWN, WindowID: id},
+			Button:          sdl2.SDL_BUTTON_LEFT,
+			State:           sdl2.SDL_PRESSED,
+			Clicks:          2,
+			X:               10,
+			Y:               20,
+		},
+		&sdl2.SDL_WindowEvent{
+			SDL_CommonEvent: sdl2.SDL_CommonEvent{Type: sdl2.SDL_WINDOWEVENT, WindowID: id},
+			Event:           sdl2.SDL_WINDOWEVENT_CLOSE,
+		},
+		&sdl2.SDL_QuitEvent{
+			SDL_CommonEvent: sdl2.SDL_CommonEvent{Type: sdl2.SDL_QUIT},
+		},
+	}
+
+	for _, e := range want {
+		sdltest.Push(t, e.(sdltest.Encoder))
+	}
+
+	var got = sdltest.Events()
+	if len(want) != len(got) {
+		t.Fatalf("got %d events, want %d: %#v", len(got), len(want), got)
+	}
+
+	for i := range want {
+		// SDL_PushEvent stamps the events
+		got[i].Common().Timestamp = 0
+		if !reflect.DeepEqual(want[i], got[i]) {
+			t.Errorf("event %d = %#v, want %#v", i, got[i], want[i])
+		}
+	}
+}
+
+// userEvents registers an event type for the test, events of it are told
+// apart by code.
+func userEvents(t *testing.T) (push func(code int32) bool, codes func() []int32) {
+
+	t.Helper()
+
+	var typ, err = sdl2.SDL_RegisterEvents(1)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	// Window events of the initialization
+	sdltest.Events()
+
+	push = func(code int32) bool {
+		var e = sdl2.SDL_UserEvent{SDL_CommonEvent: sdl2.SDL_CommonEvent{Type: sdl2.SDL_EventType(typ)}, Code: code}
+		var ok, err = sdl2.SDL_PushEvent(e.Encode())
+		if nil != err {
+			t.Error(err)
+		}
+		return ok
+	}
+
+	codes = func() []int32 {
+		var r []int32
+		for _, e := range sdltest.Events() {
+			if u, ok := e.(*sdl2.SDL_UserEvent); ok && uint32(u.Type) == typ {
+				r = append(r, u.Code)
+			}
+		}
+		return r
+	}
+
+	return push, codes
+}
+
+func userCode(e *sdl2.SDL_Event) (int32, bool) {
+	var u, ok = e.Decode().(*sdl2.SDL_UserEvent)
+	if !ok {
+		return 0, false
+	}
+	return u.Code, true
+}
+
+func TestEventWatchOtherThread(t *testing.T) {
+
+	sdltest.Init(t, sdl2.SDL_INIT_EVENTS)
+	var push, _ = userEvents(t)
+
+	var watched = make(chan sdl2.SDL_threadID, 1)
+	var watch = sdl2.SDL_AddEventWatch(func(e *sdl2.SDL_Event) bool {
+		if code, ok := userCode(e); ok && 1 == code {
+			watched <- sdl2.SDL_ThreadID()
+		}
+		return true
+	})
+	defer sdl2.SDL_DelEventWatch(watch)
+
+	var pusher = make(chan sdl2.SDL_threadID, 1)
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		pusher <- sdl2.SDL_ThreadID()
+		push(1)
+	}()
+
+	var from, in = <-pusher, <-watched
+	if from != in {
+		t.Errorf("watch ran on thread %d, want the pushing thread %d", in, from)
+	}
+	if sdl2.SDL_ThreadID() == in {
+		t.Errorf("watch ran on the test thread %d", in)
+	}
+}
+
+func TestEventFilterRejects(t *testing.T) {
+
+	sdltest.Init(t, sdl2.SDL_INIT_EVENTS)
+	var push, codes = userEvents(t)
+
+	sdl2.SDL_SetEventFilter(func(e *sdl2.SDL_Event) bool {
+		var code, _ = userCode(e)
+		return 2 != code
+	})
+	defer sdl2.SDL_SetEventFilter(nil)
+
+	if nil == sdl2.SDL_GetEventFilter() {
+		t.Error("SDL_GetEventFilter() = nil")
+	}
+
+	if !push(1) {
+		t.Error("accepted event reported as filtered")
+	}
+	if push(2) {
+		t.Error("rejected event reported as queued")
+	}
+	push(3)
+
+	if got := codes(); !reflect.DeepEqual([]int32{1, 3}, got) {
+		t.Errorf("queued codes %v, want [1 3]", got)
+	}
+}
+
+func TestDelEventWatch(t *testing.T) {
+
+	sdltest.Init(t, sdl2.SDL_INIT_EVENTS)
+	var push, codes = userEvents(t)
+
+	var seen []int32
+	var watch = sdl2.SDL_AddEventWatch(func(e *sdl2.SDL_Event) bool {
+		if code, ok := userCode(e); ok {
+			seen = append(seen, code)
+		}
+		return true
+	})
+
+	push(1)
+	sdl2.SDL_DelEventWatch(watch)
+	push(2)
+
+	if !reflect.DeepEqual([]int32{1}, seen) {
+		t.Errorf("watch saw %v, want [1]", seen)
+	}
+	if got := codes(); !reflect.DeepEqual([]int32{1, 2}, got) {
+		t.Errorf("queued codes %v, want [1 2]", got)
+	}
+}
+
+func TestFilterEvents(t *testing.T) {
+
+	sdltest.Init(t, sdl2.SDL_INIT_EVENTS)
+	var push, codes = userEvents(t)
+
+	push(1)
+	push(2)
+	push(3)
+
+	sdl2.SDL_FilterEvents(func(e *sdl2.SDL_Event) bool {
+		var code, _ = userCode(e)
+		return 2 != code
+	})
+
+	if got := codes(); !reflect.DeepEqual([]int32{1, 3}, got) {
+		t.Errorf("queued codes %v, want [1 3]", got)
+	}
+}
+
+func TestWaitEventTimeout(t *testing.T) {
+
+	sdltest.Init(t, sdl2.SDL_INIT_EVENTS)
+	var push, _ = userEvents(t)
+
+	var e sdl2.SDL_Event
+	if got, err := sdl2.SDL_WaitEventTimeout(&e, 10); got || nil != err {
+		t.Fatalf("empty queue: SDL_WaitEventTimeout = %v, %v, want false, nil", got, err)
+	}
+
+	push(7)
+
+	if got, err := sdl2.SDL_WaitEventTimeout(&e, 1000); !got || nil != err {
+		t.Fatalf("SDL_WaitEventTimeout = %v, %v, want true, nil", got, err)
+	}
+	if code, _ := userCode(&e); 7 != code {
+		t.Errorf("waited for event %#v, want code 7", e.Decode())
+	}
+}
@@ -0,0 +1,927 @@
+package sdl2
+
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
+// #include "SDL.h"
+import "C"
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unsafe"
+
+	"example.com/vk_tutor/sdl2/internal"
+)
+
+/**
+ *  \file SDL_gamecontroller.h
+ *
+ *  Include file for SDL game controller event handling
+ */
+
+/**
+ *  \file SDL_gamecontroller.h
+ *
+ *  In order to use these functions, SDL_Init() must have been called
+ *  with the ::SDL_INIT_GAMECONTROLLER flag.  This causes SDL to scan the system
+ *  for game controllers, and load appropriate drivers.
+ *
+ *  If you would like to receive controller updates while the application
+ *  is in the background, you should set the following hint before calling
+ *  SDL_Init(): SDL_HINT_JOYSTICK_ALLOW_BACKGROUND_EVENTS
+ */
+
+/**
+ * The gamecontroller structure used to identify an SDL game controller
+ */
+// struct _SDL_GameController;
+// typedef struct _SDL_GameController SDL_GameController;
+type SDL_GameController internal.CObjWrapper
+
+func (o *SDL_GameController) cObj() *C.SDL_GameController {
+	if nil == o {
+		return nil
+	}
+	return (*C.SDL_GameController)(internal.Unwrap[SDL_GameController](o))
+}
+
+func wrapGameController(p *C.SDL_GameController) *SDL_GameController {
+	if nil == p {
+		return nil
+	}
+	return internal.WrapNew[SDL_GameController](unsafe.Pointer(p))
+}
+
+// typedef enum
+//
+//	{
+//	    SDL_CONTROLLER_TYPE_UNKNOWN = 0,
+//	    SDL_CONTROLLER_TYPE_XBOX360,
+//	    SDL_CONTROLLER_TYPE_XBOXONE,
+//	    SDL_CONTROLLER_TYPE_PS3,
+//	    SDL_CONTROLLER_TYPE_PS4,
+//	    SDL_CONTROLLER_TYPE_NINTENDO_SWITCH_PRO,
+//	    SDL_CONTROLLER_TYPE_VIRTUAL,
+//	    SDL_CONTROLLER_TYPE_PS5,
+//	    SDL_CONTROLLER_TYPE_AMAZON_LUNA,
+//	    SDL_CONTROLLER_TYPE_GOOGLE_STADIA,
+//	    SDL_CONTROLLER_TYPE_NVIDIA_SHIELD,
+//	    SDL_CONTROLLER_TYPE_NINTENDO_SWITCH_JOYCON_LEFT,
+//	    SDL_CONTROLLER_TYPE_NINTENDO_SWITCH_JOYCON_RIGHT,
+//	    SDL_CONTROLLER_TYPE_NINTENDO_SWITCH_JOYCON_PAIR
+//	} SDL_GameControllerType;
+type SDL_GameControllerType int
+
+const (
+	SDL_CONTROLLER_TYPE_UNKNOWN                      SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_UNKNOWN
+	SDL_CONTROLLER_TYPE_XBOX360                      SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_XBOX360
+	SDL_CONTROLLER_TYPE_XBOXONE                      SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_XBOXONE
+	SDL_CONTROLLER_TYPE_PS3                          SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_PS3
+	SDL_CONTROLLER_TYPE_PS4                          SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_PS4
+	SDL_CONTROLLER_TYPE_NINTENDO_SWITCH_PRO          SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_NINTENDO_SWITCH_PRO
+	SDL_CONTROLLER_TYPE_VIRTUAL                      SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_VIRTUAL
+	SDL_CONTROLLER_TYPE_PS5                          SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_PS5
+	SDL_CONTROLLER_TYPE_AMAZON_LUNA                  SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_AMAZON_LUNA
+	SDL_CONTROLLER_TYPE_GOOGLE_STADIA                SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_GOOGLE_STADIA
+	SDL_CONTROLLER_TYPE_NVIDIA_SHIELD                SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_NVIDIA_SHIELD
+	SDL_CONTROLLER_TYPE_NINTENDO_SWITCH_JOYCON_LEFT  SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_NINTENDO_SWITCH_JOYCON_LEFT
+	SDL_CONTROLLER_TYPE_NINTENDO_SWITCH_JOYCON_RIGHT SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_NINTENDO_SWITCH_JOYCON_RIGHT
+	SDL_CONTROLLER_TYPE_NINTENDO_SWITCH_JOYCON_PAIR  SDL_GameControllerType = C.SDL_CONTROLLER_TYPE_NINTENDO_SWITCH_JOYCON_PAIR
+)
+
+/**
+ *  To count the number of game controllers in the system for the following:
+ *
+ *  ```c
+ *  int nJoysticks = SDL_NumJoysticks();
+ *  int nGameControllers = 0;
+ *  for (int i = 0; i < nJoysticks; i++) {
+ *      if (SDL_IsGameController(i)) {
+ *          nGameControllers++;
+ *      }
+ *  }
+ *  ```
+ *
+ *  Using the SDL_HINT_GAMECONTROLLERCONFIG hint or the SDL_GameControllerAddMapping() you can add support for controllers SDL is unaware of or cause an existing controller to have a different binding. The format is:
+ *  guid,name,mappings
+ *
+ *  Where GUID is the string value from SDL_JoystickGetGUIDString(), name is the human readable string for the device and mappings are controller mappings to joystick ones.
+ *  Under Windows there is a reserved GUID of "xinput" that covers any XInput devices.
+ *  The mapping format for joystick is:
+ *      bX - a joystick button, index X
+ *      hX.Y - hat X with value Y
+ *      aX - axis X of the joystick
+ *  Buttons can be used as a controller axis and vice versa.
+ *
+ *  This string shows an example of a valid mapping for a controller
+ *
+ * ```c
+ * "03000000341a00003608000000000000,PS3 Controller,a:b1,b:b2,y:b3,x:b0,start:b9,guide:b12,back:b8,dpup:h0.1,dpleft:h0.8,dpdown:h0.4,dpright:h0.2,leftshoulder:b4,rightshoulder:b5,leftstick:b10,rightstick:b11,leftx:a0,lefty:a1,rightx:a2,righty:a3,lefttrigger:b6,righttrigger:b7",
+ * ```
+ */
+
+/**
+ * Load a set of Game Controller mappings from a seekable SDL data stream.
+ *
+ * You can call this function several times, if needed, to load different
+ * database files.
+ *
+ * If a new mapping is loaded for an already known controller GUID, the later
+ * version will overwrite the one currently loaded.
+ *
+ * Mappings not belonging to the current platform or with no platform field
+ * specified will be ignored (i.e. mappings for Linux will be ignored in
+ * Windows, etc).
+ *
+ * This function will load the text database entirely in memory before
+ * processing it, so take this into consideration if you are in a memory
+ * constrained environment.
+ *
+ * \param rw the data stream for the mappings to be added
+ * \param freerw non-zero to close the stream after being read
+ * \returns the number of mappings added or -1 on error; call SDL_GetError()
+ *          for more information.
+ *
+ * \since This function is available since SDL 2.0.2.
+ *
+ * \sa SDL_GameControllerAddMapping
+ * \sa SDL_GameControllerAddMappingsFromFile
+ * \sa SDL_GameControllerMappingForGUID
+ */
+// extern DECLSPEC int SDLCALL SDL_GameControllerAddMappingsFromRW(SDL_RWops * rw, int freerw);
+//
+// The Go form reads the database, e.g. gamecontrollerdb.txt, from r and
+// keeps the mappings whose platform field is SDL_GetPlatform(), ignoring the
+// case. Mappings are added in file order, so a later line for a GUID wins.
+// Lines SDL rejects do not stop the loading, the first of them is returned
+// as error once r is read. Mappings can be checked without
+// hardware on a joystick from SDL_JoystickAttachVirtual: its GUID is the
+// string of SDL_JoystickGetDeviceGUID.
+func SDL_GameControllerAddMappingsFromReader(r io.Reader) (int, error) {
+
+	var lines, err = gameControllerMappings(r, SDL_GetPlatform())
+	if nil != err {
+		return 0, err
+	}
+
+	var added int
+	var first error
+
+	for _, l := range lines {
+		var res, err = SDL_GameControllerAddMapping(l.mapping)
+		switch {
+		case nil != err && nil == first:
+			first = fmt.Errorf("line %v: %w", l.line, err)
+		case 1 == res:
+			added++
+		} // switch
+	} // for
+
+	return added, first
+}
+
+type gameControllerMapping struct {
+	line    int
+	mapping string
+}
+
+// gameControllerMappings returns the mappings of the database for platform,
+// like SDL_GameControllerAddMappingsFromRW selects them: mappings without a
+// platform field are skipped. Unlike SDL, which only compares the start of
+// the field, its whole value must be platform, ignoring the case.
+func gameControllerMappings(r io.Reader, platform string) ([]gameControllerMapping, error) {
+
+	var result []gameControllerMapping
+
+	var s = bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+
+	for n := 1; s.Scan(); n++ {
+
+		var l = strings.TrimSpace(s.Text())
+		if 0 == len(l) || strings.HasPrefix(l, "#") {
+			continue
+		}
+
+		var i = strings.Index(l, "platform:")
+		if i < 0 {
+			continue
+		}
+
+		var p = l[i+len("platform:"):]
+		if j := strings.IndexByte(p, ','); j >= 0 {
+			p = p[:j]
+		}
+		if !strings.EqualFold(strings.TrimSpace(p), platform) {
+			continue
+		}
+
+		result = append(result, gameControllerMapping{n, l})
+	} // for
+
+	if err := s.Err(); nil != err {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+/**
+ * Add support for controllers that SDL is unaware of or to cause an existing
+ * controller to have a different binding.
+ *
+ * The mapping string has the format "GUID,name,mapping", where GUID is the
+ * string value from SDL_JoystickGetGUIDString(), name is the human readable
+ * string for the device and mappings are controller mappings to joystick
+ * ones. Under Windows there is a reserved GUID of "xinput" that covers all
+ * XInput devices. The mapping format for joystick is: {| |bX |a joystick
+ * button, index X |- |hX.Y |hat X with value Y |- |aX |axis X of the joystick
+ * |} Buttons can be used as a controller axes and vice versa.
+ *
+ * This string shows an example of a valid mapping for a controller:
+ *
+ * ```c
+ * "341a3608000000000000504944564944,Afterglow PS3 Controller,a:b1,b:b2,y:b3,x:b0,start:b9,guide:b12,back:b8,dpup:h0.1,dpleft:h0.8,dpdown:h0.4,dpright:h0.2,leftshoulder:b4,rightshoulder:b5,leftstick:b10,rightstick:b11,leftx:a0,lefty:a1,rightx:a2,righty:a3,lefttrigger:b6,righttrigger:b7"
+ * ```
+ *
+ * \param mappingString the mapping string
+ * \returns 1 if a new mapping is added, 0 if an existing mapping is updated,
+ *          -1 on error; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerMapping
+ * \sa SDL_GameControllerMappingForGUID
+ */
+// extern DECLSPEC int SDLCALL SDL_GameControllerAddMapping(const char* mappingString);
+func SDL_GameControllerAddMapping(mappingString string) (int, error) {
+
+	var mappingString_c = C.CString(mappingString)
+	defer C.free(unsafe.Pointer(mappingString_c))
+
+	var r = C.SDL_GameControllerAddMapping(mappingString_c)
+	if r < 0 {
+		return 0, lastError("SDL_GameControllerAddMapping")
+	}
+
+	return int(r), nil
+}
+
+/**
+ * Get the number of mappings installed.
+ *
+ * \returns the number of mappings.
+ *
+ * \since This function is available since SDL 2.0.6.
+ */
+// extern DECLSPEC int SDLCALL SDL_GameControllerNumMappings(void);
+func SDL_GameControllerNumMappings() int {
+	return int(C.SDL_GameControllerNumMappings())
+}
+
+/**
+ * Get the mapping at a particular index.
+ *
+ * \returns the mapping string. Must be freed with SDL_free(). Returns NULL if
+ *          the index is out of range.
+ *
+ * \since This function is available since SDL 2.0.6.
+ */
+// extern DECLSPEC char * SDLCALL SDL_GameControllerMappingForIndex(int mapping_index);
+func SDL_GameControllerMappingForIndex(mapping_index int) string {
+
+	var p = C.SDL_GameControllerMappingForIndex(C.int(mapping_index))
+	if nil == p {
+		return ""
+	}
+	defer C.SDL_free(unsafe.Pointer(p))
+
+	return C.GoString(p)
+}
+
+/**
+ * Get the game controller mapping string for a given GUID.
+ *
+ * The returned string must be freed with SDL_free().
+ *
+ * \param guid a structure containing the GUID for which a mapping is desired
+ * \returns a mapping string or NULL on error; call SDL_GetError() for more
+ *          information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickGetDeviceGUID
+ * \sa SDL_JoystickGetGUID
+ */
+// extern DECLSPEC char * SDLCALL SDL_GameControllerMappingForGUID(SDL_JoystickGUID guid);
+func SDL_GameControllerMappingForGUID(guid SDL_JoystickGUID) string {
+
+	var p = C.SDL_GameControllerMappingForGUID(guid.cValue())
+	if nil == p {
+		return ""
+	}
+	defer C.SDL_free(unsafe.Pointer(p))
+
+	return C.GoString(p)
+}
+
+/**
+ * Get the current mapping of a Game Controller.
+ *
+ * The returned string must be freed with SDL_free().
+ *
+ * Details about mappings are discussed with SDL_GameControllerAddMapping().
+ *
+ * \param gamecontroller the game controller you want to get the current
+ *                       mapping for
+ * \returns a string that has the controller's mapping or NULL if no mapping
+ *          is available; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerAddMapping
+ * \sa SDL_GameControllerMappingForGUID
+ */
+// extern DECLSPEC char * SDLCALL SDL_GameControllerMapping(SDL_GameController *gamecontroller);
+func SDL_GameControllerMapping(gamecontroller *SDL_GameController) string {
+
+	var p = C.SDL_GameControllerMapping(gamecontroller.cObj())
+	if nil == p {
+		return ""
+	}
+	defer C.SDL_free(unsafe.Pointer(p))
+
+	return C.GoString(p)
+}
+
+/**
+ * Check if the given joystick is supported by the game controller interface.
+ *
+ * `joystick_index` is the same as the `device_index` passed to
+ * SDL_JoystickOpen().
+ *
+ * \param joystick_index the device_index of a device, up to
+ *                       SDL_NumJoysticks()
+ * \returns SDL_TRUE if the given joystick is supported by the game controller
+ *          interface, SDL_FALSE if it isn't or it's an invalid index.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerNameForIndex
+ * \sa SDL_GameControllerOpen
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_IsGameController(int joystick_index);
+func SDL_IsGameController(joystick_index int) bool {
+	return C.SDL_TRUE == C.SDL_IsGameController(C.int(joystick_index))
+}
+
+/**
+ * Get the implementation dependent name for the game controller.
+ *
+ * This function can be called before any controllers are opened.
+ *
+ * `joystick_index` is the same as the `device_index` passed to
+ * SDL_JoystickOpen().
+ *
+ * \param joystick_index the device_index of a device, from zero to
+ *                       SDL_NumJoysticks()-1
+ * \returns the implementation-dependent name for the game controller, or NULL
+ *          if there is no name or the index is invalid.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerName
+ * \sa SDL_GameControllerOpen
+ * \sa SDL_IsGameController
+ */
+// extern DECLSPEC const char *SDLCALL SDL_GameControllerNameForIndex(int joystick_index);
+func SDL_GameControllerNameForIndex(joystick_index int) string {
+	return C.GoString(C.SDL_GameControllerNameForIndex(C.int(joystick_index)))
+}
+
+/**
+ * Get the type of a game controller.
+ *
+ * This can be called before any controllers are opened.
+ *
+ * \param joystick_index the device_index of a device, from zero to
+ *                       SDL_NumJoysticks()-1
+ * \returns the controller type.
+ *
+ * \since This function is available since SDL 2.0.12.
+ */
+// extern DECLSPEC SDL_GameControllerType SDLCALL SDL_GameControllerTypeForIndex(int joystick_index);
+func SDL_GameControllerTypeForIndex(joystick_index int) SDL_GameControllerType {
+	return SDL_GameControllerType(C.SDL_GameControllerTypeForIndex(C.int(joystick_index)))
+}
+
+/**
+ * Open a game controller for use.
+ *
+ * `joystick_index` is the same as the `device_index` passed to
+ * SDL_JoystickOpen().
+ *
+ * The index passed as an argument refers to the N'th game controller on the
+ * system. This index is not the value which will identify this controller in
+ * future controller events. The joystick's instance id (SDL_JoystickID) will
+ * be used there instead.
+ *
+ * \param joystick_index the device_index of a device, up to
+ *                       SDL_NumJoysticks()
+ * \returns a gamecontroller identifier or NULL if an error occurred; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerClose
+ * \sa SDL_GameControllerNameForIndex
+ * \sa SDL_IsGameController
+ */
+// extern DECLSPEC SDL_GameController *SDLCALL SDL_GameControllerOpen(int joystick_index);
+func SDL_GameControllerOpen(joystick_index int) (*SDL_GameController, error) {
+
+	var p = C.SDL_GameControllerOpen(C.int(joystick_index))
+	if nil == p {
+		return nil, lastError("SDL_GameControllerOpen")
+	}
+
+	return internal.WrapNew[SDL_GameController](unsafe.Pointer(p)), nil
+}
+
+/**
+ * Get the SDL_GameController associated with an instance id.
+ *
+ * \param joyid the instance id to get the SDL_GameController for
+ * \returns an SDL_GameController on success or NULL on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.4.
+ */
+// extern DECLSPEC SDL_GameController *SDLCALL SDL_GameControllerFromInstanceID(SDL_JoystickID joyid);
+func SDL_GameControllerFromInstanceID(joyid SDL_JoystickID) *SDL_GameController {
+	return wrapGameController(C.SDL_GameControllerFromInstanceID(C.SDL_JoystickID(joyid)))
+}
+
+/**
+ * Get the implementation-dependent name for an opened game controller.
+ *
+ * This is the same name as returned by SDL_GameControllerNameForIndex(), but
+ * it takes a controller identifier instead of the (unstable) device index.
+ *
+ * \param gamecontroller a game controller identifier previously returned by
+ *                       SDL_GameControllerOpen()
+ * \returns the implementation dependent name for the game controller, or NULL
+ *          if there is no name or the identifier passed is invalid.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerNameForIndex
+ * \sa SDL_GameControllerOpen
+ */
+// extern DECLSPEC const char *SDLCALL SDL_GameControllerName(SDL_GameController *gamecontroller);
+func SDL_GameControllerName(gamecontroller *SDL_GameController) string {
+	return C.GoString(C.SDL_GameControllerName(gamecontroller.cObj()))
+}
+
+/**
+ * Get the type of this currently opened controller
+ *
+ * This is the same name as returned by SDL_GameControllerTypeForIndex(), but
+ * it takes a controller identifier instead of the (unstable) device index.
+ *
+ * \param gamecontroller the game controller object to query.
+ * \returns the controller type.
+ *
+ * \since This function is available since SDL 2.0.12.
+ */
+// extern DECLSPEC SDL_GameControllerType SDLCALL SDL_GameControllerGetType(SDL_GameController *gamecontroller);
+func SDL_GameControllerGetType(gamecontroller *SDL_GameController) SDL_GameControllerType {
+	return SDL_GameControllerType(C.SDL_GameControllerGetType(gamecontroller.cObj()))
+}
+
+/**
+ * Check if a controller has been opened and is currently connected.
+ *
+ * \param gamecontroller a game controller identifier previously returned by
+ *                       SDL_GameControllerOpen()
+ * \returns SDL_TRUE if the controller has been opened and is currently
+ *          connected, or SDL_FALSE if not.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerClose
+ * \sa SDL_GameControllerOpen
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_GameControllerGetAttached(SDL_GameController *gamecontroller);
+func SDL_GameControllerGetAttached(gamecontroller *SDL_GameController) bool {
+	return C.SDL_TRUE == C.SDL_GameControllerGetAttached(gamecontroller.cObj())
+}
+
+/**
+ * Get the Joystick ID from a Game Controller.
+ *
+ * This function will give you a SDL_Joystick object, which allows you to use
+ * the SDL_Joystick functions with a SDL_GameController object. This would be
+ * useful for getting a joystick's position at any given time, even if it
+ * hasn't moved (moving it would produce an event, which would have the axis'
+ * value).
+ *
+ * The pointer returned is owned by the SDL_GameController. You should not
+ * call SDL_JoystickClose() on it, for example, since doing so will likely
+ * cause SDL to crash.
+ *
+ * \param gamecontroller the game controller object that you want to get a
+ *                       joystick from
+ * \returns a SDL_Joystick object; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ */
+// extern DECLSPEC SDL_Joystick *SDLCALL SDL_GameControllerGetJoystick(SDL_GameController *gamecontroller);
+func SDL_GameControllerGetJoystick(gamecontroller *SDL_GameController) *SDL_Joystick {
+	return wrapJoystick(C.SDL_GameControllerGetJoystick(gamecontroller.cObj()))
+}
+
+/**
+ * Query or change current state of Game Controller events.
+ *
+ * If controller events are disabled, you must call SDL_GameControllerUpdate()
+ * yourself and check the state of the controller when you want controller
+ * information.
+ *
+ * Any number can be passed to SDL_GameControllerEventState(), but only -1, 0,
+ * and 1 will have any effect. Other numbers will just be returned.
+ *
+ * \param state can be one of `SDL_QUERY`, `SDL_IGNORE`, or `SDL_ENABLE`
+ * \returns the same value passed to the function, with exception to -1
+ *          (SDL_QUERY), which will return the current state.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickEventState
+ */
+// extern DECLSPEC int SDLCALL SDL_GameControllerEventState(int state);
+func SDL_GameControllerEventState(state int) (int, error) {
+	var r = C.SDL_GameControllerEventState(C.int(state))
+	if r < 0 {
+		return 0, lastError("SDL_GameControllerEventState")
+	}
+	return int(r), nil
+}
+
+/**
+ * Manually pump game controller updates if not using the loop.
+ *
+ * This function is called automatically by the event loop if events are
+ * enabled. Under such circumstances, it will not be necessary to call this
+ * function.
+ *
+ * \since This function is available since SDL 2.0.0.
+ */
+// extern DECLSPEC void SDLCALL SDL_GameControllerUpdate(void);
+func SDL_GameControllerUpdate() {
+	C.SDL_GameControllerUpdate()
+}
+
+/**
+ *  The list of axes available from a controller
+ *
+ *  Thumbstick axis values range from SDL_JOYSTICK_AXIS_MIN to SDL_JOYSTICK_AXIS_MAX,
+ *  and are centered within ~8000 of zero, though advanced UI will allow users to set
+ *  or autodetect the dead zone, which varies between controllers.
+ *
+ *  Trigger axis values range from 0 to SDL_JOYSTICK_AXIS_MAX.
+ */
+// typedef enum
+// {
+//     SDL_CONTROLLER_AXIS_INVALID = -1,
+//     SDL_CONTROLLER_AXIS_LEFTX,
+//     SDL_CONTROLLER_AXIS_LEFTY,
+//     SDL_CONTROLLER_AXIS_RIGHTX,
+//     SDL_CONTROLLER_AXIS_RIGHTY,
+//     SDL_CONTROLLER_AXIS_TRIGGERLEFT,
+//     SDL_CONTROLLER_AXIS_TRIGGERRIGHT,
+//     SDL_CONTROLLER_AXIS_MAX
+// } SDL_GameControllerAxis;
+type SDL_GameControllerAxis int
+
+const (
+	SDL_CONTROLLER_AXIS_INVALID      SDL_GameControllerAxis = C.SDL_CONTROLLER_AXIS_INVALID
+	SDL_CONTROLLER_AXIS_LEFTX        SDL_GameControllerAxis = C.SDL_CONTROLLER_AXIS_LEFTX
+	SDL_CONTROLLER_AXIS_LEFTY        SDL_GameControllerAxis = C.SDL_CONTROLLER_AXIS_LEFTY
+	SDL_CONTROLLER_AXIS_RIGHTX       SDL_GameControllerAxis = C.SDL_CONTROLLER_AXIS_RIGHTX
+	SDL_CONTROLLER_AXIS_RIGHTY       SDL_GameControllerAxis = C.SDL_CONTROLLER_AXIS_RIGHTY
+	SDL_CONTROLLER_AXIS_TRIGGERLEFT  SDL_GameControllerAxis = C.SDL_CONTROLLER_AXIS_TRIGGERLEFT
+	SDL_CONTROLLER_AXIS_TRIGGERRIGHT SDL_GameControllerAxis = C.SDL_CONTROLLER_AXIS_TRIGGERRIGHT
+	SDL_CONTROLLER_AXIS_MAX          SDL_GameControllerAxis = C.SDL_CONTROLLER_AXIS_MAX
+)
+
+/**
+ * Convert a string into SDL_GameControllerAxis enum.
+ *
+ * This function is called internally to translate SDL_GameController mapping
+ * strings for the underlying joystick device into the consistent
+ * SDL_GameController mapping. You do not normally need to call this function
+ * unless you are parsing SDL_GameController mappings in your own code.
+ *
+ * Note specially that "righttrigger" and "lefttrigger" map to
+ * `SDL_CONTROLLER_AXIS_TRIGGERRIGHT` and `SDL_CONTROLLER_AXIS_TRIGGERLEFT`,
+ * respectively.
+ *
+ * \param str string representing a SDL_GameController axis
+ * \returns the SDL_GameControllerAxis enum corresponding to the input string,
+ *          or `SDL_CONTROLLER_AXIS_INVALID` if no match was found.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerGetStringForAxis
+ */
+// extern DECLSPEC SDL_GameControllerAxis SDLCALL SDL_GameControllerGetAxisFromString(const char *str);
+func SDL_GameControllerGetAxisFromString(str string) SDL_GameControllerAxis {
+
+	var str_c = C.CString(str)
+	defer C.free(unsafe.Pointer(str_c))
+
+	return SDL_GameControllerAxis(C.SDL_GameControllerGetAxisFromString(str_c))
+}
+
+/**
+ * Convert from an SDL_GameControllerAxis enum to a string.
+ *
+ * The caller should not SDL_free() the returned string.
+ *
+ * \param axis an enum value for a given SDL_GameControllerAxis
+ * \returns a string for the given axis, or NULL if an invalid axis is
+ *          specified. The string returned is of the format used by
+ *          SDL_GameController mapping strings.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerGetAxisFromString
+ */
+// extern DECLSPEC const char* SDLCALL SDL_GameControllerGetStringForAxis(SDL_GameControllerAxis axis);
+func SDL_GameControllerGetStringForAxis(axis SDL_GameControllerAxis) string {
+	return C.GoString(C.SDL_GameControllerGetStringForAxis(C.SDL_GameControllerAxis(axis)))
+}
+
+/**
+ * Query whether a game controller has a given axis.
+ *
+ * This merely reports whether the controller's mapping defined this axis, as
+ * that is all the information SDL has about the physical device.
+ *
+ * \param gamecontroller a game controller
+ * \param axis an axis enum value (an SDL_GameControllerAxis value)
+ * \returns SDL_TRUE if the controller has this axis, SDL_FALSE otherwise.
+ *
+ * \since This function is available since SDL 2.0.14.
+ */
+// extern DECLSPEC SDL_bool SDLCALL
+// SDL_GameControllerHasAxis(SDL_GameController *gamecontroller, SDL_GameControllerAxis axis);
+func SDL_GameControllerHasAxis(gamecontroller *SDL_GameController, axis SDL_GameControllerAxis) bool {
+	return C.SDL_TRUE == C.SDL_GameControllerHasAxis(gamecontroller.cObj(), C.SDL_GameControllerAxis(axis))
+}
+
+/**
+ * Get the current state of an axis control on a game controller.
+ *
+ * The axis indices start at index 0.
+ *
+ * The state is a value ranging from -32768 to 32767. Triggers, however, range
+ * from 0 to 32767 (they never return a negative value).
+ *
+ * \param gamecontroller a game controller
+ * \param axis an axis index (one of the SDL_GameControllerAxis values)
+ * \returns axis state (including 0) on success or 0 (also) on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerGetButton
+ */
+// extern DECLSPEC Sint16 SDLCALL
+// SDL_GameControllerGetAxis(SDL_GameController *gamecontroller, SDL_GameControllerAxis axis);
+func SDL_GameControllerGetAxis(gamecontroller *SDL_GameController, axis SDL_GameControllerAxis) int16 {
+	return int16(C.SDL_GameControllerGetAxis(gamecontroller.cObj(), C.SDL_GameControllerAxis(axis)))
+}
+
+/**
+ *  The list of buttons available from a controller
+ */
+// typedef enum
+// {
+//     SDL_CONTROLLER_BUTTON_INVALID = -1,
+//     SDL_CONTROLLER_BUTTON_A,
+//     SDL_CONTROLLER_BUTTON_B,
+//     SDL_CONTROLLER_BUTTON_X,
+//     SDL_CONTROLLER_BUTTON_Y,
+//     SDL_CONTROLLER_BUTTON_BACK,
+//     SDL_CONTROLLER_BUTTON_GUIDE,
+//     SDL_CONTROLLER_BUTTON_START,
+//     SDL_CONTROLLER_BUTTON_LEFTSTICK,
+//     SDL_CONTROLLER_BUTTON_RIGHTSTICK,
+//     SDL_CONTROLLER_BUTTON_LEFTSHOULDER,
+//     SDL_CONTROLLER_BUTTON_RIGHTSHOULDER,
+//     SDL_CONTROLLER_BUTTON_DPAD_UP,
+//     SDL_CONTROLLER_BUTTON_DPAD_DOWN,
+//     SDL_CONTROLLER_BUTTON_DPAD_LEFT,
+//     SDL_CONTROLLER_BUTTON_DPAD_RIGHT,
+//     SDL_CONTROLLER_BUTTON_MISC1,    /* Xbox Series X share button, PS5 microphone button, Nintendo Switch Pro capture button, Amazon Luna microphone button */
+//     SDL_CONTROLLER_BUTTON_PADDLE1,  /* Xbox Elite paddle P1 */
+//     SDL_CONTROLLER_BUTTON_PADDLE2,  /* Xbox Elite paddle P3 */
+//     SDL_CONTROLLER_BUTTON_PADDLE3,  /* Xbox Elite paddle P2 */
+//     SDL_CONTROLLER_BUTTON_PADDLE4,  /* Xbox Elite paddle P4 */
+//     SDL_CONTROLLER_BUTTON_TOUCHPAD, /* PS4/PS5 touchpad button */
+//     SDL_CONTROLLER_BUTTON_MAX
+// } SDL_GameControllerButton;
+type SDL_GameControllerButton int
+
+const (
+	SDL_CONTROLLER_BUTTON_INVALID       SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_INVALID
+	SDL_CONTROLLER_BUTTON_A             SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_A
+	SDL_CONTROLLER_BUTTON_B             SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_B
+	SDL_CONTROLLER_BUTTON_X             SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_X
+	SDL_CONTROLLER_BUTTON_Y             SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_Y
+	SDL_CONTROLLER_BUTTON_BACK          SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_BACK
+	SDL_CONTROLLER_BUTTON_GUIDE         SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_GUIDE
+	SDL_CONTROLLER_BUTTON_START         SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_START
+	SDL_CONTROLLER_BUTTON_LEFTSTICK     SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_LEFTSTICK
+	SDL_CONTROLLER_BUTTON_RIGHTSTICK    SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_RIGHTSTICK
+	SDL_CONTROLLER_BUTTON_LEFTSHOULDER  SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_LEFTSHOULDER
+	SDL_CONTROLLER_BUTTON_RIGHTSHOULDER SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_RIGHTSHOULDER
+	SDL_CONTROLLER_BUTTON_DPAD_UP       SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_DPAD_UP
+	SDL_CONTROLLER_BUTTON_DPAD_DOWN     SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_DPAD_DOWN
+	SDL_CONTROLLER_BUTTON_DPAD_LEFT     SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_DPAD_LEFT
+	SDL_CONTROLLER_BUTTON_DPAD_RIGHT    SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_DPAD_RIGHT
+	SDL_CONTROLLER_BUTTON_MISC1         SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_MISC1    /**< Xbox Series X share button, PS5 microphone button, Nintendo Switch Pro capture button, Amazon Luna microphone button */
+	SDL_CONTROLLER_BUTTON_PADDLE1       SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_PADDLE1  /**< Xbox Elite paddle P1 */
+	SDL_CONTROLLER_BUTTON_PADDLE2       SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_PADDLE2  /**< Xbox Elite paddle P3 */
+	SDL_CONTROLLER_BUTTON_PADDLE3       SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_PADDLE3  /**< Xbox Elite paddle P2 */
+	SDL_CONTROLLER_BUTTON_PADDLE4       SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_PADDLE4  /**< Xbox Elite paddle P4 */
+	SDL_CONTROLLER_BUTTON_TOUCHPAD      SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_TOUCHPAD /**< PS4/PS5 touchpad button */
+	SDL_CONTROLLER_BUTTON_MAX           SDL_GameControllerButton = C.SDL_CONTROLLER_BUTTON_MAX
+)
+
+/**
+ * Convert a string into an SDL_GameControllerButton enum.
+ *
+ * This function is called internally to translate SDL_GameController mapping
+ * strings for the underlying joystick device into the consistent
+ * SDL_GameController mapping. You do not normally need to call this function
+ * unless you are parsing SDL_GameController mappings in your own code.
+ *
+ * \param str string representing a SDL_GameController axis
+ * \returns the SDL_GameControllerButton enum corresponding to the input
+ *          string, or `SDL_CONTROLLER_AXIS_INVALID` if no match was found.
+ *
+ * \since This function is available since SDL 2.0.0.
+ */
+// extern DECLSPEC SDL_GameControllerButton SDLCALL SDL_GameControllerGetButtonFromString(const char *str);
+func SDL_GameControllerGetButtonFromString(str string) SDL_GameControllerButton {
+
+	var str_c = C.CString(str)
+	defer C.free(unsafe.Pointer(str_c))
+
+	return SDL_GameControllerButton(C.SDL_GameControllerGetButtonFromString(str_c))
+}
+
+/**
+ * Convert from an SDL_GameControllerButton enum to a string.
+ *
+ * The caller should not SDL_free() the returned string.
+ *
+ * \param button an enum value for a given SDL_GameControllerButton
+ * \returns a string for the given button, or NULL if an invalid button is
+ *          specified. The string returned is of the format used by
+ *          SDL_GameController mapping strings.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerGetButtonFromString
+ */
+// extern DECLSPEC const char* SDLCALL SDL_GameControllerGetStringForButton(SDL_GameControllerButton button);
+func SDL_GameControllerGetStringForButton(button SDL_GameControllerButton) string {
+	return C.GoString(C.SDL_GameControllerGetStringForButton(C.SDL_GameControllerButton(button)))
+}
+
+/**
+ * Query whether a game controller has a given button.
+ *
+ * This merely reports whether the controller's mapping defined this button,
+ * as that is all the information SDL has about the physical device.
+ *
+ * \param gamecontroller a game controller
+ * \param button a button enum value (an SDL_GameControllerButton value)
+ * \returns SDL_TRUE if the controller has this button, SDL_FALSE otherwise.
+ *
+ * \since This function is available since SDL 2.0.14.
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_GameControllerHasButton(SDL_GameController *gamecontroller,
+//                                                              SDL_GameControllerButton button);
+func SDL_GameControllerHasButton(gamecontroller *SDL_GameController, button SDL_GameControllerButton) bool {
+	return C.SDL_TRUE == C.SDL_GameControllerHasButton(gamecontroller.cObj(), C.SDL_GameControllerButton(button))
+}
+
+/**
+ * Get the current state of a button on a game controller.
+ *
+ * \param gamecontroller a game controller
+ * \param button a button index (one of the SDL_GameControllerButton values)
+ * \returns 1 for pressed state or 0 for not pressed state or error; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerGetAxis
+ */
+// extern DECLSPEC Uint8 SDLCALL SDL_GameControllerGetButton(SDL_GameController *gamecontroller,
+//                                                           SDL_GameControllerButton button);
+func SDL_GameControllerGetButton(gamecontroller *SDL_GameController, button SDL_GameControllerButton) uint8 {
+	return uint8(C.SDL_GameControllerGetButton(gamecontroller.cObj(), C.SDL_GameControllerButton(button)))
+}
+
+/**
+ * Start a rumble effect on a game controller.
+ *
+ * Each call to this function cancels any previous rumble effect, and calling
+ * it with 0 intensity stops any rumbling.
+ *
+ * \param gamecontroller The controller to vibrate
+ * \param low_frequency_rumble The intensity of the low frequency (left)
+ *                             rumble motor, from 0 to 0xFFFF
+ * \param high_frequency_rumble The intensity of the high frequency (right)
+ *                              rumble motor, from 0 to 0xFFFF
+ * \param duration_ms The duration of the rumble effect, in milliseconds
+ * \returns 0, or -1 if rumble isn't supported on this controller
+ *
+ * \since This function is available since SDL 2.0.9.
+ *
+ * \sa SDL_GameControllerHasRumble
+ */
+// extern DECLSPEC int SDLCALL SDL_GameControllerRumble(SDL_GameController *gamecontroller, Uint16 low_frequency_rumble, Uint16 high_frequency_rumble, Uint32 duration_ms);
+func SDL_GameControllerRumble(gamecontroller *SDL_GameController, low_frequency_rumble, high_frequency_rumble uint16, duration_ms uint32) error {
+	return checkError("SDL_GameControllerRumble", C.SDL_GameControllerRumble(gamecontroller.cObj(), C.Uint16(low_frequency_rumble), C.Uint16(high_frequency_rumble), C.Uint32(duration_ms)))
+}
+
+/**
+ * Start a rumble effect in the game controller's triggers.
+ *
+ * Each call to this function cancels any previous trigger rumble effect, and
+ * calling it with 0 intensity stops any rumbling.
+ *
+ * Note that this is rumbling of the _triggers_ and not the game controller as
+ * a whole. This is currently only supported on Xbox One controllers. If you
+ * want the (more common) whole-controller rumble, use
+ * SDL_GameControllerRumble() instead.
+ *
+ * \param gamecontroller The controller to vibrate
+ * \param left_rumble The intensity of the left trigger rumble motor, from 0
+ *                    to 0xFFFF
+ * \param right_rumble The intensity of the right trigger rumble motor, from 0
+ *                     to 0xFFFF
+ * \param duration_ms The duration of the rumble effect, in milliseconds
+ * \returns 0, or -1 if trigger rumble isn't supported on this controller
+ *
+ * \since This function is available since SDL 2.0.14.
+ *
+ * \sa SDL_GameControllerHasRumbleTriggers
+ */
+// extern DECLSPEC int SDLCALL SDL_GameControllerRumbleTriggers(SDL_GameController *gamecontroller, Uint16 left_rumble, Uint16 right_rumble, Uint32 duration_ms);
+func SDL_GameControllerRumbleTriggers(gamecontroller *SDL_GameController, left_rumble, right_rumble uint16, duration_ms uint32) error {
+	return checkError("SDL_GameControllerRumbleTriggers", C.SDL_GameControllerRumbleTriggers(gamecontroller.cObj(), C.Uint16(left_rumble), C.Uint16(right_rumble), C.Uint32(duration_ms)))
+}
+
+/**
+ * Query whether a game controller has rumble support.
+ *
+ * \param gamecontroller The controller to query
+ * \returns SDL_TRUE, or SDL_FALSE if this controller does not have rumble
+ *          support
+ *
+ * \since This function is available since SDL 2.0.18.
+ *
+ * \sa SDL_GameControllerRumble
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_GameControllerHasRumble(SDL_GameController *gamecontroller);
+func SDL_GameControllerHasRumble(gamecontroller *SDL_GameController) bool {
+	return C.SDL_TRUE == C.SDL_GameControllerHasRumble(gamecontroller.cObj())
+}
+
+/**
+ * Close a game controller previously opened with SDL_GameControllerOpen().
+ *
+ * \param gamecontroller a game controller identifier previously returned by
+ *                       SDL_GameControllerOpen()
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerOpen
+ */
+// extern DECLSPEC void SDLCALL SDL_GameControllerClose(SDL_GameController *gamecontroller);
+func SDL_GameControllerClose(gamecontroller *SDL_GameController) {
+	C.SDL_GameControllerClose(gamecontroller.cObj())
+}
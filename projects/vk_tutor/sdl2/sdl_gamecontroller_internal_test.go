@@ -0,0 +1,32 @@
+package sdl2
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGameControllerMappingsPlatform(t *testing.T) {
+
+	var db = `# comment
+a,A,a:b0,platform:Linux,
+b,B,a:b0,platform:linux
+c,C,a:b0,platform:Linux2,
+d,D,a:b0,platform:Lin,
+e,E,a:b0,
+f,F,a:b0,platform:Windows,
+`
+
+	var got, err = gameControllerMappings(strings.NewReader(db), "Linux")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var want = []gameControllerMapping{
+		{2, "a,A,a:b0,platform:Linux,"},
+		{3, "b,B,a:b0,platform:linux"},
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("mappings %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,73 @@
+package sdl2_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"example.com/vk_tutor/sdl2"
+	"example.com/vk_tutor/sdl2/sdltest"
+)
+
+func TestVirtualGameController(t *testing.T) {
+
+	sdltest.Init(t, sdl2.SDL_INIT_GAMECONTROLLER)
+
+	var index, err = sdl2.SDL_JoystickAttachVirtual(sdl2.SDL_JOYSTICK_TYPE_GAMECONTROLLER, 4, 4, 0)
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer sdl2.SDL_JoystickDetachVirtual(index)
+
+	// Buttons and axes swapped, to tell this mapping from a default one.
+	// The lines after it are for other platforms and must be skipped.
+	var guid = sdl2.SDL_GUIDToString(sdl2.SDL_JoystickGetDeviceGUID(index))
+	var platform = sdl2.SDL_GetPlatform()
+	var db = strings.Join([]string{
+		"# test database",
+		fmt.Sprintf("%v,Test Pad,a:b1,b:b0,leftx:a1,lefty:a0,platform:%v,", guid, strings.ToUpper(platform)),
+		fmt.Sprintf("%v,Other Pad,a:b0,b:b1,leftx:a0,lefty:a1,platform:%vX,", guid, platform),
+		fmt.Sprintf("%v,Other Pad,a:b0,b:b1,leftx:a0,lefty:a1,platform:%v", guid, platform[:len(platform)-1]),
+		fmt.Sprintf("%v,Other Pad,a:b0,b:b1,leftx:a0,lefty:a1,", guid),
+	}, "\n")
+
+	if _, err = sdl2.SDL_GameControllerAddMappingsFromReader(strings.NewReader(db)); nil != err {
+		t.Fatal(err)
+	}
+
+	if !sdl2.SDL_IsGameController(index) {
+		t.Fatalf("virtual joystick %v is not a game controller", guid)
+	}
+
+	var gc *sdl2.SDL_GameController
+	if gc, err = sdl2.SDL_GameControllerOpen(index); nil != err {
+		t.Fatal(err)
+	}
+	defer sdl2.SDL_GameControllerClose(gc)
+
+	if name := sdl2.SDL_GameControllerName(gc); "Test Pad" != name {
+		t.Fatalf("controller name %q, want the mapping of this platform", name)
+	}
+
+	var joystick = sdl2.SDL_GameControllerGetJoystick(gc)
+	if err = sdl2.SDL_JoystickSetVirtualButton(joystick, 1, sdl2.SDL_PRESSED); nil != err {
+		t.Fatal(err)
+	}
+	if err = sdl2.SDL_JoystickSetVirtualAxis(joystick, 1, 12345); nil != err {
+		t.Fatal(err)
+	}
+	sdl2.SDL_GameControllerUpdate()
+
+	if got := sdl2.SDL_GameControllerGetButton(gc, sdl2.SDL_CONTROLLER_BUTTON_A); 1 != got {
+		t.Errorf("button A = %v, want 1", got)
+	}
+	if got := sdl2.SDL_GameControllerGetButton(gc, sdl2.SDL_CONTROLLER_BUTTON_B); 0 != got {
+		t.Errorf("button B = %v, want 0", got)
+	}
+	if got := sdl2.SDL_GameControllerGetAxis(gc, sdl2.SDL_CONTROLLER_AXIS_LEFTX); 12345 != got {
+		t.Errorf("left x = %v, want 12345", got)
+	}
+	if got := sdl2.SDL_GameControllerGetAxis(gc, sdl2.SDL_CONTROLLER_AXIS_LEFTY); 0 != got {
+		t.Errorf("left y = %v, want 0", got)
+	}
+}
@@ -0,0 +1,18 @@
+package sdl2
+
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
+// #include "SDL.h"
+import "C"
+
+/**
+ *  \file SDL_gesture.h
+ *
+ *  Include file for SDL gesture event handling.
+ */
+
+// typedef Sint64 SDL_GestureID;
+type SDL_GestureID int64
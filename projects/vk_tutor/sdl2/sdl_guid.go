@@ -0,0 +1,116 @@
+package sdl2
+
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
+// #include "SDL.h"
+import "C"
+import "unsafe"
+
+/**
+ *  \file SDL_guid.h
+ *
+ *  Include file for handling ::SDL_GUID values.
+ */
+
+/**
+ * An SDL_GUID is a 128-bit identifier for an input device that
+ *   identifies that device across runs of SDL programs on the same
+ *   platform.  If the device is detached and then re-attached to a
+ *   different port, or if the base system is rebooted, the device
+ *   should still report the same GUID.
+ *
+ * GUIDs are as precise as possible but are not guaranteed to
+ *   distinguish physically distinct but equivalent devices.  For
+ *   example, two game controllers from the same vendor with the same
+ *   product ID and revision may have the same GUID.
+ *
+ * GUIDs may be platform-dependent (i.e., the same device may report
+ *   different GUIDs on different operating systems).
+ */
+// typedef struct {
+//     Uint8 data[16];
+// } SDL_GUID;
+type SDL_GUID struct {
+	Data [16]uint8
+}
+
+func (o *SDL_GUID) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_GUID)(p)
+	for i := range o.Data {
+		o.Data[i] = uint8(p1.data[i])
+	}
+}
+
+func (o *SDL_GUID) copyToCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_GUID)(p)
+	for i := range o.Data {
+		p1.data[i] = C.Uint8(o.Data[i])
+	}
+}
+
+func (o *SDL_GUID) cValue() C.SDL_GUID {
+	var r C.SDL_GUID
+	o.copyToCObj(unsafe.Pointer(&r))
+	return r
+}
+
+func goGUID(guid C.SDL_GUID) SDL_GUID {
+	var r SDL_GUID
+	r.copyFromCObj(unsafe.Pointer(&guid))
+	return r
+}
+
+// String returns the ASCII form, see SDL_GUIDToString.
+func (o SDL_GUID) String() string {
+	return SDL_GUIDToString(o)
+}
+
+/* Function prototypes */
+
+/**
+ * Get an ASCII string representation for a given ::SDL_GUID.
+ *
+ * You should supply at least 33 bytes for pszGUID.
+ *
+ * \param guid the ::SDL_GUID you wish to convert to string
+ * \param pszGUID buffer in which to write the ASCII string
+ * \param cbGUID the size of pszGUID
+ *
+ * \since This function is available since SDL 2.24.0.
+ *
+ * \sa SDL_GUIDFromString
+ */
+// extern DECLSPEC void SDLCALL SDL_GUIDToString(SDL_GUID guid, char *pszGUID, int cbGUID);
+//
+// The Go form returns the string, 33 bytes are enough.
+func SDL_GUIDToString(guid SDL_GUID) string {
+	var buf [33]C.char
+	C.SDL_GUIDToString(guid.cValue(), &buf[0], C.int(len(buf)))
+	return C.GoString(&buf[0])
+}
+
+/**
+ * Convert a GUID string into a ::SDL_GUID structure.
+ *
+ * Performs no error checking. If this function is given a string containing
+ * an invalid GUID, the function will silently succeed, but the GUID generated
+ * will not be useful.
+ *
+ * \param pchGUID string containing an ASCII representation of a GUID
+ * \returns a ::SDL_GUID structure.
+ *
+ * \since This function is available since SDL 2.24.0.
+ *
+ * \sa SDL_GUIDToString
+ */
+// extern DECLSPEC SDL_GUID SDLCALL SDL_GUIDFromString(const char *pchGUID);
+func SDL_GUIDFromString(pchGUID string) SDL_GUID {
+
+	var pchGUID_c = C.CString(pchGUID)
+	defer C.free(unsafe.Pointer(pchGUID_c))
+
+	return goGUID(C.SDL_GUIDFromString(pchGUID_c))
+}
@@ -0,0 +1,817 @@
+package sdl2
+
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
+// #include "SDL.h"
+import "C"
+
+import (
+	"unsafe"
+
+	"example.com/vk_tutor/sdl2/internal"
+)
+
+/**
+ *  \file SDL_joystick.h
+ *
+ *  Include file for SDL joystick event handling
+ */
+
+/**
+ * The joystick structure used to identify an SDL joystick
+ */
+// struct _SDL_Joystick;
+// typedef struct _SDL_Joystick SDL_Joystick;
+type SDL_Joystick internal.CObjWrapper
+
+func (o *SDL_Joystick) cObj() *C.SDL_Joystick {
+	if nil == o {
+		return nil
+	}
+	return (*C.SDL_Joystick)(internal.Unwrap[SDL_Joystick](o))
+}
+
+func wrapJoystick(p *C.SDL_Joystick) *SDL_Joystick {
+	if nil == p {
+		return nil
+	}
+	return internal.WrapNew[SDL_Joystick](unsafe.Pointer(p))
+}
+
+/* A structure that encodes the stable unique id for a joystick device */
+// typedef SDL_GUID SDL_JoystickGUID;
+type SDL_JoystickGUID = SDL_GUID
+
+/**
+ * This is a unique ID for a joystick for the time it is connected to the system,
+ * and is never reused for the lifetime of the application. If the joystick is
+ * disconnected and reconnected, it will get a new ID.
+ *
+ * The ID value starts at 0 and increments from there. The value -1 is an invalid ID.
+ */
+// typedef Sint32 SDL_JoystickID;
+type SDL_JoystickID int32
+
+// typedef enum
+//
+//	{
+//	    SDL_JOYSTICK_TYPE_UNKNOWN,
+//	    SDL_JOYSTICK_TYPE_GAMECONTROLLER,
+//	    SDL_JOYSTICK_TYPE_WHEEL,
+//	    SDL_JOYSTICK_TYPE_ARCADE_STICK,
+//	    SDL_JOYSTICK_TYPE_FLIGHT_STICK,
+//	    SDL_JOYSTICK_TYPE_DANCE_PAD,
+//	    SDL_JOYSTICK_TYPE_GUITAR,
+//	    SDL_JOYSTICK_TYPE_DRUM_KIT,
+//	    SDL_JOYSTICK_TYPE_ARCADE_PAD,
+//	    SDL_JOYSTICK_TYPE_THROTTLE
+//	} SDL_JoystickType;
+type SDL_JoystickType int
+
+const (
+	SDL_JOYSTICK_TYPE_UNKNOWN        SDL_JoystickType = C.SDL_JOYSTICK_TYPE_UNKNOWN
+	SDL_JOYSTICK_TYPE_GAMECONTROLLER SDL_JoystickType = C.SDL_JOYSTICK_TYPE_GAMECONTROLLER
+	SDL_JOYSTICK_TYPE_WHEEL          SDL_JoystickType = C.SDL_JOYSTICK_TYPE_WHEEL
+	SDL_JOYSTICK_TYPE_ARCADE_STICK   SDL_JoystickType = C.SDL_JOYSTICK_TYPE_ARCADE_STICK
+	SDL_JOYSTICK_TYPE_FLIGHT_STICK   SDL_JoystickType = C.SDL_JOYSTICK_TYPE_FLIGHT_STICK
+	SDL_JOYSTICK_TYPE_DANCE_PAD      SDL_JoystickType = C.SDL_JOYSTICK_TYPE_DANCE_PAD
+	SDL_JOYSTICK_TYPE_GUITAR         SDL_JoystickType = C.SDL_JOYSTICK_TYPE_GUITAR
+	SDL_JOYSTICK_TYPE_DRUM_KIT       SDL_JoystickType = C.SDL_JOYSTICK_TYPE_DRUM_KIT
+	SDL_JOYSTICK_TYPE_ARCADE_PAD     SDL_JoystickType = C.SDL_JOYSTICK_TYPE_ARCADE_PAD
+	SDL_JOYSTICK_TYPE_THROTTLE       SDL_JoystickType = C.SDL_JOYSTICK_TYPE_THROTTLE
+)
+
+// typedef enum
+//
+//	{
+//	    SDL_JOYSTICK_POWER_UNKNOWN = -1,
+//	    SDL_JOYSTICK_POWER_EMPTY,   /* <= 5% */
+//	    SDL_JOYSTICK_POWER_LOW,     /* <= 20% */
+//	    SDL_JOYSTICK_POWER_MEDIUM,  /* <= 70% */
+//	    SDL_JOYSTICK_POWER_FULL,    /* <= 100% */
+//	    SDL_JOYSTICK_POWER_WIRED,
+//	    SDL_JOYSTICK_POWER_MAX
+//	} SDL_JoystickPowerLevel;
+type SDL_JoystickPowerLevel int
+
+const (
+	SDL_JOYSTICK_POWER_UNKNOWN SDL_JoystickPowerLevel = C.SDL_JOYSTICK_POWER_UNKNOWN
+	SDL_JOYSTICK_POWER_EMPTY   SDL_JoystickPowerLevel = C.SDL_JOYSTICK_POWER_EMPTY  /* <= 5% */
+	SDL_JOYSTICK_POWER_LOW     SDL_JoystickPowerLevel = C.SDL_JOYSTICK_POWER_LOW    /* <= 20% */
+	SDL_JOYSTICK_POWER_MEDIUM  SDL_JoystickPowerLevel = C.SDL_JOYSTICK_POWER_MEDIUM /* <= 70% */
+	SDL_JOYSTICK_POWER_FULL    SDL_JoystickPowerLevel = C.SDL_JOYSTICK_POWER_FULL   /* <= 100% */
+	SDL_JOYSTICK_POWER_WIRED   SDL_JoystickPowerLevel = C.SDL_JOYSTICK_POWER_WIRED
+	SDL_JOYSTICK_POWER_MAX     SDL_JoystickPowerLevel = C.SDL_JOYSTICK_POWER_MAX
+)
+
+/* Function prototypes */
+
+/**
+ * Locking for multi-threaded access to the joystick API
+ *
+ * If you are using the joystick API or handling events from multiple threads
+ * you should use these locking functions to protect access to the joysticks.
+ *
+ * In particular, you are guaranteed that the joystick list won't change, so
+ * the API functions that take a joystick index will be valid, and joystick
+ * and game controller events will not be delivered.
+ *
+ * As of SDL 2.26.0, you can take the joystick lock around reinitializing the
+ * joystick subsystem, to prevent other threads from seeing joysticks in an
+ * uninitialized state. However, all open joysticks will be closed and SDL
+ * functions called with them will fail.
+ *
+ * \since This function is available since SDL 2.0.7.
+ */
+// extern DECLSPEC void SDLCALL SDL_LockJoysticks(void);
+func SDL_LockJoysticks() {
+	C.SDL_LockJoysticks()
+}
+
+/**
+ * Unlocking for multi-threaded access to the joystick API
+ *
+ * If you are using the joystick API or handling events from multiple threads
+ * you should use these locking functions to protect access to the joysticks.
+ *
+ * In particular, you are guaranteed that the joystick list won't change, so
+ * the API functions that take a joystick index will be valid, and joystick
+ * and game controller events will not be delivered.
+ *
+ * \since This function is available since SDL 2.0.7.
+ */
+// extern DECLSPEC void SDLCALL SDL_UnlockJoysticks(void);
+func SDL_UnlockJoysticks() {
+	C.SDL_UnlockJoysticks()
+}
+
+/**
+ * Count the number of joysticks attached to the system.
+ *
+ * \returns the number of attached joysticks on success or a negative error
+ *          code on failure; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickName
+ * \sa SDL_JoystickPath
+ * \sa SDL_JoystickOpen
+ */
+// extern DECLSPEC int SDLCALL SDL_NumJoysticks(void);
+func SDL_NumJoysticks() (int, error) {
+	var n = C.SDL_NumJoysticks()
+	if n < 0 {
+		return 0, lastError("SDL_NumJoysticks")
+	}
+	return int(n), nil
+}
+
+/**
+ * Get the implementation dependent name of a joystick.
+ *
+ * This can be called before any joysticks are opened.
+ *
+ * \param device_index the index of the joystick to query (the N'th joystick
+ *                     on the system)
+ * \returns the name of the selected joystick. If no name can be found, this
+ *          function returns NULL; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickName
+ * \sa SDL_JoystickOpen
+ */
+// extern DECLSPEC const char *SDLCALL SDL_JoystickNameForIndex(int device_index);
+func SDL_JoystickNameForIndex(device_index int) string {
+	return C.GoString(C.SDL_JoystickNameForIndex(C.int(device_index)))
+}
+
+/**
+ * Get the implementation dependent path of a joystick.
+ *
+ * This can be called before any joysticks are opened.
+ *
+ * \param device_index the index of the joystick to query (the N'th joystick
+ *                     on the system)
+ * \returns the path of the selected joystick. If no path can be found, this
+ *          function returns NULL; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.24.0.
+ *
+ * \sa SDL_JoystickPath
+ * \sa SDL_JoystickOpen
+ */
+// extern DECLSPEC const char *SDLCALL SDL_JoystickPathForIndex(int device_index);
+func SDL_JoystickPathForIndex(device_index int) string {
+	return C.GoString(C.SDL_JoystickPathForIndex(C.int(device_index)))
+}
+
+/**
+ * Get the implementation-dependent GUID for the joystick at a given device
+ * index.
+ *
+ * This function can be called before any joysticks are opened.
+ *
+ * \param device_index the index of the joystick to query (the N'th joystick
+ *                     on the system
+ * \returns the GUID of the selected joystick. If called on an invalid index,
+ *          this function returns a zero GUID
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickGetGUID
+ * \sa SDL_JoystickGetGUIDString
+ */
+// extern DECLSPEC SDL_JoystickGUID SDLCALL SDL_JoystickGetDeviceGUID(int device_index);
+func SDL_JoystickGetDeviceGUID(device_index int) SDL_JoystickGUID {
+	return goGUID(C.SDL_JoystickGetDeviceGUID(C.int(device_index)))
+}
+
+/**
+ * Get the type of a joystick, if available.
+ *
+ * This can be called before any joysticks are opened.
+ *
+ * \param device_index the index of the joystick to query (the N'th joystick
+ *                     on the system
+ * \returns the SDL_JoystickType of the selected joystick. If called on an
+ *          invalid index, this function returns `SDL_JOYSTICK_TYPE_UNKNOWN`
+ *
+ * \since This function is available since SDL 2.0.6.
+ */
+// extern DECLSPEC SDL_JoystickType SDLCALL SDL_JoystickGetDeviceType(int device_index);
+func SDL_JoystickGetDeviceType(device_index int) SDL_JoystickType {
+	return SDL_JoystickType(C.SDL_JoystickGetDeviceType(C.int(device_index)))
+}
+
+/**
+ * Get the instance ID of a joystick.
+ *
+ * This can be called before any joysticks are opened. If the index is out of
+ * range, this function will return -1.
+ *
+ * \param device_index the index of the joystick to query (the N'th joystick
+ *                     on the system
+ * \returns the instance id of the selected joystick. If called on an invalid
+ *          index, this function returns zero
+ *
+ * \since This function is available since SDL 2.0.6.
+ */
+// extern DECLSPEC SDL_JoystickID SDLCALL SDL_JoystickGetDeviceInstanceID(int device_index);
+func SDL_JoystickGetDeviceInstanceID(device_index int) SDL_JoystickID {
+	return SDL_JoystickID(C.SDL_JoystickGetDeviceInstanceID(C.int(device_index)))
+}
+
+/**
+ * Open a joystick for use.
+ *
+ * The `device_index` argument refers to the N'th joystick presently
+ * recognized by SDL on the system. It is **NOT** the same as the instance ID
+ * used to identify the joystick in future events. See
+ * SDL_JoystickInstanceID() for more details about instance IDs.
+ *
+ * The joystick subsystem must be initialized before a joystick can be opened
+ * for use.
+ *
+ * \param device_index the index of the joystick to query
+ * \returns a joystick identifier or NULL if an error occurred; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickClose
+ * \sa SDL_JoystickInstanceID
+ */
+// extern DECLSPEC SDL_Joystick *SDLCALL SDL_JoystickOpen(int device_index);
+func SDL_JoystickOpen(device_index int) (*SDL_Joystick, error) {
+
+	var p = C.SDL_JoystickOpen(C.int(device_index))
+	if nil == p {
+		return nil, lastError("SDL_JoystickOpen")
+	}
+
+	return internal.WrapNew[SDL_Joystick](unsafe.Pointer(p)), nil
+}
+
+/**
+ * Get the SDL_Joystick associated with an instance id.
+ *
+ * \param instance_id the instance id to get the SDL_Joystick for
+ * \returns an SDL_Joystick on success or NULL on failure; call SDL_GetError()
+ *          for more information.
+ *
+ * \since This function is available since SDL 2.0.4.
+ */
+// extern DECLSPEC SDL_Joystick *SDLCALL SDL_JoystickFromInstanceID(SDL_JoystickID instance_id);
+func SDL_JoystickFromInstanceID(instance_id SDL_JoystickID) *SDL_Joystick {
+	return wrapJoystick(C.SDL_JoystickFromInstanceID(C.SDL_JoystickID(instance_id)))
+}
+
+/**
+ * Attach a new virtual joystick.
+ *
+ * \returns the joystick's device index, or -1 if an error occurred.
+ *
+ * \since This function is available since SDL 2.0.14.
+ */
+// extern DECLSPEC int SDLCALL SDL_JoystickAttachVirtual(SDL_JoystickType type,
+//                                                       int naxes,
+//                                                       int nbuttons,
+//                                                       int nhats);
+func SDL_JoystickAttachVirtual(_type SDL_JoystickType, naxes, nbuttons, nhats int) (int, error) {
+	var i = C.SDL_JoystickAttachVirtual(C.SDL_JoystickType(_type), C.int(naxes), C.int(nbuttons), C.int(nhats))
+	if i < 0 {
+		return 0, lastError("SDL_JoystickAttachVirtual")
+	}
+	return int(i), nil
+}
+
+/**
+ * Detach a virtual joystick.
+ *
+ * \param device_index a value previously returned from
+ *                     SDL_JoystickAttachVirtual()
+ * \returns 0 on success, or -1 if an error occurred.
+ *
+ * \since This function is available since SDL 2.0.14.
+ */
+// extern DECLSPEC int SDLCALL SDL_JoystickDetachVirtual(int device_index);
+func SDL_JoystickDetachVirtual(device_index int) error {
+	return checkError("SDL_JoystickDetachVirtual", C.SDL_JoystickDetachVirtual(C.int(device_index)))
+}
+
+/**
+ * Query whether or not the joystick at a given device index is virtual.
+ *
+ * \param device_index a joystick device index.
+ * \returns SDL_TRUE if the joystick is virtual, SDL_FALSE otherwise.
+ *
+ * \since This function is available since SDL 2.0.14.
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_JoystickIsVirtual(int device_index);
+func SDL_JoystickIsVirtual(device_index int) bool {
+	return C.SDL_TRUE == C.SDL_JoystickIsVirtual(C.int(device_index))
+}
+
+/**
+ * Set values on an opened, virtual-joystick's axis.
+ *
+ * Please note that values set here will not be applied until the next call to
+ * SDL_JoystickUpdate, which can either be called directly, or can be called
+ * indirectly through various other SDL APIs, including, but not limited to
+ * the following: SDL_PollEvent, SDL_PumpEvents, SDL_WaitEventTimeout,
+ * SDL_WaitEvent.
+ *
+ * Note that when sending trigger axes, you should scale the value to the full
+ * range of Sint16. For example, a trigger at rest would have the value of
+ * `SDL_JOYSTICK_AXIS_MIN`.
+ *
+ * \param joystick the virtual joystick on which to set state.
+ * \param axis the specific axis on the virtual joystick to set.
+ * \param value the new value for the specified axis.
+ * \returns 0 on success, -1 on error.
+ *
+ * \since This function is available since SDL 2.0.14.
+ */
+// extern DECLSPEC int SDLCALL SDL_JoystickSetVirtualAxis(SDL_Joystick *joystick, int axis, Sint16 value);
+func SDL_JoystickSetVirtualAxis(joystick *SDL_Joystick, axis int, value int16) error {
+	return checkError("SDL_JoystickSetVirtualAxis", C.SDL_JoystickSetVirtualAxis(joystick.cObj(), C.int(axis), C.Sint16(value)))
+}
+
+/**
+ * Set values on an opened, virtual-joystick's button.
+ *
+ * Please note that values set here will not be applied until the next call to
+ * SDL_JoystickUpdate, which can either be called directly, or can be called
+ * indirectly through various other SDL APIs, including, but not limited to
+ * the following: SDL_PollEvent, SDL_PumpEvents, SDL_WaitEventTimeout,
+ * SDL_WaitEvent.
+ *
+ * \param joystick the virtual joystick on which to set state.
+ * \param button the specific button on the virtual joystick to set.
+ * \param value the new value for the specified button.
+ * \returns 0 on success, -1 on error.
+ *
+ * \since This function is available since SDL 2.0.14.
+ */
+// extern DECLSPEC int SDLCALL SDL_JoystickSetVirtualButton(SDL_Joystick *joystick, int button, Uint8 value);
+func SDL_JoystickSetVirtualButton(joystick *SDL_Joystick, button int, value uint8) error {
+	return checkError("SDL_JoystickSetVirtualButton", C.SDL_JoystickSetVirtualButton(joystick.cObj(), C.int(button), C.Uint8(value)))
+}
+
+/**
+ * Set values on an opened, virtual-joystick's hat.
+ *
+ * Please note that values set here will not be applied until the next call to
+ * SDL_JoystickUpdate, which can either be called directly, or can be called
+ * indirectly through various other SDL APIs, including, but not limited to
+ * the following: SDL_PollEvent, SDL_PumpEvents, SDL_WaitEventTimeout,
+ * SDL_WaitEvent.
+ *
+ * \param joystick the virtual joystick on which to set state.
+ * \param hat the specific hat on the virtual joystick to set.
+ * \param value the new value for the specified hat.
+ * \returns 0 on success, -1 on error.
+ *
+ * \since This function is available since SDL 2.0.14.
+ */
+// extern DECLSPEC int SDLCALL SDL_JoystickSetVirtualHat(SDL_Joystick *joystick, int hat, Uint8 value);
+func SDL_JoystickSetVirtualHat(joystick *SDL_Joystick, hat int, value uint8) error {
+	return checkError("SDL_JoystickSetVirtualHat", C.SDL_JoystickSetVirtualHat(joystick.cObj(), C.int(hat), C.Uint8(value)))
+}
+
+/**
+ * Get the implementation dependent name of a joystick.
+ *
+ * \param joystick the SDL_Joystick obtained from SDL_JoystickOpen()
+ * \returns the name of the selected joystick. If no name can be found, this
+ *          function returns NULL; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickNameForIndex
+ * \sa SDL_JoystickOpen
+ */
+// extern DECLSPEC const char *SDLCALL SDL_JoystickName(SDL_Joystick *joystick);
+func SDL_JoystickName(joystick *SDL_Joystick) string {
+	return C.GoString(C.SDL_JoystickName(joystick.cObj()))
+}
+
+/**
+ * Get the implementation-dependent GUID for the joystick.
+ *
+ * This function requires an open joystick.
+ *
+ * \param joystick the SDL_Joystick obtained from SDL_JoystickOpen()
+ * \returns the GUID of the given joystick. If called on an invalid index,
+ *          this function returns a zero GUID; call SDL_GetError() for more
+ *          information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickGetDeviceGUID
+ * \sa SDL_JoystickGetGUIDString
+ */
+// extern DECLSPEC SDL_JoystickGUID SDLCALL SDL_JoystickGetGUID(SDL_Joystick *joystick);
+func SDL_JoystickGetGUID(joystick *SDL_Joystick) SDL_JoystickGUID {
+	return goGUID(C.SDL_JoystickGetGUID(joystick.cObj()))
+}
+
+/**
+ * Get the type of an opened joystick.
+ *
+ * \param joystick the SDL_Joystick obtained from SDL_JoystickOpen()
+ * \returns the SDL_JoystickType of the selected joystick.
+ *
+ * \since This function is available since SDL 2.0.6.
+ */
+// extern DECLSPEC SDL_JoystickType SDLCALL SDL_JoystickGetType(SDL_Joystick *joystick);
+func SDL_JoystickGetType(joystick *SDL_Joystick) SDL_JoystickType {
+	return SDL_JoystickType(C.SDL_JoystickGetType(joystick.cObj()))
+}
+
+/**
+ * Get the status of a specified joystick.
+ *
+ * \param joystick the joystick to query
+ * \returns SDL_TRUE if the joystick has been opened, SDL_FALSE if it has not;
+ *          call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickClose
+ * \sa SDL_JoystickOpen
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_JoystickGetAttached(SDL_Joystick *joystick);
+func SDL_JoystickGetAttached(joystick *SDL_Joystick) bool {
+	return C.SDL_TRUE == C.SDL_JoystickGetAttached(joystick.cObj())
+}
+
+/**
+ * Get the instance ID of an opened joystick.
+ *
+ * \param joystick an SDL_Joystick structure containing joystick information
+ * \returns the instance ID of the specified joystick on success or a negative
+ *          error code on failure; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickOpen
+ */
+// extern DECLSPEC SDL_JoystickID SDLCALL SDL_JoystickInstanceID(SDL_Joystick *joystick);
+func SDL_JoystickInstanceID(joystick *SDL_Joystick) SDL_JoystickID {
+	return SDL_JoystickID(C.SDL_JoystickInstanceID(joystick.cObj()))
+}
+
+/**
+ * Get the number of general axis controls on a joystick.
+ *
+ * Often, the directional pad on a game controller will either look like 4
+ * separate buttons or a POV hat, and not axes, but all of this is up to the
+ * device and platform.
+ *
+ * \param joystick an SDL_Joystick structure containing joystick information
+ * \returns the number of axis controls/number of axes on success or a
+ *          negative error code on failure; call SDL_GetError() for more
+ *          information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickGetAxis
+ * \sa SDL_JoystickOpen
+ */
+// extern DECLSPEC int SDLCALL SDL_JoystickNumAxes(SDL_Joystick *joystick);
+func SDL_JoystickNumAxes(joystick *SDL_Joystick) (int, error) {
+	var n = C.SDL_JoystickNumAxes(joystick.cObj())
+	if n < 0 {
+		return 0, lastError("SDL_JoystickNumAxes")
+	}
+	return int(n), nil
+}
+
+/**
+ * Get the number of POV hats on a joystick.
+ *
+ * \param joystick an SDL_Joystick structure containing joystick information
+ * \returns the number of POV hats on success or a negative error code on
+ *          failure; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickGetHat
+ * \sa SDL_JoystickOpen
+ */
+// extern DECLSPEC int SDLCALL SDL_JoystickNumHats(SDL_Joystick *joystick);
+func SDL_JoystickNumHats(joystick *SDL_Joystick) (int, error) {
+	var n = C.SDL_JoystickNumHats(joystick.cObj())
+	if n < 0 {
+		return 0, lastError("SDL_JoystickNumHats")
+	}
+	return int(n), nil
+}
+
+/**
+ * Get the number of buttons on a joystick.
+ *
+ * \param joystick an SDL_Joystick structure containing joystick information
+ * \returns the number of buttons on success or a negative error code on
+ *          failure; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickGetButton
+ * \sa SDL_JoystickOpen
+ */
+// extern DECLSPEC int SDLCALL SDL_JoystickNumButtons(SDL_Joystick *joystick);
+func SDL_JoystickNumButtons(joystick *SDL_Joystick) (int, error) {
+	var n = C.SDL_JoystickNumButtons(joystick.cObj())
+	if n < 0 {
+		return 0, lastError("SDL_JoystickNumButtons")
+	}
+	return int(n), nil
+}
+
+/**
+ * Update the current state of the open joysticks.
+ *
+ * This is called automatically by the event loop if any joystick events are
+ * enabled.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickEventState
+ */
+// extern DECLSPEC void SDLCALL SDL_JoystickUpdate(void);
+func SDL_JoystickUpdate() {
+	C.SDL_JoystickUpdate()
+}
+
+/**
+ * Enable/disable joystick event polling.
+ *
+ * If joystick events are disabled, you must call SDL_JoystickUpdate()
+ * yourself and manually check the state of the joystick when you want
+ * joystick information.
+ *
+ * It is recommended that you leave joystick event handling enabled.
+ *
+ * **WARNING**: Calling this function may delete all events currently in SDL's
+ * event queue.
+ *
+ * \param state can be one of `SDL_QUERY`, `SDL_IGNORE`, or `SDL_ENABLE`
+ * \returns 1 if enabled, 0 if disabled, or a negative error code on failure;
+ *          call SDL_GetError() for more information.
+ *
+ *          If `state` is `SDL_QUERY` then the current state is returned,
+ *          otherwise the new processing state is returned.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GameControllerEventState
+ */
+// extern DECLSPEC int SDLCALL SDL_JoystickEventState(int state);
+func SDL_JoystickEventState(state int) (int, error) {
+	var r = C.SDL_JoystickEventState(C.int(state))
+	if r < 0 {
+		return 0, lastError("SDL_JoystickEventState")
+	}
+	return int(r), nil
+}
+
+/**
+ * Get the current state of an axis control on a joystick.
+ *
+ * SDL makes no promises about what part of the joystick any given axis refers
+ * to. Your game should have some sort of configuration UI to let users
+ * specify what each axis should be bound to. Alternately, SDL's higher-level
+ * Game Controller API makes a great effort to apply order to this lower-level
+ * interface, so you know that a specific axis is the "left thumb stick," etc.
+ *
+ * The value returned by SDL_JoystickGetAxis() is a signed integer (-32768 to
+ * 32767) representing the current position of the axis. It may be necessary
+ * to impose certain tolerances on these values to account for jitter.
+ *
+ * \param joystick an SDL_Joystick structure containing joystick information
+ * \param axis the axis to query; the axis indices start at index 0
+ * \returns a 16-bit signed integer representing the current position of the
+ *          axis or 0 on failure; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickNumAxes
+ */
+// extern DECLSPEC Sint16 SDLCALL SDL_JoystickGetAxis(SDL_Joystick *joystick,
+//                                                    int axis);
+func SDL_JoystickGetAxis(joystick *SDL_Joystick, axis int) int16 {
+	return int16(C.SDL_JoystickGetAxis(joystick.cObj(), C.int(axis)))
+}
+
+/**
+ *  \name Hat positions
+ */
+/* @{ */
+// #define SDL_HAT_CENTERED    0x00
+// #define SDL_HAT_UP          0x01
+// #define SDL_HAT_RIGHT       0x02
+// #define SDL_HAT_DOWN        0x04
+// #define SDL_HAT_LEFT        0x08
+// #define SDL_HAT_RIGHTUP     (SDL_HAT_RIGHT|SDL_HAT_UP)
+// #define SDL_HAT_RIGHTDOWN   (SDL_HAT_RIGHT|SDL_HAT_DOWN)
+// #define SDL_HAT_LEFTUP      (SDL_HAT_LEFT|SDL_HAT_UP)
+// #define SDL_HAT_LEFTDOWN    (SDL_HAT_LEFT|SDL_HAT_DOWN)
+/* @} */
+const (
+	SDL_HAT_CENTERED  uint8 = C.SDL_HAT_CENTERED
+	SDL_HAT_UP        uint8 = C.SDL_HAT_UP
+	SDL_HAT_RIGHT     uint8 = C.SDL_HAT_RIGHT
+	SDL_HAT_DOWN      uint8 = C.SDL_HAT_DOWN
+	SDL_HAT_LEFT      uint8 = C.SDL_HAT_LEFT
+	SDL_HAT_RIGHTUP   uint8 = C.SDL_HAT_RIGHTUP
+	SDL_HAT_RIGHTDOWN uint8 = C.SDL_HAT_RIGHTDOWN
+	SDL_HAT_LEFTUP    uint8 = C.SDL_HAT_LEFTUP
+	SDL_HAT_LEFTDOWN  uint8 = C.SDL_HAT_LEFTDOWN
+)
+
+/**
+ * Get the current state of a POV hat on a joystick.
+ *
+ * The returned value will be one of the following positions:
+ *
+ * - `SDL_HAT_CENTERED`
+ * - `SDL_HAT_UP`
+ * - `SDL_HAT_RIGHT`
+ * - `SDL_HAT_DOWN`
+ * - `SDL_HAT_LEFT`
+ * - `SDL_HAT_RIGHTUP`
+ * - `SDL_HAT_RIGHTDOWN`
+ * - `SDL_HAT_LEFTUP`
+ * - `SDL_HAT_LEFTDOWN`
+ *
+ * \param joystick an SDL_Joystick structure containing joystick information
+ * \param hat the hat index to get the state from; indices start at index 0
+ * \returns the current hat position.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickNumHats
+ */
+// extern DECLSPEC Uint8 SDLCALL SDL_JoystickGetHat(SDL_Joystick *joystick,
+//                                                  int hat);
+func SDL_JoystickGetHat(joystick *SDL_Joystick, hat int) uint8 {
+	return uint8(C.SDL_JoystickGetHat(joystick.cObj(), C.int(hat)))
+}
+
+/**
+ * Get the current state of a button on a joystick.
+ *
+ * \param joystick an SDL_Joystick structure containing joystick information
+ * \param button the button index to get the state from; indices start at
+ *               index 0
+ * \returns 1 if the specified button is pressed, 0 otherwise.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickNumButtons
+ */
+// extern DECLSPEC Uint8 SDLCALL SDL_JoystickGetButton(SDL_Joystick *joystick,
+//                                                     int button);
+func SDL_JoystickGetButton(joystick *SDL_Joystick, button int) uint8 {
+	return uint8(C.SDL_JoystickGetButton(joystick.cObj(), C.int(button)))
+}
+
+/**
+ * Start a rumble effect.
+ *
+ * Each call to this function cancels any previous rumble effect, and calling
+ * it with 0 intensity stops any rumbling.
+ *
+ * \param joystick The joystick to vibrate
+ * \param low_frequency_rumble The intensity of the low frequency (left)
+ *                             rumble motor, from 0 to 0xFFFF
+ * \param high_frequency_rumble The intensity of the high frequency (right)
+ *                              rumble motor, from 0 to 0xFFFF
+ * \param duration_ms The duration of the rumble effect, in milliseconds
+ * \returns 0, or -1 if rumble isn't supported on this joystick
+ *
+ * \since This function is available since SDL 2.0.9.
+ *
+ * \sa SDL_JoystickHasRumble
+ */
+// extern DECLSPEC int SDLCALL SDL_JoystickRumble(SDL_Joystick *joystick, Uint16 low_frequency_rumble, Uint16 high_frequency_rumble, Uint32 duration_ms);
+func SDL_JoystickRumble(joystick *SDL_Joystick, low_frequency_rumble, high_frequency_rumble uint16, duration_ms uint32) error {
+	return checkError("SDL_JoystickRumble", C.SDL_JoystickRumble(joystick.cObj(), C.Uint16(low_frequency_rumble), C.Uint16(high_frequency_rumble), C.Uint32(duration_ms)))
+}
+
+/**
+ * Start a rumble effect in the joystick's triggers
+ *
+ * Each call to this function cancels any previous trigger rumble effect, and
+ * calling it with 0 intensity stops any rumbling.
+ *
+ * Note that this is rumbling of the _triggers_ and not the game controller as
+ * a whole. This is currently only supported on Xbox One controllers. If you
+ * want the (more common) whole-controller rumble, use SDL_JoystickRumble()
+ * instead.
+ *
+ * \param joystick The joystick to vibrate
+ * \param left_rumble The intensity of the left trigger rumble motor, from 0
+ *                    to 0xFFFF
+ * \param right_rumble The intensity of the right trigger rumble motor, from 0
+ *                     to 0xFFFF
+ * \param duration_ms The duration of the rumble effect, in milliseconds
+ * \returns 0, or -1 if trigger rumble isn't supported on this joystick
+ *
+ * \since This function is available since SDL 2.0.14.
+ *
+ * \sa SDL_JoystickHasRumbleTriggers
+ */
+// extern DECLSPEC int SDLCALL SDL_JoystickRumbleTriggers(SDL_Joystick *joystick, Uint16 left_rumble, Uint16 right_rumble, Uint32 duration_ms);
+func SDL_JoystickRumbleTriggers(joystick *SDL_Joystick, left_rumble, right_rumble uint16, duration_ms uint32) error {
+	return checkError("SDL_JoystickRumbleTriggers", C.SDL_JoystickRumbleTriggers(joystick.cObj(), C.Uint16(left_rumble), C.Uint16(right_rumble), C.Uint32(duration_ms)))
+}
+
+/**
+ * Query whether a joystick has rumble support.
+ *
+ * \param joystick The joystick to query
+ * \return SDL_TRUE if the joystick has rumble, SDL_FALSE otherwise.
+ *
+ * \since This function is available since SDL 2.0.18.
+ *
+ * \sa SDL_JoystickRumble
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_JoystickHasRumble(SDL_Joystick *joystick);
+func SDL_JoystickHasRumble(joystick *SDL_Joystick) bool {
+	return C.SDL_TRUE == C.SDL_JoystickHasRumble(joystick.cObj())
+}
+
+/**
+ * Close a joystick previously opened with SDL_JoystickOpen().
+ *
+ * \param joystick The joystick device to close
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_JoystickOpen
+ */
+// extern DECLSPEC void SDLCALL SDL_JoystickClose(SDL_Joystick *joystick);
+func SDL_JoystickClose(joystick *SDL_Joystick) {
+	C.SDL_JoystickClose(joystick.cObj())
+}
+
+/**
+ * Get the battery level of a joystick as SDL_JoystickPowerLevel.
+ *
+ * \param joystick the SDL_Joystick to query
+ * \returns the current battery level as SDL_JoystickPowerLevel on success or
+ *          `SDL_JOYSTICK_POWER_UNKNOWN` if it is unknown
+ *
+ * \since This function is available since SDL 2.0.4.
+ */
+// extern DECLSPEC SDL_JoystickPowerLevel SDLCALL SDL_JoystickCurrentPowerLevel(SDL_Joystick *joystick);
+func SDL_JoystickCurrentPowerLevel(joystick *SDL_Joystick) SDL_JoystickPowerLevel {
+	return SDL_JoystickPowerLevel(C.SDL_JoystickCurrentPowerLevel(joystick.cObj()))
+}
@@ -0,0 +1,428 @@
+package sdl2
+
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
+// #include "SDL.h"
+import "C"
+import "unsafe"
+
+/**
+ *  \file SDL_keyboard.h
+ *
+ *  Include file for SDL keyboard event handling
+ */
+
+/**
+ *  \brief The SDL keysym structure, used in key events.
+ *
+ *  \note  If you are looking for translated character input, see the ::SDL_TEXTINPUT event.
+ */
+// typedef struct SDL_Keysym
+// {
+//     SDL_Scancode scancode;      /**< SDL physical key code - see ::SDL_Scancode for details */
+//     SDL_Keycode sym;            /**< SDL virtual key code - see ::SDL_Keycode for details */
+//     Uint16 mod;                 /**< current key modifiers */
+//     Uint32 unused;
+// } SDL_Keysym;
+type SDL_Keysym struct {
+	Scancode SDL_Scancode
+	Sym      SDL_Keycode
+	Mod      SDL_Keymod
+}
+
+func (o *SDL_Keysym) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_Keysym)(p)
+	o.Scancode = SDL_Scancode(p1.scancode)
+	o.Sym = SDL_Keycode(p1.sym)
+	o.Mod = SDL_Keymod(p1.mod)
+}
+
+func (o *SDL_Keysym) copyToCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_Keysym)(p)
+	p1.scancode = C.SDL_Scancode(o.Scancode)
+	p1.sym = C.SDL_Keycode(o.Sym)
+	p1.mod = C.Uint16(o.Mod)
+}
+
+/* Function prototypes */
+
+/**
+ * Query the window which currently has keyboard focus.
+ *
+ * \returns the window with keyboard focus.
+ *
+ * \since This function is available since SDL 2.0.0.
+ */
+// extern DECLSPEC SDL_Window * SDLCALL SDL_GetKeyboardFocus(void);
+func SDL_GetKeyboardFocus() *SDL_Window {
+	return wrapWindow(C.SDL_GetKeyboardFocus())
+}
+
+// SDL_KeyboardState is a snapshot of the keyboard, indexed by SDL_Scancode.
+type SDL_KeyboardState []uint8
+
+// Pressed reports whether the key was pressed when the snapshot was taken.
+func (o SDL_KeyboardState) Pressed(scancode SDL_Scancode) bool {
+	return scancode >= 0 && int(scancode) < len(o) && 0 != o[scancode]
+}
+
+/**
+ * Get a snapshot of the current state of the keyboard.
+ *
+ * The pointer returned is a pointer to an internal SDL array. It will be
+ * valid for the whole lifetime of the application and should not be freed by
+ * the caller.
+ *
+ * A array element with a value of 1 means that the key is pressed and a value
+ * of 0 means that it is not. Indexes into this array are obtained by using
+ * SDL_Scancode values.
+ *
+ * Use SDL_PumpEvents() to update the state array.
+ *
+ * This function gives you the current state after all events have been
+ * processed, so if a key or button has been pressed and released before you
+ * process events, then the pressed state will never show up in the
+ * SDL_GetKeyboardState() calls.
+ *
+ * Note: This function doesn't take into account whether shift has been
+ * pressed or not.
+ *
+ * \param numkeys if non-NULL, receives the length of the returned array
+ * \returns a pointer to an array of key states.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_PumpEvents
+ * \sa SDL_ResetKeyboard
+ */
+// extern DECLSPEC const Uint8 *SDLCALL SDL_GetKeyboardState(int *numkeys);
+//
+// The Go form returns a copy of the array, taken at the call.
+func SDL_GetKeyboardState() SDL_KeyboardState {
+
+	var numkeys C.int
+	var p = C.SDL_GetKeyboardState(&numkeys)
+
+	var r = make(SDL_KeyboardState, int(numkeys))
+	copy(r, unsafe.Slice((*uint8)(unsafe.Pointer(p)), int(numkeys)))
+
+	return r
+}
+
+/**
+ * Clear the state of the keyboard
+ *
+ * This function will generate key up events for all pressed keys.
+ *
+ * \since This function is available since SDL 2.24.0.
+ *
+ * \sa SDL_GetKeyboardState
+ */
+// extern DECLSPEC void SDLCALL SDL_ResetKeyboard(void);
+func SDL_ResetKeyboard() {
+	C.SDL_ResetKeyboard()
+}
+
+/**
+ * Get the current key modifier state for the keyboard.
+ *
+ * \returns an OR'd combination of the modifier keys for the keyboard. See
+ *          SDL_Keymod for details.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetKeyboardState
+ * \sa SDL_SetModState
+ */
+// extern DECLSPEC SDL_Keymod SDLCALL SDL_GetModState(void);
+func SDL_GetModState() SDL_Keymod {
+	return SDL_Keymod(C.SDL_GetModState())
+}
+
+/**
+ * Set the current key modifier state for the keyboard.
+ *
+ * The inverse of SDL_GetModState(), SDL_SetModState() allows you to impose
+ * modifier key states on your application. Simply pass your desired modifier
+ * states into `modstate`. This value may be a bitwise, OR'd combination of
+ * SDL_Keymod values.
+ *
+ * This does not change the keyboard state, only the key modifier flags that
+ * SDL reports.
+ *
+ * \param modstate the desired SDL_Keymod for the keyboard
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetModState
+ */
+// extern DECLSPEC void SDLCALL SDL_SetModState(SDL_Keymod modstate);
+func SDL_SetModState(modstate SDL_Keymod) {
+	C.SDL_SetModState(C.SDL_Keymod(modstate))
+}
+
+/**
+ * Get the key code corresponding to the given scancode according to the
+ * current keyboard layout.
+ *
+ * See SDL_Keycode for details.
+ *
+ * \param scancode the desired SDL_Scancode to query
+ * \returns the SDL_Keycode that corresponds to the given SDL_Scancode.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetKeyName
+ * \sa SDL_GetScancodeFromKey
+ */
+// extern DECLSPEC SDL_Keycode SDLCALL SDL_GetKeyFromScancode(SDL_Scancode scancode);
+func SDL_GetKeyFromScancode(scancode SDL_Scancode) SDL_Keycode {
+	return SDL_Keycode(C.SDL_GetKeyFromScancode(C.SDL_Scancode(scancode)))
+}
+
+/**
+ * Get the scancode corresponding to the given key code according to the
+ * current keyboard layout.
+ *
+ * See SDL_Scancode for details.
+ *
+ * \param key the desired SDL_Keycode to query
+ * \returns the SDL_Scancode that corresponds to the given SDL_Keycode.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetKeyFromScancode
+ * \sa SDL_GetScancodeName
+ */
+// extern DECLSPEC SDL_Scancode SDLCALL SDL_GetScancodeFromKey(SDL_Keycode key);
+func SDL_GetScancodeFromKey(key SDL_Keycode) SDL_Scancode {
+	return SDL_Scancode(C.SDL_GetScancodeFromKey(C.SDL_Keycode(key)))
+}
+
+/**
+ * Get a human-readable name for a scancode.
+ *
+ * See SDL_Scancode for details.
+ *
+ * **Warning**: The returned name is by design not stable across platforms,
+ * e.g. the name for `SDL_SCANCODE_LGUI` is "Left GUI" under Linux but "Left
+ * Windows" under Microsoft Windows, and some scancodes like
+ * `SDL_SCANCODE_NONUSBACKSLASH` don't have any name at all. There are even
+ * scancodes that share names, e.g. `SDL_SCANCODE_RETURN` and
+ * `SDL_SCANCODE_RETURN2` (both called "Return"). This function is therefore
+ * unsuitable for creating a stable cross-platform two-way mapping between
+ * strings and scancodes.
+ *
+ * \param scancode the desired SDL_Scancode to query
+ * \returns a pointer to the name for the scancode. If the scancode doesn't
+ *          have a name this function returns an empty string ("").
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetScancodeFromKey
+ * \sa SDL_GetScancodeFromName
+ */
+// extern DECLSPEC const char *SDLCALL SDL_GetScancodeName(SDL_Scancode scancode);
+func SDL_GetScancodeName(scancode SDL_Scancode) string {
+	return C.GoString(C.SDL_GetScancodeName(C.SDL_Scancode(scancode)))
+}
+
+/**
+ * Get a scancode from a human-readable name.
+ *
+ * \param name the human-readable scancode name
+ * \returns the SDL_Scancode, or `SDL_SCANCODE_UNKNOWN` if the name wasn't
+ *          recognized; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetKeyFromName
+ * \sa SDL_GetScancodeFromKey
+ * \sa SDL_GetScancodeName
+ */
+// extern DECLSPEC SDL_Scancode SDLCALL SDL_GetScancodeFromName(const char *name);
+func SDL_GetScancodeFromName(name string) SDL_Scancode {
+
+	var name_c = C.CString(name)
+	defer C.free(unsafe.Pointer(name_c))
+
+	return SDL_Scancode(C.SDL_GetScancodeFromName(name_c))
+}
+
+/**
+ * Get a human-readable name for a key.
+ *
+ * See SDL_Scancode and SDL_Keycode for details.
+ *
+ * \param key the desired SDL_Keycode to query
+ * \returns a pointer to a UTF-8 string that stays valid at least until the
+ *          next call to this function. If you need it around any longer, you
+ *          must copy it. If the key doesn't have a name, this function
+ *          returns an empty string ("").
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetKeyFromName
+ * \sa SDL_GetKeyFromScancode
+ * \sa SDL_GetScancodeFromKey
+ */
+// extern DECLSPEC const char *SDLCALL SDL_GetKeyName(SDL_Keycode key);
+func SDL_GetKeyName(key SDL_Keycode) string {
+	return C.GoString(C.SDL_GetKeyName(C.SDL_Keycode(key)))
+}
+
+/**
+ * Get a key code from a human-readable name.
+ *
+ * \param name the human-readable key name
+ * \returns key code, or `SDLK_UNKNOWN` if the name wasn't recognized; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetKeyFromScancode
+ * \sa SDL_GetKeyName
+ * \sa SDL_GetScancodeFromName
+ */
+// extern DECLSPEC SDL_Keycode SDLCALL SDL_GetKeyFromName(const char *name);
+func SDL_GetKeyFromName(name string) SDL_Keycode {
+
+	var name_c = C.CString(name)
+	defer C.free(unsafe.Pointer(name_c))
+
+	return SDL_Keycode(C.SDL_GetKeyFromName(name_c))
+}
+
+/**
+ * Start accepting Unicode text input events.
+ *
+ * This function will start accepting Unicode text input events in the focused
+ * SDL window, and start emitting SDL_TextInputEvent (SDL_TEXTINPUT) and
+ * SDL_TextEditingEvent (SDL_TEXTEDITING) events. Please use this function in
+ * pair with SDL_StopTextInput().
+ *
+ * On some platforms using this function activates the screen keyboard.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_SetTextInputRect
+ * \sa SDL_StopTextInput
+ */
+// extern DECLSPEC void SDLCALL SDL_StartTextInput(void);
+func SDL_StartTextInput() {
+	C.SDL_StartTextInput()
+}
+
+/**
+ * Check whether or not Unicode text input events are enabled.
+ *
+ * \returns SDL_TRUE if text input events are enabled else SDL_FALSE.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_StartTextInput
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_IsTextInputActive(void);
+func SDL_IsTextInputActive() bool {
+	return C.SDL_TRUE == C.SDL_IsTextInputActive()
+}
+
+/**
+ * Stop receiving any text input events.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_StartTextInput
+ */
+// extern DECLSPEC void SDLCALL SDL_StopTextInput(void);
+func SDL_StopTextInput() {
+	C.SDL_StopTextInput()
+}
+
+/**
+ * Dismiss the composition window/IME without disabling the subsystem.
+ *
+ * \since This function is available since SDL 2.0.22.
+ *
+ * \sa SDL_StartTextInput
+ * \sa SDL_StopTextInput
+ */
+// extern DECLSPEC void SDLCALL SDL_ClearComposition(void);
+func SDL_ClearComposition() {
+	C.SDL_ClearComposition()
+}
+
+/**
+ * Returns if an IME Composite or Candidate window is currently shown.
+ *
+ * \since This function is available since SDL 2.0.22.
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_IsTextInputShown(void);
+func SDL_IsTextInputShown() bool {
+	return C.SDL_TRUE == C.SDL_IsTextInputShown()
+}
+
+/**
+ * Set the rectangle used to type Unicode text inputs.
+ *
+ * To start text input in a given location, this function is intended to be
+ * called before SDL_StartTextInput, although some platforms support moving
+ * the rectangle even while text input (and a composition) is active.
+ *
+ * Note: If you want to use the system native IME window, try setting hint
+ * **SDL_HINT_IME_SHOW_UI** to **1**, otherwise this function won't give you
+ * any feedback.
+ *
+ * \param rect the SDL_Rect structure representing the rectangle to receive
+ *             text (ignored if NULL)
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_StartTextInput
+ */
+// extern DECLSPEC void SDLCALL SDL_SetTextInputRect(const SDL_Rect *rect);
+func SDL_SetTextInputRect(rect *SDL_Rect) {
+
+	var rect1 C.SDL_Rect
+	var pRect1 *C.SDL_Rect
+	if nil != rect {
+		rect.copyToCObj(unsafe.Pointer(&rect1))
+		pRect1 = &rect1
+	}
+
+	C.SDL_SetTextInputRect(pRect1)
+}
+
+/**
+ * Check whether the platform has screen keyboard support.
+ *
+ * \returns SDL_TRUE if the platform has some screen keyboard support or
+ *          SDL_FALSE if not.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_StartTextInput
+ * \sa SDL_IsScreenKeyboardShown
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_HasScreenKeyboardSupport(void);
+func SDL_HasScreenKeyboardSupport() bool {
+	return C.SDL_TRUE == C.SDL_HasScreenKeyboardSupport()
+}
+
+/**
+ * Check whether the screen keyboard is shown for given window.
+ *
+ * \param window the window for which screen keyboard should be queried
+ * \returns SDL_TRUE if screen keyboard is shown or SDL_FALSE if not.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_HasScreenKeyboardSupport
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_IsScreenKeyboardShown(SDL_Window *window);
+func SDL_IsScreenKeyboardShown(window *SDL_Window) bool {
+	return C.SDL_TRUE == C.SDL_IsScreenKeyboardShown(window.cObj())
+}
@@ -0,0 +1,326 @@
+package sdl2
+
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
+// #include "SDL.h"
+import "C"
+
+/**
+ *  \file SDL_keycode.h
+ *
+ *  Defines constants which identify keyboard keys and modifiers.
+ */
+
+/**
+ *  \brief The SDL virtual key representation.
+ *
+ *  Values of this type are used to represent keyboard keys using the current
+ *  layout of the keyboard.  These values include Unicode values representing
+ *  the unmodified character that would be generated by pressing the key, or
+ *  an SDLK_* constant for those keys that do not generate characters.
+ *
+ *  A special exception is the number keys at the top of the keyboard which
+ *  always map to SDLK_0...SDLK_9, regardless of layout.
+ */
+// typedef Sint32 SDL_Keycode;
+type SDL_Keycode int32
+
+// #define SDLK_SCANCODE_MASK (1<<30)
+// #define SDL_SCANCODE_TO_KEYCODE(X)  (X | SDLK_SCANCODE_MASK)
+const SDLK_SCANCODE_MASK SDL_Keycode = C.SDLK_SCANCODE_MASK
+
+func SDL_SCANCODE_TO_KEYCODE(x SDL_Scancode) SDL_Keycode {
+	return SDL_Keycode(x) | SDLK_SCANCODE_MASK
+}
+
+// typedef enum
+// {
+//     SDLK_UNKNOWN = 0,
+//     ...
+// } SDL_KeyCode;
+
+const (
+	SDLK_UNKNOWN            SDL_Keycode = C.SDLK_UNKNOWN
+	SDLK_RETURN             SDL_Keycode = C.SDLK_RETURN
+	SDLK_ESCAPE             SDL_Keycode = C.SDLK_ESCAPE
+	SDLK_BACKSPACE          SDL_Keycode = C.SDLK_BACKSPACE
+	SDLK_TAB                SDL_Keycode = C.SDLK_TAB
+	SDLK_SPACE              SDL_Keycode = C.SDLK_SPACE
+	SDLK_EXCLAIM            SDL_Keycode = C.SDLK_EXCLAIM
+	SDLK_QUOTEDBL           SDL_Keycode = C.SDLK_QUOTEDBL
+	SDLK_HASH               SDL_Keycode = C.SDLK_HASH
+	SDLK_PERCENT            SDL_Keycode = C.SDLK_PERCENT
+	SDLK_DOLLAR             SDL_Keycode = C.SDLK_DOLLAR
+	SDLK_AMPERSAND          SDL_Keycode = C.SDLK_AMPERSAND
+	SDLK_QUOTE              SDL_Keycode = C.SDLK_QUOTE
+	SDLK_LEFTPAREN          SDL_Keycode = C.SDLK_LEFTPAREN
+	SDLK_RIGHTPAREN         SDL_Keycode = C.SDLK_RIGHTPAREN
+	SDLK_ASTERISK           SDL_Keycode = C.SDLK_ASTERISK
+	SDLK_PLUS               SDL_Keycode = C.SDLK_PLUS
+	SDLK_COMMA              SDL_Keycode = C.SDLK_COMMA
+	SDLK_MINUS              SDL_Keycode = C.SDLK_MINUS
+	SDLK_PERIOD             SDL_Keycode = C.SDLK_PERIOD
+	SDLK_SLASH              SDL_Keycode = C.SDLK_SLASH
+	SDLK_0                  SDL_Keycode = C.SDLK_0
+	SDLK_1                  SDL_Keycode = C.SDLK_1
+	SDLK_2                  SDL_Keycode = C.SDLK_2
+	SDLK_3                  SDL_Keycode = C.SDLK_3
+	SDLK_4                  SDL_Keycode = C.SDLK_4
+	SDLK_5                  SDL_Keycode = C.SDLK_5
+	SDLK_6                  SDL_Keycode = C.SDLK_6
+	SDLK_7                  SDL_Keycode = C.SDLK_7
+	SDLK_8                  SDL_Keycode = C.SDLK_8
+	SDLK_9                  SDL_Keycode = C.SDLK_9
+	SDLK_COLON              SDL_Keycode = C.SDLK_COLON
+	SDLK_SEMICOLON          SDL_Keycode = C.SDLK_SEMICOLON
+	SDLK_LESS               SDL_Keycode = C.SDLK_LESS
+	SDLK_EQUALS             SDL_Keycode = C.SDLK_EQUALS
+	SDLK_GREATER            SDL_Keycode = C.SDLK_GREATER
+	SDLK_QUESTION           SDL_Keycode = C.SDLK_QUESTION
+	SDLK_AT                 SDL_Keycode = C.SDLK_AT
+	SDLK_LEFTBRACKET        SDL_Keycode = C.SDLK_LEFTBRACKET
+	SDLK_BACKSLASH          SDL_Keycode = C.SDLK_BACKSLASH
+	SDLK_RIGHTBRACKET       SDL_Keycode = C.SDLK_RIGHTBRACKET
+	SDLK_CARET              SDL_Keycode = C.SDLK_CARET
+	SDLK_UNDERSCORE         SDL_Keycode = C.SDLK_UNDERSCORE
+	SDLK_BACKQUOTE          SDL_Keycode = C.SDLK_BACKQUOTE
+	SDLK_a                  SDL_Keycode = C.SDLK_a
+	SDLK_b                  SDL_Keycode = C.SDLK_b
+	SDLK_c                  SDL_Keycode = C.SDLK_c
+	SDLK_d                  SDL_Keycode = C.SDLK_d
+	SDLK_e                  SDL_Keycode = C.SDLK_e
+	SDLK_f                  SDL_Keycode = C.SDLK_f
+	SDLK_g                  SDL_Keycode = C.SDLK_g
+	SDLK_h                  SDL_Keycode = C.SDLK_h
+	SDLK_i                  SDL_Keycode = C.SDLK_i
+	SDLK_j                  SDL_Keycode = C.SDLK_j
+	SDLK_k                  SDL_Keycode = C.SDLK_k
+	SDLK_l                  SDL_Keycode = C.SDLK_l
+	SDLK_m                  SDL_Keycode = C.SDLK_m
+	SDLK_n                  SDL_Keycode = C.SDLK_n
+	SDLK_o                  SDL_Keycode = C.SDLK_o
+	SDLK_p                  SDL_Keycode = C.SDLK_p
+	SDLK_q                  SDL_Keycode = C.SDLK_q
+	SDLK_r                  SDL_Keycode = C.SDLK_r
+	SDLK_s                  SDL_Keycode = C.SDLK_s
+	SDLK_t                  SDL_Keycode = C.SDLK_t
+	SDLK_u                  SDL_Keycode = C.SDLK_u
+	SDLK_v                  SDL_Keycode = C.SDLK_v
+	SDLK_w                  SDL_Keycode = C.SDLK_w
+	SDLK_x                  SDL_Keycode = C.SDLK_x
+	SDLK_y                  SDL_Keycode = C.SDLK_y
+	SDLK_z                  SDL_Keycode = C.SDLK_z
+	SDLK_CAPSLOCK           SDL_Keycode = C.SDLK_CAPSLOCK
+	SDLK_F1                 SDL_Keycode = C.SDLK_F1
+	SDLK_F2                 SDL_Keycode = C.SDLK_F2
+	SDLK_F3                 SDL_Keycode = C.SDLK_F3
+	SDLK_F4                 SDL_Keycode = C.SDLK_F4
+	SDLK_F5                 SDL_Keycode = C.SDLK_F5
+	SDLK_F6                 SDL_Keycode = C.SDLK_F6
+	SDLK_F7                 SDL_Keycode = C.SDLK_F7
+	SDLK_F8                 SDL_Keycode = C.SDLK_F8
+	SDLK_F9                 SDL_Keycode = C.SDLK_F9
+	SDLK_F10                SDL_Keycode = C.SDLK_F10
+	SDLK_F11                SDL_Keycode = C.SDLK_F11
+	SDLK_F12                SDL_Keycode = C.SDLK_F12
+	SDLK_PRINTSCREEN        SDL_Keycode = C.SDLK_PRINTSCREEN
+	SDLK_SCROLLLOCK         SDL_Keycode = C.SDLK_SCROLLLOCK
+	SDLK_PAUSE              SDL_Keycode = C.SDLK_PAUSE
+	SDLK_INSERT             SDL_Keycode = C.SDLK_INSERT
+	SDLK_HOME               SDL_Keycode = C.SDLK_HOME
+	SDLK_PAGEUP             SDL_Keycode = C.SDLK_PAGEUP
+	SDLK_DELETE             SDL_Keycode = C.SDLK_DELETE
+	SDLK_END                SDL_Keycode = C.SDLK_END
+	SDLK_PAGEDOWN           SDL_Keycode = C.SDLK_PAGEDOWN
+	SDLK_RIGHT              SDL_Keycode = C.SDLK_RIGHT
+	SDLK_LEFT               SDL_Keycode = C.SDLK_LEFT
+	SDLK_DOWN               SDL_Keycode = C.SDLK_DOWN
+	SDLK_UP                 SDL_Keycode = C.SDLK_UP
+	SDLK_NUMLOCKCLEAR       SDL_Keycode = C.SDLK_NUMLOCKCLEAR
+	SDLK_KP_DIVIDE          SDL_Keycode = C.SDLK_KP_DIVIDE
+	SDLK_KP_MULTIPLY        SDL_Keycode = C.SDLK_KP_MULTIPLY
+	SDLK_KP_MINUS           SDL_Keycode = C.SDLK_KP_MINUS
+	SDLK_KP_PLUS            SDL_Keycode = C.SDLK_KP_PLUS
+	SDLK_KP_ENTER           SDL_Keycode = C.SDLK_KP_ENTER
+	SDLK_KP_1               SDL_Keycode = C.SDLK_KP_1
+	SDLK_KP_2               SDL_Keycode = C.SDLK_KP_2
+	SDLK_KP_3               SDL_Keycode = C.SDLK_KP_3
+	SDLK_KP_4               SDL_Keycode = C.SDLK_KP_4
+	SDLK_KP_5               SDL_Keycode = C.SDLK_KP_5
+	SDLK_KP_6               SDL_Keycode = C.SDLK_KP_6
+	SDLK_KP_7               SDL_Keycode = C.SDLK_KP_7
+	SDLK_KP_8               SDL_Keycode = C.SDLK_KP_8
+	SDLK_KP_9               SDL_Keycode = C.SDLK_KP_9
+	SDLK_KP_0               SDL_Keycode = C.SDLK_KP_0
+	SDLK_KP_PERIOD          SDL_Keycode = C.SDLK_KP_PERIOD
+	SDLK_APPLICATION        SDL_Keycode = C.SDLK_APPLICATION
+	SDLK_POWER              SDL_Keycode = C.SDLK_POWER
+	SDLK_KP_EQUALS          SDL_Keycode = C.SDLK_KP_EQUALS
+	SDLK_F13                SDL_Keycode = C.SDLK_F13
+	SDLK_F14                SDL_Keycode = C.SDLK_F14
+	SDLK_F15                SDL_Keycode = C.SDLK_F15
+	SDLK_F16                SDL_Keycode = C.SDLK_F16
+	SDLK_F17                SDL_Keycode = C.SDLK_F17
+	SDLK_F18                SDL_Keycode = C.SDLK_F18
+	SDLK_F19                SDL_Keycode = C.SDLK_F19
+	SDLK_F20                SDL_Keycode = C.SDLK_F20
+	SDLK_F21                SDL_Keycode = C.SDLK_F21
+	SDLK_F22                SDL_Keycode = C.SDLK_F22
+	SDLK_F23                SDL_Keycode = C.SDLK_F23
+	SDLK_F24                SDL_Keycode = C.SDLK_F24
+	SDLK_EXECUTE            SDL_Keycode = C.SDLK_EXECUTE
+	SDLK_HELP               SDL_Keycode = C.SDLK_HELP
+	SDLK_MENU               SDL_Keycode = C.SDLK_MENU
+	SDLK_SELECT             SDL_Keycode = C.SDLK_SELECT
+	SDLK_STOP               SDL_Keycode = C.SDLK_STOP
+	SDLK_AGAIN              SDL_Keycode = C.SDLK_AGAIN
+	SDLK_UNDO               SDL_Keycode = C.SDLK_UNDO
+	SDLK_CUT                SDL_Keycode = C.SDLK_CUT
+	SDLK_COPY               SDL_Keycode = C.SDLK_COPY
+	SDLK_PASTE              SDL_Keycode = C.SDLK_PASTE
+	SDLK_FIND               SDL_Keycode = C.SDLK_FIND
+	SDLK_MUTE               SDL_Keycode = C.SDLK_MUTE
+	SDLK_VOLUMEUP           SDL_Keycode = C.SDLK_VOLUMEUP
+	SDLK_VOLUMEDOWN         SDL_Keycode = C.SDLK_VOLUMEDOWN
+	SDLK_KP_COMMA           SDL_Keycode = C.SDLK_KP_COMMA
+	SDLK_KP_EQUALSAS400     SDL_Keycode = C.SDLK_KP_EQUALSAS400
+	SDLK_ALTERASE           SDL_Keycode = C.SDLK_ALTERASE
+	SDLK_SYSREQ             SDL_Keycode = C.SDLK_SYSREQ
+	SDLK_CANCEL             SDL_Keycode = C.SDLK_CANCEL
+	SDLK_CLEAR              SDL_Keycode = C.SDLK_CLEAR
+	SDLK_PRIOR              SDL_Keycode = C.SDLK_PRIOR
+	SDLK_RETURN2            SDL_Keycode = C.SDLK_RETURN2
+	SDLK_SEPARATOR          SDL_Keycode = C.SDLK_SEPARATOR
+	SDLK_OUT                SDL_Keycode = C.SDLK_OUT
+	SDLK_OPER               SDL_Keycode = C.SDLK_OPER
+	SDLK_CLEARAGAIN         SDL_Keycode = C.SDLK_CLEARAGAIN
+	SDLK_CRSEL              SDL_Keycode = C.SDLK_CRSEL
+	SDLK_EXSEL              SDL_Keycode = C.SDLK_EXSEL
+	SDLK_KP_00              SDL_Keycode = C.SDLK_KP_00
+	SDLK_KP_000             SDL_Keycode = C.SDLK_KP_000
+	SDLK_THOUSANDSSEPARATOR SDL_Keycode = C.SDLK_THOUSANDSSEPARATOR
+	SDLK_DECIMALSEPARATOR   SDL_Keycode = C.SDLK_DECIMALSEPARATOR
+	SDLK_CURRENCYUNIT       SDL_Keycode = C.SDLK_CURRENCYUNIT
+	SDLK_CURRENCYSUBUNIT    SDL_Keycode = C.SDLK_CURRENCYSUBUNIT
+	SDLK_KP_LEFTPAREN       SDL_Keycode = C.SDLK_KP_LEFTPAREN
+	SDLK_KP_RIGHTPAREN      SDL_Keycode = C.SDLK_KP_RIGHTPAREN
+	SDLK_KP_LEFTBRACE       SDL_Keycode = C.SDLK_KP_LEFTBRACE
+	SDLK_KP_RIGHTBRACE      SDL_Keycode = C.SDLK_KP_RIGHTBRACE
+	SDLK_KP_TAB             SDL_Keycode = C.SDLK_KP_TAB
+	SDLK_KP_BACKSPACE       SDL_Keycode = C.SDLK_KP_BACKSPACE
+	SDLK_KP_A               SDL_Keycode = C.SDLK_KP_A
+	SDLK_KP_B               SDL_Keycode = C.SDLK_KP_B
+	SDLK_KP_C               SDL_Keycode = C.SDLK_KP_C
+	SDLK_KP_D               SDL_Keycode = C.SDLK_KP_D
+	SDLK_KP_E               SDL_Keycode = C.SDLK_KP_E
+	SDLK_KP_F               SDL_Keycode = C.SDLK_KP_F
+	SDLK_KP_XOR             SDL_Keycode = C.SDLK_KP_XOR
+	SDLK_KP_POWER           SDL_Keycode = C.SDLK_KP_POWER
+	SDLK_KP_PERCENT         SDL_Keycode = C.SDLK_KP_PERCENT
+	SDLK_KP_LESS            SDL_Keycode = C.SDLK_KP_LESS
+	SDLK_KP_GREATER         SDL_Keycode = C.SDLK_KP_GREATER
+	SDLK_KP_AMPERSAND       SDL_Keycode = C.SDLK_KP_AMPERSAND
+	SDLK_KP_DBLAMPERSAND    SDL_Keycode = C.SDLK_KP_DBLAMPERSAND
+	SDLK_KP_VERTICALBAR     SDL_Keycode = C.SDLK_KP_VERTICALBAR
+	SDLK_KP_DBLVERTICALBAR  SDL_Keycode = C.SDLK_KP_DBLVERTICALBAR
+	SDLK_KP_COLON           SDL_Keycode = C.SDLK_KP_COLON
+	SDLK_KP_HASH            SDL_Keycode = C.SDLK_KP_HASH
+	SDLK_KP_SPACE           SDL_Keycode = C.SDLK_KP_SPACE
+	SDLK_KP_AT              SDL_Keycode = C.SDLK_KP_AT
+	SDLK_KP_EXCLAM          SDL_Keycode = C.SDLK_KP_EXCLAM
+	SDLK_KP_MEMSTORE        SDL_Keycode = C.SDLK_KP_MEMSTORE
+	SDLK_KP_MEMRECALL       SDL_Keycode = C.SDLK_KP_MEMRECALL
+	SDLK_KP_MEMCLEAR        SDL_Keycode = C.SDLK_KP_MEMCLEAR
+	SDLK_KP_MEMADD          SDL_Keycode = C.SDLK_KP_MEMADD
+	SDLK_KP_MEMSUBTRACT     SDL_Keycode = C.SDLK_KP_MEMSUBTRACT
+	SDLK_KP_MEMMULTIPLY     SDL_Keycode = C.SDLK_KP_MEMMULTIPLY
+	SDLK_KP_MEMDIVIDE       SDL_Keycode = C.SDLK_KP_MEMDIVIDE
+	SDLK_KP_PLUSMINUS       SDL_Keycode = C.SDLK_KP_PLUSMINUS
+	SDLK_KP_CLEAR           SDL_Keycode = C.SDLK_KP_CLEAR
+	SDLK_KP_CLEARENTRY      SDL_Keycode = C.SDLK_KP_CLEARENTRY
+	SDLK_KP_BINARY          SDL_Keycode = C.SDLK_KP_BINARY
+	SDLK_KP_OCTAL           SDL_Keycode = C.SDLK_KP_OCTAL
+	SDLK_KP_DECIMAL         SDL_Keycode = C.SDLK_KP_DECIMAL
+	SDLK_KP_HEXADECIMAL     SDL_Keycode = C.SDLK_KP_HEXADECIMAL
+	SDLK_LCTRL              SDL_Keycode = C.SDLK_LCTRL
+	SDLK_LSHIFT             SDL_Keycode = C.SDLK_LSHIFT
+	SDLK_LALT               SDL_Keycode = C.SDLK_LALT
+	SDLK_LGUI               SDL_Keycode = C.SDLK_LGUI
+	SDLK_RCTRL              SDL_Keycode = C.SDLK_RCTRL
+	SDLK_RSHIFT             SDL_Keycode = C.SDLK_RSHIFT
+	SDLK_RALT               SDL_Keycode = C.SDLK_RALT
+	SDLK_RGUI               SDL_Keycode = C.SDLK_RGUI
+	SDLK_MODE               SDL_Keycode = C.SDLK_MODE
+	SDLK_AUDIONEXT          SDL_Keycode = C.SDLK_AUDIONEXT
+	SDLK_AUDIOPREV          SDL_Keycode = C.SDLK_AUDIOPREV
+	SDLK_AUDIOSTOP          SDL_Keycode = C.SDLK_AUDIOSTOP
+	SDLK_AUDIOPLAY          SDL_Keycode = C.SDLK_AUDIOPLAY
+	SDLK_AUDIOMUTE          SDL_Keycode = C.SDLK_AUDIOMUTE
+	SDLK_MEDIASELECT        SDL_Keycode = C.SDLK_MEDIASELECT
+	SDLK_WWW                SDL_Keycode = C.SDLK_WWW
+	SDLK_MAIL               SDL_Keycode = C.SDLK_MAIL
+	SDLK_CALCULATOR         SDL_Keycode = C.SDLK_CALCULATOR
+	SDLK_COMPUTER           SDL_Keycode = C.SDLK_COMPUTER
+	SDLK_AC_SEARCH          SDL_Keycode = C.SDLK_AC_SEARCH
+	SDLK_AC_HOME            SDL_Keycode = C.SDLK_AC_HOME
+	SDLK_AC_BACK            SDL_Keycode = C.SDLK_AC_BACK
+	SDLK_AC_FORWARD         SDL_Keycode = C.SDLK_AC_FORWARD
+	SDLK_AC_STOP            SDL_Keycode = C.SDLK_AC_STOP
+	SDLK_AC_REFRESH         SDL_Keycode = C.SDLK_AC_REFRESH
+	SDLK_AC_BOOKMARKS       SDL_Keycode = C.SDLK_AC_BOOKMARKS
+	SDLK_BRIGHTNESSDOWN     SDL_Keycode = C.SDLK_BRIGHTNESSDOWN
+	SDLK_BRIGHTNESSUP       SDL_Keycode = C.SDLK_BRIGHTNESSUP
+	SDLK_DISPLAYSWITCH      SDL_Keycode = C.SDLK_DISPLAYSWITCH
+	SDLK_KBDILLUMTOGGLE     SDL_Keycode = C.SDLK_KBDILLUMTOGGLE
+	SDLK_KBDILLUMDOWN       SDL_Keycode = C.SDLK_KBDILLUMDOWN
+	SDLK_KBDILLUMUP         SDL_Keycode = C.SDLK_KBDILLUMUP
+	SDLK_EJECT              SDL_Keycode = C.SDLK_EJECT
+	SDLK_SLEEP              SDL_Keycode = C.SDLK_SLEEP
+	SDLK_APP1               SDL_Keycode = C.SDLK_APP1
+	SDLK_APP2               SDL_Keycode = C.SDLK_APP2
+	SDLK_AUDIOREWIND        SDL_Keycode = C.SDLK_AUDIOREWIND
+	SDLK_AUDIOFASTFORWARD   SDL_Keycode = C.SDLK_AUDIOFASTFORWARD
+	SDLK_SOFTLEFT           SDL_Keycode = C.SDLK_SOFTLEFT
+	SDLK_SOFTRIGHT          SDL_Keycode = C.SDLK_SOFTRIGHT
+	SDLK_CALL               SDL_Keycode = C.SDLK_CALL
+	SDLK_ENDCALL            SDL_Keycode = C.SDLK_ENDCALL
+)
+
+// String returns the name of the key, see SDL_GetKeyName.
+func (o SDL_Keycode) String() string {
+	return SDL_GetKeyName(o)
+}
+
+/**
+ * \brief Enumeration of valid key mods (possibly OR'd together).
+ */
+// typedef enum
+// {
+//     KMOD_NONE = 0x0000,
+//     ...
+// } SDL_Keymod;
+type SDL_Keymod uint16
+
+const (
+	KMOD_NONE     SDL_Keymod = C.KMOD_NONE
+	KMOD_LSHIFT   SDL_Keymod = C.KMOD_LSHIFT
+	KMOD_RSHIFT   SDL_Keymod = C.KMOD_RSHIFT
+	KMOD_LCTRL    SDL_Keymod = C.KMOD_LCTRL
+	KMOD_RCTRL    SDL_Keymod = C.KMOD_RCTRL
+	KMOD_LALT     SDL_Keymod = C.KMOD_LALT
+	KMOD_RALT     SDL_Keymod = C.KMOD_RALT
+	KMOD_LGUI     SDL_Keymod = C.KMOD_LGUI
+	KMOD_RGUI     SDL_Keymod = C.KMOD_RGUI
+	KMOD_NUM      SDL_Keymod = C.KMOD_NUM
+	KMOD_CAPS     SDL_Keymod = C.KMOD_CAPS
+	KMOD_MODE     SDL_Keymod = C.KMOD_MODE
+	KMOD_SCROLL   SDL_Keymod = C.KMOD_SCROLL
+	KMOD_CTRL     SDL_Keymod = C.KMOD_CTRL
+	KMOD_SHIFT    SDL_Keymod = C.KMOD_SHIFT
+	KMOD_ALT      SDL_Keymod = C.KMOD_ALT
+	KMOD_GUI      SDL_Keymod = C.KMOD_GUI
+	KMOD_RESERVED SDL_Keymod = C.KMOD_RESERVED /**< This is for source-level compatibility with SDL 2.0.0. */
+)
@@ -0,0 +1,387 @@
+package sdl2
+
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
+// #include "SDL.h"
+//
+// extern void goLogOutput(int, SDL_LogPriority, char*);
+//
+// static void SDLCALL _SDL_LogOutput(void *userdata, int category, SDL_LogPriority priority, const char *message) {
+//     goLogOutput(category, priority, (char*)message);
+// }
+//
+// static SDL_LogOutputFunction _SDL_LogDefault;
+// static void *_SDL_LogDefaultData;
+//
+// static void _SDL_LogSetOutputFunction(int set) {
+//     if (NULL == _SDL_LogDefault) {
+//         SDL_LogGetOutputFunction(&_SDL_LogDefault, &_SDL_LogDefaultData);
+//     }
+//     if (set) {
+//         SDL_LogSetOutputFunction(_SDL_LogOutput, NULL);
+//     } else {
+//         SDL_LogSetOutputFunction(_SDL_LogDefault, _SDL_LogDefaultData);
+//     }
+// }
+//
+// static void _SDL_LogMessage(int category, SDL_LogPriority priority, const char *message) {
+//     SDL_LogMessage(category, priority, "%s", message);
+// }
+import "C"
+
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+)
+
+/**
+ *  \file SDL_log.h
+ *
+ *  Simple log messages with categories and priorities.
+ *
+ *  By default logs are quiet, but if you're debugging SDL you might want:
+ *
+ *      SDL_LogSetAllPriority(SDL_LOG_PRIORITY_WARN);
+ *
+ *  Here's where the messages go on different platforms:
+ *      Windows: debug output stream
+ *      Android: log output
+ *      Others: standard error output (stderr)
+ */
+
+/**
+ *  \brief The maximum size of a log message prior to SDL 2.0.24
+ *
+ *  As of 2.0.24 there is no limit to the length of SDL log messages.
+ */
+// #define SDL_MAX_LOG_MESSAGE 4096
+
+/**
+ *  \brief The predefined log categories
+ *
+ *  By default the application category is enabled at the INFO level,
+ *  the assert category is enabled at the WARN level, test is enabled
+ *  at the VERBOSE level and all other categories are enabled at the
+ *  CRITICAL level.
+ */
+// typedef enum
+// {
+//     SDL_LOG_CATEGORY_APPLICATION,
+//     SDL_LOG_CATEGORY_ERROR,
+//     SDL_LOG_CATEGORY_ASSERT,
+//     SDL_LOG_CATEGORY_SYSTEM,
+//     SDL_LOG_CATEGORY_AUDIO,
+//     SDL_LOG_CATEGORY_VIDEO,
+//     SDL_LOG_CATEGORY_RENDER,
+//     SDL_LOG_CATEGORY_INPUT,
+//     SDL_LOG_CATEGORY_TEST,
+//
+//     /* Reserved for future SDL library use */
+//     SDL_LOG_CATEGORY_RESERVED1,
+//     SDL_LOG_CATEGORY_RESERVED2,
+//     SDL_LOG_CATEGORY_RESERVED3,
+//     SDL_LOG_CATEGORY_RESERVED4,
+//     SDL_LOG_CATEGORY_RESERVED5,
+//     SDL_LOG_CATEGORY_RESERVED6,
+//     SDL_LOG_CATEGORY_RESERVED7,
+//     SDL_LOG_CATEGORY_RESERVED8,
+//     SDL_LOG_CATEGORY_RESERVED9,
+//     SDL_LOG_CATEGORY_RESERVED10,
+//
+//     /* Beyond this point is reserved for application use, e.g.
+//        enum {
+//            MYAPP_CATEGORY_AWESOME1 = SDL_LOG_CATEGORY_CUSTOM,
+//            MYAPP_CATEGORY_AWESOME2,
+//            MYAPP_CATEGORY_AWESOME3,
+//            ...
+//        };
+//      */
+//     SDL_LOG_CATEGORY_CUSTOM
+// } SDL_LogCategory;
+
+type SDL_LogCategory int
+
+const (
+	SDL_LOG_CATEGORY_APPLICATION SDL_LogCategory = C.SDL_LOG_CATEGORY_APPLICATION
+	SDL_LOG_CATEGORY_ERROR       SDL_LogCategory = C.SDL_LOG_CATEGORY_ERROR
+	SDL_LOG_CATEGORY_ASSERT      SDL_LogCategory = C.SDL_LOG_CATEGORY_ASSERT
+	SDL_LOG_CATEGORY_SYSTEM      SDL_LogCategory = C.SDL_LOG_CATEGORY_SYSTEM
+	SDL_LOG_CATEGORY_AUDIO       SDL_LogCategory = C.SDL_LOG_CATEGORY_AUDIO
+	SDL_LOG_CATEGORY_VIDEO       SDL_LogCategory = C.SDL_LOG_CATEGORY_VIDEO
+	SDL_LOG_CATEGORY_RENDER      SDL_LogCategory = C.SDL_LOG_CATEGORY_RENDER
+	SDL_LOG_CATEGORY_INPUT       SDL_LogCategory = C.SDL_LOG_CATEGORY_INPUT
+	SDL_LOG_CATEGORY_TEST        SDL_LogCategory = C.SDL_LOG_CATEGORY_TEST
+	SDL_LOG_CATEGORY_RESERVED1   SDL_LogCategory = C.SDL_LOG_CATEGORY_RESERVED1
+	SDL_LOG_CATEGORY_RESERVED2   SDL_LogCategory = C.SDL_LOG_CATEGORY_RESERVED2
+	SDL_LOG_CATEGORY_RESERVED3   SDL_LogCategory = C.SDL_LOG_CATEGORY_RESERVED3
+	SDL_LOG_CATEGORY_RESERVED4   SDL_LogCategory = C.SDL_LOG_CATEGORY_RESERVED4
+	SDL_LOG_CATEGORY_RESERVED5   SDL_LogCategory = C.SDL_LOG_CATEGORY_RESERVED5
+	SDL_LOG_CATEGORY_RESERVED6   SDL_LogCategory = C.SDL_LOG_CATEGORY_RESERVED6
+	SDL_LOG_CATEGORY_RESERVED7   SDL_LogCategory = C.SDL_LOG_CATEGORY_RESERVED7
+	SDL_LOG_CATEGORY_RESERVED8   SDL_LogCategory = C.SDL_LOG_CATEGORY_RESERVED8
+	SDL_LOG_CATEGORY_RESERVED9   SDL_LogCategory = C.SDL_LOG_CATEGORY_RESERVED9
+	SDL_LOG_CATEGORY_RESERVED10  SDL_LogCategory = C.SDL_LOG_CATEGORY_RESERVED10
+	SDL_LOG_CATEGORY_CUSTOM      SDL_LogCategory = C.SDL_LOG_CATEGORY_CUSTOM
+)
+
+var logCategoryNames = [...]string{
+	SDL_LOG_CATEGORY_APPLICATION: "application",
+	SDL_LOG_CATEGORY_ERROR:       "error",
+	SDL_LOG_CATEGORY_ASSERT:      "assert",
+	SDL_LOG_CATEGORY_SYSTEM:      "system",
+	SDL_LOG_CATEGORY_AUDIO:       "audio",
+	SDL_LOG_CATEGORY_VIDEO:       "video",
+	SDL_LOG_CATEGORY_RENDER:      "render",
+	SDL_LOG_CATEGORY_INPUT:       "input",
+	SDL_LOG_CATEGORY_TEST:        "test",
+}
+
+// String returns the name of a predefined category, e.g. "video", else
+// "custom+N" counted from SDL_LOG_CATEGORY_CUSTOM or "reserved+N".
+func (c SDL_LogCategory) String() string {
+
+	switch {
+	case c >= SDL_LOG_CATEGORY_CUSTOM:
+		return fmt.Sprintf("custom+%d", int(c-SDL_LOG_CATEGORY_CUSTOM))
+	case c >= 0 && int(c) < len(logCategoryNames) && "" != logCategoryNames[c]:
+		return logCategoryNames[c]
+	case c >= SDL_LOG_CATEGORY_RESERVED1:
+		return fmt.Sprintf("reserved+%d", int(c-SDL_LOG_CATEGORY_RESERVED1))
+	} // switch
+
+	return fmt.Sprintf("SDL_LogCategory(%d)", int(c))
+}
+
+/**
+ *  \brief The predefined log priorities
+ */
+// typedef enum
+// {
+//     SDL_LOG_PRIORITY_VERBOSE = 1,
+//     SDL_LOG_PRIORITY_DEBUG,
+//     SDL_LOG_PRIORITY_INFO,
+//     SDL_LOG_PRIORITY_WARN,
+//     SDL_LOG_PRIORITY_ERROR,
+//     SDL_LOG_PRIORITY_CRITICAL,
+//     SDL_NUM_LOG_PRIORITIES
+// } SDL_LogPriority;
+
+type SDL_LogPriority int
+
+const (
+	SDL_LOG_PRIORITY_VERBOSE  SDL_LogPriority = C.SDL_LOG_PRIORITY_VERBOSE
+	SDL_LOG_PRIORITY_DEBUG    SDL_LogPriority = C.SDL_LOG_PRIORITY_DEBUG
+	SDL_LOG_PRIORITY_INFO     SDL_LogPriority = C.SDL_LOG_PRIORITY_INFO
+	SDL_LOG_PRIORITY_WARN     SDL_LogPriority = C.SDL_LOG_PRIORITY_WARN
+	SDL_LOG_PRIORITY_ERROR    SDL_LogPriority = C.SDL_LOG_PRIORITY_ERROR
+	SDL_LOG_PRIORITY_CRITICAL SDL_LogPriority = C.SDL_LOG_PRIORITY_CRITICAL
+	SDL_NUM_LOG_PRIORITIES    SDL_LogPriority = C.SDL_NUM_LOG_PRIORITIES
+)
+
+/**
+ * Set the priority of all log categories.
+ *
+ * \param priority the SDL_LogPriority to assign
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_LogSetPriority
+ */
+// extern DECLSPEC void SDLCALL SDL_LogSetAllPriority(SDL_LogPriority priority);
+func SDL_LogSetAllPriority(priority SDL_LogPriority) {
+	C.SDL_LogSetAllPriority(C.SDL_LogPriority(priority))
+}
+
+/**
+ * Set the priority of a particular log category.
+ *
+ * \param category the category to assign a priority to
+ * \param priority the SDL_LogPriority to assign
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_LogGetPriority
+ * \sa SDL_LogSetAllPriority
+ */
+// extern DECLSPEC void SDLCALL SDL_LogSetPriority(int category,
+//                                                 SDL_LogPriority priority);
+func SDL_LogSetPriority(category SDL_LogCategory, priority SDL_LogPriority) {
+	C.SDL_LogSetPriority(C.int(category), C.SDL_LogPriority(priority))
+}
+
+/**
+ * Get the priority of a particular log category.
+ *
+ * \param category the category to query
+ * \returns the SDL_LogPriority for the requested category
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_LogSetPriority
+ */
+// extern DECLSPEC SDL_LogPriority SDLCALL SDL_LogGetPriority(int category);
+func SDL_LogGetPriority(category SDL_LogCategory) SDL_LogPriority {
+	return SDL_LogPriority(C.SDL_LogGetPriority(C.int(category)))
+}
+
+/**
+ * Reset all priorities to default.
+ *
+ * This is called by SDL_Quit().
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_LogSetAllPriority
+ * \sa SDL_LogSetPriority
+ */
+// extern DECLSPEC void SDLCALL SDL_LogResetPriorities(void);
+func SDL_LogResetPriorities() {
+	C.SDL_LogResetPriorities()
+}
+
+/**
+ * Log a message with SDL_LOG_CATEGORY_APPLICATION and SDL_LOG_PRIORITY_INFO.
+ *
+ * = * \param fmt a printf() style message format string
+ *
+ * \param ... additional parameters matching % tokens in the `fmt` string, if
+ *            any
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_LogCritical
+ * \sa SDL_LogDebug
+ * \sa SDL_LogError
+ * \sa SDL_LogInfo
+ * \sa SDL_LogMessage
+ * \sa SDL_LogMessageV
+ * \sa SDL_LogVerbose
+ * \sa SDL_LogWarn
+ */
+// extern DECLSPEC void SDLCALL SDL_Log(SDL_PRINTF_FORMAT_STRING const char *fmt, ...) SDL_PRINTF_VARARG_FUNC(1);
+//
+// The message is formatted with fmt.Sprintf.
+func SDL_Log(format string, a ...interface{}) {
+	SDL_LogMessage(SDL_LOG_CATEGORY_APPLICATION, SDL_LOG_PRIORITY_INFO, format, a...)
+}
+
+/**
+ * Log a message with the specified category and priority.
+ *
+ * \param category the category of the message
+ * \param priority the priority of the message
+ * \param fmt a printf() style message format string
+ * \param ... additional parameters matching % tokens in the **fmt** string,
+ *            if any
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_Log
+ * \sa SDL_LogCritical
+ * \sa SDL_LogDebug
+ * \sa SDL_LogError
+ * \sa SDL_LogInfo
+ * \sa SDL_LogMessageV
+ * \sa SDL_LogVerbose
+ * \sa SDL_LogWarn
+ */
+// extern DECLSPEC void SDLCALL SDL_LogMessage(int category,
+//                                             SDL_LogPriority priority,
+//                                             SDL_PRINTF_FORMAT_STRING const char *fmt, ...) SDL_PRINTF_VARARG_FUNC(3);
+//
+// The message is formatted with fmt.Sprintf.
+func SDL_LogMessage(category SDL_LogCategory, priority SDL_LogPriority, format string, a ...interface{}) {
+
+	var message_c = C.CString(fmt.Sprintf(format, a...))
+	defer C.free(unsafe.Pointer(message_c))
+
+	C._SDL_LogMessage(C.int(category), C.SDL_LogPriority(priority), message_c)
+}
+
+/**
+ * The prototype for the log output callback function.
+ *
+ * This function is called by SDL when there is new text to be logged.
+ *
+ * \param userdata what was passed as `userdata` to SDL_LogSetOutputFunction()
+ * \param category the category of the message
+ * \param priority the priority of the message
+ * \param message the message being output
+ */
+// typedef void (SDLCALL *SDL_LogOutputFunction)(void *userdata, int category, SDL_LogPriority priority, const char *message);
+//
+// Closures replace userdata. SDL calls the function on the thread logging,
+// it must be safe for concurrent use.
+type SDL_LogOutputFunction func(category SDL_LogCategory, priority SDL_LogPriority, message string)
+
+var logOutput struct {
+	mu sync.Mutex
+	f  SDL_LogOutputFunction
+}
+
+/**
+ * Get the current log output function.
+ *
+ * \param callback an SDL_LogOutputFunction filled in with the current log
+ *                 callback
+ * \param userdata a pointer filled in with the pointer that is passed to
+ *                 `callback`
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_LogSetOutputFunction
+ */
+// extern DECLSPEC void SDLCALL SDL_LogGetOutputFunction(SDL_LogOutputFunction *callback, void **userdata);
+//
+// The Go form returns the function of SDL_LogSetOutputFunction, nil for
+// SDL's.
+func SDL_LogGetOutputFunction() SDL_LogOutputFunction {
+
+	logOutput.mu.Lock()
+	defer logOutput.mu.Unlock()
+
+	return logOutput.f
+}
+
+/**
+ * Replace the default log output function with one of your own.
+ *
+ * \param callback an SDL_LogOutputFunction to call instead of the default
+ * \param userdata a pointer that is passed to `callback`
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_LogGetOutputFunction
+ */
+// extern DECLSPEC void SDLCALL SDL_LogSetOutputFunction(SDL_LogOutputFunction callback, void *userdata);
+//
+// nil restores the output function of SDL.
+func SDL_LogSetOutputFunction(callback SDL_LogOutputFunction) {
+
+	logOutput.mu.Lock()
+	defer logOutput.mu.Unlock()
+
+	logOutput.f = callback
+
+	var set C.int
+	if nil != callback {
+		set = 1
+	}
+	C._SDL_LogSetOutputFunction(set)
+}
+
+// logMessage passes a message to the Go output function.
+func logMessage(category SDL_LogCategory, priority SDL_LogPriority, message string) {
+
+	logOutput.mu.Lock()
+	var f = logOutput.f
+	logOutput.mu.Unlock()
+
+	if nil != f {
+		f(category, priority, message)
+	}
+}
@@ -0,0 +1,621 @@
+package sdl2
+
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
+// #include "SDL.h"
+import "C"
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"unsafe"
+
+	"example.com/vk_tutor/sdl2/internal"
+)
+
+/**
+ *  \file SDL_mouse.h
+ *
+ *  Include file for SDL mouse event handling.
+ */
+
+// typedef struct SDL_Cursor SDL_Cursor;   /**< Implementation dependent */
+type SDL_Cursor internal.CObjWrapper
+
+func (o *SDL_Cursor) cObj() *C.SDL_Cursor {
+	if nil == o {
+		return nil
+	}
+	return (*C.SDL_Cursor)(internal.Unwrap[SDL_Cursor](o))
+}
+
+func wrapCursor(p *C.SDL_Cursor) *SDL_Cursor {
+	if nil == p {
+		return nil
+	}
+	return internal.WrapNew[SDL_Cursor](unsafe.Pointer(p))
+}
+
+/**
+ * \brief Cursor types for SDL_CreateSystemCursor().
+ */
+// typedef enum
+// {
+//     SDL_SYSTEM_CURSOR_ARROW,     /**< Arrow */
+//     SDL_SYSTEM_CURSOR_IBEAM,     /**< I-beam */
+//     SDL_SYSTEM_CURSOR_WAIT,      /**< Wait */
+//     SDL_SYSTEM_CURSOR_CROSSHAIR, /**< Crosshair */
+//     SDL_SYSTEM_CURSOR_WAITARROW, /**< Small wait cursor (or Wait if not available) */
+//     SDL_SYSTEM_CURSOR_SIZENWSE,  /**< Double arrow pointing northwest and southeast */
+//     SDL_SYSTEM_CURSOR_SIZENESW,  /**< Double arrow pointing northeast and southwest */
+//     SDL_SYSTEM_CURSOR_SIZEWE,    /**< Double arrow pointing west and east */
+//     SDL_SYSTEM_CURSOR_SIZENS,    /**< Double arrow pointing north and south */
+//     SDL_SYSTEM_CURSOR_SIZEALL,   /**< Four pointed arrow pointing north, south, east, and west */
+//     SDL_SYSTEM_CURSOR_NO,        /**< Slashed circle or crossbones */
+//     SDL_SYSTEM_CURSOR_HAND,      /**< Hand */
+//     SDL_NUM_SYSTEM_CURSORS
+// } SDL_SystemCursor;
+type SDL_SystemCursor int
+
+const (
+	SDL_SYSTEM_CURSOR_ARROW     SDL_SystemCursor = C.SDL_SYSTEM_CURSOR_ARROW     /**< Arrow */
+	SDL_SYSTEM_CURSOR_IBEAM     SDL_SystemCursor = C.SDL_SYSTEM_CURSOR_IBEAM     /**< I-beam */
+	SDL_SYSTEM_CURSOR_WAIT      SDL_SystemCursor = C.SDL_SYSTEM_CURSOR_WAIT      /**< Wait */
+	SDL_SYSTEM_CURSOR_CROSSHAIR SDL_SystemCursor = C.SDL_SYSTEM_CURSOR_CROSSHAIR /**< Crosshair */
+	SDL_SYSTEM_CURSOR_WAITARROW SDL_SystemCursor = C.SDL_SYSTEM_CURSOR_WAITARROW /**< Small wait cursor (or Wait if not available) */
+	SDL_SYSTEM_CURSOR_SIZENWSE  SDL_SystemCursor = C.SDL_SYSTEM_CURSOR_SIZENWSE  /**< Double arrow pointing northwest and southeast */
+	SDL_SYSTEM_CURSOR_SIZENESW  SDL_SystemCursor = C.SDL_SYSTEM_CURSOR_SIZENESW  /**< Double arrow pointing northeast and southwest */
+	SDL_SYSTEM_CURSOR_SIZEWE    SDL_SystemCursor = C.SDL_SYSTEM_CURSOR_SIZEWE    /**< Double arrow pointing west and east */
+	SDL_SYSTEM_CURSOR_SIZENS    SDL_SystemCursor = C.SDL_SYSTEM_CURSOR_SIZENS    /**< Double arrow pointing north and south */
+	SDL_SYSTEM_CURSOR_SIZEALL   SDL_SystemCursor = C.SDL_SYSTEM_CURSOR_SIZEALL   /**< Four pointed arrow pointing north, south, east, and west */
+	SDL_SYSTEM_CURSOR_NO        SDL_SystemCursor = C.SDL_SYSTEM_CURSOR_NO        /**< Slashed circle or crossbones */
+	SDL_SYSTEM_CURSOR_HAND      SDL_SystemCursor = C.SDL_SYSTEM_CURSOR_HAND      /**< Hand */
+	SDL_NUM_SYSTEM_CURSORS      SDL_SystemCursor = C.SDL_NUM_SYSTEM_CURSORS
+)
+
+/**
+ * \brief Scroll direction types for the Scroll event
+ */
+// typedef enum
+// {
+//     SDL_MOUSEWHEEL_NORMAL,    /**< The scroll direction is normal */
+//     SDL_MOUSEWHEEL_FLIPPED    /**< The scroll direction is flipped / natural */
+// } SDL_MouseWheelDirection;
+type SDL_MouseWheelDirection uint32
+
+const (
+	SDL_MOUSEWHEEL_NORMAL  SDL_MouseWheelDirection = C.SDL_MOUSEWHEEL_NORMAL  /**< The scroll direction is normal */
+	SDL_MOUSEWHEEL_FLIPPED SDL_MouseWheelDirection = C.SDL_MOUSEWHEEL_FLIPPED /**< The scroll direction is flipped / natural */
+)
+
+/* Function prototypes */
+
+/**
+ * Get the window which currently has mouse focus.
+ *
+ * \returns the window with mouse focus.
+ *
+ * \since This function is available since SDL 2.0.0.
+ */
+// extern DECLSPEC SDL_Window * SDLCALL SDL_GetMouseFocus(void);
+func SDL_GetMouseFocus() *SDL_Window {
+	return wrapWindow(C.SDL_GetMouseFocus())
+}
+
+/**
+ * Retrieve the current state of the mouse.
+ *
+ * The current button state is returned as a button bitmask, which can be
+ * tested using the `SDL_BUTTON(X)` macros (where `X` is generally 1 for the
+ * left, 2 for middle, 3 for the right button), and `x` and `y` are set to the
+ * mouse cursor position relative to the focus window. You can pass NULL for
+ * either `x` or `y`.
+ *
+ * \param x the x coordinate of the mouse cursor position relative to the
+ *          focus window
+ * \param y the y coordinate of the mouse cursor position relative to the
+ *          focus window
+ * \returns a 32-bit button bitmask of the current button state.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetGlobalMouseState
+ * \sa SDL_GetRelativeMouseState
+ * \sa SDL_PumpEvents
+ */
+// extern DECLSPEC Uint32 SDLCALL SDL_GetMouseState(int *x, int *y);
+func SDL_GetMouseState(x, y *int) SDL_MouseButtonFlags {
+	var x1, y1 C.int
+	var state = C.SDL_GetMouseState(&x1, &y1)
+	setInts(x, x1, y, y1)
+	return SDL_MouseButtonFlags(state)
+}
+
+/**
+ * Get the current state of the mouse in relation to the desktop.
+ *
+ * This works similarly to SDL_GetMouseState(), but the coordinates will be
+ * reported relative to the top-left of the desktop. This can be useful if you
+ * need to track the mouse outside of a specific window and SDL_CaptureMouse()
+ * doesn't fit your needs. For example, it could be useful if you need to
+ * track the mouse while dragging a window, where coordinates relative to a
+ * window might not be in sync at all times.
+ *
+ * Note: SDL_GetMouseState() returns the mouse position as SDL understands it
+ * from the last pump of the event queue. This function, however, queries the
+ * OS for the current mouse position, and as such, might be a slightly less
+ * efficient function. Unless you know what you're doing and have a good
+ * reason to use this function, you probably want SDL_GetMouseState() instead.
+ *
+ * \param x filled in with the current X coord relative to the desktop; can be
+ *          NULL
+ * \param y filled in with the current Y coord relative to the desktop; can be
+ *          NULL
+ * \returns the current button state as a bitmask which can be tested using
+ *          the SDL_BUTTON(X) macros.
+ *
+ * \since This function is available since SDL 2.0.4.
+ *
+ * \sa SDL_CaptureMouse
+ */
+// extern DECLSPEC Uint32 SDLCALL SDL_GetGlobalMouseState(int *x, int *y);
+func SDL_GetGlobalMouseState(x, y *int) SDL_MouseButtonFlags {
+	var x1, y1 C.int
+	var state = C.SDL_GetGlobalMouseState(&x1, &y1)
+	setInts(x, x1, y, y1)
+	return SDL_MouseButtonFlags(state)
+}
+
+/**
+ * Retrieve the relative state of the mouse.
+ *
+ * The current button state is returned as a button bitmask, which can be
+ * tested using the `SDL_BUTTON(X)` macros (where `X` is generally 1 for the
+ * left, 2 for middle, 3 for the right button), and `x` and `y` are set to the
+ * mouse deltas since the last call to SDL_GetRelativeMouseState() or since
+ * event initialization. You can pass NULL for either `x` or `y`.
+ *
+ * \param x a pointer filled with the last recorded x coordinate of the mouse
+ * \param y a pointer filled with the last recorded y coordinate of the mouse
+ * \returns a 32-bit button bitmask of the relative button state.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetMouseState
+ */
+// extern DECLSPEC Uint32 SDLCALL SDL_GetRelativeMouseState(int *x, int *y);
+func SDL_GetRelativeMouseState(x, y *int) SDL_MouseButtonFlags {
+	var x1, y1 C.int
+	var state = C.SDL_GetRelativeMouseState(&x1, &y1)
+	setInts(x, x1, y, y1)
+	return SDL_MouseButtonFlags(state)
+}
+
+/**
+ * Move the mouse cursor to the given position within the window.
+ *
+ * This function generates a mouse motion event if relative mode is not
+ * enabled. If relative mode is enabled, you can force mouse events for the
+ * warp by setting the SDL_HINT_MOUSE_RELATIVE_WARP_MOTION hint.
+ *
+ * Note that this function will appear to succeed, but not actually move the
+ * mouse when used over Microsoft Remote Desktop.
+ *
+ * \param window the window to move the mouse into, or NULL for the current
+ *               mouse focus
+ * \param x the x coordinate within the window
+ * \param y the y coordinate within the window
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_WarpMouseGlobal
+ */
+// extern DECLSPEC void SDLCALL SDL_WarpMouseInWindow(SDL_Window * window,
+//                                                    int x, int y);
+func SDL_WarpMouseInWindow(window *SDL_Window, x, y int) {
+	C.SDL_WarpMouseInWindow(window.cObj(), C.int(x), C.int(y))
+}
+
+/**
+ * Move the mouse to the given position in global screen space.
+ *
+ * This function generates a mouse motion event.
+ *
+ * A failure of this function usually means that it is unsupported by a
+ * platform.
+ *
+ * Note that this function will appear to succeed, but not actually move the
+ * mouse when used over Microsoft Remote Desktop.
+ *
+ * \param x the x coordinate
+ * \param y the y coordinate
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.4.
+ *
+ * \sa SDL_WarpMouseInWindow
+ */
+// extern DECLSPEC int SDLCALL SDL_WarpMouseGlobal(int x, int y);
+func SDL_WarpMouseGlobal(x, y int) error {
+	return checkError("SDL_WarpMouseGlobal", C.SDL_WarpMouseGlobal(C.int(x), C.int(y)))
+}
+
+/**
+ * Set relative mouse mode.
+ *
+ * While the mouse is in relative mode, the cursor is hidden, and the driver
+ * will try to report continuous motion in the current window. Only relative
+ * motion events will be delivered, the mouse position will not change.
+ *
+ * Note that this function will not be able to provide continuous relative
+ * motion when used over Microsoft Remote Desktop, instead motion is limited
+ * to the bounds of the screen.
+ *
+ * This function will flush any pending mouse motion.
+ *
+ * \param enabled SDL_TRUE to enable relative mode, SDL_FALSE to disable.
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ *          If relative mode is not supported, this returns -1.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetRelativeMouseMode
+ */
+// extern DECLSPEC int SDLCALL SDL_SetRelativeMouseMode(SDL_bool enabled);
+func SDL_SetRelativeMouseMode(enabled bool) error {
+	return checkError("SDL_SetRelativeMouseMode", C.SDL_SetRelativeMouseMode(sdlBool(enabled)))
+}
+
+/**
+ * Capture the mouse and to track input outside an SDL window.
+ *
+ * Capturing enables your app to obtain mouse events globally, instead of just
+ * within your window. Not all video targets support this function. When
+ * capturing is enabled, the current window will get all mouse events, but
+ * unlike relative mode, no change is made to the cursor and it is not
+ * restrained to your window.
+ *
+ * This function may also deny mouse input to other windows--both those in
+ * your application and others on the system--so you should use this function
+ * sparingly, and in small bursts. For example, you might want to track the
+ * mouse while the user is dragging something, until the user releases a mouse
+ * button. It is not recommended that you capture the mouse for long periods
+ * of time, such as the entire time your app is running. For that, you should
+ * probably use SDL_SetRelativeMouseMode() or SDL_SetWindowGrab(), depending
+ * on your goals.
+ *
+ * While captured, mouse events still report coordinates relative to the
+ * current (foreground) window, but those coordinates may be outside the
+ * bounds of the window (including negative values). Capturing is only allowed
+ * for the foreground window. If the window loses focus while capturing, the
+ * capture will be disabled automatically.
+ *
+ * While capturing is enabled, the current window will have the
+ * `SDL_WINDOW_MOUSE_CAPTURE` flag set.
+ *
+ * Please note that as of SDL 2.0.22, SDL will attempt to "auto capture" the
+ * mouse while the user is pressing a button; this is to try and make mouse
+ * behavior more consistent between platforms, and deal with the common case
+ * of a user dragging the mouse outside of the window. This means that if you
+ * are calling SDL_CaptureMouse() only to deal with this situation, you no
+ * longer have to (although it is safe to do so). If this causes problems for
+ * your app, you can disable auto capture by setting the
+ * `SDL_HINT_MOUSE_AUTO_CAPTURE` hint to zero.
+ *
+ * \param enabled SDL_TRUE to enable capturing, SDL_FALSE to disable.
+ * \returns 0 on success or -1 if not supported; call SDL_GetError() for more
+ *          information.
+ *
+ * \since This function is available since SDL 2.0.4.
+ *
+ * \sa SDL_GetGlobalMouseState
+ */
+// extern DECLSPEC int SDLCALL SDL_CaptureMouse(SDL_bool enabled);
+func SDL_CaptureMouse(enabled bool) error {
+	return checkError("SDL_CaptureMouse", C.SDL_CaptureMouse(sdlBool(enabled)))
+}
+
+/**
+ * Query whether relative mouse mode is enabled.
+ *
+ * \returns SDL_TRUE if relative mode is enabled or SDL_FALSE otherwise.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_SetRelativeMouseMode
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_GetRelativeMouseMode(void);
+func SDL_GetRelativeMouseMode() bool {
+	return C.SDL_TRUE == C.SDL_GetRelativeMouseMode()
+}
+
+/**
+ * Create a cursor using the specified bitmap data and mask (in MSB format).
+ *
+ * `mask` has to be in MSB (Most Significant Bit) format.
+ *
+ * The cursor width (`w`) must be a multiple of 8 bits.
+ *
+ * The cursor is created in black and white according to the following:
+ *
+ * - data=0, mask=1: white
+ * - data=1, mask=1: black
+ * - data=0, mask=0: transparent
+ * - data=1, mask=0: inverted color if possible, black if not.
+ *
+ * Cursors created with this function must be freed with SDL_FreeCursor().
+ *
+ * If you want to have a color cursor, or create your cursor from an
+ * SDL_Surface, you should use SDL_CreateColorCursor(). Alternately, you can
+ * hide the cursor and draw your own as part of your game's rendering, but it
+ * will be bound to the framerate.
+ *
+ * Also, since SDL 2.0.0, SDL_CreateSystemCursor() is available, which
+ * provides twelve readily available system cursors to pick from.
+ *
+ * \param data the color value for each pixel of the cursor
+ * \param mask the mask value for each pixel of the cursor
+ * \param w the width of the cursor
+ * \param h the height of the cursor
+ * \param hot_x the X-axis location of the upper left corner of the cursor
+ *              relative to the actual mouse position
+ * \param hot_y the Y-axis location of the upper left corner of the cursor
+ *              relative to the actual mouse position
+ * \returns a new cursor with the specified parameters on success or NULL on
+ *          failure; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_FreeCursor
+ * \sa SDL_SetCursor
+ * \sa SDL_ShowCursor
+ */
+// extern DECLSPEC SDL_Cursor *SDLCALL SDL_CreateCursor(const Uint8 * data,
+//                                                      const Uint8 * mask,
+//                                                      int w, int h, int hot_x,
+//                                                      int hot_y);
+//
+// data and mask hold (w+7)/8*h bytes each, rows padded to whole bytes.
+func SDL_CreateCursor(data, mask []uint8, w, h, hot_x, hot_y int) (*SDL_Cursor, error) {
+
+	var n = (w + 7) / 8 * h
+	if w <= 0 || h <= 0 || len(data) < n || len(mask) < n {
+		return nil, &SDLError{"SDL_CreateCursor", fmt.Sprintf("%v bytes of data and %v of mask for %vx%v", len(data), len(mask), w, h)}
+	}
+
+	var p = C.SDL_CreateCursor(
+		(*C.Uint8)(unsafe.Pointer(&data[0])),
+		(*C.Uint8)(unsafe.Pointer(&mask[0])),
+		C.int(w),
+		C.int(h),
+		C.int(hot_x),
+		C.int(hot_y),
+	)
+
+	if nil == p {
+		return nil, lastError("SDL_CreateCursor")
+	}
+
+	return internal.WrapNew[SDL_Cursor](unsafe.Pointer(p)), nil
+}
+
+/**
+ * Create a color cursor.
+ *
+ * \param surface an SDL_Surface structure representing the cursor image
+ * \param hot_x the x position of the cursor hot spot
+ * \param hot_y the y position of the cursor hot spot
+ * \returns the new cursor on success or NULL on failure; call SDL_GetError()
+ *          for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_CreateCursor
+ * \sa SDL_FreeCursor
+ */
+// extern DECLSPEC SDL_Cursor *SDLCALL SDL_CreateColorCursor(SDL_Surface *surface,
+//                                                           int hot_x,
+//                                                           int hot_y);
+//
+// The Go form takes an image instead of a surface, it is converted to
+// non-premultiplied RGBA.
+func SDL_CreateColorCursor(img image.Image, hot_x, hot_y int) (*SDL_Cursor, error) {
+
+	var b = img.Bounds()
+	var rgba = image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+
+	// The surface refers to the pixels, they must not move
+	var pixels = C.CBytes(rgba.Pix)
+	defer C.free(pixels)
+
+	var surface = C.SDL_CreateRGBSurfaceWithFormatFrom(
+		pixels,
+		C.int(b.Dx()),
+		C.int(b.Dy()),
+		32,
+		C.int(rgba.Stride),
+		C.SDL_PIXELFORMAT_RGBA32,
+	)
+	if nil == surface {
+		return nil, lastError("SDL_CreateRGBSurfaceWithFormatFrom")
+	}
+	defer C.SDL_FreeSurface(surface)
+
+	var p = C.SDL_CreateColorCursor(surface, C.int(hot_x), C.int(hot_y))
+	if nil == p {
+		return nil, lastError("SDL_CreateColorCursor")
+	}
+
+	return internal.WrapNew[SDL_Cursor](unsafe.Pointer(p)), nil
+}
+
+/**
+ * Create a system cursor.
+ *
+ * \param id an SDL_SystemCursor enum value
+ * \returns a cursor on success or NULL on failure; call SDL_GetError() for
+ *          more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_FreeCursor
+ */
+// extern DECLSPEC SDL_Cursor *SDLCALL SDL_CreateSystemCursor(SDL_SystemCursor id);
+func SDL_CreateSystemCursor(id SDL_SystemCursor) (*SDL_Cursor, error) {
+
+	var p = C.SDL_CreateSystemCursor(C.SDL_SystemCursor(id))
+	if nil == p {
+		return nil, lastError("SDL_CreateSystemCursor")
+	}
+
+	return internal.WrapNew[SDL_Cursor](unsafe.Pointer(p)), nil
+}
+
+/**
+ * Set the active cursor.
+ *
+ * This function sets the currently active cursor to the specified one. If the
+ * cursor is currently visible, the change will be immediately represented on
+ * the display. SDL_SetCursor(NULL) can be used to force cursor redraw, if
+ * this is desired for any reason.
+ *
+ * \param cursor a cursor to make active
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_CreateCursor
+ * \sa SDL_GetCursor
+ * \sa SDL_ShowCursor
+ */
+// extern DECLSPEC void SDLCALL SDL_SetCursor(SDL_Cursor * cursor);
+func SDL_SetCursor(cursor *SDL_Cursor) {
+	C.SDL_SetCursor(cursor.cObj())
+}
+
+/**
+ * Get the active cursor.
+ *
+ * This function returns a pointer to the current cursor which is owned by the
+ * library. It is not necessary to free the cursor with SDL_FreeCursor().
+ *
+ * \returns the active cursor or NULL if there is no mouse.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_SetCursor
+ */
+// extern DECLSPEC SDL_Cursor *SDLCALL SDL_GetCursor(void);
+func SDL_GetCursor() *SDL_Cursor {
+	return wrapCursor(C.SDL_GetCursor())
+}
+
+/**
+ * Get the default cursor.
+ *
+ * \returns the default cursor on success or NULL on failure.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_CreateSystemCursor
+ */
+// extern DECLSPEC SDL_Cursor *SDLCALL SDL_GetDefaultCursor(void);
+func SDL_GetDefaultCursor() *SDL_Cursor {
+	return wrapCursor(C.SDL_GetDefaultCursor())
+}
+
+/**
+ * Free a previously-created cursor.
+ *
+ * Use this function to free cursor resources created with SDL_CreateCursor(),
+ * SDL_CreateColorCursor() or SDL_CreateSystemCursor().
+ *
+ * \param cursor the cursor to free
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_CreateColorCursor
+ * \sa SDL_CreateCursor
+ * \sa SDL_CreateSystemCursor
+ */
+// extern DECLSPEC void SDLCALL SDL_FreeCursor(SDL_Cursor * cursor);
+func SDL_FreeCursor(cursor *SDL_Cursor) {
+	C.SDL_FreeCursor(cursor.cObj())
+}
+
+/**
+ * Toggle whether or not the cursor is shown.
+ *
+ * The cursor starts off displayed but can be turned off. Passing `SDL_ENABLE`
+ * displays the cursor and passing `SDL_DISABLE` hides it.
+ *
+ * The current state of the mouse cursor can be queried by passing
+ * `SDL_QUERY`; either `SDL_DISABLE` or `SDL_ENABLE` will be returned.
+ *
+ * \param toggle `SDL_ENABLE` to show the cursor, `SDL_DISABLE` to hide it,
+ *               `SDL_QUERY` to query the current state without changing it.
+ * \returns `SDL_ENABLE` if the cursor is shown, or `SDL_DISABLE` if the
+ *          cursor is hidden, or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_CreateCursor
+ * \sa SDL_SetCursor
+ */
+// extern DECLSPEC int SDLCALL SDL_ShowCursor(int toggle);
+func SDL_ShowCursor(toggle int) (int, error) {
+	var r = C.SDL_ShowCursor(C.int(toggle))
+	if r < 0 {
+		return 0, lastError("SDL_ShowCursor")
+	}
+	return int(r), nil
+}
+
+/**
+ * Used as a mask when testing buttons in buttonstate.
+ *
+ * - Button 1:  Left mouse button
+ * - Button 2:  Middle mouse button
+ * - Button 3:  Right mouse button
+ */
+// #define SDL_BUTTON(X)       (1 << ((X)-1))
+// #define SDL_BUTTON_LEFT     1
+// #define SDL_BUTTON_MIDDLE   2
+// #define SDL_BUTTON_RIGHT    3
+// #define SDL_BUTTON_X1       4
+// #define SDL_BUTTON_X2       5
+// #define SDL_BUTTON_LMASK    SDL_BUTTON(SDL_BUTTON_LEFT)
+// #define SDL_BUTTON_MMASK    SDL_BUTTON(SDL_BUTTON_MIDDLE)
+// #define SDL_BUTTON_RMASK    SDL_BUTTON(SDL_BUTTON_RIGHT)
+// #define SDL_BUTTON_X1MASK   SDL_BUTTON(SDL_BUTTON_X1)
+// #define SDL_BUTTON_X2MASK   SDL_BUTTON(SDL_BUTTON_X2)
+const (
+	SDL_BUTTON_LEFT   uint8 = C.SDL_BUTTON_LEFT
+	SDL_BUTTON_MIDDLE uint8 = C.SDL_BUTTON_MIDDLE
+	SDL_BUTTON_RIGHT  uint8 = C.SDL_BUTTON_RIGHT
+	SDL_BUTTON_X1     uint8 = C.SDL_BUTTON_X1
+	SDL_BUTTON_X2     uint8 = C.SDL_BUTTON_X2
+)
+
+// SDL_MouseButtonFlags is a button state, as returned by SDL_GetMouseState.
+type SDL_MouseButtonFlags uint32
+
+const (
+	SDL_BUTTON_LMASK  SDL_MouseButtonFlags = C.SDL_BUTTON_LMASK
+	SDL_BUTTON_MMASK  SDL_MouseButtonFlags = C.SDL_BUTTON_MMASK
+	SDL_BUTTON_RMASK  SDL_MouseButtonFlags = C.SDL_BUTTON_RMASK
+	SDL_BUTTON_X1MASK SDL_MouseButtonFlags = C.SDL_BUTTON_X1MASK
+	SDL_BUTTON_X2MASK SDL_MouseButtonFlags = C.SDL_BUTTON_X2MASK
+)
+
+func SDL_BUTTON(x uint8) SDL_MouseButtonFlags {
+	return 1 << (x - 1)
+}
@@ -0,0 +1,28 @@
+package sdl2_test
+
+import (
+	"errors"
+	"testing"
+
+	"example.com/vk_tutor/sdl2"
+	"example.com/vk_tutor/sdl2/sdltest"
+)
+
+func TestCreateCursorSize(t *testing.T) {
+
+	// Rows of 12 pixels take 2 bytes
+	var _, err = sdl2.SDL_CreateCursor(make([]uint8, 3), make([]uint8, 4), 12, 2, 0, 0)
+
+	var sdlErr *sdl2.SDLError
+	if !errors.As(err, &sdlErr) || "SDL_CreateCursor" != sdlErr.Func {
+		t.Fatalf("short data: error %v, want an *SDLError of SDL_CreateCursor", err)
+	}
+
+	sdltest.Init(t, sdl2.SDL_INIT_VIDEO)
+
+	var cursor *sdl2.SDL_Cursor
+	if cursor, err = sdl2.SDL_CreateCursor(make([]uint8, 4), make([]uint8, 4), 12, 2, 0, 0); nil != err {
+		t.Fatal(err)
+	}
+	sdl2.SDL_FreeCursor(cursor)
+}
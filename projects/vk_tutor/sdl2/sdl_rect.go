@@ -0,0 +1,105 @@
+package sdl2
+
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
+// #include "SDL.h"
+import "C"
+import "unsafe"
+
+/**
+ *  \file SDL_rect.h
+ *
+ *  Header file for SDL_rect definition and management functions.
+ */
+
+/**
+ * The structure that defines a point (integer)
+ *
+ * \sa SDL_EnclosePoints
+ * \sa SDL_PointInRect
+ */
+// typedef struct SDL_Point
+// {
+//     int x;
+//     int y;
+// } SDL_Point;
+type SDL_Point struct {
+	X int
+	Y int
+}
+
+func (o *SDL_Point) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_Point)(p)
+	o.X = int(p1.x)
+	o.Y = int(p1.y)
+}
+
+func (o *SDL_Point) copyToCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_Point)(p)
+	p1.x = C.int(o.X)
+	p1.y = C.int(o.Y)
+}
+
+/**
+ * A rectangle, with the origin at the upper left (integer).
+ *
+ * \sa SDL_RectEmpty
+ * \sa SDL_RectEquals
+ * \sa SDL_HasIntersection
+ * \sa SDL_IntersectRect
+ * \sa SDL_IntersectRectAndLine
+ * \sa SDL_UnionRect
+ * \sa SDL_EnclosePoints
+ */
+// typedef struct SDL_Rect
+// {
+//     int x, y;
+//     int w, h;
+// } SDL_Rect;
+type SDL_Rect struct {
+	X, Y int
+	W, H int
+}
+
+func (o *SDL_Rect) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_Rect)(p)
+	o.X = int(p1.x)
+	o.Y = int(p1.y)
+	o.W = int(p1.w)
+	o.H = int(p1.h)
+}
+
+func (o *SDL_Rect) copyToCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_Rect)(p)
+	p1.x = C.int(o.X)
+	p1.y = C.int(o.Y)
+	p1.w = C.int(o.W)
+	p1.h = C.int(o.H)
+}
+
+/**
+ * Returns true if point resides inside a rectangle.
+ */
+// SDL_FORCE_INLINE SDL_bool SDL_PointInRect(const SDL_Point *p, const SDL_Rect *r)
+func SDL_PointInRect(p *SDL_Point, r *SDL_Rect) bool {
+	return p.X >= r.X && p.X < r.X+r.W && p.Y >= r.Y && p.Y < r.Y+r.H
+}
+
+/**
+ * Returns true if the rectangle has no area.
+ */
+// SDL_FORCE_INLINE SDL_bool SDL_RectEmpty(const SDL_Rect *r)
+func SDL_RectEmpty(r *SDL_Rect) bool {
+	return nil == r || r.W <= 0 || r.H <= 0
+}
+
+/**
+ * Returns true if the two rectangles are equal.
+ */
+// SDL_FORCE_INLINE SDL_bool SDL_RectEquals(const SDL_Rect *a, const SDL_Rect *b)
+func SDL_RectEquals(a, b *SDL_Rect) bool {
+	return nil != a && nil != b && *a == *b
+}
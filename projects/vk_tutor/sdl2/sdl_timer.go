@@ -0,0 +1,250 @@
+package sdl2
+
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
+// #include "SDL.h"
+//
+// extern Uint32 goTimerCallback(Uint32, void*);
+//
+// static Uint32 SDLCALL _SDL_TimerCallback(Uint32 interval, void *param) {
+//     return goTimerCallback(interval, param);
+// }
+//
+// static SDL_TimerID _SDL_AddTimer(Uint32 interval, uintptr_t key) {
+//     return SDL_AddTimer(interval, _SDL_TimerCallback, (void*)key);
+// }
+import "C"
+
+import "sync"
+
+/**
+ *  \file SDL_timer.h
+ *
+ *  Header for the SDL time management routines.
+ */
+
+/**
+ * Get the number of milliseconds since SDL library initialization.
+ *
+ * Note that you should not use the SDL_TICKS_PASSED macro with values
+ * returned by this function, as that macro does clever math to compensate for
+ * the 32-bit overflow every ~49 days that SDL_GetTicks() suffers from. 64-bit
+ * values from this function can be safely compared directly.
+ *
+ * For example, if you want to wait 100 ms, you could do this:
+ *
+ * ```c
+ * const Uint64 timeout = SDL_GetTicks64() + 100;
+ * while (SDL_GetTicks64() < timeout) {
+ *     // ... do work until timeout has elapsed
+ * }
+ * ```
+ *
+ * \returns an unsigned 64-bit value representing the number of milliseconds
+ *          since the SDL library initialized.
+ *
+ * \since This function is available since SDL 2.0.18.
+ */
+// extern DECLSPEC Uint64 SDLCALL SDL_GetTicks64(void);
+func SDL_GetTicks64() uint64 {
+	return uint64(C.SDL_GetTicks64())
+}
+
+/**
+ * Get the current value of the high resolution counter.
+ *
+ * This function is typically used for profiling.
+ *
+ * The counter values are only meaningful relative to each other. Differences
+ * between values can be converted to times by using
+ * SDL_GetPerformanceFrequency().
+ *
+ * \returns the current counter value.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetPerformanceFrequency
+ */
+// extern DECLSPEC Uint64 SDLCALL SDL_GetPerformanceCounter(void);
+func SDL_GetPerformanceCounter() uint64 {
+	return uint64(C.SDL_GetPerformanceCounter())
+}
+
+/**
+ * Get the count per second of the high resolution counter.
+ *
+ * \returns a platform-specific count per second.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetPerformanceCounter
+ */
+// extern DECLSPEC Uint64 SDLCALL SDL_GetPerformanceFrequency(void);
+func SDL_GetPerformanceFrequency() uint64 {
+	return uint64(C.SDL_GetPerformanceFrequency())
+}
+
+/**
+ * Wait a specified number of milliseconds before returning.
+ *
+ * This function waits a specified number of milliseconds before returning. It
+ * waits at least the specified time, but possibly longer due to OS
+ * scheduling.
+ *
+ * \param ms the number of milliseconds to delay
+ *
+ * \since This function is available since SDL 2.0.0.
+ */
+// extern DECLSPEC void SDLCALL SDL_Delay(Uint32 ms);
+func SDL_Delay(ms uint32) {
+	C.SDL_Delay(C.Uint32(ms))
+}
+
+/**
+ * Function prototype for the timer callback function.
+ *
+ * The callback function is passed the current timer interval and returns
+ * the next timer interval. If the returned value is the same as the one
+ * passed in, the periodic alarm continues, otherwise a new alarm is
+ * scheduled. If the callback returns 0, the periodic alarm is cancelled.
+ */
+// typedef Uint32 (SDLCALL * SDL_TimerCallback) (Uint32 interval, void *param);
+//
+// Closures replace param.
+type SDL_TimerCallback func(interval uint32) uint32
+
+/**
+ * Definition of the timer ID type.
+ */
+// typedef int SDL_TimerID;
+type SDL_TimerID int32
+
+// timers holds the callbacks of the running timers. param is a key into it
+// rather than a cgo.Handle: SDL_RemoveTimer does not wait for a callback
+// already started, which must not find its handle deleted.
+var timers struct {
+	mu   sync.Mutex
+	next uintptr
+	byID map[SDL_TimerID]uintptr
+	m    map[uintptr]*timer
+}
+
+type timer struct {
+	callback SDL_TimerCallback
+	id       SDL_TimerID
+}
+
+/**
+ * Call a callback function at a future time.
+ *
+ * If you use this function, you must pass `SDL_INIT_TIMER` to SDL_Init().
+ *
+ * The callback function is passed the current timer interval and the user
+ * supplied parameter from the SDL_AddTimer() call and should return the next
+ * timer interval. If the value returned from the callback is 0, the timer is
+ * canceled.
+ *
+ * The callback is run on a separate thread.
+ *
+ * Timers take into account the amount of time it took to execute the
+ * callback. For example, if the callback took 250 ms to execute and returned
+ * 1000 (ms), the timer would only wait another 750 ms before its next
+ * iteration.
+ *
+ * Timing may be inexact due to OS scheduling. Be sure to note the current
+ * time with SDL_GetTicks() or SDL_GetPerformanceCounter() in case your
+ * callback needs to adjust for variances.
+ *
+ * \param interval the timer delay, in milliseconds, passed to `callback`
+ * \param callback the SDL_TimerCallback function to call when the specified
+ *                 `interval` elapses
+ * \param param a pointer that is passed to `callback`
+ * \returns a timer ID or 0 if an error occurs; call SDL_GetError() for more
+ *          information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_RemoveTimer
+ */
+// extern DECLSPEC SDL_TimerID SDLCALL SDL_AddTimer(Uint32 interval,
+//                                                  SDL_TimerCallback callback,
+//                                                  void *param);
+func SDL_AddTimer(interval uint32, callback SDL_TimerCallback) (SDL_TimerID, error) {
+
+	timers.mu.Lock()
+	defer timers.mu.Unlock()
+
+	if nil == timers.m {
+		timers.byID = make(map[SDL_TimerID]uintptr)
+		timers.m = make(map[uintptr]*timer)
+	}
+
+	timers.next++
+	var key = timers.next
+
+	// The lock is held until the ID is known, a callback firing at once
+	// waits for it
+	var t = &timer{callback: callback}
+	timers.m[key] = t
+
+	var id = SDL_TimerID(C._SDL_AddTimer(C.Uint32(interval), C.uintptr_t(key)))
+	if 0 == id {
+		delete(timers.m, key)
+		return 0, lastError("SDL_AddTimer")
+	}
+
+	t.id = id
+	timers.byID[id] = key
+
+	return id, nil
+}
+
+// timerCallback runs the callback of key and forgets it once it returns 0.
+func timerCallback(interval uint32, key uintptr) uint32 {
+
+	timers.mu.Lock()
+	var t = timers.m[key]
+	timers.mu.Unlock()
+
+	if nil == t {
+		return 0
+	}
+
+	var next = t.callback(interval)
+	if 0 == next {
+		timers.mu.Lock()
+		delete(timers.m, key)
+		delete(timers.byID, t.id)
+		timers.mu.Unlock()
+	}
+
+	return next
+}
+
+/**
+ * Remove a timer created with SDL_AddTimer().
+ *
+ * \param id the ID of the timer to remove
+ * \returns SDL_TRUE if the timer is removed or SDL_FALSE if the timer wasn't
+ *          found.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_AddTimer
+ */
+// extern DECLSPEC SDL_bool SDLCALL SDL_RemoveTimer(SDL_TimerID id);
+func SDL_RemoveTimer(id SDL_TimerID) bool {
+
+	timers.mu.Lock()
+	defer timers.mu.Unlock()
+
+	if key, ok := timers.byID[id]; ok {
+		delete(timers.m, key)
+		delete(timers.byID, id)
+	}
+
+	return C.SDL_TRUE == C.SDL_RemoveTimer(C.SDL_TimerID(id))
+}
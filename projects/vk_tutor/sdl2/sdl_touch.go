@@ -0,0 +1,28 @@
+package sdl2
+
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
+// #include "SDL.h"
+import "C"
+
+/**
+ *  \file SDL_touch.h
+ *
+ *  Include file for SDL touch event handling.
+ */
+
+// typedef Sint64 SDL_TouchID;
+// typedef Sint64 SDL_FingerID;
+type SDL_TouchID int64
+type SDL_FingerID int64
+
+/* Used as the device ID for mouse events simulated with touch input */
+// #define SDL_TOUCH_MOUSEID ((Uint32)-1)
+const SDL_TOUCH_MOUSEID uint32 = 0xFFFFFFFF
+
+/* Used as the SDL_TouchID for touch events simulated with mouse input */
+// #define SDL_MOUSE_TOUCHID ((Sint64)-1)
+const SDL_MOUSE_TOUCHID SDL_TouchID = -1
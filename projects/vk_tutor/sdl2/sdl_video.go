@@ -1,12 +1,26 @@
 package sdl2
 
-// #cgo CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2 -DSDL_MAIN_HANDLED
-// #cgo LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
-// #cgo LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
 // #include "SDL.h"
+//
+// extern SDL_HitTestResult goHitTest(SDL_Window*, SDL_Point*, void*);
+//
+// static SDL_HitTestResult SDLCALL _SDL_HitTest(SDL_Window *win, const SDL_Point *area, void *data) {
+//     return goHitTest(win, (SDL_Point*)area, data);
+// }
+//
+// static int _SDL_SetWindowHitTest(SDL_Window *window, uintptr_t h) {
+//     return SDL_SetWindowHitTest(window, 0 != h ? _SDL_HitTest : NULL, (void*)h);
+// }
 import "C"
 
 import (
+	"runtime/cgo"
+	"sync"
 	"unsafe"
 
 	"example.com/vk_tutor/sdl2/internal"
@@ -18,17 +32,17 @@ import (
  *  Header file for SDL video functions.
  */
 
-// /**
-//  *  \brief  The structure that defines a display mode
-//  *
-//  *  \sa SDL_GetNumDisplayModes()
-//  *  \sa SDL_GetDisplayMode()
-//  *  \sa SDL_GetDesktopDisplayMode()
-//  *  \sa SDL_GetCurrentDisplayMode()
-//  *  \sa SDL_GetClosestDisplayMode()
-//  *  \sa SDL_SetWindowDisplayMode()
-//  *  \sa SDL_GetWindowDisplayMode()
-//  */
+/**
+ *  \brief  The structure that defines a display mode
+ *
+ *  \sa SDL_GetNumDisplayModes()
+ *  \sa SDL_GetDisplayMode()
+ *  \sa SDL_GetDesktopDisplayMode()
+ *  \sa SDL_GetCurrentDisplayMode()
+ *  \sa SDL_GetClosestDisplayMode()
+ *  \sa SDL_SetWindowDisplayMode()
+ *  \sa SDL_GetWindowDisplayMode()
+ */
 // typedef struct
 // {
 //     Uint32 format;              /**< pixel format */
@@ -37,6 +51,31 @@ import (
 //     int refresh_rate;           /**< refresh rate (or zero for unspecified) */
 //     void *driverdata;           /**< driver-specific data, initialize to 0 */
 // } SDL_DisplayMode;
+type SDL_DisplayMode struct {
+	Format      uint32 /**< pixel format */
+	W           int    /**< width, in screen coordinates */
+	H           int    /**< height, in screen coordinates */
+	RefreshRate int    /**< refresh rate (or zero for unspecified) */
+	driverdata  unsafe.Pointer
+}
+
+func (o *SDL_DisplayMode) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_DisplayMode)(p)
+	o.Format = uint32(p1.format)
+	o.W = int(p1.w)
+	o.H = int(p1.h)
+	o.RefreshRate = int(p1.refresh_rate)
+	o.driverdata = p1.driverdata
+}
+
+func (o *SDL_DisplayMode) copyToCObj(p unsafe.Pointer) {
+	var p1 = (*C.SDL_DisplayMode)(p)
+	p1.format = C.Uint32(o.Format)
+	p1.w = C.int(o.W)
+	p1.h = C.int(o.H)
+	p1.refresh_rate = C.int(o.RefreshRate)
+	p1.driverdata = o.driverdata
+}
 
 /**
  *  \brief The type used to identify a window
@@ -74,6 +113,29 @@ import (
 // typedef struct SDL_Window SDL_Window;
 type SDL_Window internal.CObjWrapper
 
+func (o *SDL_Window) cObj() *C.SDL_Window {
+	if nil == o {
+		return nil
+	}
+	return (*C.SDL_Window)(internal.Unwrap[SDL_Window](o))
+}
+
+func wrapWindow(p *C.SDL_Window) *SDL_Window {
+	if nil == p {
+		return nil
+	}
+	return internal.WrapNew[SDL_Window](unsafe.Pointer(p))
+}
+
+func setInts(p1 *int, v1 C.int, p2 *int, v2 C.int) {
+	if nil != p1 {
+		*p1 = int(v1)
+	}
+	if nil != p2 {
+		*p2 = int(v2)
+	}
+}
+
 /**
  *  \brief The flags on a window
  *
@@ -183,50 +245,85 @@ func SDL_WINDOWPOS_ISCENTERED(x int) bool {
 }
 
 // /**
-//  *  \brief Event subtype for window events
-//  */
+//   - \brief Event subtype for window events
+//     */
+//
 // typedef enum
-// {
-//     SDL_WINDOWEVENT_NONE,           /**< Never used */
-//     SDL_WINDOWEVENT_SHOWN,          /**< Window has been shown */
-//     SDL_WINDOWEVENT_HIDDEN,         /**< Window has been hidden */
-//     SDL_WINDOWEVENT_EXPOSED,        /**< Window has been exposed and should be
-//                                          redrawn */
-//     SDL_WINDOWEVENT_MOVED,          /**< Window has been moved to data1, data2
-//                                      */
-//     SDL_WINDOWEVENT_RESIZED,        /**< Window has been resized to data1xdata2 */
-//     SDL_WINDOWEVENT_SIZE_CHANGED,   /**< The window size has changed, either as
-//                                          a result of an API call or through the
-//                                          system or user changing the window size. */
-//     SDL_WINDOWEVENT_MINIMIZED,      /**< Window has been minimized */
-//     SDL_WINDOWEVENT_MAXIMIZED,      /**< Window has been maximized */
-//     SDL_WINDOWEVENT_RESTORED,       /**< Window has been restored to normal size
-//                                          and position */
-//     SDL_WINDOWEVENT_ENTER,          /**< Window has gained mouse focus */
-//     SDL_WINDOWEVENT_LEAVE,          /**< Window has lost mouse focus */
-//     SDL_WINDOWEVENT_FOCUS_GAINED,   /**< Window has gained keyboard focus */
-//     SDL_WINDOWEVENT_FOCUS_LOST,     /**< Window has lost keyboard focus */
-//     SDL_WINDOWEVENT_CLOSE,          /**< The window manager requests that the window be closed */
-//     SDL_WINDOWEVENT_TAKE_FOCUS,     /**< Window is being offered a focus (should SetWindowInputFocus() on itself or a subwindow, or ignore) */
-//     SDL_WINDOWEVENT_HIT_TEST,       /**< Window had a hit test that wasn't SDL_HITTEST_NORMAL. */
-//     SDL_WINDOWEVENT_ICCPROF_CHANGED,/**< The ICC profile of the window's display has changed. */
-//     SDL_WINDOWEVENT_DISPLAY_CHANGED /**< Window has been moved to display data1. */
-// } SDL_WindowEventID;
+//
+//	{
+//	    SDL_WINDOWEVENT_NONE,           /**< Never used */
+//	    SDL_WINDOWEVENT_SHOWN,          /**< Window has been shown */
+//	    SDL_WINDOWEVENT_HIDDEN,         /**< Window has been hidden */
+//	    SDL_WINDOWEVENT_EXPOSED,        /**< Window has been exposed and should be
+//	                                         redrawn */
+//	    SDL_WINDOWEVENT_MOVED,          /**< Window has been moved to data1, data2
+//	                                     */
+//	    SDL_WINDOWEVENT_RESIZED,        /**< Window has been resized to data1xdata2 */
+//	    SDL_WINDOWEVENT_SIZE_CHANGED,   /**< The window size has changed, either as
+//	                                         a result of an API call or through the
+//	                                         system or user changing the window size. */
+//	    SDL_WINDOWEVENT_MINIMIZED,      /**< Window has been minimized */
+//	    SDL_WINDOWEVENT_MAXIMIZED,      /**< Window has been maximized */
+//	    SDL_WINDOWEVENT_RESTORED,       /**< Window has been restored to normal size
+//	                                         and position */
+//	    SDL_WINDOWEVENT_ENTER,          /**< Window has gained mouse focus */
+//	    SDL_WINDOWEVENT_LEAVE,          /**< Window has lost mouse focus */
+//	    SDL_WINDOWEVENT_FOCUS_GAINED,   /**< Window has gained keyboard focus */
+//	    SDL_WINDOWEVENT_FOCUS_LOST,     /**< Window has lost keyboard focus */
+//	    SDL_WINDOWEVENT_CLOSE,          /**< The window manager requests that the window be closed */
+//	    SDL_WINDOWEVENT_TAKE_FOCUS,     /**< Window is being offered a focus (should SetWindowInputFocus() on itself or a subwindow, or ignore) */
+//	    SDL_WINDOWEVENT_HIT_TEST,       /**< Window had a hit test that wasn't SDL_HITTEST_NORMAL. */
+//	    SDL_WINDOWEVENT_ICCPROF_CHANGED,/**< The ICC profile of the window's display has changed. */
+//	    SDL_WINDOWEVENT_DISPLAY_CHANGED /**< Window has been moved to display data1. */
+//	} SDL_WindowEventID;
+type SDL_WindowEventID uint8
+
+const (
+	SDL_WINDOWEVENT_NONE            SDL_WindowEventID = C.SDL_WINDOWEVENT_NONE            /**< Never used */
+	SDL_WINDOWEVENT_SHOWN           SDL_WindowEventID = C.SDL_WINDOWEVENT_SHOWN           /**< Window has been shown */
+	SDL_WINDOWEVENT_HIDDEN          SDL_WindowEventID = C.SDL_WINDOWEVENT_HIDDEN          /**< Window has been hidden */
+	SDL_WINDOWEVENT_EXPOSED         SDL_WindowEventID = C.SDL_WINDOWEVENT_EXPOSED         /**< Window has been exposed and should be redrawn */
+	SDL_WINDOWEVENT_MOVED           SDL_WindowEventID = C.SDL_WINDOWEVENT_MOVED           /**< Window has been moved to data1, data2 */
+	SDL_WINDOWEVENT_RESIZED         SDL_WindowEventID = C.SDL_WINDOWEVENT_RESIZED         /**< Window has been resized to data1xdata2 */
+	SDL_WINDOWEVENT_SIZE_CHANGED    SDL_WindowEventID = C.SDL_WINDOWEVENT_SIZE_CHANGED    /**< The window size has changed, either as a result of an API call or through the system or user changing the window size. */
+	SDL_WINDOWEVENT_MINIMIZED       SDL_WindowEventID = C.SDL_WINDOWEVENT_MINIMIZED       /**< Window has been minimized */
+	SDL_WINDOWEVENT_MAXIMIZED       SDL_WindowEventID = C.SDL_WINDOWEVENT_MAXIMIZED       /**< Window has been maximized */
+	SDL_WINDOWEVENT_RESTORED        SDL_WindowEventID = C.SDL_WINDOWEVENT_RESTORED        /**< Window has been restored to normal size and position */
+	SDL_WINDOWEVENT_ENTER           SDL_WindowEventID = C.SDL_WINDOWEVENT_ENTER           /**< Window has gained mouse focus */
+	SDL_WINDOWEVENT_LEAVE           SDL_WindowEventID = C.SDL_WINDOWEVENT_LEAVE           /**< Window has lost mouse focus */
+	SDL_WINDOWEVENT_FOCUS_GAINED    SDL_WindowEventID = C.SDL_WINDOWEVENT_FOCUS_GAINED    /**< Window has gained keyboard focus */
+	SDL_WINDOWEVENT_FOCUS_LOST      SDL_WindowEventID = C.SDL_WINDOWEVENT_FOCUS_LOST      /**< Window has lost keyboard focus */
+	SDL_WINDOWEVENT_CLOSE           SDL_WindowEventID = C.SDL_WINDOWEVENT_CLOSE           /**< The window manager requests that the window be closed */
+	SDL_WINDOWEVENT_TAKE_FOCUS      SDL_WindowEventID = C.SDL_WINDOWEVENT_TAKE_FOCUS      /**< Window is being offered a focus (should SetWindowInputFocus() on itself or a subwindow, or ignore) */
+	SDL_WINDOWEVENT_HIT_TEST        SDL_WindowEventID = C.SDL_WINDOWEVENT_HIT_TEST        /**< Window had a hit test that wasn't SDL_HITTEST_NORMAL. */
+	SDL_WINDOWEVENT_ICCPROF_CHANGED SDL_WindowEventID = C.SDL_WINDOWEVENT_ICCPROF_CHANGED /**< The ICC profile of the window's display has changed. */
+	SDL_WINDOWEVENT_DISPLAY_CHANGED SDL_WindowEventID = C.SDL_WINDOWEVENT_DISPLAY_CHANGED /**< Window has been moved to display data1. */
+)
 
 // /**
-//  *  \brief Event subtype for display events
-//  */
+//   - \brief Event subtype for display events
+//     */
+//
 // typedef enum
-// {
-//     SDL_DISPLAYEVENT_NONE,          /**< Never used */
-//     SDL_DISPLAYEVENT_ORIENTATION,   /**< Display orientation has changed to data1 */
-//     SDL_DISPLAYEVENT_CONNECTED,     /**< Display has been added to the system */
-//     SDL_DISPLAYEVENT_DISCONNECTED   /**< Display has been removed from the system */
-// } SDL_DisplayEventID;
+//
+//	{
+//	    SDL_DISPLAYEVENT_NONE,          /**< Never used */
+//	    SDL_DISPLAYEVENT_ORIENTATION,   /**< Display orientation has changed to data1 */
+//	    SDL_DISPLAYEVENT_CONNECTED,     /**< Display has been added to the system */
+//	    SDL_DISPLAYEVENT_DISCONNECTED   /**< Display has been removed from the system */
+//	} SDL_DisplayEventID;
+type SDL_DisplayEventID uint8
 
-// /**
-//  *  \brief Display orientation
-//  */
+const (
+	SDL_DISPLAYEVENT_NONE         SDL_DisplayEventID = C.SDL_DISPLAYEVENT_NONE         /**< Never used */
+	SDL_DISPLAYEVENT_ORIENTATION  SDL_DisplayEventID = C.SDL_DISPLAYEVENT_ORIENTATION  /**< Display orientation has changed to data1 */
+	SDL_DISPLAYEVENT_CONNECTED    SDL_DisplayEventID = C.SDL_DISPLAYEVENT_CONNECTED    /**< Display has been added to the system */
+	SDL_DISPLAYEVENT_DISCONNECTED SDL_DisplayEventID = C.SDL_DISPLAYEVENT_DISCONNECTED /**< Display has been removed from the system */
+)
+
+/**
+ *  \brief Display orientation
+ */
 // typedef enum
 // {
 //     SDL_ORIENTATION_UNKNOWN,            /**< The display orientation can't be determined */
@@ -235,16 +332,32 @@ func SDL_WINDOWPOS_ISCENTERED(x int) bool {
 //     SDL_ORIENTATION_PORTRAIT,           /**< The display is in portrait mode */
 //     SDL_ORIENTATION_PORTRAIT_FLIPPED    /**< The display is in portrait mode, upside down */
 // } SDL_DisplayOrientation;
+type SDL_DisplayOrientation int
 
-// /**
-//  *  \brief Window flash operation
-//  */
+const (
+	SDL_ORIENTATION_UNKNOWN           SDL_DisplayOrientation = C.SDL_ORIENTATION_UNKNOWN           /**< The display orientation can't be determined */
+	SDL_ORIENTATION_LANDSCAPE         SDL_DisplayOrientation = C.SDL_ORIENTATION_LANDSCAPE         /**< The display is in landscape mode, with the right side up, relative to portrait mode */
+	SDL_ORIENTATION_LANDSCAPE_FLIPPED SDL_DisplayOrientation = C.SDL_ORIENTATION_LANDSCAPE_FLIPPED /**< The display is in landscape mode, with the left side up, relative to portrait mode */
+	SDL_ORIENTATION_PORTRAIT          SDL_DisplayOrientation = C.SDL_ORIENTATION_PORTRAIT          /**< The display is in portrait mode */
+	SDL_ORIENTATION_PORTRAIT_FLIPPED  SDL_DisplayOrientation = C.SDL_ORIENTATION_PORTRAIT_FLIPPED  /**< The display is in portrait mode, upside down */
+)
+
+/**
+ *  \brief Window flash operation
+ */
 // typedef enum
 // {
 //     SDL_FLASH_CANCEL,                   /**< Cancel any window flash state */
 //     SDL_FLASH_BRIEFLY,                  /**< Flash the window briefly to get attention */
 //     SDL_FLASH_UNTIL_FOCUSED             /**< Flash the window until it gets focus */
 // } SDL_FlashOperation;
+type SDL_FlashOperation int
+
+const (
+	SDL_FLASH_CANCEL        SDL_FlashOperation = C.SDL_FLASH_CANCEL        /**< Cancel any window flash state */
+	SDL_FLASH_BRIEFLY       SDL_FlashOperation = C.SDL_FLASH_BRIEFLY       /**< Flash the window briefly to get attention */
+	SDL_FLASH_UNTIL_FOCUSED SDL_FlashOperation = C.SDL_FLASH_UNTIL_FOCUSED /**< Flash the window until it gets focus */
+)
 
 // /**
 //  *  \brief An opaque handle to an OpenGL context.
@@ -315,32 +428,42 @@ func SDL_WINDOWPOS_ISCENTERED(x int) bool {
 
 // /* Function prototypes */
 
-// /**
-//  * Get the number of video drivers compiled into SDL.
-//  *
-//  * \returns a number >= 1 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetVideoDriver
-//  */
+/**
+ * Get the number of video drivers compiled into SDL.
+ *
+ * \returns a number >= 1 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetVideoDriver
+ */
 // extern DECLSPEC int SDLCALL SDL_GetNumVideoDrivers(void);
+func SDL_GetNumVideoDrivers() (int, error) {
+	var n = C.SDL_GetNumVideoDrivers()
+	if n < 0 {
+		return 0, lastError("SDL_GetNumVideoDrivers")
+	}
+	return int(n), nil
+}
 
-// /**
-//  * Get the name of a built in video driver.
-//  *
-//  * The video drivers are presented in the order in which they are normally
-//  * checked during initialization.
-//  *
-//  * \param index the index of a video driver
-//  * \returns the name of the video driver with the given **index**.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetNumVideoDrivers
-//  */
+/**
+ * Get the name of a built in video driver.
+ *
+ * The video drivers are presented in the order in which they are normally
+ * checked during initialization.
+ *
+ * \param index the index of a video driver
+ * \returns the name of the video driver with the given **index**.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetNumVideoDrivers
+ */
 // extern DECLSPEC const char *SDLCALL SDL_GetVideoDriver(int index);
+func SDL_GetVideoDriver(index int) string {
+	return C.GoString(C.SDL_GetVideoDriver(C.int(index)))
+}
 
 // /**
 //  * Initialize the video subsystem, optionally specifying a video driver.
@@ -384,334 +507,494 @@ func SDL_WINDOWPOS_ISCENTERED(x int) bool {
 //  */
 // extern DECLSPEC void SDLCALL SDL_VideoQuit(void);
 
-// /**
-//  * Get the name of the currently initialized video driver.
-//  *
-//  * \returns the name of the current video driver or NULL if no driver has been
-//  *          initialized.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetNumVideoDrivers
-//  * \sa SDL_GetVideoDriver
-//  */
+/**
+ * Get the name of the currently initialized video driver.
+ *
+ * \returns the name of the current video driver or NULL if no driver has been
+ *          initialized.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetNumVideoDrivers
+ * \sa SDL_GetVideoDriver
+ */
 // extern DECLSPEC const char *SDLCALL SDL_GetCurrentVideoDriver(void);
+func SDL_GetCurrentVideoDriver() string {
+	return C.GoString(C.SDL_GetCurrentVideoDriver())
+}
 
-// /**
-//  * Get the number of available video displays.
-//  *
-//  * \returns a number >= 1 or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetDisplayBounds
-//  */
+/**
+ * Get the number of available video displays.
+ *
+ * \returns a number >= 1 or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetDisplayBounds
+ */
 // extern DECLSPEC int SDLCALL SDL_GetNumVideoDisplays(void);
+func SDL_GetNumVideoDisplays() (int, error) {
+	var n = C.SDL_GetNumVideoDisplays()
+	if n < 0 {
+		return 0, lastError("SDL_GetNumVideoDisplays")
+	}
+	return int(n), nil
+}
 
-// /**
-//  * Get the name of a display in UTF-8 encoding.
-//  *
-//  * \param displayIndex the index of display from which the name should be
-//  *                     queried
-//  * \returns the name of a display or NULL for an invalid display index or
-//  *          failure; call SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetNumVideoDisplays
-//  */
+/**
+ * Get the name of a display in UTF-8 encoding.
+ *
+ * \param displayIndex the index of display from which the name should be
+ *                     queried
+ * \returns the name of a display or NULL for an invalid display index or
+ *          failure; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetNumVideoDisplays
+ */
 // extern DECLSPEC const char * SDLCALL SDL_GetDisplayName(int displayIndex);
+func SDL_GetDisplayName(displayIndex int) (string, error) {
+	var name = C.SDL_GetDisplayName(C.int(displayIndex))
+	if nil == name {
+		return "", lastError("SDL_GetDisplayName")
+	}
+	return C.GoString(name), nil
+}
 
-// /**
-//  * Get the desktop area represented by a display.
-//  *
-//  * The primary display (`displayIndex` zero) is always located at 0,0.
-//  *
-//  * \param displayIndex the index of the display to query
-//  * \param rect the SDL_Rect structure filled in with the display bounds
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetNumVideoDisplays
-//  */
+/**
+ * Get the desktop area represented by a display.
+ *
+ * The primary display (`displayIndex` zero) is always located at 0,0.
+ *
+ * \param displayIndex the index of the display to query
+ * \param rect the SDL_Rect structure filled in with the display bounds
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetNumVideoDisplays
+ */
 // extern DECLSPEC int SDLCALL SDL_GetDisplayBounds(int displayIndex, SDL_Rect * rect);
+func SDL_GetDisplayBounds(displayIndex int, rect *SDL_Rect) error {
 
-// /**
-//  * Get the usable desktop area represented by a display.
-//  *
-//  * The primary display (`displayIndex` zero) is always located at 0,0.
-//  *
-//  * This is the same area as SDL_GetDisplayBounds() reports, but with portions
-//  * reserved by the system removed. For example, on Apple's macOS, this
-//  * subtracts the area occupied by the menu bar and dock.
-//  *
-//  * Setting a window to be fullscreen generally bypasses these unusable areas,
-//  * so these are good guidelines for the maximum space available to a
-//  * non-fullscreen window.
-//  *
-//  * The parameter `rect` is ignored if it is NULL.
-//  *
-//  * This function also returns -1 if the parameter `displayIndex` is out of
-//  * range.
-//  *
-//  * \param displayIndex the index of the display to query the usable bounds
-//  *                     from
-//  * \param rect the SDL_Rect structure filled in with the display bounds
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.5.
-//  *
-//  * \sa SDL_GetDisplayBounds
-//  * \sa SDL_GetNumVideoDisplays
-//  */
+	var rect1 C.SDL_Rect
+	if err := checkError("SDL_GetDisplayBounds", C.SDL_GetDisplayBounds(C.int(displayIndex), &rect1)); nil != err {
+		return err
+	}
+
+	rect.copyFromCObj(unsafe.Pointer(&rect1))
+	return nil
+}
+
+/**
+ * Get the usable desktop area represented by a display.
+ *
+ * The primary display (`displayIndex` zero) is always located at 0,0.
+ *
+ * This is the same area as SDL_GetDisplayBounds() reports, but with portions
+ * reserved by the system removed. For example, on Apple's macOS, this
+ * subtracts the area occupied by the menu bar and dock.
+ *
+ * Setting a window to be fullscreen generally bypasses these unusable areas,
+ * so these are good guidelines for the maximum space available to a
+ * non-fullscreen window.
+ *
+ * The parameter `rect` is ignored if it is NULL.
+ *
+ * This function also returns -1 if the parameter `displayIndex` is out of
+ * range.
+ *
+ * \param displayIndex the index of the display to query the usable bounds
+ *                     from
+ * \param rect the SDL_Rect structure filled in with the display bounds
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.5.
+ *
+ * \sa SDL_GetDisplayBounds
+ * \sa SDL_GetNumVideoDisplays
+ */
 // extern DECLSPEC int SDLCALL SDL_GetDisplayUsableBounds(int displayIndex, SDL_Rect * rect);
+func SDL_GetDisplayUsableBounds(displayIndex int, rect *SDL_Rect) error {
 
-// /**
-//  * Get the dots/pixels-per-inch for a display.
-//  *
-//  * Diagonal, horizontal and vertical DPI can all be optionally returned if the
-//  * appropriate parameter is non-NULL.
-//  *
-//  * A failure of this function usually means that either no DPI information is
-//  * available or the `displayIndex` is out of range.
-//  *
-//  * **WARNING**: This reports the DPI that the hardware reports, and it is not
-//  * always reliable! It is almost always better to use SDL_GetWindowSize() to
-//  * find the window size, which might be in logical points instead of pixels,
-//  * and then SDL_GL_GetDrawableSize(), SDL_Vulkan_GetDrawableSize(),
-//  * SDL_Metal_GetDrawableSize(), or SDL_GetRendererOutputSize(), and compare
-//  * the two values to get an actual scaling value between the two. We will be
-//  * rethinking how high-dpi details should be managed in SDL3 to make things
-//  * more consistent, reliable, and clear.
-//  *
-//  * \param displayIndex the index of the display from which DPI information
-//  *                     should be queried
-//  * \param ddpi a pointer filled in with the diagonal DPI of the display; may
-//  *             be NULL
-//  * \param hdpi a pointer filled in with the horizontal DPI of the display; may
-//  *             be NULL
-//  * \param vdpi a pointer filled in with the vertical DPI of the display; may
-//  *             be NULL
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.4.
-//  *
-//  * \sa SDL_GetNumVideoDisplays
-//  */
+	var rect1 C.SDL_Rect
+	if err := checkError("SDL_GetDisplayUsableBounds", C.SDL_GetDisplayUsableBounds(C.int(displayIndex), &rect1)); nil != err {
+		return err
+	}
+
+	rect.copyFromCObj(unsafe.Pointer(&rect1))
+	return nil
+}
+
+/**
+ * Get the dots/pixels-per-inch for a display.
+ *
+ * Diagonal, horizontal and vertical DPI can all be optionally returned if the
+ * appropriate parameter is non-NULL.
+ *
+ * A failure of this function usually means that either no DPI information is
+ * available or the `displayIndex` is out of range.
+ *
+ * **WARNING**: This reports the DPI that the hardware reports, and it is not
+ * always reliable! It is almost always better to use SDL_GetWindowSize() to
+ * find the window size, which might be in logical points instead of pixels,
+ * and then SDL_GL_GetDrawableSize(), SDL_Vulkan_GetDrawableSize(),
+ * SDL_Metal_GetDrawableSize(), or SDL_GetRendererOutputSize(), and compare
+ * the two values to get an actual scaling value between the two. We will be
+ * rethinking how high-dpi details should be managed in SDL3 to make things
+ * more consistent, reliable, and clear.
+ *
+ * \param displayIndex the index of the display from which DPI information
+ *                     should be queried
+ * \param ddpi a pointer filled in with the diagonal DPI of the display; may
+ *             be NULL
+ * \param hdpi a pointer filled in with the horizontal DPI of the display; may
+ *             be NULL
+ * \param vdpi a pointer filled in with the vertical DPI of the display; may
+ *             be NULL
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.4.
+ *
+ * \sa SDL_GetNumVideoDisplays
+ */
 // extern DECLSPEC int SDLCALL SDL_GetDisplayDPI(int displayIndex, float * ddpi, float * hdpi, float * vdpi);
+func SDL_GetDisplayDPI(displayIndex int, ddpi, hdpi, vdpi *float32) error {
 
-// /**
-//  * Get the orientation of a display.
-//  *
-//  * \param displayIndex the index of the display to query
-//  * \returns The SDL_DisplayOrientation enum value of the display, or
-//  *          `SDL_ORIENTATION_UNKNOWN` if it isn't available.
-//  *
-//  * \since This function is available since SDL 2.0.9.
-//  *
-//  * \sa SDL_GetNumVideoDisplays
-//  */
+	var ddpi1, hdpi1, vdpi1 C.float
+	if err := checkError("SDL_GetDisplayDPI", C.SDL_GetDisplayDPI(C.int(displayIndex), &ddpi1, &hdpi1, &vdpi1)); nil != err {
+		return err
+	}
+
+	if nil != ddpi {
+		*ddpi = float32(ddpi1)
+	}
+	if nil != hdpi {
+		*hdpi = float32(hdpi1)
+	}
+	if nil != vdpi {
+		*vdpi = float32(vdpi1)
+	}
+
+	return nil
+}
+
+/**
+ * Get the orientation of a display.
+ *
+ * \param displayIndex the index of the display to query
+ * \returns The SDL_DisplayOrientation enum value of the display, or
+ *          `SDL_ORIENTATION_UNKNOWN` if it isn't available.
+ *
+ * \since This function is available since SDL 2.0.9.
+ *
+ * \sa SDL_GetNumVideoDisplays
+ */
 // extern DECLSPEC SDL_DisplayOrientation SDLCALL SDL_GetDisplayOrientation(int displayIndex);
+func SDL_GetDisplayOrientation(displayIndex int) SDL_DisplayOrientation {
+	return SDL_DisplayOrientation(C.SDL_GetDisplayOrientation(C.int(displayIndex)))
+}
 
-// /**
-//  * Get the number of available display modes.
-//  *
-//  * The `displayIndex` needs to be in the range from 0 to
-//  * SDL_GetNumVideoDisplays() - 1.
-//  *
-//  * \param displayIndex the index of the display to query
-//  * \returns a number >= 1 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetDisplayMode
-//  * \sa SDL_GetNumVideoDisplays
-//  */
+/**
+ * Get the number of available display modes.
+ *
+ * The `displayIndex` needs to be in the range from 0 to
+ * SDL_GetNumVideoDisplays() - 1.
+ *
+ * \param displayIndex the index of the display to query
+ * \returns a number >= 1 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetDisplayMode
+ * \sa SDL_GetNumVideoDisplays
+ */
 // extern DECLSPEC int SDLCALL SDL_GetNumDisplayModes(int displayIndex);
+func SDL_GetNumDisplayModes(displayIndex int) (int, error) {
+	var n = C.SDL_GetNumDisplayModes(C.int(displayIndex))
+	if n < 0 {
+		return 0, lastError("SDL_GetNumDisplayModes")
+	}
+	return int(n), nil
+}
 
-// /**
-//  * Get information about a specific display mode.
-//  *
-//  * The display modes are sorted in this priority:
-//  *
-//  * - width -> largest to smallest
-//  * - height -> largest to smallest
-//  * - bits per pixel -> more colors to fewer colors
-//  * - packed pixel layout -> largest to smallest
-//  * - refresh rate -> highest to lowest
-//  *
-//  * \param displayIndex the index of the display to query
-//  * \param modeIndex the index of the display mode to query
-//  * \param mode an SDL_DisplayMode structure filled in with the mode at
-//  *             `modeIndex`
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetNumDisplayModes
-//  */
+/**
+ * Get information about a specific display mode.
+ *
+ * The display modes are sorted in this priority:
+ *
+ * - width -> largest to smallest
+ * - height -> largest to smallest
+ * - bits per pixel -> more colors to fewer colors
+ * - packed pixel layout -> largest to smallest
+ * - refresh rate -> highest to lowest
+ *
+ * \param displayIndex the index of the display to query
+ * \param modeIndex the index of the display mode to query
+ * \param mode an SDL_DisplayMode structure filled in with the mode at
+ *             `modeIndex`
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetNumDisplayModes
+ */
 // extern DECLSPEC int SDLCALL SDL_GetDisplayMode(int displayIndex, int modeIndex,
 //                                                SDL_DisplayMode * mode);
+func SDL_GetDisplayMode(displayIndex, modeIndex int, mode *SDL_DisplayMode) error {
 
-// /**
-//  * Get information about the desktop's display mode.
-//  *
-//  * There's a difference between this function and SDL_GetCurrentDisplayMode()
-//  * when SDL runs fullscreen and has changed the resolution. In that case this
-//  * function will return the previous native display mode, and not the current
-//  * display mode.
-//  *
-//  * \param displayIndex the index of the display to query
-//  * \param mode an SDL_DisplayMode structure filled in with the current display
-//  *             mode
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetCurrentDisplayMode
-//  * \sa SDL_GetDisplayMode
-//  * \sa SDL_SetWindowDisplayMode
-//  */
-// extern DECLSPEC int SDLCALL SDL_GetDesktopDisplayMode(int displayIndex, SDL_DisplayMode * mode);
+	var mode1 C.SDL_DisplayMode
+	if err := checkError("SDL_GetDisplayMode", C.SDL_GetDisplayMode(C.int(displayIndex), C.int(modeIndex), &mode1)); nil != err {
+		return err
+	}
 
-// /**
-//  * Get information about the current display mode.
-//  *
-//  * There's a difference between this function and SDL_GetDesktopDisplayMode()
-//  * when SDL runs fullscreen and has changed the resolution. In that case this
-//  * function will return the current display mode, and not the previous native
-//  * display mode.
-//  *
-//  * \param displayIndex the index of the display to query
-//  * \param mode an SDL_DisplayMode structure filled in with the current display
-//  *             mode
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetDesktopDisplayMode
-//  * \sa SDL_GetDisplayMode
-//  * \sa SDL_GetNumVideoDisplays
-//  * \sa SDL_SetWindowDisplayMode
-//  */
+	mode.copyFromCObj(unsafe.Pointer(&mode1))
+	return nil
+}
+
+/**
+ * Get information about the desktop's display mode.
+ *
+ * There's a difference between this function and SDL_GetCurrentDisplayMode()
+ * when SDL runs fullscreen and has changed the resolution. In that case this
+ * function will return the previous native display mode, and not the current
+ * display mode.
+ *
+ * \param displayIndex the index of the display to query
+ * \param mode an SDL_DisplayMode structure filled in with the current display
+ *             mode
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetCurrentDisplayMode
+ * \sa SDL_GetDisplayMode
+ * \sa SDL_SetWindowDisplayMode
+ */
+// extern DECLSPEC int SDLCALL SDL_GetDesktopDisplayMode(int displayIndex, SDL_DisplayMode * mode);
+func SDL_GetDesktopDisplayMode(displayIndex int, mode *SDL_DisplayMode) error {
+
+	var mode1 C.SDL_DisplayMode
+	if err := checkError("SDL_GetDesktopDisplayMode", C.SDL_GetDesktopDisplayMode(C.int(displayIndex), &mode1)); nil != err {
+		return err
+	}
+
+	mode.copyFromCObj(unsafe.Pointer(&mode1))
+	return nil
+}
+
+/**
+ * Get information about the current display mode.
+ *
+ * There's a difference between this function and SDL_GetDesktopDisplayMode()
+ * when SDL runs fullscreen and has changed the resolution. In that case this
+ * function will return the current display mode, and not the previous native
+ * display mode.
+ *
+ * \param displayIndex the index of the display to query
+ * \param mode an SDL_DisplayMode structure filled in with the current display
+ *             mode
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetDesktopDisplayMode
+ * \sa SDL_GetDisplayMode
+ * \sa SDL_GetNumVideoDisplays
+ * \sa SDL_SetWindowDisplayMode
+ */
 // extern DECLSPEC int SDLCALL SDL_GetCurrentDisplayMode(int displayIndex, SDL_DisplayMode * mode);
+func SDL_GetCurrentDisplayMode(displayIndex int, mode *SDL_DisplayMode) error {
 
-// /**
-//  * Get the closest match to the requested display mode.
-//  *
-//  * The available display modes are scanned and `closest` is filled in with the
-//  * closest mode matching the requested mode and returned. The mode format and
-//  * refresh rate default to the desktop mode if they are set to 0. The modes
-//  * are scanned with size being first priority, format being second priority,
-//  * and finally checking the refresh rate. If all the available modes are too
-//  * small, then NULL is returned.
-//  *
-//  * \param displayIndex the index of the display to query
-//  * \param mode an SDL_DisplayMode structure containing the desired display
-//  *             mode
-//  * \param closest an SDL_DisplayMode structure filled in with the closest
-//  *                match of the available display modes
-//  * \returns the passed in value `closest` or NULL if no matching video mode
-//  *          was available; call SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetDisplayMode
-//  * \sa SDL_GetNumDisplayModes
-//  */
+	var mode1 C.SDL_DisplayMode
+	if err := checkError("SDL_GetCurrentDisplayMode", C.SDL_GetCurrentDisplayMode(C.int(displayIndex), &mode1)); nil != err {
+		return err
+	}
+
+	mode.copyFromCObj(unsafe.Pointer(&mode1))
+	return nil
+}
+
+/**
+ * Get the closest match to the requested display mode.
+ *
+ * The available display modes are scanned and `closest` is filled in with the
+ * closest mode matching the requested mode and returned. The mode format and
+ * refresh rate default to the desktop mode if they are set to 0. The modes
+ * are scanned with size being first priority, format being second priority,
+ * and finally checking the refresh rate. If all the available modes are too
+ * small, then NULL is returned.
+ *
+ * \param displayIndex the index of the display to query
+ * \param mode an SDL_DisplayMode structure containing the desired display
+ *             mode
+ * \param closest an SDL_DisplayMode structure filled in with the closest
+ *                match of the available display modes
+ * \returns the passed in value `closest` or NULL if no matching video mode
+ *          was available; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetDisplayMode
+ * \sa SDL_GetNumDisplayModes
+ */
 // extern DECLSPEC SDL_DisplayMode * SDLCALL SDL_GetClosestDisplayMode(int displayIndex, const SDL_DisplayMode * mode, SDL_DisplayMode * closest);
+func SDL_GetClosestDisplayMode(displayIndex int, mode *SDL_DisplayMode, closest *SDL_DisplayMode) error {
 
-// /**
-//  * Get the index of the display containing a point
-//  *
-//  * \param point the point to query
-//  * \returns the index of the display containing the point or a negative error
-//  *          code on failure; call SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.24.0.
-//  *
-//  * \sa SDL_GetDisplayBounds
-//  * \sa SDL_GetNumVideoDisplays
-//  */
+	var mode1, closest1 C.SDL_DisplayMode
+	mode.copyToCObj(unsafe.Pointer(&mode1))
+
+	if nil == C.SDL_GetClosestDisplayMode(C.int(displayIndex), &mode1, &closest1) {
+		return lastError("SDL_GetClosestDisplayMode")
+	}
+
+	closest.copyFromCObj(unsafe.Pointer(&closest1))
+	return nil
+}
+
+/**
+ * Get the index of the display containing a point
+ *
+ * \param point the point to query
+ * \returns the index of the display containing the point or a negative error
+ *          code on failure; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.24.0.
+ *
+ * \sa SDL_GetDisplayBounds
+ * \sa SDL_GetNumVideoDisplays
+ */
 // extern DECLSPEC int SDLCALL SDL_GetPointDisplayIndex(const SDL_Point * point);
+func SDL_GetPointDisplayIndex(point *SDL_Point) (int, error) {
 
-// /**
-//  * Get the index of the display primarily containing a rect
-//  *
-//  * \param rect the rect to query
-//  * \returns the index of the display entirely containing the rect or closest
-//  *          to the center of the rect on success or a negative error code on
-//  *          failure; call SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.24.0.
-//  *
-//  * \sa SDL_GetDisplayBounds
-//  * \sa SDL_GetNumVideoDisplays
-//  */
+	var point1 C.SDL_Point
+	point.copyToCObj(unsafe.Pointer(&point1))
+
+	var i = C.SDL_GetPointDisplayIndex(&point1)
+	if i < 0 {
+		return 0, lastError("SDL_GetPointDisplayIndex")
+	}
+
+	return int(i), nil
+}
+
+/**
+ * Get the index of the display primarily containing a rect
+ *
+ * \param rect the rect to query
+ * \returns the index of the display entirely containing the rect or closest
+ *          to the center of the rect on success or a negative error code on
+ *          failure; call SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.24.0.
+ *
+ * \sa SDL_GetDisplayBounds
+ * \sa SDL_GetNumVideoDisplays
+ */
 // extern DECLSPEC int SDLCALL SDL_GetRectDisplayIndex(const SDL_Rect * rect);
+func SDL_GetRectDisplayIndex(rect *SDL_Rect) (int, error) {
 
-// /**
-//  * Get the index of the display associated with a window.
-//  *
-//  * \param window the window to query
-//  * \returns the index of the display containing the center of the window on
-//  *          success or a negative error code on failure; call SDL_GetError()
-//  *          for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetDisplayBounds
-//  * \sa SDL_GetNumVideoDisplays
-//  */
+	var rect1 C.SDL_Rect
+	rect.copyToCObj(unsafe.Pointer(&rect1))
+
+	var i = C.SDL_GetRectDisplayIndex(&rect1)
+	if i < 0 {
+		return 0, lastError("SDL_GetRectDisplayIndex")
+	}
+
+	return int(i), nil
+}
+
+/**
+ * Get the index of the display associated with a window.
+ *
+ * \param window the window to query
+ * \returns the index of the display containing the center of the window on
+ *          success or a negative error code on failure; call SDL_GetError()
+ *          for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetDisplayBounds
+ * \sa SDL_GetNumVideoDisplays
+ */
 // extern DECLSPEC int SDLCALL SDL_GetWindowDisplayIndex(SDL_Window * window);
+func SDL_GetWindowDisplayIndex(window *SDL_Window) (int, error) {
+	var i = C.SDL_GetWindowDisplayIndex(window.cObj())
+	if i < 0 {
+		return 0, lastError("SDL_GetWindowDisplayIndex")
+	}
+	return int(i), nil
+}
 
-// /**
-//  * Set the display mode to use when a window is visible at fullscreen.
-//  *
-//  * This only affects the display mode used when the window is fullscreen. To
-//  * change the window size when the window is not fullscreen, use
-//  * SDL_SetWindowSize().
-//  *
-//  * \param window the window to affect
-//  * \param mode the SDL_DisplayMode structure representing the mode to use, or
-//  *             NULL to use the window's dimensions and the desktop's format
-//  *             and refresh rate
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowDisplayMode
-//  * \sa SDL_SetWindowFullscreen
-//  */
+/**
+ * Set the display mode to use when a window is visible at fullscreen.
+ *
+ * This only affects the display mode used when the window is fullscreen. To
+ * change the window size when the window is not fullscreen, use
+ * SDL_SetWindowSize().
+ *
+ * \param window the window to affect
+ * \param mode the SDL_DisplayMode structure representing the mode to use, or
+ *             NULL to use the window's dimensions and the desktop's format
+ *             and refresh rate
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowDisplayMode
+ * \sa SDL_SetWindowFullscreen
+ */
 // extern DECLSPEC int SDLCALL SDL_SetWindowDisplayMode(SDL_Window * window,
 //                                                      const SDL_DisplayMode * mode);
+func SDL_SetWindowDisplayMode(window *SDL_Window, mode *SDL_DisplayMode) error {
 
-// /**
-//  * Query the display mode to use when a window is visible at fullscreen.
-//  *
-//  * \param window the window to query
-//  * \param mode an SDL_DisplayMode structure filled in with the fullscreen
-//  *             display mode
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_SetWindowDisplayMode
-//  * \sa SDL_SetWindowFullscreen
-//  */
+	var mode1 C.SDL_DisplayMode
+	var pMode1 *C.SDL_DisplayMode
+	if nil != mode {
+		mode.copyToCObj(unsafe.Pointer(&mode1))
+		pMode1 = &mode1
+	}
+
+	return checkError("SDL_SetWindowDisplayMode", C.SDL_SetWindowDisplayMode(window.cObj(), pMode1))
+}
+
+/**
+ * Query the display mode to use when a window is visible at fullscreen.
+ *
+ * \param window the window to query
+ * \param mode an SDL_DisplayMode structure filled in with the fullscreen
+ *             display mode
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_SetWindowDisplayMode
+ * \sa SDL_SetWindowFullscreen
+ */
 // extern DECLSPEC int SDLCALL SDL_GetWindowDisplayMode(SDL_Window * window,
 //                                                      SDL_DisplayMode * mode);
+func SDL_GetWindowDisplayMode(window *SDL_Window, mode *SDL_DisplayMode) error {
+
+	var mode1 C.SDL_DisplayMode
+	if err := checkError("SDL_GetWindowDisplayMode", C.SDL_GetWindowDisplayMode(window.cObj(), &mode1)); nil != err {
+		return err
+	}
+
+	mode.copyFromCObj(unsafe.Pointer(&mode1))
+	return nil
+}
 
 // /**
 //  * Get the raw ICC profile data for the screen the window is currently on.
@@ -813,7 +1096,7 @@ func SDL_WINDOWPOS_ISCENTERED(x int) bool {
 // extern DECLSPEC SDL_Window * SDLCALL SDL_CreateWindow(const char *title,
 //                                                       int x, int y, int w,
 //                                                       int h, Uint32 flags);
-func SDL_CreateWindow(title string, x, y, w, h int, flags SDL_WindowFlags) *SDL_Window {
+func SDL_CreateWindow(title string, x, y, w, h int, flags SDL_WindowFlags) (*SDL_Window, error) {
 
 	var title_c = C.CString(title)
 	defer C.free(unsafe.Pointer(title_c))
@@ -828,10 +1111,10 @@ func SDL_CreateWindow(title string, x, y, w, h int, flags SDL_WindowFlags) *SDL_
 	)
 
 	if nil == p {
-		return nil
+		return nil, lastError("SDL_CreateWindow")
 	}
 
-	return internal.WrapNew[SDL_Window](unsafe.Pointer(p))
+	return internal.WrapNew[SDL_Window](unsafe.Pointer(p)), nil
 }
 
 // /**
@@ -853,83 +1136,108 @@ func SDL_CreateWindow(title string, x, y, w, h int, flags SDL_WindowFlags) *SDL_
 //  */
 // extern DECLSPEC SDL_Window * SDLCALL SDL_CreateWindowFrom(const void *data);
 
-// /**
-//  * Get the numeric ID of a window.
-//  *
-//  * The numeric ID is what SDL_WindowEvent references, and is necessary to map
-//  * these events to specific SDL_Window objects.
-//  *
-//  * \param window the window to query
-//  * \returns the ID of the window on success or 0 on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowFromID
-//  */
+/**
+ * Get the numeric ID of a window.
+ *
+ * The numeric ID is what SDL_WindowEvent references, and is necessary to map
+ * these events to specific SDL_Window objects.
+ *
+ * \param window the window to query
+ * \returns the ID of the window on success or 0 on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowFromID
+ */
 // extern DECLSPEC Uint32 SDLCALL SDL_GetWindowID(SDL_Window * window);
+func SDL_GetWindowID(window *SDL_Window) (uint32, error) {
+	var id = C.SDL_GetWindowID(window.cObj())
+	if 0 == id {
+		return 0, lastError("SDL_GetWindowID")
+	}
+	return uint32(id), nil
+}
 
-// /**
-//  * Get a window from a stored ID.
-//  *
-//  * The numeric ID is what SDL_WindowEvent references, and is necessary to map
-//  * these events to specific SDL_Window objects.
-//  *
-//  * \param id the ID of the window
-//  * \returns the window associated with `id` or NULL if it doesn't exist; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowID
-//  */
+/**
+ * Get a window from a stored ID.
+ *
+ * The numeric ID is what SDL_WindowEvent references, and is necessary to map
+ * these events to specific SDL_Window objects.
+ *
+ * \param id the ID of the window
+ * \returns the window associated with `id` or NULL if it doesn't exist; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowID
+ */
 // extern DECLSPEC SDL_Window * SDLCALL SDL_GetWindowFromID(Uint32 id);
+//
+// The result is a new wrapper of the window, compare windows by ID.
+func SDL_GetWindowFromID(id uint32) *SDL_Window {
+	return wrapWindow(C.SDL_GetWindowFromID(C.Uint32(id)))
+}
 
-// /**
-//  * Get the window flags.
-//  *
-//  * \param window the window to query
-//  * \returns a mask of the SDL_WindowFlags associated with `window`
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_CreateWindow
-//  * \sa SDL_HideWindow
-//  * \sa SDL_MaximizeWindow
-//  * \sa SDL_MinimizeWindow
-//  * \sa SDL_SetWindowFullscreen
-//  * \sa SDL_SetWindowGrab
-//  * \sa SDL_ShowWindow
-//  */
+/**
+ * Get the window flags.
+ *
+ * \param window the window to query
+ * \returns a mask of the SDL_WindowFlags associated with `window`
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_CreateWindow
+ * \sa SDL_HideWindow
+ * \sa SDL_MaximizeWindow
+ * \sa SDL_MinimizeWindow
+ * \sa SDL_SetWindowFullscreen
+ * \sa SDL_SetWindowGrab
+ * \sa SDL_ShowWindow
+ */
 // extern DECLSPEC Uint32 SDLCALL SDL_GetWindowFlags(SDL_Window * window);
+func SDL_GetWindowFlags(window *SDL_Window) SDL_WindowFlags {
+	return SDL_WindowFlags(C.SDL_GetWindowFlags(window.cObj()))
+}
 
-// /**
-//  * Set the title of a window.
-//  *
-//  * This string is expected to be in UTF-8 encoding.
-//  *
-//  * \param window the window to change
-//  * \param title the desired window title in UTF-8 format
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowTitle
-//  */
+/**
+ * Set the title of a window.
+ *
+ * This string is expected to be in UTF-8 encoding.
+ *
+ * \param window the window to change
+ * \param title the desired window title in UTF-8 format
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowTitle
+ */
 // extern DECLSPEC void SDLCALL SDL_SetWindowTitle(SDL_Window * window,
 //                                                 const char *title);
+func SDL_SetWindowTitle(window *SDL_Window, title string) {
 
-// /**
-//  * Get the title of a window.
-//  *
-//  * \param window the window to query
-//  * \returns the title of the window in UTF-8 format or "" if there is no
-//  *          title.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_SetWindowTitle
-//  */
+	var title_c = C.CString(title)
+	defer C.free(unsafe.Pointer(title_c))
+
+	C.SDL_SetWindowTitle(window.cObj(), title_c)
+}
+
+/**
+ * Get the title of a window.
+ *
+ * \param window the window to query
+ * \returns the title of the window in UTF-8 format or "" if there is no
+ *          title.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_SetWindowTitle
+ */
 // extern DECLSPEC const char *SDLCALL SDL_GetWindowTitle(SDL_Window * window);
+func SDL_GetWindowTitle(window *SDL_Window) string {
+	return C.GoString(C.SDL_GetWindowTitle(window.cObj()))
+}
 
 // /**
 //  * Set the icon for a window.
@@ -974,270 +1282,327 @@ func SDL_CreateWindow(title string, x, y, w, h int, flags SDL_WindowFlags) *SDL_
 // extern DECLSPEC void *SDLCALL SDL_GetWindowData(SDL_Window * window,
 //                                                 const char *name);
 
-// /**
-//  * Set the position of a window.
-//  *
-//  * The window coordinate origin is the upper left of the display.
-//  *
-//  * \param window the window to reposition
-//  * \param x the x coordinate of the window in screen coordinates, or
-//  *          `SDL_WINDOWPOS_CENTERED` or `SDL_WINDOWPOS_UNDEFINED`
-//  * \param y the y coordinate of the window in screen coordinates, or
-//  *          `SDL_WINDOWPOS_CENTERED` or `SDL_WINDOWPOS_UNDEFINED`
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowPosition
-//  */
+/**
+ * Set the position of a window.
+ *
+ * The window coordinate origin is the upper left of the display.
+ *
+ * \param window the window to reposition
+ * \param x the x coordinate of the window in screen coordinates, or
+ *          `SDL_WINDOWPOS_CENTERED` or `SDL_WINDOWPOS_UNDEFINED`
+ * \param y the y coordinate of the window in screen coordinates, or
+ *          `SDL_WINDOWPOS_CENTERED` or `SDL_WINDOWPOS_UNDEFINED`
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowPosition
+ */
 // extern DECLSPEC void SDLCALL SDL_SetWindowPosition(SDL_Window * window,
 //                                                    int x, int y);
+func SDL_SetWindowPosition(window *SDL_Window, x, y int) {
+	C.SDL_SetWindowPosition(window.cObj(), C.int(x), C.int(y))
+}
 
-// /**
-//  * Get the position of a window.
-//  *
-//  * If you do not need the value for one of the positions a NULL may be passed
-//  * in the `x` or `y` parameter.
-//  *
-//  * \param window the window to query
-//  * \param x a pointer filled in with the x position of the window, in screen
-//  *          coordinates, may be NULL
-//  * \param y a pointer filled in with the y position of the window, in screen
-//  *          coordinates, may be NULL
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_SetWindowPosition
-//  */
-// extern DECLSPEC void SDLCALL SDL_GetWindowPosition(SDL_Window * window,
-//                                                    int *x, int *y);
-
-// /**
-//  * Set the size of a window's client area.
-//  *
-//  * The window size in screen coordinates may differ from the size in pixels,
-//  * if the window was created with `SDL_WINDOW_ALLOW_HIGHDPI` on a platform
-//  * with high-dpi support (e.g. iOS or macOS). Use SDL_GL_GetDrawableSize() or
-//  * SDL_GetRendererOutputSize() to get the real client area size in pixels.
-//  *
-//  * Fullscreen windows automatically match the size of the display mode, and
-//  * you should use SDL_SetWindowDisplayMode() to change their size.
-//  *
-//  * \param window the window to change
-//  * \param w the width of the window in pixels, in screen coordinates, must be
-//  *          > 0
-//  * \param h the height of the window in pixels, in screen coordinates, must be
-//  *          > 0
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowSize
-//  * \sa SDL_SetWindowDisplayMode
-//  */
+/**
+ * Get the position of a window.
+ *
+ * If you do not need the value for one of the positions a NULL may be passed
+ * in the `x` or `y` parameter.
+ *
+ * \param window the window to query
+ * \param x a pointer filled in with the x position of the window, in screen
+ *          coordinates, may be NULL
+ * \param y a pointer filled in with the y position of the window, in screen
+ *          coordinates, may be NULL
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_SetWindowPosition
+ */
+// extern DECLSPEC void SDLCALL SDL_GetWindowPosition(SDL_Window * window,
+//                                                    int *x, int *y);
+func SDL_GetWindowPosition(window *SDL_Window, x, y *int) {
+	var x1, y1 C.int
+	C.SDL_GetWindowPosition(window.cObj(), &x1, &y1)
+	setInts(x, x1, y, y1)
+}
+
+/**
+ * Set the size of a window's client area.
+ *
+ * The window size in screen coordinates may differ from the size in pixels,
+ * if the window was created with `SDL_WINDOW_ALLOW_HIGHDPI` on a platform
+ * with high-dpi support (e.g. iOS or macOS). Use SDL_GL_GetDrawableSize() or
+ * SDL_GetRendererOutputSize() to get the real client area size in pixels.
+ *
+ * Fullscreen windows automatically match the size of the display mode, and
+ * you should use SDL_SetWindowDisplayMode() to change their size.
+ *
+ * \param window the window to change
+ * \param w the width of the window in pixels, in screen coordinates, must be
+ *          > 0
+ * \param h the height of the window in pixels, in screen coordinates, must be
+ *          > 0
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowSize
+ * \sa SDL_SetWindowDisplayMode
+ */
 // extern DECLSPEC void SDLCALL SDL_SetWindowSize(SDL_Window * window, int w,
 //                                                int h);
+func SDL_SetWindowSize(window *SDL_Window, w, h int) {
+	C.SDL_SetWindowSize(window.cObj(), C.int(w), C.int(h))
+}
 
-// /**
-//  * Get the size of a window's client area.
-//  *
-//  * NULL can safely be passed as the `w` or `h` parameter if the width or
-//  * height value is not desired.
-//  *
-//  * The window size in screen coordinates may differ from the size in pixels,
-//  * if the window was created with `SDL_WINDOW_ALLOW_HIGHDPI` on a platform
-//  * with high-dpi support (e.g. iOS or macOS). Use SDL_GL_GetDrawableSize(),
-//  * SDL_Vulkan_GetDrawableSize(), or SDL_GetRendererOutputSize() to get the
-//  * real client area size in pixels.
-//  *
-//  * \param window the window to query the width and height from
-//  * \param w a pointer filled in with the width of the window, in screen
-//  *          coordinates, may be NULL
-//  * \param h a pointer filled in with the height of the window, in screen
-//  *          coordinates, may be NULL
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GL_GetDrawableSize
-//  * \sa SDL_Vulkan_GetDrawableSize
-//  * \sa SDL_SetWindowSize
-//  */
+/**
+ * Get the size of a window's client area.
+ *
+ * NULL can safely be passed as the `w` or `h` parameter if the width or
+ * height value is not desired.
+ *
+ * The window size in screen coordinates may differ from the size in pixels,
+ * if the window was created with `SDL_WINDOW_ALLOW_HIGHDPI` on a platform
+ * with high-dpi support (e.g. iOS or macOS). Use SDL_GL_GetDrawableSize(),
+ * SDL_Vulkan_GetDrawableSize(), or SDL_GetRendererOutputSize() to get the
+ * real client area size in pixels.
+ *
+ * \param window the window to query the width and height from
+ * \param w a pointer filled in with the width of the window, in screen
+ *          coordinates, may be NULL
+ * \param h a pointer filled in with the height of the window, in screen
+ *          coordinates, may be NULL
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GL_GetDrawableSize
+ * \sa SDL_Vulkan_GetDrawableSize
+ * \sa SDL_SetWindowSize
+ */
 // extern DECLSPEC void SDLCALL SDL_GetWindowSize(SDL_Window * window, int *w,
 //                                                int *h);
+func SDL_GetWindowSize(window *SDL_Window, w, h *int) {
+	var w1, h1 C.int
+	C.SDL_GetWindowSize(window.cObj(), &w1, &h1)
+	setInts(w, w1, h, h1)
+}
 
-// /**
-//  * Get the size of a window's borders (decorations) around the client area.
-//  *
-//  * Note: If this function fails (returns -1), the size values will be
-//  * initialized to 0, 0, 0, 0 (if a non-NULL pointer is provided), as if the
-//  * window in question was borderless.
-//  *
-//  * Note: This function may fail on systems where the window has not yet been
-//  * decorated by the display server (for example, immediately after calling
-//  * SDL_CreateWindow). It is recommended that you wait at least until the
-//  * window has been presented and composited, so that the window system has a
-//  * chance to decorate the window and provide the border dimensions to SDL.
-//  *
-//  * This function also returns -1 if getting the information is not supported.
-//  *
-//  * \param window the window to query the size values of the border
-//  *               (decorations) from
-//  * \param top pointer to variable for storing the size of the top border; NULL
-//  *            is permitted
-//  * \param left pointer to variable for storing the size of the left border;
-//  *             NULL is permitted
-//  * \param bottom pointer to variable for storing the size of the bottom
-//  *               border; NULL is permitted
-//  * \param right pointer to variable for storing the size of the right border;
-//  *              NULL is permitted
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.5.
-//  *
-//  * \sa SDL_GetWindowSize
-//  */
+/**
+ * Get the size of a window's borders (decorations) around the client area.
+ *
+ * Note: If this function fails (returns -1), the size values will be
+ * initialized to 0, 0, 0, 0 (if a non-NULL pointer is provided), as if the
+ * window in question was borderless.
+ *
+ * Note: This function may fail on systems where the window has not yet been
+ * decorated by the display server (for example, immediately after calling
+ * SDL_CreateWindow). It is recommended that you wait at least until the
+ * window has been presented and composited, so that the window system has a
+ * chance to decorate the window and provide the border dimensions to SDL.
+ *
+ * This function also returns -1 if getting the information is not supported.
+ *
+ * \param window the window to query the size values of the border
+ *               (decorations) from
+ * \param top pointer to variable for storing the size of the top border; NULL
+ *            is permitted
+ * \param left pointer to variable for storing the size of the left border;
+ *             NULL is permitted
+ * \param bottom pointer to variable for storing the size of the bottom
+ *               border; NULL is permitted
+ * \param right pointer to variable for storing the size of the right border;
+ *              NULL is permitted
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.5.
+ *
+ * \sa SDL_GetWindowSize
+ */
 // extern DECLSPEC int SDLCALL SDL_GetWindowBordersSize(SDL_Window * window,
 //                                                      int *top, int *left,
 //                                                      int *bottom, int *right);
+func SDL_GetWindowBordersSize(window *SDL_Window, top, left, bottom, right *int) error {
 
-// /**
-//  * Get the size of a window in pixels.
-//  *
-//  * This may differ from SDL_GetWindowSize() if we're rendering to a high-DPI
-//  * drawable, i.e. the window was created with `SDL_WINDOW_ALLOW_HIGHDPI` on a
-//  * platform with high-DPI support (Apple calls this "Retina"), and not
-//  * disabled by the `SDL_HINT_VIDEO_HIGHDPI_DISABLED` hint.
-//  *
-//  * \param window the window from which the drawable size should be queried
-//  * \param w a pointer to variable for storing the width in pixels, may be NULL
-//  * \param h a pointer to variable for storing the height in pixels, may be
-//  *          NULL
-//  *
-//  * \since This function is available since SDL 2.26.0.
-//  *
-//  * \sa SDL_CreateWindow
-//  * \sa SDL_GetWindowSize
-//  */
+	var top1, left1, bottom1, right1 C.int
+	if err := checkError("SDL_GetWindowBordersSize", C.SDL_GetWindowBordersSize(window.cObj(), &top1, &left1, &bottom1, &right1)); nil != err {
+		return err
+	}
+
+	setInts(top, top1, left, left1)
+	setInts(bottom, bottom1, right, right1)
+	return nil
+}
+
+/**
+ * Get the size of a window in pixels.
+ *
+ * This may differ from SDL_GetWindowSize() if we're rendering to a high-DPI
+ * drawable, i.e. the window was created with `SDL_WINDOW_ALLOW_HIGHDPI` on a
+ * platform with high-DPI support (Apple calls this "Retina"), and not
+ * disabled by the `SDL_HINT_VIDEO_HIGHDPI_DISABLED` hint.
+ *
+ * \param window the window from which the drawable size should be queried
+ * \param w a pointer to variable for storing the width in pixels, may be NULL
+ * \param h a pointer to variable for storing the height in pixels, may be
+ *          NULL
+ *
+ * \since This function is available since SDL 2.26.0.
+ *
+ * \sa SDL_CreateWindow
+ * \sa SDL_GetWindowSize
+ */
 // extern DECLSPEC void SDLCALL SDL_GetWindowSizeInPixels(SDL_Window * window,
 //                                                        int *w, int *h);
+func SDL_GetWindowSizeInPixels(window *SDL_Window, w, h *int) {
+	var w1, h1 C.int
+	C.SDL_GetWindowSizeInPixels(window.cObj(), &w1, &h1)
+	setInts(w, w1, h, h1)
+}
 
-// /**
-//  * Set the minimum size of a window's client area.
-//  *
-//  * \param window the window to change
-//  * \param min_w the minimum width of the window in pixels
-//  * \param min_h the minimum height of the window in pixels
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowMinimumSize
-//  * \sa SDL_SetWindowMaximumSize
-//  */
+/**
+ * Set the minimum size of a window's client area.
+ *
+ * \param window the window to change
+ * \param min_w the minimum width of the window in pixels
+ * \param min_h the minimum height of the window in pixels
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowMinimumSize
+ * \sa SDL_SetWindowMaximumSize
+ */
 // extern DECLSPEC void SDLCALL SDL_SetWindowMinimumSize(SDL_Window * window,
 //                                                       int min_w, int min_h);
+func SDL_SetWindowMinimumSize(window *SDL_Window, min_w, min_h int) {
+	C.SDL_SetWindowMinimumSize(window.cObj(), C.int(min_w), C.int(min_h))
+}
 
-// /**
-//  * Get the minimum size of a window's client area.
-//  *
-//  * \param window the window to query
-//  * \param w a pointer filled in with the minimum width of the window, may be
-//  *          NULL
-//  * \param h a pointer filled in with the minimum height of the window, may be
-//  *          NULL
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowMaximumSize
-//  * \sa SDL_SetWindowMinimumSize
-//  */
+/**
+ * Get the minimum size of a window's client area.
+ *
+ * \param window the window to query
+ * \param w a pointer filled in with the minimum width of the window, may be
+ *          NULL
+ * \param h a pointer filled in with the minimum height of the window, may be
+ *          NULL
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowMaximumSize
+ * \sa SDL_SetWindowMinimumSize
+ */
 // extern DECLSPEC void SDLCALL SDL_GetWindowMinimumSize(SDL_Window * window,
 //                                                       int *w, int *h);
+func SDL_GetWindowMinimumSize(window *SDL_Window, w, h *int) {
+	var w1, h1 C.int
+	C.SDL_GetWindowMinimumSize(window.cObj(), &w1, &h1)
+	setInts(w, w1, h, h1)
+}
 
-// /**
-//  * Set the maximum size of a window's client area.
-//  *
-//  * \param window the window to change
-//  * \param max_w the maximum width of the window in pixels
-//  * \param max_h the maximum height of the window in pixels
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowMaximumSize
-//  * \sa SDL_SetWindowMinimumSize
-//  */
+/**
+ * Set the maximum size of a window's client area.
+ *
+ * \param window the window to change
+ * \param max_w the maximum width of the window in pixels
+ * \param max_h the maximum height of the window in pixels
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowMaximumSize
+ * \sa SDL_SetWindowMinimumSize
+ */
 // extern DECLSPEC void SDLCALL SDL_SetWindowMaximumSize(SDL_Window * window,
 //                                                       int max_w, int max_h);
+func SDL_SetWindowMaximumSize(window *SDL_Window, max_w, max_h int) {
+	C.SDL_SetWindowMaximumSize(window.cObj(), C.int(max_w), C.int(max_h))
+}
 
-// /**
-//  * Get the maximum size of a window's client area.
-//  *
-//  * \param window the window to query
-//  * \param w a pointer filled in with the maximum width of the window, may be
-//  *          NULL
-//  * \param h a pointer filled in with the maximum height of the window, may be
-//  *          NULL
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowMinimumSize
-//  * \sa SDL_SetWindowMaximumSize
-//  */
+/**
+ * Get the maximum size of a window's client area.
+ *
+ * \param window the window to query
+ * \param w a pointer filled in with the maximum width of the window, may be
+ *          NULL
+ * \param h a pointer filled in with the maximum height of the window, may be
+ *          NULL
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowMinimumSize
+ * \sa SDL_SetWindowMaximumSize
+ */
 // extern DECLSPEC void SDLCALL SDL_GetWindowMaximumSize(SDL_Window * window,
 //                                                       int *w, int *h);
+func SDL_GetWindowMaximumSize(window *SDL_Window, w, h *int) {
+	var w1, h1 C.int
+	C.SDL_GetWindowMaximumSize(window.cObj(), &w1, &h1)
+	setInts(w, w1, h, h1)
+}
 
-// /**
-//  * Set the border state of a window.
-//  *
-//  * This will add or remove the window's `SDL_WINDOW_BORDERLESS` flag and add
-//  * or remove the border from the actual window. This is a no-op if the
-//  * window's border already matches the requested state.
-//  *
-//  * You can't change the border state of a fullscreen window.
-//  *
-//  * \param window the window of which to change the border state
-//  * \param bordered SDL_FALSE to remove border, SDL_TRUE to add border
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowFlags
-//  */
+/**
+ * Set the border state of a window.
+ *
+ * This will add or remove the window's `SDL_WINDOW_BORDERLESS` flag and add
+ * or remove the border from the actual window. This is a no-op if the
+ * window's border already matches the requested state.
+ *
+ * You can't change the border state of a fullscreen window.
+ *
+ * \param window the window of which to change the border state
+ * \param bordered SDL_FALSE to remove border, SDL_TRUE to add border
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowFlags
+ */
 // extern DECLSPEC void SDLCALL SDL_SetWindowBordered(SDL_Window * window,
 //                                                    SDL_bool bordered);
+func SDL_SetWindowBordered(window *SDL_Window, bordered bool) {
+	C.SDL_SetWindowBordered(window.cObj(), sdlBool(bordered))
+}
 
-// /**
-//  * Set the user-resizable state of a window.
-//  *
-//  * This will add or remove the window's `SDL_WINDOW_RESIZABLE` flag and
-//  * allow/disallow user resizing of the window. This is a no-op if the window's
-//  * resizable state already matches the requested state.
-//  *
-//  * You can't change the resizable state of a fullscreen window.
-//  *
-//  * \param window the window of which to change the resizable state
-//  * \param resizable SDL_TRUE to allow resizing, SDL_FALSE to disallow
-//  *
-//  * \since This function is available since SDL 2.0.5.
-//  *
-//  * \sa SDL_GetWindowFlags
-//  */
+/**
+ * Set the user-resizable state of a window.
+ *
+ * This will add or remove the window's `SDL_WINDOW_RESIZABLE` flag and
+ * allow/disallow user resizing of the window. This is a no-op if the window's
+ * resizable state already matches the requested state.
+ *
+ * You can't change the resizable state of a fullscreen window.
+ *
+ * \param window the window of which to change the resizable state
+ * \param resizable SDL_TRUE to allow resizing, SDL_FALSE to disallow
+ *
+ * \since This function is available since SDL 2.0.5.
+ *
+ * \sa SDL_GetWindowFlags
+ */
 // extern DECLSPEC void SDLCALL SDL_SetWindowResizable(SDL_Window * window,
 //                                                     SDL_bool resizable);
+func SDL_SetWindowResizable(window *SDL_Window, resizable bool) {
+	C.SDL_SetWindowResizable(window.cObj(), sdlBool(resizable))
+}
 
-// /**
-//  * Set the window to always be above the others.
-//  *
-//  * This will add or remove the window's `SDL_WINDOW_ALWAYS_ON_TOP` flag. This
-//  * will bring the window to the front and keep the window above the rest.
-//  *
-//  * \param window The window of which to change the always on top state
-//  * \param on_top SDL_TRUE to set the window always on top, SDL_FALSE to
-//  *               disable
-//  *
-//  * \since This function is available since SDL 2.0.16.
-//  *
-//  * \sa SDL_GetWindowFlags
-//  */
+/**
+ * Set the window to always be above the others.
+ *
+ * This will add or remove the window's `SDL_WINDOW_ALWAYS_ON_TOP` flag. This
+ * will bring the window to the front and keep the window above the rest.
+ *
+ * \param window The window of which to change the always on top state
+ * \param on_top SDL_TRUE to set the window always on top, SDL_FALSE to
+ *               disable
+ *
+ * \since This function is available since SDL 2.0.16.
+ *
+ * \sa SDL_GetWindowFlags
+ */
 // extern DECLSPEC void SDLCALL SDL_SetWindowAlwaysOnTop(SDL_Window * window,
 //                                                       SDL_bool on_top);
+func SDL_SetWindowAlwaysOnTop(window *SDL_Window, on_top bool) {
+	C.SDL_SetWindowAlwaysOnTop(window.cObj(), sdlBool(on_top))
+}
 
 /**
  * Show a window.
@@ -1255,81 +1620,99 @@ func SDL_ShowWindow(window *SDL_Window) {
 	C.SDL_ShowWindow((*C.SDL_Window)(p))
 }
 
-// /**
-//  * Hide a window.
-//  *
-//  * \param window the window to hide
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_ShowWindow
-//  */
+/**
+ * Hide a window.
+ *
+ * \param window the window to hide
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_ShowWindow
+ */
 // extern DECLSPEC void SDLCALL SDL_HideWindow(SDL_Window * window);
+func SDL_HideWindow(window *SDL_Window) {
+	C.SDL_HideWindow(window.cObj())
+}
 
-// /**
-//  * Raise a window above other windows and set the input focus.
-//  *
-//  * \param window the window to raise
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  */
+/**
+ * Raise a window above other windows and set the input focus.
+ *
+ * \param window the window to raise
+ *
+ * \since This function is available since SDL 2.0.0.
+ */
 // extern DECLSPEC void SDLCALL SDL_RaiseWindow(SDL_Window * window);
+func SDL_RaiseWindow(window *SDL_Window) {
+	C.SDL_RaiseWindow(window.cObj())
+}
 
-// /**
-//  * Make a window as large as possible.
-//  *
-//  * \param window the window to maximize
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_MinimizeWindow
-//  * \sa SDL_RestoreWindow
-//  */
+/**
+ * Make a window as large as possible.
+ *
+ * \param window the window to maximize
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_MinimizeWindow
+ * \sa SDL_RestoreWindow
+ */
 // extern DECLSPEC void SDLCALL SDL_MaximizeWindow(SDL_Window * window);
+func SDL_MaximizeWindow(window *SDL_Window) {
+	C.SDL_MaximizeWindow(window.cObj())
+}
 
-// /**
-//  * Minimize a window to an iconic representation.
-//  *
-//  * \param window the window to minimize
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_MaximizeWindow
-//  * \sa SDL_RestoreWindow
-//  */
+/**
+ * Minimize a window to an iconic representation.
+ *
+ * \param window the window to minimize
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_MaximizeWindow
+ * \sa SDL_RestoreWindow
+ */
 // extern DECLSPEC void SDLCALL SDL_MinimizeWindow(SDL_Window * window);
+func SDL_MinimizeWindow(window *SDL_Window) {
+	C.SDL_MinimizeWindow(window.cObj())
+}
 
-// /**
-//  * Restore the size and position of a minimized or maximized window.
-//  *
-//  * \param window the window to restore
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_MaximizeWindow
-//  * \sa SDL_MinimizeWindow
-//  */
+/**
+ * Restore the size and position of a minimized or maximized window.
+ *
+ * \param window the window to restore
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_MaximizeWindow
+ * \sa SDL_MinimizeWindow
+ */
 // extern DECLSPEC void SDLCALL SDL_RestoreWindow(SDL_Window * window);
+func SDL_RestoreWindow(window *SDL_Window) {
+	C.SDL_RestoreWindow(window.cObj())
+}
 
-// /**
-//  * Set a window's fullscreen state.
-//  *
-//  * `flags` may be `SDL_WINDOW_FULLSCREEN`, for "real" fullscreen with a
-//  * videomode change; `SDL_WINDOW_FULLSCREEN_DESKTOP` for "fake" fullscreen
-//  * that takes the size of the desktop; and 0 for windowed mode.
-//  *
-//  * \param window the window to change
-//  * \param flags `SDL_WINDOW_FULLSCREEN`, `SDL_WINDOW_FULLSCREEN_DESKTOP` or 0
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowDisplayMode
-//  * \sa SDL_SetWindowDisplayMode
-//  */
+/**
+ * Set a window's fullscreen state.
+ *
+ * `flags` may be `SDL_WINDOW_FULLSCREEN`, for "real" fullscreen with a
+ * videomode change; `SDL_WINDOW_FULLSCREEN_DESKTOP` for "fake" fullscreen
+ * that takes the size of the desktop; and 0 for windowed mode.
+ *
+ * \param window the window to change
+ * \param flags `SDL_WINDOW_FULLSCREEN`, `SDL_WINDOW_FULLSCREEN_DESKTOP` or 0
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowDisplayMode
+ * \sa SDL_SetWindowDisplayMode
+ */
 // extern DECLSPEC int SDLCALL SDL_SetWindowFullscreen(SDL_Window * window,
 //                                                     Uint32 flags);
+func SDL_SetWindowFullscreen(window *SDL_Window, flags SDL_WindowFlags) error {
+	return checkError("SDL_SetWindowFullscreen", C.SDL_SetWindowFullscreen(window.cObj(), C.Uint32(flags)))
+}
 
 // /**
 //  * Get the SDL surface associated with the window.
@@ -1399,272 +1782,340 @@ func SDL_ShowWindow(window *SDL_Window) {
 //                                                          const SDL_Rect * rects,
 //                                                          int numrects);
 
-// /**
-//  * Set a window's input grab mode.
-//  *
-//  * When input is grabbed, the mouse is confined to the window. This function
-//  * will also grab the keyboard if `SDL_HINT_GRAB_KEYBOARD` is set. To grab the
-//  * keyboard without also grabbing the mouse, use SDL_SetWindowKeyboardGrab().
-//  *
-//  * If the caller enables a grab while another window is currently grabbed, the
-//  * other window loses its grab in favor of the caller's window.
-//  *
-//  * \param window the window for which the input grab mode should be set
-//  * \param grabbed SDL_TRUE to grab input or SDL_FALSE to release input
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetGrabbedWindow
-//  * \sa SDL_GetWindowGrab
-//  */
+/**
+ * Set a window's input grab mode.
+ *
+ * When input is grabbed, the mouse is confined to the window. This function
+ * will also grab the keyboard if `SDL_HINT_GRAB_KEYBOARD` is set. To grab the
+ * keyboard without also grabbing the mouse, use SDL_SetWindowKeyboardGrab().
+ *
+ * If the caller enables a grab while another window is currently grabbed, the
+ * other window loses its grab in favor of the caller's window.
+ *
+ * \param window the window for which the input grab mode should be set
+ * \param grabbed SDL_TRUE to grab input or SDL_FALSE to release input
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetGrabbedWindow
+ * \sa SDL_GetWindowGrab
+ */
 // extern DECLSPEC void SDLCALL SDL_SetWindowGrab(SDL_Window * window,
 //                                                SDL_bool grabbed);
+func SDL_SetWindowGrab(window *SDL_Window, grabbed bool) {
+	C.SDL_SetWindowGrab(window.cObj(), sdlBool(grabbed))
+}
 
-// /**
-//  * Set a window's keyboard grab mode.
-//  *
-//  * Keyboard grab enables capture of system keyboard shortcuts like Alt+Tab or
-//  * the Meta/Super key. Note that not all system keyboard shortcuts can be
-//  * captured by applications (one example is Ctrl+Alt+Del on Windows).
-//  *
-//  * This is primarily intended for specialized applications such as VNC clients
-//  * or VM frontends. Normal games should not use keyboard grab.
-//  *
-//  * When keyboard grab is enabled, SDL will continue to handle Alt+Tab when the
-//  * window is full-screen to ensure the user is not trapped in your
-//  * application. If you have a custom keyboard shortcut to exit fullscreen
-//  * mode, you may suppress this behavior with
-//  * `SDL_HINT_ALLOW_ALT_TAB_WHILE_GRABBED`.
-//  *
-//  * If the caller enables a grab while another window is currently grabbed, the
-//  * other window loses its grab in favor of the caller's window.
-//  *
-//  * \param window The window for which the keyboard grab mode should be set.
-//  * \param grabbed This is SDL_TRUE to grab keyboard, and SDL_FALSE to release.
-//  *
-//  * \since This function is available since SDL 2.0.16.
-//  *
-//  * \sa SDL_GetWindowKeyboardGrab
-//  * \sa SDL_SetWindowMouseGrab
-//  * \sa SDL_SetWindowGrab
-//  */
+/**
+ * Set a window's keyboard grab mode.
+ *
+ * Keyboard grab enables capture of system keyboard shortcuts like Alt+Tab or
+ * the Meta/Super key. Note that not all system keyboard shortcuts can be
+ * captured by applications (one example is Ctrl+Alt+Del on Windows).
+ *
+ * This is primarily intended for specialized applications such as VNC clients
+ * or VM frontends. Normal games should not use keyboard grab.
+ *
+ * When keyboard grab is enabled, SDL will continue to handle Alt+Tab when the
+ * window is full-screen to ensure the user is not trapped in your
+ * application. If you have a custom keyboard shortcut to exit fullscreen
+ * mode, you may suppress this behavior with
+ * `SDL_HINT_ALLOW_ALT_TAB_WHILE_GRABBED`.
+ *
+ * If the caller enables a grab while another window is currently grabbed, the
+ * other window loses its grab in favor of the caller's window.
+ *
+ * \param window The window for which the keyboard grab mode should be set.
+ * \param grabbed This is SDL_TRUE to grab keyboard, and SDL_FALSE to release.
+ *
+ * \since This function is available since SDL 2.0.16.
+ *
+ * \sa SDL_GetWindowKeyboardGrab
+ * \sa SDL_SetWindowMouseGrab
+ * \sa SDL_SetWindowGrab
+ */
 // extern DECLSPEC void SDLCALL SDL_SetWindowKeyboardGrab(SDL_Window * window,
 //                                                        SDL_bool grabbed);
+func SDL_SetWindowKeyboardGrab(window *SDL_Window, grabbed bool) {
+	C.SDL_SetWindowKeyboardGrab(window.cObj(), sdlBool(grabbed))
+}
 
-// /**
-//  * Set a window's mouse grab mode.
-//  *
-//  * Mouse grab confines the mouse cursor to the window.
-//  *
-//  * \param window The window for which the mouse grab mode should be set.
-//  * \param grabbed This is SDL_TRUE to grab mouse, and SDL_FALSE to release.
-//  *
-//  * \since This function is available since SDL 2.0.16.
-//  *
-//  * \sa SDL_GetWindowMouseGrab
-//  * \sa SDL_SetWindowKeyboardGrab
-//  * \sa SDL_SetWindowGrab
-//  */
+/**
+ * Set a window's mouse grab mode.
+ *
+ * Mouse grab confines the mouse cursor to the window.
+ *
+ * \param window The window for which the mouse grab mode should be set.
+ * \param grabbed This is SDL_TRUE to grab mouse, and SDL_FALSE to release.
+ *
+ * \since This function is available since SDL 2.0.16.
+ *
+ * \sa SDL_GetWindowMouseGrab
+ * \sa SDL_SetWindowKeyboardGrab
+ * \sa SDL_SetWindowGrab
+ */
 // extern DECLSPEC void SDLCALL SDL_SetWindowMouseGrab(SDL_Window * window,
 //                                                     SDL_bool grabbed);
+func SDL_SetWindowMouseGrab(window *SDL_Window, grabbed bool) {
+	C.SDL_SetWindowMouseGrab(window.cObj(), sdlBool(grabbed))
+}
 
-// /**
-//  * Get a window's input grab mode.
-//  *
-//  * \param window the window to query
-//  * \returns SDL_TRUE if input is grabbed, SDL_FALSE otherwise.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_SetWindowGrab
-//  */
+/**
+ * Get a window's input grab mode.
+ *
+ * \param window the window to query
+ * \returns SDL_TRUE if input is grabbed, SDL_FALSE otherwise.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_SetWindowGrab
+ */
 // extern DECLSPEC SDL_bool SDLCALL SDL_GetWindowGrab(SDL_Window * window);
+func SDL_GetWindowGrab(window *SDL_Window) bool {
+	return C.SDL_TRUE == C.SDL_GetWindowGrab(window.cObj())
+}
 
-// /**
-//  * Get a window's keyboard grab mode.
-//  *
-//  * \param window the window to query
-//  * \returns SDL_TRUE if keyboard is grabbed, and SDL_FALSE otherwise.
-//  *
-//  * \since This function is available since SDL 2.0.16.
-//  *
-//  * \sa SDL_SetWindowKeyboardGrab
-//  * \sa SDL_GetWindowGrab
-//  */
+/**
+ * Get a window's keyboard grab mode.
+ *
+ * \param window the window to query
+ * \returns SDL_TRUE if keyboard is grabbed, and SDL_FALSE otherwise.
+ *
+ * \since This function is available since SDL 2.0.16.
+ *
+ * \sa SDL_SetWindowKeyboardGrab
+ * \sa SDL_GetWindowGrab
+ */
 // extern DECLSPEC SDL_bool SDLCALL SDL_GetWindowKeyboardGrab(SDL_Window * window);
+func SDL_GetWindowKeyboardGrab(window *SDL_Window) bool {
+	return C.SDL_TRUE == C.SDL_GetWindowKeyboardGrab(window.cObj())
+}
 
-// /**
-//  * Get a window's mouse grab mode.
-//  *
-//  * \param window the window to query
-//  * \returns SDL_TRUE if mouse is grabbed, and SDL_FALSE otherwise.
-//  *
-//  * \since This function is available since SDL 2.0.16.
-//  *
-//  * \sa SDL_SetWindowKeyboardGrab
-//  * \sa SDL_GetWindowGrab
-//  */
+/**
+ * Get a window's mouse grab mode.
+ *
+ * \param window the window to query
+ * \returns SDL_TRUE if mouse is grabbed, and SDL_FALSE otherwise.
+ *
+ * \since This function is available since SDL 2.0.16.
+ *
+ * \sa SDL_SetWindowKeyboardGrab
+ * \sa SDL_GetWindowGrab
+ */
 // extern DECLSPEC SDL_bool SDLCALL SDL_GetWindowMouseGrab(SDL_Window * window);
+func SDL_GetWindowMouseGrab(window *SDL_Window) bool {
+	return C.SDL_TRUE == C.SDL_GetWindowMouseGrab(window.cObj())
+}
 
-// /**
-//  * Get the window that currently has an input grab enabled.
-//  *
-//  * \returns the window if input is grabbed or NULL otherwise.
-//  *
-//  * \since This function is available since SDL 2.0.4.
-//  *
-//  * \sa SDL_GetWindowGrab
-//  * \sa SDL_SetWindowGrab
-//  */
+/**
+ * Get the window that currently has an input grab enabled.
+ *
+ * \returns the window if input is grabbed or NULL otherwise.
+ *
+ * \since This function is available since SDL 2.0.4.
+ *
+ * \sa SDL_GetWindowGrab
+ * \sa SDL_SetWindowGrab
+ */
 // extern DECLSPEC SDL_Window * SDLCALL SDL_GetGrabbedWindow(void);
+func SDL_GetGrabbedWindow() *SDL_Window {
+	return wrapWindow(C.SDL_GetGrabbedWindow())
+}
 
-// /**
-//  * Confines the cursor to the specified area of a window.
-//  *
-//  * Note that this does NOT grab the cursor, it only defines the area a cursor
-//  * is restricted to when the window has mouse focus.
-//  *
-//  * \param window The window that will be associated with the barrier.
-//  * \param rect A rectangle area in window-relative coordinates. If NULL the
-//  *             barrier for the specified window will be destroyed.
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.18.
-//  *
-//  * \sa SDL_GetWindowMouseRect
-//  * \sa SDL_SetWindowMouseGrab
-//  */
+/**
+ * Confines the cursor to the specified area of a window.
+ *
+ * Note that this does NOT grab the cursor, it only defines the area a cursor
+ * is restricted to when the window has mouse focus.
+ *
+ * \param window The window that will be associated with the barrier.
+ * \param rect A rectangle area in window-relative coordinates. If NULL the
+ *             barrier for the specified window will be destroyed.
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.18.
+ *
+ * \sa SDL_GetWindowMouseRect
+ * \sa SDL_SetWindowMouseGrab
+ */
 // extern DECLSPEC int SDLCALL SDL_SetWindowMouseRect(SDL_Window * window, const SDL_Rect * rect);
+func SDL_SetWindowMouseRect(window *SDL_Window, rect *SDL_Rect) error {
 
-// /**
-//  * Get the mouse confinement rectangle of a window.
-//  *
-//  * \param window The window to query
-//  * \returns A pointer to the mouse confinement rectangle of a window, or NULL
-//  *          if there isn't one.
-//  *
-//  * \since This function is available since SDL 2.0.18.
-//  *
-//  * \sa SDL_SetWindowMouseRect
-//  */
+	var rect1 C.SDL_Rect
+	var pRect1 *C.SDL_Rect
+	if nil != rect {
+		rect.copyToCObj(unsafe.Pointer(&rect1))
+		pRect1 = &rect1
+	}
+
+	return checkError("SDL_SetWindowMouseRect", C.SDL_SetWindowMouseRect(window.cObj(), pRect1))
+}
+
+/**
+ * Get the mouse confinement rectangle of a window.
+ *
+ * \param window The window to query
+ * \returns A pointer to the mouse confinement rectangle of a window, or NULL
+ *          if there isn't one.
+ *
+ * \since This function is available since SDL 2.0.18.
+ *
+ * \sa SDL_SetWindowMouseRect
+ */
 // extern DECLSPEC const SDL_Rect * SDLCALL SDL_GetWindowMouseRect(SDL_Window * window);
+func SDL_GetWindowMouseRect(window *SDL_Window) *SDL_Rect {
 
-// /**
-//  * Set the brightness (gamma multiplier) for a given window's display.
-//  *
-//  * Despite the name and signature, this method sets the brightness of the
-//  * entire display, not an individual window. A window is considered to be
-//  * owned by the display that contains the window's center pixel. (The index of
-//  * this display can be retrieved using SDL_GetWindowDisplayIndex().) The
-//  * brightness set will not follow the window if it is moved to another
-//  * display.
-//  *
-//  * Many platforms will refuse to set the display brightness in modern times.
-//  * You are better off using a shader to adjust gamma during rendering, or
-//  * something similar.
-//  *
-//  * \param window the window used to select the display whose brightness will
-//  *               be changed
-//  * \param brightness the brightness (gamma multiplier) value to set where 0.0
-//  *                   is completely dark and 1.0 is normal brightness
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_GetWindowBrightness
-//  * \sa SDL_SetWindowGammaRamp
-//  */
+	var rect1 = C.SDL_GetWindowMouseRect(window.cObj())
+	if nil == rect1 {
+		return nil
+	}
+
+	var rect SDL_Rect
+	rect.copyFromCObj(unsafe.Pointer(rect1))
+	return &rect
+}
+
+/**
+ * Set the brightness (gamma multiplier) for a given window's display.
+ *
+ * Despite the name and signature, this method sets the brightness of the
+ * entire display, not an individual window. A window is considered to be
+ * owned by the display that contains the window's center pixel. (The index of
+ * this display can be retrieved using SDL_GetWindowDisplayIndex().) The
+ * brightness set will not follow the window if it is moved to another
+ * display.
+ *
+ * Many platforms will refuse to set the display brightness in modern times.
+ * You are better off using a shader to adjust gamma during rendering, or
+ * something similar.
+ *
+ * \param window the window used to select the display whose brightness will
+ *               be changed
+ * \param brightness the brightness (gamma multiplier) value to set where 0.0
+ *                   is completely dark and 1.0 is normal brightness
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_GetWindowBrightness
+ * \sa SDL_SetWindowGammaRamp
+ */
 // extern DECLSPEC int SDLCALL SDL_SetWindowBrightness(SDL_Window * window, float brightness);
+func SDL_SetWindowBrightness(window *SDL_Window, brightness float32) error {
+	return checkError("SDL_SetWindowBrightness", C.SDL_SetWindowBrightness(window.cObj(), C.float(brightness)))
+}
 
-// /**
-//  * Get the brightness (gamma multiplier) for a given window's display.
-//  *
-//  * Despite the name and signature, this method retrieves the brightness of the
-//  * entire display, not an individual window. A window is considered to be
-//  * owned by the display that contains the window's center pixel. (The index of
-//  * this display can be retrieved using SDL_GetWindowDisplayIndex().)
-//  *
-//  * \param window the window used to select the display whose brightness will
-//  *               be queried
-//  * \returns the brightness for the display where 0.0 is completely dark and
-//  *          1.0 is normal brightness.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_SetWindowBrightness
-//  */
+/**
+ * Get the brightness (gamma multiplier) for a given window's display.
+ *
+ * Despite the name and signature, this method retrieves the brightness of the
+ * entire display, not an individual window. A window is considered to be
+ * owned by the display that contains the window's center pixel. (The index of
+ * this display can be retrieved using SDL_GetWindowDisplayIndex().)
+ *
+ * \param window the window used to select the display whose brightness will
+ *               be queried
+ * \returns the brightness for the display where 0.0 is completely dark and
+ *          1.0 is normal brightness.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_SetWindowBrightness
+ */
 // extern DECLSPEC float SDLCALL SDL_GetWindowBrightness(SDL_Window * window);
+func SDL_GetWindowBrightness(window *SDL_Window) float32 {
+	return float32(C.SDL_GetWindowBrightness(window.cObj()))
+}
 
-// /**
-//  * Set the opacity for a window.
-//  *
-//  * The parameter `opacity` will be clamped internally between 0.0f
-//  * (transparent) and 1.0f (opaque).
-//  *
-//  * This function also returns -1 if setting the opacity isn't supported.
-//  *
-//  * \param window the window which will be made transparent or opaque
-//  * \param opacity the opacity value (0.0f - transparent, 1.0f - opaque)
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.5.
-//  *
-//  * \sa SDL_GetWindowOpacity
-//  */
+/**
+ * Set the opacity for a window.
+ *
+ * The parameter `opacity` will be clamped internally between 0.0f
+ * (transparent) and 1.0f (opaque).
+ *
+ * This function also returns -1 if setting the opacity isn't supported.
+ *
+ * \param window the window which will be made transparent or opaque
+ * \param opacity the opacity value (0.0f - transparent, 1.0f - opaque)
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.5.
+ *
+ * \sa SDL_GetWindowOpacity
+ */
 // extern DECLSPEC int SDLCALL SDL_SetWindowOpacity(SDL_Window * window, float opacity);
+func SDL_SetWindowOpacity(window *SDL_Window, opacity float32) error {
+	return checkError("SDL_SetWindowOpacity", C.SDL_SetWindowOpacity(window.cObj(), C.float(opacity)))
+}
 
-// /**
-//  * Get the opacity of a window.
-//  *
-//  * If transparency isn't supported on this platform, opacity will be reported
-//  * as 1.0f without error.
-//  *
-//  * The parameter `opacity` is ignored if it is NULL.
-//  *
-//  * This function also returns -1 if an invalid window was provided.
-//  *
-//  * \param window the window to get the current opacity value from
-//  * \param out_opacity the float filled in (0.0f - transparent, 1.0f - opaque)
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.5.
-//  *
-//  * \sa SDL_SetWindowOpacity
-//  */
+/**
+ * Get the opacity of a window.
+ *
+ * If transparency isn't supported on this platform, opacity will be reported
+ * as 1.0f without error.
+ *
+ * The parameter `opacity` is ignored if it is NULL.
+ *
+ * This function also returns -1 if an invalid window was provided.
+ *
+ * \param window the window to get the current opacity value from
+ * \param out_opacity the float filled in (0.0f - transparent, 1.0f - opaque)
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.5.
+ *
+ * \sa SDL_SetWindowOpacity
+ */
 // extern DECLSPEC int SDLCALL SDL_GetWindowOpacity(SDL_Window * window, float * out_opacity);
+func SDL_GetWindowOpacity(window *SDL_Window, out_opacity *float32) error {
 
-// /**
-//  * Set the window as a modal for another window.
-//  *
-//  * \param modal_window the window that should be set modal
-//  * \param parent_window the parent window for the modal window
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.5.
-//  */
+	var opacity1 C.float
+	if err := checkError("SDL_GetWindowOpacity", C.SDL_GetWindowOpacity(window.cObj(), &opacity1)); nil != err {
+		return err
+	}
+
+	*out_opacity = float32(opacity1)
+	return nil
+}
+
+/**
+ * Set the window as a modal for another window.
+ *
+ * \param modal_window the window that should be set modal
+ * \param parent_window the parent window for the modal window
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.5.
+ */
 // extern DECLSPEC int SDLCALL SDL_SetWindowModalFor(SDL_Window * modal_window, SDL_Window * parent_window);
+func SDL_SetWindowModalFor(modal_window *SDL_Window, parent_window *SDL_Window) error {
+	return checkError("SDL_SetWindowModalFor", C.SDL_SetWindowModalFor(modal_window.cObj(), parent_window.cObj()))
+}
 
-// /**
-//  * Explicitly set input focus to the window.
-//  *
-//  * You almost certainly want SDL_RaiseWindow() instead of this function. Use
-//  * this with caution, as you might give focus to a window that is completely
-//  * obscured by other windows.
-//  *
-//  * \param window the window that should get the input focus
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.5.
-//  *
-//  * \sa SDL_RaiseWindow
-//  */
+/**
+ * Explicitly set input focus to the window.
+ *
+ * You almost certainly want SDL_RaiseWindow() instead of this function. Use
+ * this with caution, as you might give focus to a window that is completely
+ * obscured by other windows.
+ *
+ * \param window the window that should get the input focus
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.5.
+ *
+ * \sa SDL_RaiseWindow
+ */
 // extern DECLSPEC int SDLCALL SDL_SetWindowInputFocus(SDL_Window * window);
+func SDL_SetWindowInputFocus(window *SDL_Window) error {
+	return checkError("SDL_SetWindowInputFocus", C.SDL_SetWindowInputFocus(window.cObj()))
+}
 
 // /**
 //  * Set the gamma ramp for the display that owns a given window.
@@ -1729,11 +2180,11 @@ func SDL_ShowWindow(window *SDL_Window) {
 //                                                    Uint16 * green,
 //                                                    Uint16 * blue);
 
-// /**
-//  * Possible return values from the SDL_HitTest callback.
-//  *
-//  * \sa SDL_HitTest
-//  */
+/**
+ * Possible return values from the SDL_HitTest callback.
+ *
+ * \sa SDL_HitTest
+ */
 // typedef enum
 // {
 //     SDL_HITTEST_NORMAL,  /**< Region is normal. No special properties. */
@@ -1747,76 +2198,129 @@ func SDL_ShowWindow(window *SDL_Window) {
 //     SDL_HITTEST_RESIZE_BOTTOMLEFT,
 //     SDL_HITTEST_RESIZE_LEFT
 // } SDL_HitTestResult;
+type SDL_HitTestResult int
 
-// /**
-//  * Callback used for hit-testing.
-//  *
-//  * \param win the SDL_Window where hit-testing was set on
-//  * \param area an SDL_Point which should be hit-tested
-//  * \param data what was passed as `callback_data` to SDL_SetWindowHitTest()
-//  * \return an SDL_HitTestResult value.
-//  *
-//  * \sa SDL_SetWindowHitTest
-//  */
+const (
+	SDL_HITTEST_NORMAL             SDL_HitTestResult = C.SDL_HITTEST_NORMAL    /**< Region is normal. No special properties. */
+	SDL_HITTEST_DRAGGABLE          SDL_HitTestResult = C.SDL_HITTEST_DRAGGABLE /**< Region can drag entire window. */
+	SDL_HITTEST_RESIZE_TOPLEFT     SDL_HitTestResult = C.SDL_HITTEST_RESIZE_TOPLEFT
+	SDL_HITTEST_RESIZE_TOP         SDL_HitTestResult = C.SDL_HITTEST_RESIZE_TOP
+	SDL_HITTEST_RESIZE_TOPRIGHT    SDL_HitTestResult = C.SDL_HITTEST_RESIZE_TOPRIGHT
+	SDL_HITTEST_RESIZE_RIGHT       SDL_HitTestResult = C.SDL_HITTEST_RESIZE_RIGHT
+	SDL_HITTEST_RESIZE_BOTTOMRIGHT SDL_HitTestResult = C.SDL_HITTEST_RESIZE_BOTTOMRIGHT
+	SDL_HITTEST_RESIZE_BOTTOM      SDL_HitTestResult = C.SDL_HITTEST_RESIZE_BOTTOM
+	SDL_HITTEST_RESIZE_BOTTOMLEFT  SDL_HitTestResult = C.SDL_HITTEST_RESIZE_BOTTOMLEFT
+	SDL_HITTEST_RESIZE_LEFT        SDL_HitTestResult = C.SDL_HITTEST_RESIZE_LEFT
+)
+
+/**
+ * Callback used for hit-testing.
+ *
+ * \param win the SDL_Window where hit-testing was set on
+ * \param area an SDL_Point which should be hit-tested
+ * \param data what was passed as `callback_data` to SDL_SetWindowHitTest()
+ * \return an SDL_HitTestResult value.
+ *
+ * \sa SDL_SetWindowHitTest
+ */
 // typedef SDL_HitTestResult (SDLCALL *SDL_HitTest)(SDL_Window *win,
 //                                                  const SDL_Point *area,
 //                                                  void *data);
+//
+// Closures replace the data pointer.
+type SDL_HitTest func(win *SDL_Window, area *SDL_Point) SDL_HitTestResult
 
-// /**
-//  * Provide a callback that decides if a window region has special properties.
-//  *
-//  * Normally windows are dragged and resized by decorations provided by the
-//  * system window manager (a title bar, borders, etc), but for some apps, it
-//  * makes sense to drag them from somewhere else inside the window itself; for
-//  * example, one might have a borderless window that wants to be draggable from
-//  * any part, or simulate its own title bar, etc.
-//  *
-//  * This function lets the app provide a callback that designates pieces of a
-//  * given window as special. This callback is run during event processing if we
-//  * need to tell the OS to treat a region of the window specially; the use of
-//  * this callback is known as "hit testing."
-//  *
-//  * Mouse input may not be delivered to your application if it is within a
-//  * special area; the OS will often apply that input to moving the window or
-//  * resizing the window and not deliver it to the application.
-//  *
-//  * Specifying NULL for a callback disables hit-testing. Hit-testing is
-//  * disabled by default.
-//  *
-//  * Platforms that don't support this functionality will return -1
-//  * unconditionally, even if you're attempting to disable hit-testing.
-//  *
-//  * Your callback may fire at any time, and its firing does not indicate any
-//  * specific behavior (for example, on Windows, this certainly might fire when
-//  * the OS is deciding whether to drag your window, but it fires for lots of
-//  * other reasons, too, some unrelated to anything you probably care about _and
-//  * when the mouse isn't actually at the location it is testing_). Since this
-//  * can fire at any time, you should try to keep your callback efficient,
-//  * devoid of allocations, etc.
-//  *
-//  * \param window the window to set hit-testing on
-//  * \param callback the function to call when doing a hit-test
-//  * \param callback_data an app-defined void pointer passed to **callback**
-//  * \returns 0 on success or -1 on error (including unsupported); call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.4.
-//  */
+// Callback handles of the windows with hit-testing, keyed by *C.SDL_Window
+var windowHitTests sync.Map
+
+/**
+ * Provide a callback that decides if a window region has special properties.
+ *
+ * Normally windows are dragged and resized by decorations provided by the
+ * system window manager (a title bar, borders, etc), but for some apps, it
+ * makes sense to drag them from somewhere else inside the window itself; for
+ * example, one might have a borderless window that wants to be draggable from
+ * any part, or simulate its own title bar, etc.
+ *
+ * This function lets the app provide a callback that designates pieces of a
+ * given window as special. This callback is run during event processing if we
+ * need to tell the OS to treat a region of the window specially; the use of
+ * this callback is known as "hit testing."
+ *
+ * Mouse input may not be delivered to your application if it is within a
+ * special area; the OS will often apply that input to moving the window or
+ * resizing the window and not deliver it to the application.
+ *
+ * Specifying NULL for a callback disables hit-testing. Hit-testing is
+ * disabled by default.
+ *
+ * Platforms that don't support this functionality will return -1
+ * unconditionally, even if you're attempting to disable hit-testing.
+ *
+ * Your callback may fire at any time, and its firing does not indicate any
+ * specific behavior (for example, on Windows, this certainly might fire when
+ * the OS is deciding whether to drag your window, but it fires for lots of
+ * other reasons, too, some unrelated to anything you probably care about _and
+ * when the mouse isn't actually at the location it is testing_). Since this
+ * can fire at any time, you should try to keep your callback efficient,
+ * devoid of allocations, etc.
+ *
+ * \param window the window to set hit-testing on
+ * \param callback the function to call when doing a hit-test
+ * \param callback_data an app-defined void pointer passed to **callback**
+ * \returns 0 on success or -1 on error (including unsupported); call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.4.
+ */
 // extern DECLSPEC int SDLCALL SDL_SetWindowHitTest(SDL_Window * window,
 //                                                  SDL_HitTest callback,
 //                                                  void *callback_data);
+//
+// The callback replaces the previous one of the window and is released with
+// the window; nil disables hit-testing. It is called by SDL_PollEvent and
+// friends, keep it short.
+func SDL_SetWindowHitTest(window *SDL_Window, callback SDL_HitTest) error {
+
+	var h cgo.Handle
+	if nil != callback {
+		h = cgo.NewHandle(callback)
+	}
 
-// /**
-//  * Request a window to demand attention from the user.
-//  *
-//  * \param window the window to be flashed
-//  * \param operation the flash operation
-//  * \returns 0 on success or a negative error code on failure; call
-//  *          SDL_GetError() for more information.
-//  *
-//  * \since This function is available since SDL 2.0.16.
-//  */
+	var p = window.cObj()
+
+	if err := checkError("SDL_SetWindowHitTest", C._SDL_SetWindowHitTest(p, C.uintptr_t(h))); nil != err {
+		if 0 != h {
+			h.Delete()
+		}
+		return err
+	}
+
+	var old, ok = windowHitTests.LoadAndDelete(p)
+	if ok {
+		old.(cgo.Handle).Delete()
+	}
+	if 0 != h {
+		windowHitTests.Store(p, h)
+	}
+
+	return nil
+}
+
+/**
+ * Request a window to demand attention from the user.
+ *
+ * \param window the window to be flashed
+ * \param operation the flash operation
+ * \returns 0 on success or a negative error code on failure; call
+ *          SDL_GetError() for more information.
+ *
+ * \since This function is available since SDL 2.0.16.
+ */
 // extern DECLSPEC int SDLCALL SDL_FlashWindow(SDL_Window * window, SDL_FlashOperation operation);
+func SDL_FlashWindow(window *SDL_Window, operation SDL_FlashOperation) error {
+	return checkError("SDL_FlashWindow", C.SDL_FlashWindow(window.cObj(), C.SDL_FlashOperation(operation)))
+}
 
 /**
  * Destroy a window.
@@ -1833,53 +2337,67 @@ func SDL_ShowWindow(window *SDL_Window) {
  */
 // extern DECLSPEC void SDLCALL SDL_DestroyWindow(SDL_Window * window);
 func SDL_DestroyWindow(window *SDL_Window) {
-	var p = internal.Unwrap[SDL_Window](window)
-	C.SDL_DestroyWindow((*C.SDL_Window)(p))
+
+	var p = window.cObj()
+	C.SDL_DestroyWindow(p)
+
+	if h, ok := windowHitTests.LoadAndDelete(p); ok {
+		h.(cgo.Handle).Delete()
+	}
 }
 
-// /**
-//  * Check whether the screensaver is currently enabled.
-//  *
-//  * The screensaver is disabled by default since SDL 2.0.2. Before SDL 2.0.2
-//  * the screensaver was enabled by default.
-//  *
-//  * The default can also be changed using `SDL_HINT_VIDEO_ALLOW_SCREENSAVER`.
-//  *
-//  * \returns SDL_TRUE if the screensaver is enabled, SDL_FALSE if it is
-//  *          disabled.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_DisableScreenSaver
-//  * \sa SDL_EnableScreenSaver
-//  */
+/**
+ * Check whether the screensaver is currently enabled.
+ *
+ * The screensaver is disabled by default since SDL 2.0.2. Before SDL 2.0.2
+ * the screensaver was enabled by default.
+ *
+ * The default can also be changed using `SDL_HINT_VIDEO_ALLOW_SCREENSAVER`.
+ *
+ * \returns SDL_TRUE if the screensaver is enabled, SDL_FALSE if it is
+ *          disabled.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_DisableScreenSaver
+ * \sa SDL_EnableScreenSaver
+ */
 // extern DECLSPEC SDL_bool SDLCALL SDL_IsScreenSaverEnabled(void);
+func SDL_IsScreenSaverEnabled() bool {
+	return C.SDL_TRUE == C.SDL_IsScreenSaverEnabled()
+}
 
-// /**
-//  * Allow the screen to be blanked by a screen saver.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_DisableScreenSaver
-//  * \sa SDL_IsScreenSaverEnabled
-//  */
+/**
+ * Allow the screen to be blanked by a screen saver.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_DisableScreenSaver
+ * \sa SDL_IsScreenSaverEnabled
+ */
 // extern DECLSPEC void SDLCALL SDL_EnableScreenSaver(void);
+func SDL_EnableScreenSaver() {
+	C.SDL_EnableScreenSaver()
+}
 
-// /**
-//  * Prevent the screen from being blanked by a screen saver.
-//  *
-//  * If you disable the screensaver, it is automatically re-enabled when SDL
-//  * quits.
-//  *
-//  * The screensaver is disabled by default since SDL 2.0.2. Before SDL 2.0.2
-//  * the screensaver was enabled by default.
-//  *
-//  * \since This function is available since SDL 2.0.0.
-//  *
-//  * \sa SDL_EnableScreenSaver
-//  * \sa SDL_IsScreenSaverEnabled
-//  */
+/**
+ * Prevent the screen from being blanked by a screen saver.
+ *
+ * If you disable the screensaver, it is automatically re-enabled when SDL
+ * quits.
+ *
+ * The screensaver is disabled by default since SDL 2.0.2. Before SDL 2.0.2
+ * the screensaver was enabled by default.
+ *
+ * \since This function is available since SDL 2.0.0.
+ *
+ * \sa SDL_EnableScreenSaver
+ * \sa SDL_IsScreenSaverEnabled
+ */
 // extern DECLSPEC void SDLCALL SDL_DisableScreenSaver(void);
+func SDL_DisableScreenSaver() {
+	C.SDL_DisableScreenSaver()
+}
 
 // /**
 //  *  \name OpenGL support functions
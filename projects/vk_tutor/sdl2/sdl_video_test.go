@@ -0,0 +1,51 @@
+package sdl2_test
+
+import (
+	"testing"
+
+	"example.com/vk_tutor/sdl2"
+	"example.com/vk_tutor/sdl2/sdltest"
+)
+
+func TestWindowLifecycle(t *testing.T) {
+
+	sdltest.Init(t, sdl2.SDL_INIT_VIDEO)
+
+	var window, err = sdl2.SDL_CreateWindow("first", 0, 0, 320, 240, sdl2.SDL_WINDOW_HIDDEN)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var id uint32
+	if id, err = sdl2.SDL_GetWindowID(window); nil != err {
+		t.Fatal(err)
+	}
+
+	if found := sdl2.SDL_GetWindowFromID(id); nil == found {
+		t.Fatalf("SDL_GetWindowFromID(%d) = nil", id)
+	} else if got, _ := sdl2.SDL_GetWindowID(found); id != got {
+		t.Errorf("SDL_GetWindowFromID(%d) has ID %d", id, got)
+	}
+
+	if 0 == sdl2.SDL_GetWindowFlags(window)&sdl2.SDL_WINDOW_HIDDEN {
+		t.Errorf("window created hidden is shown, flags %#x", sdl2.SDL_GetWindowFlags(window))
+	}
+
+	sdl2.SDL_SetWindowTitle(window, "second")
+	if got := sdl2.SDL_GetWindowTitle(window); "second" != got {
+		t.Errorf("title = %q, want %q", got, "second")
+	}
+
+	sdl2.SDL_SetWindowSize(window, 640, 480)
+	var w, h int
+	sdl2.SDL_GetWindowSize(window, &w, &h)
+	if 640 != w || 480 != h {
+		t.Errorf("size = %dx%d, want 640x480", w, h)
+	}
+
+	sdl2.SDL_DestroyWindow(window)
+
+	if found := sdl2.SDL_GetWindowFromID(id); nil != found {
+		t.Errorf("SDL_GetWindowFromID(%d) finds the destroyed window", id)
+	}
+}
@@ -1,8 +1,10 @@
 package sdl2
 
-// #cgo CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2 -DSDL_MAIN_HANDLED
-// #cgo LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
-// #cgo LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo CFLAGS: -DSDL_MAIN_HANDLED
+// #cgo windows CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/SDL2
+// #cgo windows LDFLAGS: -L${SRCDIR}/lib -static -lmingw32 -lSDL2main -lSDL2 -mwindows
+// #cgo windows LDFLAGS: -Wl,--dynamicbase -Wl,--nxcompat -Wl,--high-entropy-va -lm -ldinput8 -ldxguid -ldxerr8 -luser32 -lgdi32 -lwinmm -limm32 -lole32 -loleaut32 -lshell32 -lsetupapi -lversion -luuid
+// #cgo linux pkg-config: sdl2
 // #include "SDL.h"
 // #include "SDL_vulkan.h"
 import "C"
@@ -160,7 +162,7 @@ type VkSurfaceKHR cHandleWrapper[C.VkSurfaceKHR]
 // extern DECLSPEC SDL_bool SDLCALL SDL_Vulkan_GetInstanceExtensions(SDL_Window *window,
 //                                                                   unsigned int *pCount,
 //                                                                   const char **pNames);
-func SDL_Vulkan_GetInstanceExtensions(window *SDL_Window, pCount *uint, pNames []string) bool {
+func SDL_Vulkan_GetInstanceExtensions(window *SDL_Window, pCount *uint, pNames []string) error {
 
 	var window1 *C.SDL_Window
 	if nil != window {
@@ -206,7 +208,7 @@ func SDL_Vulkan_GetInstanceExtensions(window *SDL_Window, pCount *uint, pNames [
 		&count1,
 		pNames1,
 	) {
-		return false
+		return lastError("SDL_Vulkan_GetInstanceExtensions")
 	}
 
 	*pCount = uint(count1)
@@ -222,7 +224,7 @@ func SDL_Vulkan_GetInstanceExtensions(window *SDL_Window, pCount *uint, pNames [
 		}
 	}
 
-	return true
+	return nil
 }
 
 /**
@@ -246,7 +248,7 @@ func SDL_Vulkan_GetInstanceExtensions(window *SDL_Window, pCount *uint, pNames [
 // extern DECLSPEC SDL_bool SDLCALL SDL_Vulkan_CreateSurface(SDL_Window *window,
 //                                                           VkInstance instance,
 //                                                           VkSurfaceKHR* surface);
-func SDL_Vulkan_CreateSurface(window *SDL_Window, instance vulkan.VkInstance, surface *vulkan.VkSurfaceKHR) bool {
+func SDL_Vulkan_CreateSurface(window *SDL_Window, instance vulkan.VkInstance, surface *vulkan.VkSurfaceKHR) error {
 
 	var instance_sdl VkInstance
 	{
@@ -258,7 +260,7 @@ func SDL_Vulkan_CreateSurface(window *SDL_Window, instance vulkan.VkInstance, su
 	var surface_sdl VkSurfaceKHR
 
 	if !_SDL_Vulkan_CreateSurface(window, instance_sdl, &surface_sdl) {
-		return false
+		return lastError("SDL_Vulkan_CreateSurface")
 	}
 
 	//
@@ -268,7 +270,7 @@ func SDL_Vulkan_CreateSurface(window *SDL_Window, instance vulkan.VkInstance, su
 		*((*C.VkSurfaceKHR)(p_surface_vk_c)) = *p_surface_sdl_c
 	}
 
-	return true
+	return nil
 }
 
 func _SDL_Vulkan_CreateSurface(window *SDL_Window, instance VkInstance, surface *VkSurfaceKHR) bool {
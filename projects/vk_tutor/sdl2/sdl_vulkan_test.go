@@ -0,0 +1,54 @@
+package sdl2_test
+
+import (
+	"testing"
+
+	"example.com/vk_tutor/sdl2"
+	"example.com/vk_tutor/sdl2/sdltest"
+	"example.com/vk_tutor/selector"
+	"example.com/vk_tutor/vulkan"
+)
+
+// Skipped without the offscreen driver or a Vulkan loader
+func TestVulkanSurface(t *testing.T) {
+
+	sdltest.Init(t, sdl2.SDL_INIT_VIDEO)
+
+	var s = sdltest.NewSurface(t, 320, 240)
+
+	var null vulkan.VkSurfaceKHR
+	if null == s.Handle {
+		t.Fatal("null surface")
+	}
+	if 0 == sdl2.SDL_GetWindowFlags(s.Window)&sdl2.SDL_WINDOW_VULKAN {
+		t.Errorf("window without SDL_WINDOW_VULKAN, flags %#x", sdl2.SDL_GetWindowFlags(s.Window))
+	}
+
+	var w, h int
+	sdl2.SDL_Vulkan_GetDrawableSize(s.Window, &w, &h)
+	if 320 != w || 240 != h {
+		t.Errorf("drawable size = %dx%d, want 320x240", w, h)
+	}
+
+	var devices, err = selector.Enumerate(s.Instance.Handle, s.Handle)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	// A device that presents to the surface can describe it
+	for _, d := range devices {
+
+		if 0 == len(d.PresentFamilies) {
+			t.Logf("%v cannot present to the surface", d)
+			continue
+		}
+
+		var caps vulkan.VkSurfaceCapabilitiesKHR
+		if res := vulkan.VkGetPhysicalDeviceSurfaceCapabilitiesKHR(d.Handle, s.Handle, &caps); vulkan.VK_SUCCESS != res {
+			t.Errorf("%v: vkGetPhysicalDeviceSurfaceCapabilitiesKHR failed: %v", d, res)
+		}
+		if 0 == len(d.SurfaceFormats) {
+			t.Errorf("%v presents without surface formats", d)
+		}
+	} // for
+}
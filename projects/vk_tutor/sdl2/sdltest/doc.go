@@ -0,0 +1,15 @@
+// Headless SDL for tests
+//
+// Init starts SDL with the offscreen video driver, or the dummy one where
+// SDL was built without it, and the dummy audio driver, so windows and the
+// event queue work without a desktop, e.g. in CI. Window creates windows
+// that are torn down with the test, Push queues synthetic events through
+// SDL_PushEvent and Events reads them back decoded.
+//
+// NewSurface adds a Vulkan instance and surface to a window. It needs the
+// offscreen driver and a Vulkan loader, the test is skipped without them.
+//
+// SDL is process-wide, tests using this package must not run in parallel.
+// The SDL_VIDEODRIVER and SDL_AUDIODRIVER environment variables take
+// precedence over the drivers chosen here.
+package sdltest
@@ -0,0 +1,105 @@
+package sdltest
+
+import (
+	"runtime"
+	"testing"
+
+	"example.com/vk_tutor/sdl2"
+)
+
+// Init initializes the subsystems of flags headless and quits SDL when the
+// test ends. It returns the video driver, "offscreen" or "dummy".
+//
+// The test goroutine is locked to its thread until then, SDL expects windows
+// and events on the thread that initialized the video subsystem.
+func Init(t testing.TB, flags uint32) string {
+
+	t.Helper()
+
+	runtime.LockOSThread()
+	t.Cleanup(runtime.UnlockOSThread)
+
+	var driver = "dummy"
+	if hasVideoDriver("offscreen") {
+		driver = "offscreen"
+	}
+
+	sdl2.SDL_SetHint(sdl2.SDL_HINT_VIDEODRIVER, driver)
+	sdl2.SDL_SetHint(sdl2.SDL_HINT_AUDIODRIVER, "dummy")
+
+	if err := sdl2.SDL_Init(flags); nil != err {
+		sdl2.SDL_Quit()
+		t.Fatal(err)
+	}
+	t.Cleanup(sdl2.SDL_Quit)
+
+	if 0 != flags&sdl2.SDL_INIT_VIDEO {
+		driver = sdl2.SDL_GetCurrentVideoDriver()
+	}
+
+	return driver
+}
+
+func hasVideoDriver(name string) bool {
+
+	var n, err = sdl2.SDL_GetNumVideoDrivers()
+	if nil != err {
+		return false
+	}
+
+	for i := 0; i < n; i++ {
+		if name == sdl2.SDL_GetVideoDriver(i) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Window creates a window of w x h at the origin, destroyed when the test
+// ends.
+func Window(t testing.TB, w, h int, flags sdl2.SDL_WindowFlags) *sdl2.SDL_Window {
+
+	t.Helper()
+
+	var window, err = sdl2.SDL_CreateWindow(t.Name(), 0, 0, w, h, flags)
+	if nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sdl2.SDL_DestroyWindow(window) })
+
+	return window
+}
+
+// Encoder is an event that can be pushed, e.g. *sdl2.SDL_KeyboardEvent.
+type Encoder interface {
+	Encode() *sdl2.SDL_Event
+}
+
+// Push queues events as if SDL had received them, in order. Events
+// rejected by an event filter are dropped silently.
+func Push(t testing.TB, events ...Encoder) {
+
+	t.Helper()
+
+	for _, e := range events {
+		if _, err := sdl2.SDL_PushEvent(e.Encode()); nil != err {
+			t.Fatal(err)
+		}
+	}
+}
+
+// Events pumps the event loop and returns the queued events decoded, the
+// queue is empty afterwards.
+func Events() []sdl2.Event {
+
+	var r []sdl2.Event
+
+	var e sdl2.SDL_Event
+	for 0 != sdl2.SDL_PollEvent(&e) {
+		r = append(r, e.Decode())
+		e.Free()
+	}
+
+	return r
+}
@@ -0,0 +1,59 @@
+package sdltest
+
+import (
+	"testing"
+
+	"example.com/vk_tutor/bootstrap"
+	"example.com/vk_tutor/sdl2"
+	"example.com/vk_tutor/vulkan"
+)
+
+// Surface is a Vulkan window with the instance and surface created for it.
+type Surface struct {
+	Window   *sdl2.SDL_Window
+	Instance *bootstrap.Instance
+	Handle   vulkan.VkSurfaceKHR
+}
+
+// NewSurface creates a window of w x h with SDL_WINDOW_VULKAN, an instance
+// with the extensions SDL needs and a surface, all destroyed when the test
+// ends. The test is skipped when SDL cannot create a Vulkan window, e.g.
+// with the dummy driver or without a Vulkan loader. Call Init first.
+func NewSurface(t testing.TB, w, h int) *Surface {
+
+	t.Helper()
+
+	var window, err = sdl2.SDL_CreateWindow(t.Name(), 0, 0, w, h, sdl2.SDL_WINDOW_HIDDEN|sdl2.SDL_WINDOW_VULKAN)
+	if nil != err {
+		t.Skip(err)
+	}
+	t.Cleanup(func() { sdl2.SDL_DestroyWindow(window) })
+
+	var cnt uint
+	if err := sdl2.SDL_Vulkan_GetInstanceExtensions(window, &cnt, nil); nil != err {
+		t.Fatal(err)
+	}
+
+	var ext_names = make([]string, cnt)
+	if err := sdl2.SDL_Vulkan_GetInstanceExtensions(window, &cnt, ext_names); nil != err {
+		t.Fatal(err)
+	}
+
+	var builder = bootstrap.NewInstanceBuilder().
+		AppName(t.Name(), vulkan.VK_MAKE_VERSION(1, 0, 0)).
+		RequireExtensions(ext_names[:cnt]...)
+
+	var instance *bootstrap.Instance
+	if instance, err = builder.Build(); nil != err {
+		t.Skip(err)
+	}
+	t.Cleanup(instance.Destroy)
+
+	var surface vulkan.VkSurfaceKHR
+	if err := sdl2.SDL_Vulkan_CreateSurface(window, instance.Handle, &surface); nil != err {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { vulkan.VkDestroySurfaceKHR(instance.Handle, surface, nil) })
+
+	return &Surface{window, instance, surface}
+}
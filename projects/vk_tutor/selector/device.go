@@ -0,0 +1,119 @@
+package selector
+
+import (
+	"fmt"
+
+	"example.com/vk_tutor/vulkan"
+)
+
+// Device is a physical device with the properties selection looks at.
+type Device struct {
+	Index           int // In vkEnumeratePhysicalDevices order
+	Handle          vulkan.VkPhysicalDevice
+	Properties      vulkan.VkPhysicalDeviceProperties
+	Features        vulkan.VkPhysicalDeviceFeatures
+	QueueFamilies   []vulkan.VkQueueFamilyProperties
+	Extensions      map[string]uint32 // Name to spec version
+	PresentFamilies []int             // Queue families that can present to the surface
+
+	// Surface support, empty without a surface
+	SurfaceFormats []vulkan.VkSurfaceFormatKHR
+	PresentModes   []vulkan.VkPresentModeKHR
+}
+
+func (o *Device) String() string {
+	return fmt.Sprintf("#%v %v (%v)", o.Index, o.Properties.DeviceName, DeviceTypeName(o.Properties.DeviceType))
+}
+
+// Enumerate queries every physical device of instance. Surface support is
+// only queried when surface is not the null handle.
+func Enumerate(instance vulkan.VkInstance, surface vulkan.VkSurfaceKHR) ([]*Device, error) {
+
+	var cnt uint32
+	if res := vulkan.VkEnumeratePhysicalDevices(instance, &cnt, nil); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkEnumeratePhysicalDevices failed: %v", res)
+	}
+
+	var handles = make([]vulkan.VkPhysicalDevice, cnt)
+	if res := vulkan.VkEnumeratePhysicalDevices(instance, &cnt, handles); vulkan.VK_SUCCESS != res {
+		return nil, fmt.Errorf("vkEnumeratePhysicalDevices failed: %v", res)
+	}
+
+	var r = make([]*Device, 0, cnt)
+
+	for i, h := range handles[:cnt] {
+
+		var d = &Device{
+			Index:      i,
+			Handle:     h,
+			Extensions: make(map[string]uint32),
+		}
+
+		vulkan.VkGetPhysicalDeviceProperties(h, &d.Properties)
+		vulkan.VkGetPhysicalDeviceFeatures(h, &d.Features)
+
+		var n uint32
+		vulkan.VkGetPhysicalDeviceQueueFamilyProperties(h, &n, nil)
+		d.QueueFamilies = make([]vulkan.VkQueueFamilyProperties, n)
+		vulkan.VkGetPhysicalDeviceQueueFamilyProperties(h, &n, d.QueueFamilies)
+		d.QueueFamilies = d.QueueFamilies[:n]
+
+		var m int
+		vulkan.VkEnumerateDeviceExtensionProperties(h, nil, &m, nil)
+		var exts = make([]vulkan.VkExtensionProperties, m)
+		vulkan.VkEnumerateDeviceExtensionProperties(h, nil, &m, exts)
+		for _, e := range exts[:m] {
+			d.Extensions[e.ExtensionName] = e.SpecVersion
+		}
+
+		if hasSurface(surface) {
+			d.querySurface(surface)
+		}
+
+		r = append(r, d)
+	} // for
+
+	return r, nil
+}
+
+func hasSurface(surface vulkan.VkSurfaceKHR) bool {
+	var null vulkan.VkSurfaceKHR
+	return null != surface
+}
+
+func (o *Device) querySurface(surface vulkan.VkSurfaceKHR) {
+
+	for i := range o.QueueFamilies {
+		var b bool
+		if vulkan.VK_SUCCESS == vulkan.VkGetPhysicalDeviceSurfaceSupportKHR(o.Handle, i, surface, &b) && b {
+			o.PresentFamilies = append(o.PresentFamilies, i)
+		}
+	} // for
+
+	var n int
+	vulkan.VkGetPhysicalDeviceSurfaceFormatsKHR(o.Handle, surface, &n, nil)
+	o.SurfaceFormats = make([]vulkan.VkSurfaceFormatKHR, n)
+	vulkan.VkGetPhysicalDeviceSurfaceFormatsKHR(o.Handle, surface, &n, o.SurfaceFormats)
+	o.SurfaceFormats = o.SurfaceFormats[:n]
+
+	n = 0
+	vulkan.VkGetPhysicalDeviceSurfacePresentModesKHR(o.Handle, surface, &n, nil)
+	o.PresentModes = make([]vulkan.VkPresentModeKHR, n)
+	vulkan.VkGetPhysicalDeviceSurfacePresentModesKHR(o.Handle, surface, &n, o.PresentModes)
+	o.PresentModes = o.PresentModes[:n]
+}
+
+// DeviceTypeName returns e.g. "discrete GPU".
+func DeviceTypeName(t vulkan.VkPhysicalDeviceType) string {
+	switch t {
+	case vulkan.VK_PHYSICAL_DEVICE_TYPE_INTEGRATED_GPU:
+		return "integrated GPU"
+	case vulkan.VK_PHYSICAL_DEVICE_TYPE_DISCRETE_GPU:
+		return "discrete GPU"
+	case vulkan.VK_PHYSICAL_DEVICE_TYPE_VIRTUAL_GPU:
+		return "virtual GPU"
+	case vulkan.VK_PHYSICAL_DEVICE_TYPE_CPU:
+		return "CPU"
+	}
+	return "other"
+}
@@ -0,0 +1,9 @@
+// Physical device selection
+//
+// Devices are checked against hard requirements (API version, extensions,
+// features, queue capabilities, surface support); every failed requirement
+// is recorded as a human-readable rejection reason. Suitable devices are
+// ranked by the sum of the weights of the preferences they match. The
+// choice can be overridden by index, name substring or pipeline cache UUID,
+// see OverrideEnv.
+package selector
@@ -0,0 +1,377 @@
+package selector
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"example.com/vk_tutor/vulkan"
+)
+
+// Environment variable holding a device override, see ParseOverride.
+const OverrideEnv = "VK_TUTOR_DEVICE"
+
+// Requirements a device must meet to be selected at all.
+type Requirements struct {
+	APIVersion uint32   // Minimum apiVersion, 0 for any
+	Extensions []string // Device extensions
+
+	Features vulkan.FeatureSet // Required features
+
+	// Every bit must be supported by at least one queue family, e.g.
+	// VK_QUEUE_GRAPHICS_BIT|VK_QUEUE_COMPUTE_BIT
+	QueueFlags vulkan.VkQueueFlags
+
+	// A queue family that can present, and at least one surface format and
+	// present mode. Devices must have been enumerated with a surface.
+	Present bool
+
+	// Further checks returning rejection reasons, e.g. Profile.Reasons of
+	// the profiles package
+	Checks []func(d *Device) []string
+}
+
+// Preference adds Weight to the score of the devices it matches.
+type Preference struct {
+	Name   string
+	Weight float64
+	Match  func(d *Device) bool
+}
+
+// PreferDeviceType matches devices of type t.
+func PreferDeviceType(t vulkan.VkPhysicalDeviceType, weight float64) Preference {
+	return Preference{
+		Name:   DeviceTypeName(t),
+		Weight: weight,
+		Match:  func(d *Device) bool { return t == d.Properties.DeviceType },
+	}
+}
+
+// PreferExtension matches devices supporting an optional extension.
+func PreferExtension(name string, weight float64) Preference {
+	return Preference{
+		Name:   name,
+		Weight: weight,
+		Match: func(d *Device) bool {
+			var _, ok = d.Extensions[name]
+			return ok
+		},
+	}
+}
+
+// PreferAPIVersion matches devices supporting at least version.
+func PreferAPIVersion(version uint32, weight float64) Preference {
+	return Preference{
+		Name:   "Vulkan " + vulkan.Version(version).String(),
+		Weight: weight,
+		Match:  func(d *Device) bool { return d.Properties.ApiVersion >= version },
+	}
+}
+
+// PreferFeatures matches devices supporting every feature of features.
+func PreferFeatures(features vulkan.FeatureSet, weight float64) Preference {
+	return Preference{
+		Name:   features.String(),
+		Weight: weight,
+		Match:  func(d *Device) bool { return features.Difference(d.Features.FeatureSet()).IsEmpty() },
+	}
+}
+
+// DefaultPreferences favors discrete over integrated over virtual GPUs over
+// CPU implementations.
+var DefaultPreferences = []Preference{
+	PreferDeviceType(vulkan.VK_PHYSICAL_DEVICE_TYPE_DISCRETE_GPU, 1000),
+	PreferDeviceType(vulkan.VK_PHYSICAL_DEVICE_TYPE_INTEGRATED_GPU, 500),
+	PreferDeviceType(vulkan.VK_PHYSICAL_DEVICE_TYPE_VIRTUAL_GPU, 100),
+	PreferDeviceType(vulkan.VK_PHYSICAL_DEVICE_TYPE_CPU, 10),
+}
+
+// Candidate is a ranked device.
+type Candidate struct {
+	*Device
+	Score      float64
+	Matched    []string // Names of the matched preferences
+	Rejections []string // Why the device does not meet the requirements
+	Overridden bool     // Chosen by an override
+}
+
+// Suitable reports whether the device meets all requirements.
+func (o *Candidate) Suitable() bool {
+	return 0 == len(o.Rejections)
+}
+
+// Check returns the reasons why d does not meet req, nil if it does.
+func Check(d *Device, req *Requirements) []string {
+
+	var r []string
+
+	if req.APIVersion > d.Properties.ApiVersion {
+		r = append(r, fmt.Sprintf("API version %v < required %v",
+			vulkan.Version(d.Properties.ApiVersion), vulkan.Version(req.APIVersion)))
+	}
+
+	for _, e := range req.Extensions {
+		if _, ok := d.Extensions[e]; !ok {
+			r = append(r, fmt.Sprintf("extension %v not supported", e))
+		}
+	}
+
+	for _, f := range req.Features.Difference(d.Features.FeatureSet()).Features() {
+		r = append(r, fmt.Sprintf("feature %v not supported", f))
+	}
+
+	var flags vulkan.VkQueueFlags
+	for _, q := range d.QueueFamilies {
+		flags |= q.QueueFlags
+	}
+	if missing := req.QueueFlags &^ flags; 0 != missing {
+		r = append(r, fmt.Sprintf("no queue family with %v", queueFlagNames(missing)))
+	}
+
+	if req.Present {
+		if 0 == len(d.PresentFamilies) {
+			r = append(r, "no queue family can present to the surface")
+		}
+		if 0 == len(d.SurfaceFormats) {
+			r = append(r, "no surface formats")
+		}
+		if 0 == len(d.PresentModes) {
+			r = append(r, "no present modes")
+		}
+	}
+
+	for _, check := range req.Checks {
+		r = append(r, check(d)...)
+	}
+
+	return r
+}
+
+// Rank checks and scores devices. Suitable devices come first, best score
+// first, then rejected ones; ties keep enumeration order. An empty override
+// ranks by score only, otherwise the device it names is moved to the front
+// and marked Overridden; it is an error if it names no device.
+func Rank(devices []*Device, req *Requirements, prefs []Preference, override string) ([]*Candidate, error) {
+
+	if nil == req {
+		req = &Requirements{}
+	}
+
+	var r = make([]*Candidate, 0, len(devices))
+
+	for _, d := range devices {
+
+		var c = &Candidate{Device: d, Rejections: Check(d, req)}
+
+		for _, p := range prefs {
+			if p.Match(d) {
+				c.Score += p.Weight
+				c.Matched = append(c.Matched, p.Name)
+			}
+		} // for
+
+		r = append(r, c)
+	} // for
+
+	if "" != override {
+		var d, err = ParseOverride(override).Find(devices)
+		if nil != err {
+			return nil, err
+		}
+		for _, c := range r {
+			c.Overridden = c.Device == d
+		}
+	}
+
+	sort.SliceStable(r, func(i, j int) bool {
+		var a, b = r[i], r[j]
+		if a.Overridden != b.Overridden {
+			return a.Overridden
+		}
+		if a.Suitable() != b.Suitable() {
+			return a.Suitable()
+		}
+		return a.Score > b.Score
+	})
+
+	return r, nil
+}
+
+// Select enumerates the devices of instance and returns the best suitable
+// one along with the full ranking. An overridden device that does not meet
+// the requirements is an error rather than silently replaced.
+func Select(instance vulkan.VkInstance, surface vulkan.VkSurfaceKHR, req *Requirements, prefs []Preference, override string) (*Candidate, []*Candidate, error) {
+
+	var devices, err = Enumerate(instance, surface)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	if 0 == len(devices) {
+		return nil, nil, errors.New("no Vulkan physical devices")
+	}
+
+	ranked, err := Rank(devices, req, prefs, override)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	var best = ranked[0]
+	if !best.Suitable() {
+		if best.Overridden {
+			return nil, ranked, fmt.Errorf("device %v chosen by override is not suitable: %v",
+				best.Device, strings.Join(best.Rejections, "; "))
+		}
+		return nil, ranked, fmt.Errorf("no suitable device:\n%v", Report(ranked))
+	}
+
+	return best, ranked, nil
+}
+
+// Report formats a ranking, one device per line followed by its rejection
+// reasons.
+func Report(ranked []*Candidate) string {
+
+	var sb strings.Builder
+
+	for _, c := range ranked {
+
+		var status = fmt.Sprintf("score %v", c.Score)
+		if !c.Suitable() {
+			status = "rejected"
+		}
+		if c.Overridden {
+			status += ", override"
+		}
+
+		fmt.Fprintf(&sb, "%v, Vulkan %v, %v driver %v: %v\n", c.Device, vulkan.Version(c.Properties.ApiVersion),
+			vulkan.VendorName(c.Properties.VendorID), vulkan.DriverVersionString(c.Properties.VendorID, c.Properties.DriverVersion), status)
+
+		for _, reason := range c.Rejections {
+			fmt.Fprintf(&sb, "\t%v\n", reason)
+		}
+	} // for
+
+	return sb.String()
+}
+
+// Override names one device by index, name substring or pipeline cache
+// UUID.
+type Override struct {
+	Index             int // -1 when not given by index
+	PipelineCacheUUID []byte
+	Name              string // Case-insensitive substring of the device name
+}
+
+// ParseOverride parses a decimal index, a pipeline cache UUID in hex (dashes
+// allowed), or otherwise a device name substring. The pipeline cache UUID is
+// VkPhysicalDeviceProperties.pipelineCacheUUID, as listed by vkreport, not
+// the deviceUUID of VkPhysicalDeviceIDProperties.
+func ParseOverride(s string) Override {
+
+	s = strings.TrimSpace(s)
+
+	if i, err := strconv.Atoi(s); nil == err && i >= 0 {
+		return Override{Index: i}
+	}
+
+	var h = strings.ReplaceAll(s, "-", "")
+	if 2*vulkan.VK_UUID_SIZE == len(h) {
+		if b, err := hex.DecodeString(h); nil == err {
+			return Override{Index: -1, PipelineCacheUUID: b}
+		}
+	}
+
+	return Override{Index: -1, Name: s}
+}
+
+// Find returns the device the override names. A name or pipeline cache UUID
+// that matches more than one device is an error; identical GPUs are told
+// apart by index.
+func (o Override) Find(devices []*Device) (*Device, error) {
+
+	switch {
+
+	case o.Index >= 0:
+		for _, d := range devices {
+			if o.Index == d.Index {
+				return d, nil
+			}
+		}
+		return nil, fmt.Errorf("device override: no device #%v, %v devices", o.Index, len(devices))
+
+	case nil != o.PipelineCacheUUID:
+		// Identical GPUs on the same driver share the UUID
+		var found *Device
+		for _, d := range devices {
+			if string(o.PipelineCacheUUID) == string(d.Properties.PipelineCacheUUID[:]) {
+				if nil != found {
+					return nil, fmt.Errorf("device override: pipeline cache UUID %x matches both %v and %v, select by index", o.PipelineCacheUUID, found, d)
+				}
+				found = d
+			}
+		} // for
+		if nil == found {
+			return nil, fmt.Errorf("device override: no device with pipeline cache UUID %x", o.PipelineCacheUUID)
+		}
+		return found, nil
+	}
+
+	var found *Device
+	for _, d := range devices {
+		if strings.Contains(strings.ToLower(d.Properties.DeviceName), strings.ToLower(o.Name)) {
+			if nil != found {
+				return nil, fmt.Errorf("device override: %q matches both %v and %v", o.Name, found, d)
+			}
+			found = d
+		}
+	} // for
+
+	if nil == found {
+		return nil, fmt.Errorf("device override: no device name contains %q", o.Name)
+	}
+
+	return found, nil
+}
+
+// OverrideFromEnv returns flag if not empty, else the OverrideEnv variable.
+func OverrideFromEnv(flag string) string {
+	if "" != flag {
+		return flag
+	}
+	return os.Getenv(OverrideEnv)
+}
+
+var queueFlagBits = []struct {
+	bit  vulkan.VkQueueFlagBits
+	name string
+}{
+	{vulkan.VK_QUEUE_GRAPHICS_BIT, "graphics"},
+	{vulkan.VK_QUEUE_COMPUTE_BIT, "compute"},
+	{vulkan.VK_QUEUE_TRANSFER_BIT, "transfer"},
+	{vulkan.VK_QUEUE_SPARSE_BINDING_BIT, "sparse binding"},
+	{vulkan.VK_QUEUE_PROTECTED_BIT, "protected"},
+	{vulkan.VK_QUEUE_VIDEO_DECODE_BIT_KHR, "video decode"},
+	{vulkan.VK_QUEUE_OPTICAL_FLOW_BIT_NV, "optical flow"},
+}
+
+func queueFlagNames(flags vulkan.VkQueueFlags) string {
+
+	var r []string
+	for _, b := range queueFlagBits {
+		if 0 != flags&vulkan.VkQueueFlags(b.bit) {
+			r = append(r, b.name)
+			flags &^= vulkan.VkQueueFlags(b.bit)
+		}
+	}
+
+	if 0 != flags {
+		r = append(r, fmt.Sprintf("0x%x", uint32(flags)))
+	}
+
+	return strings.Join(r, "+")
+}
@@ -0,0 +1,82 @@
+package selector
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseOverride(t *testing.T) {
+
+	var uuid = []byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
+
+	for _, c := range []struct {
+		s    string
+		want Override
+	}{
+		{"1", Override{Index: 1}},
+		{" 0 ", Override{Index: 0}},
+		{"00112233-4455-6677-8899-aabbccddeeff", Override{Index: -1, PipelineCacheUUID: uuid}},
+		{"00112233445566778899AABBCCDDEEFF", Override{Index: -1, PipelineCacheUUID: uuid}},
+		{"-1", Override{Index: -1, Name: "-1"}},
+		{"GeForce", Override{Index: -1, Name: "GeForce"}},
+	} {
+		if got := ParseOverride(c.s); !reflect.DeepEqual(c.want, got) {
+			t.Errorf("ParseOverride(%q) = %+v, want %+v", c.s, got, c.want)
+		}
+	} // for
+}
+
+func TestOverrideFind(t *testing.T) {
+
+	var devices = []*Device{{Index: 0}, {Index: 1}}
+	devices[0].Properties.DeviceName = "Intel UHD Graphics"
+	devices[1].Properties.DeviceName = "NVIDIA GeForce RTX"
+	devices[1].Properties.PipelineCacheUUID[15] = 1
+
+	for _, c := range []struct {
+		s    string
+		want *Device
+	}{
+		{"1", devices[1]},
+		{"geforce", devices[1]},
+		{"00000000-0000-0000-0000-000000000001", devices[1]},
+		{"00000000-0000-0000-0000-000000000000", devices[0]},
+	} {
+		if got, err := ParseOverride(c.s).Find(devices); nil != err || c.want != got {
+			t.Errorf("Find(%q) = %v, %v, want %v", c.s, got, err, c.want)
+		}
+	} // for
+
+	for _, c := range []struct{ s, err string }{
+		{"2", "no device #2"},
+		{"ffffffff-0000-0000-0000-000000000000", "no device with pipeline cache UUID"},
+		{"i", "matches both"},
+		{"AMD", "no device name contains"},
+	} {
+		if _, err := ParseOverride(c.s).Find(devices); nil == err || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("Find(%q) error %v, want one containing %q", c.s, err, c.err)
+		}
+	} // for
+}
+
+func TestOverrideFindSameUUID(t *testing.T) {
+
+	// Two identical GPUs on one driver
+	var devices = []*Device{{Index: 0}, {Index: 1}}
+	for _, d := range devices {
+		d.Properties.DeviceName = "NVIDIA GeForce RTX"
+		d.Properties.PipelineCacheUUID[15] = 1
+	}
+
+	if _, err := ParseOverride("00000000-0000-0000-0000-000000000001").Find(devices); nil == err || !strings.Contains(err.Error(), "matches both") {
+		t.Errorf("Find by shared UUID error %v, want one containing %q", err, "matches both")
+	}
+
+	for i, d := range devices {
+		if got, err := ParseOverride(strconv.Itoa(i)).Find(devices); nil != err || d != got {
+			t.Errorf("Find(%v) = %v, %v, want %v", i, got, err, d)
+		}
+	} // for
+}
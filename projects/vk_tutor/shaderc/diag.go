@@ -0,0 +1,107 @@
+package shaderc
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Diagnostic is one compiler message.
+type Diagnostic struct {
+	File     string
+	Line     int // 0 when the message is not tied to a line
+	Severity string
+	Msg      string
+}
+
+func (o Diagnostic) String() string {
+	if 0 == o.Line {
+		return fmt.Sprintf("%v: %v: %v", o.File, o.Severity, o.Msg)
+	}
+	return fmt.Sprintf("%v:%v: %v: %v", o.File, o.Line, o.Severity, o.Msg)
+}
+
+// CompileError is returned when the compiler rejects a shader.
+type CompileError struct {
+	File        string
+	Diagnostics []Diagnostic // Errors and warnings, in compiler order
+	Output      string       // Raw compiler output
+}
+
+func (o *CompileError) Error() string {
+
+	var lines []string
+	for _, d := range o.Diagnostics {
+		lines = append(lines, d.String())
+	}
+
+	if 0 == len(lines) {
+		var out = strings.TrimSpace(o.Output)
+		if "" == out {
+			out = "compilation failed"
+		}
+		return fmt.Sprintf("%v: error: %v", o.File, out)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+var (
+	// glslc: shader.frag:12: error: 'x' : undeclared identifier
+	glslcLineRe = regexp.MustCompile(`^(.+?):(\d+): (error|warning): (.*)$`)
+
+	// glslc: shader.frag: error: ...
+	glslcFileRe = regexp.MustCompile(`^(.+?): (error|warning): (.*)$`)
+
+	// glslangValidator: ERROR: shader.frag:12: 'x' : undeclared identifier
+	glslangRe = regexp.MustCompile(`^(ERROR|WARNING): (.+?):(\d+): (.*)$`)
+)
+
+// parseDiagnostics extracts file:line messages from glslc or
+// glslangValidator output. Summary lines are dropped.
+func parseDiagnostics(out string) []Diagnostic {
+
+	var r []Diagnostic
+
+	for _, line := range strings.Split(out, "\n") {
+
+		line = strings.TrimRight(line, "\r")
+
+		if m := glslangRe.FindStringSubmatch(line); nil != m {
+			var n, _ = strconv.Atoi(m[3])
+			r = append(r, Diagnostic{
+				File:     m[2],
+				Line:     n,
+				Severity: strings.ToLower(m[1]),
+				Msg:      m[4],
+			})
+			continue
+		}
+
+		if m := glslcLineRe.FindStringSubmatch(line); nil != m {
+			var n, _ = strconv.Atoi(m[2])
+			r = append(r, Diagnostic{
+				File:     m[1],
+				Line:     n,
+				Severity: m[3],
+				Msg:      m[4],
+			})
+			continue
+		}
+
+		if m := glslcFileRe.FindStringSubmatch(line); nil != m {
+			// Skip glslang's "ERROR: N compilation errors." summary
+			if strings.HasPrefix(m[1], "ERROR") || strings.HasPrefix(m[1], "WARNING") {
+				continue
+			}
+			r = append(r, Diagnostic{
+				File:     m[1],
+				Severity: m[2],
+				Msg:      m[3],
+			})
+		}
+	} // for
+
+	return r
+}
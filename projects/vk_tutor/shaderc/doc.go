@@ -0,0 +1,10 @@
+// Shader compilation through a locally installed glslc or glslangValidator
+//
+// Sources are compiled to SPIR-V with #include resolution, preprocessor
+// defines and a target environment. Outputs are cached by a content hash of
+// the source, every file it includes and the compile options, so unchanged
+// shaders are not recompiled.
+//
+// For development, Reloader watches shader files and rebuilds the pipelines
+// using them between frames, keeping the last good pipeline on failure.
+package shaderc
@@ -0,0 +1,83 @@
+package shaderc
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// Shader is one compiled shader to emit.
+type Shader struct {
+	Name   string // Go variable name
+	Source string // Source path, for the comment
+	Hash   string
+	SPIRV  []byte
+}
+
+// VarName derives a Go identifier from prefix and a shader path:
+// ("spv", "shaders/shader.vert") gives "spvShaderVert".
+func VarName(prefix, path string) string {
+
+	var b strings.Builder
+	b.WriteString(prefix)
+
+	var upper = "" != prefix
+	for _, r := range filepath.Base(path) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	} // for
+
+	var s = b.String()
+	if "" == s || unicode.IsDigit(rune(s[0])) {
+		s = "_" + s
+	}
+
+	return s
+}
+
+// WriteGo writes a gofmt'ed Go source file of package pkg declaring one
+// []byte variable per shader.
+func WriteGo(w io.Writer, pkg string, shaders []Shader) error {
+
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "// Code generated by shaderc; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %v\n", pkg)
+
+	for _, s := range shaders {
+
+		fmt.Fprintf(&b, "\n// %v is the SPIR-V of %v", s.Name, filepath.ToSlash(s.Source))
+		if "" != s.Hash {
+			fmt.Fprintf(&b, "\n// (sha256 %v)", s.Hash)
+		}
+		fmt.Fprintf(&b, ".\nvar %v = []byte{", s.Name)
+
+		for i, c := range s.SPIRV {
+			if 0 == i%16 {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(&b, "0x%02x, ", c)
+		} // for
+
+		b.WriteString("\n}\n")
+	} // for
+
+	var src, err = format.Source(b.Bytes())
+	if nil != err {
+		return err
+	}
+
+	_, err = w.Write(src)
+	return err
+}
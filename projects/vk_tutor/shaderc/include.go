@@ -0,0 +1,186 @@
+package shaderc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	includeRe = regexp.MustCompile(`^\s*#\s*include\s*([<"])([^>"]+)[>"]`)
+	ifRe      = regexp.MustCompile(`^\s*#\s*(if|ifdef|ifndef|elif|else|endif)\b\s*(.*)$`)
+)
+
+// Includes returns path followed by every file it includes, directly or
+// indirectly, in first-seen order. Quoted includes are looked up next to the
+// including file first, then in dirs; angle bracket includes only in dirs.
+// Includes in comments and #if 0 blocks are ignored.
+func Includes(path string, dirs []string) ([]string, error) {
+
+	var r []string
+	var seen = make(map[string]bool)
+
+	var visit func(file, from string, line int) error
+	visit = func(file, from string, line int) error {
+
+		if seen[file] {
+			return nil
+		}
+		seen[file] = true
+
+		var src, err = os.ReadFile(file)
+		if nil != err {
+			if "" != from {
+				return fmt.Errorf("%v:%v: %v", from, line, err)
+			}
+			return err
+		}
+
+		r = append(r, file)
+
+		for i, l := range activeLines(src) {
+
+			var n = i + 1
+			var m = includeRe.FindStringSubmatch(l)
+			if nil == m {
+				continue
+			}
+
+			var inc, ok = findInclude(m[2], filepath.Dir(file), "\"" == m[1], dirs)
+			if !ok {
+				return fmt.Errorf("%v:%v: cannot find include file %q", file, n, m[2])
+			}
+
+			if err := visit(inc, file, n); nil != err {
+				return err
+			}
+		} // for
+
+		return nil
+	}
+
+	if err := visit(filepath.Clean(path), "", 0); nil != err {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func findInclude(name, dir string, quoted bool, dirs []string) (string, bool) {
+
+	var candidates []string
+
+	if filepath.IsAbs(name) {
+		candidates = append(candidates, name)
+	} else {
+		if quoted {
+			candidates = append(candidates, filepath.Join(dir, name))
+		}
+		for _, d := range dirs {
+			candidates = append(candidates, filepath.Join(d, name))
+		}
+	}
+
+	for _, c := range candidates {
+		if fi, err := os.Stat(c); nil == err && !fi.IsDir() {
+			return filepath.Clean(c), true
+		}
+	}
+
+	return "", false
+}
+
+// activeLines returns the lines of src with comments and the lines of #if 0
+// blocks blanked, so indices stay line numbers. Other conditions are not
+// evaluated, the includes of all their branches count.
+func activeLines(src []byte) []string {
+
+	var lines = strings.Split(stripComments(string(src)), "\n")
+
+	// The open #if blocks, true while in the #if 0 branch
+	var skip []bool
+	var skipping = func() bool {
+		for _, s := range skip {
+			if s {
+				return true
+			}
+		}
+		return false
+	}
+
+	for i, l := range lines {
+
+		var m = ifRe.FindStringSubmatch(l)
+		if nil == m {
+			if skipping() {
+				lines[i] = ""
+			}
+			continue
+		}
+
+		lines[i] = ""
+
+		var top = len(skip) - 1
+		switch m[1] {
+		case "if", "ifdef", "ifndef":
+			skip = append(skip, "if" == m[1] && "0" == strings.TrimSpace(m[2]))
+		case "elif", "else":
+			if top >= 0 {
+				skip[top] = false
+			}
+		case "endif":
+			if top >= 0 {
+				skip = skip[:top]
+			}
+		} // switch
+	} // for
+
+	return lines
+}
+
+// stripComments replaces the comments of GLSL or HLSL source with spaces,
+// keeping the newlines of block comments. Quoted text, e.g. the file of an
+// #include, is kept as is.
+func stripComments(src string) string {
+
+	var b strings.Builder
+	b.Grow(len(src))
+
+	for i := 0; i < len(src); i++ {
+
+		switch {
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && '\n' != src[i] {
+				i++
+			}
+			if i < len(src) {
+				b.WriteByte('\n')
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			var end = strings.Index(src[i+2:], "*/")
+			var body = src[i:]
+			if end >= 0 {
+				body = src[i : i+2+end+2]
+			}
+			b.WriteByte(' ')
+			b.WriteString(strings.Repeat("\n", strings.Count(body, "\n")))
+			i += len(body) - 1
+		case '"' == src[i]:
+			var j = i + 1
+			for j < len(src) && '"' != src[j] && '\n' != src[j] {
+				j++
+			}
+			if j < len(src) && '"' == src[j] {
+				j++
+			}
+			b.WriteString(src[i:j])
+			i = j - 1
+		default:
+			b.WriteByte(src[i])
+		} // switch
+	} // for
+
+	return b.String()
+}
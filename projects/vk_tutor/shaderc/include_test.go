@@ -0,0 +1,74 @@
+package shaderc
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestIncludes(t *testing.T) {
+
+	var dir = t.TempDir()
+	var write = func(name, src string) string {
+		var p = filepath.Join(dir, name)
+		if err := os.WriteFile(p, []byte(src), 0o644); nil != err {
+			t.Fatal(err)
+		}
+		return p
+	}
+
+	var main = write("main.frag", `#version 450
+#include "used.glsl"
+// #include "line_comment.glsl"
+/* #include "block_comment.glsl"
+#include "block_comment.glsl" */
+#if 0
+#include "if0.glsl"
+#if 1
+#include "nested.glsl"
+#endif
+#else
+#include "else.glsl"
+#endif
+#ifdef FEATURE
+#include "ifdef.glsl"
+#endif
+#include "url//like.glsl" // trailing
+void main() {}
+`)
+	var used = write("used.glsl", "")
+	var elseFile = write("else.glsl", "")
+	var ifdef = write("ifdef.glsl", "")
+	if err := os.Mkdir(filepath.Join(dir, "url"), 0o755); nil != err {
+		t.Fatal(err)
+	}
+	var like = write(filepath.Join("url", "like.glsl"), "")
+
+	var got, err = Includes(main, nil)
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var want = []string{main, used, elseFile, ifdef, like}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Includes = %v, want %v", got, want)
+	}
+}
+
+func TestIncludesMissing(t *testing.T) {
+
+	var dir = t.TempDir()
+	var main = filepath.Join(dir, "main.vert")
+	if err := os.WriteFile(main, []byte("/* x */ #include \"missing.glsl\"\n"), 0o644); nil != err {
+		t.Fatal(err)
+	}
+
+	var _, err = Includes(main, nil)
+	if nil == err {
+		t.Fatal("Includes of a missing file succeeded")
+	}
+	if want := main + `:1: cannot find include file "missing.glsl"`; want != err.Error() {
+		t.Errorf("error %q, want %q", err, want)
+	}
+}
@@ -0,0 +1,281 @@
+package shaderc
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Reloader recompiles shaders when their sources, includes or SPIR-V files
+// change and rebuilds the pipelines using them. It is meant for development
+// builds: call Update once per frame, between frames, from the thread that
+// owns the device.
+//
+// Paths ending in .spv are loaded as is, anything else is compiled with
+// Options.
+type Reloader struct {
+	Options  *Options
+	Interval time.Duration // Minimum time between polls, default 250ms
+	Log      func(error)   // Reports reload failures, default log.Print
+
+	shaders   map[string]*watchedShader
+	pipelines []*Pipeline
+	polled    time.Time
+}
+
+// Pipeline is a set of shaders and the function building a pipeline from
+// them.
+//
+// Build receives the SPIR-V of every shader keyed by path. It must create the
+// new pipeline first and only replace and destroy the old one on success, so
+// the last good pipeline stays in use when it returns an error.
+type Pipeline struct {
+	Shaders []string
+	Build   func(spirv map[string][]byte) error
+
+	err error
+}
+
+// Err returns why the last rebuild failed, or nil when the pipeline is
+// current.
+func (o *Pipeline) Err() error {
+	return o.err
+}
+
+type watchedShader struct {
+	files []string
+	mods  map[string]time.Time
+	spirv []byte
+	err   error
+}
+
+// NewReloader returns a Reloader compiling with opts.
+func NewReloader(opts *Options) *Reloader {
+	return &Reloader{
+		Options: opts,
+		shaders: make(map[string]*watchedShader),
+	}
+}
+
+// Add compiles the shaders, builds the pipeline once and watches it. An
+// error is returned and nothing is watched if the initial build fails.
+func (o *Reloader) Add(shaders []string, build func(spirv map[string][]byte) error) (*Pipeline, error) {
+
+	var p = &Pipeline{Shaders: shaders, Build: build}
+
+	for _, s := range shaders {
+		if _, ok := o.shaders[s]; ok {
+			continue
+		}
+		var w = o.load(s)
+		if nil != w.err {
+			return nil, w.err
+		}
+		o.shaders[s] = w
+	} // for
+
+	if err := build(o.spirv(p)); nil != err {
+		return nil, err
+	}
+
+	o.pipelines = append(o.pipelines, p)
+
+	return p, nil
+}
+
+// Remove stops watching p. Shaders not used by other pipelines are dropped.
+func (o *Reloader) Remove(p *Pipeline) {
+
+	for i, v := range o.pipelines {
+		if v == p {
+			o.pipelines = append(o.pipelines[:i], o.pipelines[i+1:]...)
+			break
+		}
+	}
+
+	var used = make(map[string]bool)
+	for _, v := range o.pipelines {
+		for _, s := range v.Shaders {
+			used[s] = true
+		}
+	}
+
+	for s := range o.shaders {
+		if !used[s] {
+			delete(o.shaders, s)
+		}
+	}
+}
+
+// Update checks watched files for changes, recompiles changed shaders and
+// rebuilds the pipelines using them. It returns true if any pipeline was
+// rebuilt. Failures are reported through Log and kept in Err.
+func (o *Reloader) Update() bool {
+
+	var interval = o.Interval
+	if 0 == interval {
+		interval = 250 * time.Millisecond
+	}
+
+	var now = time.Now()
+	if now.Sub(o.polled) < interval {
+		return false
+	}
+	o.polled = now
+
+	var changed = make(map[string]bool)
+
+	for s, w := range o.shaders {
+
+		if !w.changed() {
+			continue
+		}
+
+		var n = o.load(s)
+		if nil != n.err {
+			// Keep the last good SPIR-V, but watch the new file set so the
+			// fix is picked up
+			if nil == n.files {
+				n.files, n.mods = w.files, stat(w.files)
+			}
+			n.spirv = w.spirv
+			o.shaders[s] = n
+			o.report(n.err)
+			continue
+		}
+
+		o.shaders[s] = n
+		changed[s] = true
+	} // for
+
+	var rebuilt bool
+
+	for _, p := range o.pipelines {
+
+		var affected bool
+		for _, s := range p.Shaders {
+			if changed[s] {
+				affected = true
+			}
+			if nil != o.shaders[s].err {
+				// Wait until every shader compiles
+				affected = false
+				p.err = o.shaders[s].err
+				break
+			}
+		} // for
+
+		if !affected {
+			continue
+		}
+
+		if err := p.Build(o.spirv(p)); nil != err {
+			p.err = fmt.Errorf("rebuilding pipeline (%v): %w", strings.Join(p.Shaders, ", "), err)
+			o.report(p.err)
+			continue
+		}
+
+		p.err = nil
+		rebuilt = true
+	} // for
+
+	return rebuilt
+}
+
+// Err returns the current errors of all pipelines, for display, or nil.
+func (o *Reloader) Err() error {
+
+	var errs []error
+	for _, p := range o.pipelines {
+		if nil != p.err {
+			errs = append(errs, p.err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (o *Reloader) report(err error) {
+	if nil != o.Log {
+		o.Log(err)
+	} else {
+		log.Print(err)
+	}
+}
+
+func (o *Reloader) spirv(p *Pipeline) map[string][]byte {
+
+	var r = make(map[string][]byte, len(p.Shaders))
+	for _, s := range p.Shaders {
+		r[s] = o.shaders[s].spirv
+	}
+
+	return r
+}
+
+func (o *Reloader) load(path string) *watchedShader {
+
+	var w watchedShader
+
+	if strings.EqualFold(".spv", filepath.Ext(path)) {
+		w.files = []string{path}
+		w.mods = stat(w.files)
+		w.spirv, w.err = os.ReadFile(path)
+		return &w
+	}
+
+	var r, err = Compile(path, o.Options)
+	if nil != err {
+		w.err = err
+		// Still watch whatever the include scan finds
+		var opts = o.Options
+		if nil == opts {
+			opts = &Options{}
+		}
+		if files, err := Includes(path, opts.IncludeDirs); nil == err {
+			w.files = files
+			w.mods = stat(files)
+		}
+		return &w
+	}
+
+	w.files = r.Files
+	w.mods = stat(r.Files)
+	w.spirv = r.SPIRV
+
+	return &w
+}
+
+func (o *watchedShader) changed() bool {
+
+	for _, f := range o.files {
+
+		var fi, err = os.Stat(f)
+		if nil != err {
+			// Editors may delete and recreate files on save; wait for it
+			continue
+		}
+
+		if !fi.ModTime().Equal(o.mods[f]) {
+			return true
+		}
+	} // for
+
+	return false
+}
+
+func stat(files []string) map[string]time.Time {
+
+	var r = make(map[string]time.Time, len(files))
+	for _, f := range files {
+		if fi, err := os.Stat(f); nil == err {
+			r[f] = fi.ModTime()
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,240 @@
+package shaderc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"example.com/vk_tutor/internal/fsutil"
+)
+
+// Options of a compilation. The zero value compiles GLSL for Vulkan 1.0 with
+// whichever compiler is found first in PATH and no cache.
+type Options struct {
+	Compiler    string   // glslc or glslangValidator path, empty to search PATH
+	IncludeDirs []string // -I
+	Defines     []string // -D, "NAME" or "NAME=VALUE"
+	TargetEnv   string   // e.g. "vulkan1.2", default "vulkan1.0"
+	Stage       string   // e.g. "vert", empty to infer from the file name
+	EntryPoint  string   // HLSL entry point, default "main"
+	CacheDir    string   // Output cache, empty to disable
+}
+
+// Result of a compilation.
+type Result struct {
+	SPIRV  []byte
+	Files  []string // Source followed by every included file
+	Hash   string   // Cache key, hex SHA-256
+	Cached bool
+}
+
+var stages = map[string]bool{
+	"vert": true, "tesc": true, "tese": true, "geom": true, "frag": true, "comp": true,
+	"mesh": true, "task": true,
+	"rgen": true, "rint": true, "rahit": true, "rchit": true, "rmiss": true, "rcall": true,
+}
+
+// Stage infers the shader stage from a file name: shader.frag,
+// shader.frag.glsl and shader.frag.hlsl are all "frag".
+func Stage(path string) (string, error) {
+
+	var name = filepath.Base(path)
+	name = strings.TrimSuffix(name, ".glsl")
+	name = strings.TrimSuffix(name, ".hlsl")
+
+	var ext = strings.TrimPrefix(filepath.Ext(name), ".")
+	if !stages[ext] {
+		return "", fmt.Errorf("%v: cannot infer shader stage from file name", path)
+	}
+
+	return ext, nil
+}
+
+func isHLSL(path string) bool {
+	return strings.EqualFold(".hlsl", filepath.Ext(path))
+}
+
+// DefaultCacheDir returns the per-user cache directory for compiled shaders.
+func DefaultCacheDir() string {
+
+	var dir, err = os.UserCacheDir()
+	if nil != err {
+		return ""
+	}
+
+	return filepath.Join(dir, "vk_tutor", "shaderc")
+}
+
+// FindCompiler returns the compiler named in opts, or the first of glslc and
+// glslangValidator found in PATH.
+func FindCompiler(opts *Options) (string, error) {
+
+	if nil != opts && "" != opts.Compiler {
+		return exec.LookPath(opts.Compiler)
+	}
+
+	for _, name := range []string{"glslc", "glslangValidator"} {
+		if p, err := exec.LookPath(name); nil == err {
+			return p, nil
+		}
+	}
+
+	return "", errors.New("shaderc: neither glslc nor glslangValidator found in PATH")
+}
+
+func isGlslang(compiler string) bool {
+	return strings.Contains(strings.ToLower(filepath.Base(compiler)), "glslang")
+}
+
+// Compile compiles one shader source to SPIR-V. Compiler messages are
+// returned as a *CompileError.
+func Compile(path string, opts *Options) (*Result, error) {
+
+	if nil == opts {
+		opts = &Options{}
+	}
+
+	var stage = opts.Stage
+	if "" == stage {
+		var err error
+		if stage, err = Stage(path); nil != err {
+			return nil, err
+		}
+	}
+
+	var files, err = Includes(path, opts.IncludeDirs)
+	if nil != err {
+		return nil, err
+	}
+
+	compiler, err := FindCompiler(opts)
+	if nil != err {
+		return nil, err
+	}
+
+	var args = compilerArgs(compiler, path, stage, opts)
+
+	hash, err := hashInputs(files, append([]string{filepath.Base(compiler)}, args...))
+	if nil != err {
+		return nil, err
+	}
+
+	var r = &Result{Files: files, Hash: hash}
+
+	var cached string
+	if "" != opts.CacheDir {
+		cached = filepath.Join(opts.CacheDir, hash+".spv")
+		if b, err := os.ReadFile(cached); nil == err {
+			r.SPIRV = b
+			r.Cached = true
+			return r, nil
+		}
+	}
+
+	tmp, err := os.MkdirTemp("", "shaderc")
+	if nil != err {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	var out = filepath.Join(tmp, "out.spv")
+
+	var cmd = exec.Command(compiler, append(args, "-o", out, path)...)
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	if err := cmd.Run(); nil != err {
+		var e = &CompileError{
+			File:        path,
+			Diagnostics: parseDiagnostics(output.String()),
+			Output:      output.String(),
+		}
+		if 0 == len(e.Diagnostics) && 0 == output.Len() {
+			e.Output = err.Error()
+		}
+		return nil, e
+	}
+
+	r.SPIRV, err = os.ReadFile(out)
+	if nil != err {
+		return nil, err
+	}
+
+	if "" != cached {
+		// Failing to cache is not a compile failure
+		_ = fsutil.WriteFileAtomic(cached, r.SPIRV)
+	}
+
+	return r, nil
+}
+
+func compilerArgs(compiler, path, stage string, opts *Options) []string {
+
+	var target = opts.TargetEnv
+	if "" == target {
+		target = "vulkan1.0"
+	}
+
+	var entry = opts.EntryPoint
+	if "" == entry {
+		entry = "main"
+	}
+
+	var a []string
+
+	if isGlslang(compiler) {
+		a = append(a, "-V", "--target-env", target, "-S", stage)
+		if isHLSL(path) {
+			a = append(a, "-D", "-e", entry)
+		}
+	} else {
+		a = append(a, "--target-env="+target, "-fshader-stage="+stage)
+		if isHLSL(path) {
+			a = append(a, "-x", "hlsl", "-fentry-point="+entry)
+		}
+	}
+
+	for _, d := range opts.IncludeDirs {
+		a = append(a, "-I"+d)
+	}
+
+	var defines = append([]string(nil), opts.Defines...)
+	sort.Strings(defines)
+	for _, d := range defines {
+		a = append(a, "-D"+d)
+	}
+
+	return a
+}
+
+// hashInputs hashes the compiler arguments and the name and content of every
+// input file.
+func hashInputs(files []string, args []string) (string, error) {
+
+	var h = sha256.New()
+
+	for _, a := range args {
+		fmt.Fprintf(h, "arg %q\n", a)
+	}
+
+	for _, f := range files {
+
+		var b, err = os.ReadFile(f)
+		if nil != err {
+			return "", err
+		}
+
+		fmt.Fprintf(h, "file %q %v\n", filepath.ToSlash(f), len(b))
+		h.Write(b)
+	} // for
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
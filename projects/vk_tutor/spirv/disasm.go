@@ -0,0 +1,511 @@
+package spirv
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// DisasmOptions control Disassemble.
+type DisasmOptions struct {
+	RawIDs   bool // Print %<number> instead of friendly names
+	Comments bool // Append the GLSL type and decorations of declarations
+}
+
+var generators = map[uint32]string{
+	0:  "Khronos",
+	1:  "LunarG",
+	2:  "Valve",
+	3:  "Codeplay",
+	4:  "NVIDIA",
+	5:  "ARM",
+	6:  "Khronos LLVM/SPIR-V Translator",
+	7:  "Khronos SPIR-V Tools Assembler",
+	8:  "Khronos Glslang Reference Front End",
+	9:  "Qualcomm",
+	10: "AMD",
+	11: "Intel",
+	12: "Imagination",
+	13: "Google Shaderc over Glslang",
+	14: "Google spiregg",
+	15: "Google rspirv",
+	16: "X-LEGEND Mesa-IR/SPIR-V Translator",
+	17: "Khronos SPIR-V Tools Linker",
+}
+
+// GeneratorName describes the generator word of a module header, e.g.
+// "Google Shaderc over Glslang; 10".
+func GeneratorName(generator uint32) string {
+
+	var tool, version = generator >> 16, generator & 0xffff
+
+	if name, ok := generators[tool]; ok {
+		return fmt.Sprintf("%v; %v", name, version)
+	}
+
+	return fmt.Sprintf("Unknown(%v); %v", tool, version)
+}
+
+var glslStd450 = [...]string{
+	1: "Round", "RoundEven", "Trunc", "FAbs", "SAbs", "FSign", "SSign", "Floor", "Ceil", "Fract",
+	"Radians", "Degrees", "Sin", "Cos", "Tan", "Asin", "Acos", "Atan", "Sinh", "Cosh",
+	"Tanh", "Asinh", "Acosh", "Atanh", "Atan2", "Pow", "Exp", "Log", "Exp2", "Log2",
+	"Sqrt", "InverseSqrt", "Determinant", "MatrixInverse", "Modf", "ModfStruct", "FMin", "UMin", "SMin", "FMax",
+	"UMax", "SMax", "FClamp", "UClamp", "SClamp", "FMix", "IMix", "Step", "SmoothStep", "Fma",
+	"Frexp", "FrexpStruct", "Ldexp", "PackSnorm4x8", "PackUnorm4x8", "PackSnorm2x16", "PackUnorm2x16", "PackHalf2x16", "PackDouble2x32", "UnpackSnorm2x16",
+	"UnpackUnorm2x16", "UnpackHalf2x16", "UnpackSnorm4x8", "UnpackUnorm4x8", "UnpackDouble2x32", "Length", "Distance", "Cross", "Normalize", "FaceForward",
+	"Reflect", "Refract", "FindILsb", "FindSMsb", "FindUMsb", "InterpolateAtCentroid", "InterpolateAtSample", "InterpolateAtOffset", "NMin", "NMax",
+	"NClamp",
+}
+
+// Scalar type of a constant, for literals sized by their type.
+type scalarType struct {
+	float  bool
+	signed bool
+	width  int
+}
+
+type disasm struct {
+	opts     DisasmOptions
+	names    map[uint32]string
+	scalars  map[uint32]scalarType
+	extSets  map[uint32]string
+	comments map[uint32]string
+}
+
+// Disassemble writes the module in the SPIR-V assembly syntax of spirv-dis.
+func (o *Module) Disassemble(w io.Writer, opts *DisasmOptions) error {
+
+	var d = &disasm{
+		scalars: make(map[uint32]scalarType),
+		extSets: make(map[uint32]string),
+	}
+	if nil != opts {
+		d.opts = *opts
+	}
+
+	for _, inst := range o.Instructions {
+		var w = inst.Words
+		switch {
+		case OpTypeInt == inst.Opcode && len(w) >= 3:
+			d.scalars[w[0]] = scalarType{false, 0 != w[2], int(w[1])}
+		case OpTypeFloat == inst.Opcode && len(w) >= 2:
+			d.scalars[w[0]] = scalarType{true, true, int(w[1])}
+		case OpExtInstImport == inst.Opcode && len(w) >= 1:
+			d.extSets[w[0]], _ = String(w, 1)
+		}
+	} // for
+
+	if !d.opts.RawIDs {
+		d.names = o.friendlyNames(d.scalars)
+	}
+
+	if d.opts.Comments {
+		d.comments = o.declComments()
+	}
+
+	var bw = bufio.NewWriter(w)
+
+	var major, minor = o.VersionNumbers()
+	fmt.Fprintf(bw, "; SPIR-V\n")
+	fmt.Fprintf(bw, "; Version: %v.%v\n", major, minor)
+	fmt.Fprintf(bw, "; Generator: %v\n", GeneratorName(o.Generator))
+	fmt.Fprintf(bw, "; Bound: %v\n", o.Bound)
+	fmt.Fprintf(bw, "; Schema: %v\n", o.Schema)
+
+	for _, inst := range o.Instructions {
+
+		var result, text, has_result = d.instruction(inst)
+
+		if has_result {
+			fmt.Fprintf(bw, "%14s = %v", d.id(result), text)
+		} else {
+			fmt.Fprintf(bw, "%17s%v", "", text)
+		}
+
+		if c := d.comments[result]; has_result && "" != c {
+			fmt.Fprintf(bw, " ; %v", c)
+		}
+
+		bw.WriteString("\n")
+	} // for
+
+	return bw.Flush()
+}
+
+func (o *disasm) id(v uint32) string {
+	if name, ok := o.names[v]; ok {
+		return "%" + name
+	}
+	return "%" + strconv.FormatUint(uint64(v), 10)
+}
+
+// instruction formats one instruction without its result ID.
+func (o *disasm) instruction(inst Instruction) (result uint32, text string, has_result bool) {
+
+	var p = operandPrinter{d: o, words: inst.Words}
+
+	var spec = opInfos[inst.Opcode].operands
+	var parts = []string{inst.Opcode.String()}
+
+	// Result type and result come first in the binary, but the result is
+	// printed on the left and the type right after the opcode.
+	var fields = strings.Fields(spec)
+	if len(fields) > 0 && "T" == fields[0] && len(p.words) >= 2 {
+		parts = append(parts, o.id(p.words[0]))
+		result, has_result = p.words[1], true
+		p.i = 2
+		fields = fields[2:]
+	} else if len(fields) > 0 && "R" == fields[0] && len(p.words) >= 1 {
+		result, has_result = p.words[0], true
+		p.i = 1
+		fields = fields[1:]
+	}
+
+	if OpExtInst == inst.Opcode && len(p.words) >= 3 {
+		p.set = o.extSets[p.words[2]]
+	}
+	if (OpConstant == inst.Opcode || OpSpecConstant == inst.Opcode) && len(p.words) >= 1 {
+		p.ctx = o.scalars[p.words[0]]
+	}
+
+	p.parse(fields)
+
+	// Anything the grammar did not cover, e.g. unknown opcodes
+	for ; p.i < len(p.words); p.i++ {
+		p.out = append(p.out, strconv.FormatUint(uint64(p.words[p.i]), 10))
+	}
+
+	return result, strings.Join(append(parts, p.out...), " "), has_result
+}
+
+type operandPrinter struct {
+	d     *disasm
+	words []uint32
+	i     int
+	out   []string
+	set   string     // Extended instruction set of OpExtInst
+	ctx   scalarType // Result type of OpConstant
+}
+
+func (o *operandPrinter) parse(fields []string) {
+
+	for _, f := range fields {
+
+		var repeat = strings.HasSuffix(f, "*")
+		f = strings.TrimRight(f, "*?")
+
+		if repeat {
+			for o.i < len(o.words) {
+				o.operand(f)
+			}
+			continue
+		}
+
+		// Missing optional operands, or a truncated instruction
+		if o.i >= len(o.words) {
+			return
+		}
+
+		o.operand(f)
+	} // for
+}
+
+func (o *operandPrinter) next() uint32 {
+	var v = o.words[o.i]
+	o.i++
+	return v
+}
+
+func (o *operandPrinter) operand(kind string) {
+
+	switch kind {
+
+	case "T", "R", "id":
+		o.out = append(o.out, o.d.id(o.next()))
+
+	case "lit":
+		o.out = append(o.out, strconv.FormatUint(uint64(o.next()), 10))
+
+	case "str":
+		var s, n = String(o.words, o.i)
+		o.i = n
+		o.out = append(o.out, quote(s))
+
+	case "ctx":
+		o.out = append(o.out, o.literal())
+
+	case "ext":
+		var n = o.next()
+		if "GLSL.std.450" == o.set && int(n) < len(glslStd450) && "" != glslStd450[n] {
+			o.out = append(o.out, glslStd450[n])
+		} else {
+			o.out = append(o.out, strconv.FormatUint(uint64(n), 10))
+		}
+
+	case "specop":
+		o.out = append(o.out, strings.TrimPrefix(Op(o.next()).String(), "Op"))
+
+	case "idid", "litid", "idlit":
+		var first = "id"
+		if strings.HasPrefix(kind, "lit") {
+			first = "lit"
+		}
+		o.operand(first)
+		if o.i < len(o.words) {
+			o.operand(strings.TrimPrefix(kind, first))
+		}
+
+	default:
+		o.enum(kind)
+	}
+}
+
+func (o *operandPrinter) enum(kind string) {
+
+	var k = enums[kind]
+	var v = o.next()
+
+	o.out = append(o.out, enumName(kind, v))
+
+	if nil == k {
+		return
+	}
+
+	if !k.bits {
+		o.parse(strings.Fields(k.values[v].operands))
+		return
+	}
+
+	for bit := uint32(1); 0 != bit; bit <<= 1 {
+		if 0 != v&bit {
+			o.parse(strings.Fields(k.values[bit].operands))
+		}
+	}
+}
+
+// literal formats the value of OpConstant according to its result type.
+func (o *operandPrinter) literal() string {
+
+	var lo = uint64(o.next())
+	var v = lo
+	if o.ctx.width > 32 && o.i < len(o.words) {
+		v |= uint64(o.next()) << 32
+	}
+
+	switch {
+	case o.ctx.float && 64 == o.ctx.width:
+		return strconv.FormatFloat(math.Float64frombits(v), 'g', -1, 64)
+	case o.ctx.float && 32 == o.ctx.width:
+		return strconv.FormatFloat(float64(math.Float32frombits(uint32(v))), 'g', -1, 32)
+	case o.ctx.float && 16 == o.ctx.width:
+		return fmt.Sprintf("0x%04x", v)
+	case o.ctx.signed && 0 != o.ctx.width && o.ctx.width < 64:
+		var shift = 64 - uint(o.ctx.width)
+		return strconv.FormatInt(int64(v<<shift)>>shift, 10)
+	case o.ctx.signed:
+		return strconv.FormatInt(int64(v), 10)
+	}
+
+	return strconv.FormatUint(v, 10)
+}
+
+func quote(s string) string {
+	var r = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// friendlyNames names result IDs after OpName, or after their declaration
+// for types and scalar constants the way spirv-dis does: %float, %v4float,
+// %_ptr_Uniform_UBO, %int_1. Other IDs stay numeric.
+func (o *Module) friendlyNames(scalars map[uint32]scalarType) map[uint32]string {
+
+	var names = make(map[uint32]string)
+	var used = make(map[string]bool)
+
+	var assign = func(id uint32, name string) {
+		if _, ok := names[id]; ok || "" == name {
+			return
+		}
+		name = sanitize(name)
+		var n = name
+		for i := 0; used[n]; i++ {
+			n = fmt.Sprintf("%v_%v", name, i)
+		}
+		used[n] = true
+		names[id] = n
+	}
+
+	for _, inst := range o.Instructions {
+		if OpName == inst.Opcode && len(inst.Words) >= 2 {
+			var s, _ = String(inst.Words, 1)
+			assign(inst.Words[0], s)
+		}
+	} // for
+
+	var name = func(id uint32) string {
+		if n, ok := names[id]; ok {
+			return n
+		}
+		return strconv.FormatUint(uint64(id), 10)
+	}
+
+	for _, inst := range o.Instructions {
+
+		var w = inst.Words
+		if 0 == len(w) {
+			continue
+		}
+
+		switch inst.Opcode {
+
+		case OpTypeVoid:
+			assign(w[0], "void")
+
+		case OpTypeBool:
+			assign(w[0], "bool")
+
+		case OpTypeInt, OpTypeFloat:
+			assign(w[0], scalarName(scalars[w[0]]))
+
+		case OpTypeVector:
+			if len(w) >= 3 {
+				assign(w[0], fmt.Sprintf("v%v%v", w[2], name(w[1])))
+			}
+
+		case OpTypeMatrix:
+			if len(w) >= 3 {
+				assign(w[0], fmt.Sprintf("mat%v%v", w[2], name(w[1])))
+			}
+
+		case OpTypeArray:
+			if len(w) >= 3 {
+				assign(w[0], fmt.Sprintf("_arr_%v_%v", name(w[1]), name(w[2])))
+			}
+
+		case OpTypeRuntimeArray:
+			if len(w) >= 2 {
+				assign(w[0], "_runtimearr_"+name(w[1]))
+			}
+
+		case OpTypeStruct:
+			assign(w[0], fmt.Sprintf("_struct_%v", w[0]))
+
+		case OpTypePointer:
+			if len(w) >= 3 {
+				assign(w[0], fmt.Sprintf("_ptr_%v_%v", StorageClass(w[1]), name(w[2])))
+			}
+
+		case OpConstantTrue:
+			if len(w) >= 2 {
+				assign(w[1], "true")
+			}
+
+		case OpConstantFalse:
+			if len(w) >= 2 {
+				assign(w[1], "false")
+			}
+
+		case OpConstant:
+			if len(w) >= 3 {
+				var p = operandPrinter{words: w, i: 2, ctx: scalars[w[0]]}
+				var v = p.literal()
+				v = strings.NewReplacer("-", "n", ".", "_", "+", "").Replace(v)
+				assign(w[1], name(w[0])+"_"+v)
+			}
+
+		} // switch
+	} // for
+
+	return names
+}
+
+func scalarName(t scalarType) string {
+
+	if t.float {
+		switch t.width {
+		case 16:
+			return "half"
+		case 32:
+			return "float"
+		case 64:
+			return "double"
+		}
+		return fmt.Sprintf("fp%v", t.width)
+	}
+
+	var s = "uint"
+	if t.signed {
+		s = "int"
+	}
+	if 32 != t.width {
+		s += strconv.Itoa(t.width)
+	}
+
+	return s
+}
+
+// sanitize makes a valid assembly ID name: letters, digits and underscores,
+// not starting with a digit.
+func sanitize(s string) string {
+
+	var b strings.Builder
+	for _, c := range s {
+		if '_' == c || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') {
+			b.WriteRune(c)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+
+	var r = b.String()
+	if "" == r || ('0' <= r[0] && r[0] <= '9') {
+		r = "_" + r
+	}
+
+	return r
+}
+
+// declComments describes decorated declarations, e.g.
+// "UniformBufferObject, DescriptorSet 0, Binding 0" for a uniform block
+// variable.
+func (o *Module) declComments() map[uint32]string {
+
+	var r = make(map[uint32]string)
+	var decorations = make(map[uint32][]string)
+
+	for _, inst := range o.Instructions {
+
+		if OpDecorate != inst.Opcode || len(inst.Words) < 2 {
+			continue
+		}
+
+		var p = operandPrinter{d: &disasm{}, words: inst.Words, i: 1}
+		p.enum("Decoration")
+		decorations[inst.Words[0]] = append(decorations[inst.Words[0]], strings.Join(p.out, " "))
+	} // for
+
+	var refl, err = o.Reflect()
+	if nil != err {
+		refl = &Reflection{}
+	}
+
+	for id, d := range decorations {
+		if t, ok := refl.Types[id]; ok && KindStruct == t.Kind {
+			r[id] = strings.Join(d, ", ")
+		}
+	}
+
+	for _, v := range refl.Variables {
+		var parts []string
+		if nil != v.Type {
+			parts = append(parts, v.Type.String())
+		}
+		r[v.ID] = strings.Join(append(parts, decorations[v.ID]...), ", ")
+	} // for
+
+	return r
+}
@@ -0,0 +1,2 @@
+// SPIR-V binary parsing, reflection and disassembly
+package spirv
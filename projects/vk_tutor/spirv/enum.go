@@ -0,0 +1,1049 @@
+package spirv
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SourceLanguage is the source language operand of OpSource.
+type SourceLanguage uint32
+
+const (
+	SourceLanguageUnknown        SourceLanguage = 0
+	SourceLanguageESSL           SourceLanguage = 1
+	SourceLanguageGLSL           SourceLanguage = 2
+	SourceLanguageOpenCL_C       SourceLanguage = 3
+	SourceLanguageOpenCL_CPP     SourceLanguage = 4
+	SourceLanguageHLSL           SourceLanguage = 5
+	SourceLanguageCPP_for_OpenCL SourceLanguage = 6
+	SourceLanguageSYCL           SourceLanguage = 7
+)
+
+func (o SourceLanguage) String() string {
+	return enumName("SourceLanguage", uint32(o))
+}
+
+// ExecutionModel is the execution model operand of OpEntryPoint.
+type ExecutionModel uint32
+
+const (
+	ExecutionModelVertex                 ExecutionModel = 0
+	ExecutionModelTessellationControl    ExecutionModel = 1
+	ExecutionModelTessellationEvaluation ExecutionModel = 2
+	ExecutionModelGeometry               ExecutionModel = 3
+	ExecutionModelFragment               ExecutionModel = 4
+	ExecutionModelGLCompute              ExecutionModel = 5
+	ExecutionModelKernel                 ExecutionModel = 6
+	ExecutionModelTaskNV                 ExecutionModel = 5267
+	ExecutionModelMeshNV                 ExecutionModel = 5268
+	ExecutionModelRayGenerationKHR       ExecutionModel = 5313
+	ExecutionModelIntersectionKHR        ExecutionModel = 5314
+	ExecutionModelAnyHitKHR              ExecutionModel = 5315
+	ExecutionModelClosestHitKHR          ExecutionModel = 5316
+	ExecutionModelMissKHR                ExecutionModel = 5317
+	ExecutionModelCallableKHR            ExecutionModel = 5318
+	ExecutionModelTaskEXT                ExecutionModel = 5364
+	ExecutionModelMeshEXT                ExecutionModel = 5365
+)
+
+func (o ExecutionModel) String() string {
+	return enumName("ExecutionModel", uint32(o))
+}
+
+// AddressingModel is the addressing model operand of OpMemoryModel.
+type AddressingModel uint32
+
+const (
+	AddressingModelLogical                 AddressingModel = 0
+	AddressingModelPhysical32              AddressingModel = 1
+	AddressingModelPhysical64              AddressingModel = 2
+	AddressingModelPhysicalStorageBuffer64 AddressingModel = 5348
+)
+
+func (o AddressingModel) String() string {
+	return enumName("AddressingModel", uint32(o))
+}
+
+// MemoryModel is the memory model operand of OpMemoryModel.
+type MemoryModel uint32
+
+const (
+	MemoryModelSimple  MemoryModel = 0
+	MemoryModelGLSL450 MemoryModel = 1
+	MemoryModelOpenCL  MemoryModel = 2
+	MemoryModelVulkan  MemoryModel = 3
+)
+
+func (o MemoryModel) String() string {
+	return enumName("MemoryModel", uint32(o))
+}
+
+// ExecutionMode is the mode operand of OpExecutionMode.
+type ExecutionMode uint32
+
+const (
+	ExecutionModeInvocations                      ExecutionMode = 0
+	ExecutionModeSpacingEqual                     ExecutionMode = 1
+	ExecutionModeSpacingFractionalEven            ExecutionMode = 2
+	ExecutionModeSpacingFractionalOdd             ExecutionMode = 3
+	ExecutionModeVertexOrderCw                    ExecutionMode = 4
+	ExecutionModeVertexOrderCcw                   ExecutionMode = 5
+	ExecutionModePixelCenterInteger               ExecutionMode = 6
+	ExecutionModeOriginUpperLeft                  ExecutionMode = 7
+	ExecutionModeOriginLowerLeft                  ExecutionMode = 8
+	ExecutionModeEarlyFragmentTests               ExecutionMode = 9
+	ExecutionModePointMode                        ExecutionMode = 10
+	ExecutionModeXfb                              ExecutionMode = 11
+	ExecutionModeDepthReplacing                   ExecutionMode = 12
+	ExecutionModeDepthGreater                     ExecutionMode = 14
+	ExecutionModeDepthLess                        ExecutionMode = 15
+	ExecutionModeDepthUnchanged                   ExecutionMode = 16
+	ExecutionModeLocalSize                        ExecutionMode = 17
+	ExecutionModeLocalSizeHint                    ExecutionMode = 18
+	ExecutionModeInputPoints                      ExecutionMode = 19
+	ExecutionModeInputLines                       ExecutionMode = 20
+	ExecutionModeInputLinesAdjacency              ExecutionMode = 21
+	ExecutionModeTriangles                        ExecutionMode = 22
+	ExecutionModeInputTrianglesAdjacency          ExecutionMode = 23
+	ExecutionModeQuads                            ExecutionMode = 24
+	ExecutionModeIsolines                         ExecutionMode = 25
+	ExecutionModeOutputVertices                   ExecutionMode = 26
+	ExecutionModeOutputPoints                     ExecutionMode = 27
+	ExecutionModeOutputLineStrip                  ExecutionMode = 28
+	ExecutionModeOutputTriangleStrip              ExecutionMode = 29
+	ExecutionModeVecTypeHint                      ExecutionMode = 30
+	ExecutionModeContractionOff                   ExecutionMode = 31
+	ExecutionModeInitializer                      ExecutionMode = 33
+	ExecutionModeFinalizer                        ExecutionMode = 34
+	ExecutionModeSubgroupSize                     ExecutionMode = 35
+	ExecutionModeSubgroupsPerWorkgroup            ExecutionMode = 36
+	ExecutionModeSubgroupsPerWorkgroupId          ExecutionMode = 37
+	ExecutionModeLocalSizeId                      ExecutionMode = 38
+	ExecutionModeLocalSizeHintId                  ExecutionMode = 39
+	ExecutionModePostDepthCoverage                ExecutionMode = 4446
+	ExecutionModeDenormPreserve                   ExecutionMode = 4459
+	ExecutionModeDenormFlushToZero                ExecutionMode = 4460
+	ExecutionModeSignedZeroInfNanPreserve         ExecutionMode = 4461
+	ExecutionModeRoundingModeRTE                  ExecutionMode = 4462
+	ExecutionModeRoundingModeRTZ                  ExecutionMode = 4463
+	ExecutionModeStencilRefReplacingEXT           ExecutionMode = 5027
+	ExecutionModeOutputLinesEXT                   ExecutionMode = 5269
+	ExecutionModeOutputPrimitivesEXT              ExecutionMode = 5270
+	ExecutionModeDerivativeGroupQuadsNV           ExecutionMode = 5289
+	ExecutionModeDerivativeGroupLinearNV          ExecutionMode = 5290
+	ExecutionModeOutputTrianglesEXT               ExecutionMode = 5298
+	ExecutionModePixelInterlockOrderedEXT         ExecutionMode = 5366
+	ExecutionModePixelInterlockUnorderedEXT       ExecutionMode = 5367
+	ExecutionModeSampleInterlockOrderedEXT        ExecutionMode = 5368
+	ExecutionModeSampleInterlockUnorderedEXT      ExecutionMode = 5369
+	ExecutionModeShadingRateInterlockOrderedEXT   ExecutionMode = 5370
+	ExecutionModeShadingRateInterlockUnorderedEXT ExecutionMode = 5371
+)
+
+func (o ExecutionMode) String() string {
+	return enumName("ExecutionMode", uint32(o))
+}
+
+// StorageClass is the storage class operand of OpTypePointer and OpVariable.
+type StorageClass uint32
+
+const (
+	StorageClassUniformConstant         StorageClass = 0
+	StorageClassInput                   StorageClass = 1
+	StorageClassUniform                 StorageClass = 2
+	StorageClassOutput                  StorageClass = 3
+	StorageClassWorkgroup               StorageClass = 4
+	StorageClassCrossWorkgroup          StorageClass = 5
+	StorageClassPrivate                 StorageClass = 6
+	StorageClassFunction                StorageClass = 7
+	StorageClassGeneric                 StorageClass = 8
+	StorageClassPushConstant            StorageClass = 9
+	StorageClassAtomicCounter           StorageClass = 10
+	StorageClassImage                   StorageClass = 11
+	StorageClassStorageBuffer           StorageClass = 12
+	StorageClassCallableDataKHR         StorageClass = 5328
+	StorageClassIncomingCallableDataKHR StorageClass = 5329
+	StorageClassRayPayloadKHR           StorageClass = 5338
+	StorageClassHitAttributeKHR         StorageClass = 5339
+	StorageClassIncomingRayPayloadKHR   StorageClass = 5342
+	StorageClassShaderRecordBufferKHR   StorageClass = 5343
+	StorageClassPhysicalStorageBuffer   StorageClass = 5349
+	StorageClassTaskPayloadWorkgroupEXT StorageClass = 5402
+)
+
+func (o StorageClass) String() string {
+	return enumName("StorageClass", uint32(o))
+}
+
+// Decoration is the decoration operand of OpDecorate and OpMemberDecorate.
+type Decoration uint32
+
+const (
+	DecorationRelaxedPrecision            Decoration = 0
+	DecorationSpecId                      Decoration = 1
+	DecorationBlock                       Decoration = 2
+	DecorationBufferBlock                 Decoration = 3
+	DecorationRowMajor                    Decoration = 4
+	DecorationColMajor                    Decoration = 5
+	DecorationArrayStride                 Decoration = 6
+	DecorationMatrixStride                Decoration = 7
+	DecorationGLSLShared                  Decoration = 8
+	DecorationGLSLPacked                  Decoration = 9
+	DecorationCPacked                     Decoration = 10
+	DecorationBuiltIn                     Decoration = 11
+	DecorationNoPerspective               Decoration = 13
+	DecorationFlat                        Decoration = 14
+	DecorationPatch                       Decoration = 15
+	DecorationCentroid                    Decoration = 16
+	DecorationSample                      Decoration = 17
+	DecorationInvariant                   Decoration = 18
+	DecorationRestrict                    Decoration = 19
+	DecorationAliased                     Decoration = 20
+	DecorationVolatile                    Decoration = 21
+	DecorationConstant                    Decoration = 22
+	DecorationCoherent                    Decoration = 23
+	DecorationNonWritable                 Decoration = 24
+	DecorationNonReadable                 Decoration = 25
+	DecorationUniform                     Decoration = 26
+	DecorationUniformId                   Decoration = 27
+	DecorationSaturatedConversion         Decoration = 28
+	DecorationStream                      Decoration = 29
+	DecorationLocation                    Decoration = 30
+	DecorationComponent                   Decoration = 31
+	DecorationIndex                       Decoration = 32
+	DecorationBinding                     Decoration = 33
+	DecorationDescriptorSet               Decoration = 34
+	DecorationOffset                      Decoration = 35
+	DecorationXfbBuffer                   Decoration = 36
+	DecorationXfbStride                   Decoration = 37
+	DecorationFuncParamAttr               Decoration = 38
+	DecorationFPRoundingMode              Decoration = 39
+	DecorationFPFastMathMode              Decoration = 40
+	DecorationLinkageAttributes           Decoration = 41
+	DecorationNoContraction               Decoration = 42
+	DecorationInputAttachmentIndex        Decoration = 43
+	DecorationAlignment                   Decoration = 44
+	DecorationMaxByteOffset               Decoration = 45
+	DecorationAlignmentId                 Decoration = 46
+	DecorationMaxByteOffsetId             Decoration = 47
+	DecorationNoSignedWrap                Decoration = 4469
+	DecorationNoUnsignedWrap              Decoration = 4470
+	DecorationExplicitInterpAMD           Decoration = 4999
+	DecorationOverrideCoverageNV          Decoration = 5248
+	DecorationPassthroughNV               Decoration = 5250
+	DecorationViewportRelativeNV          Decoration = 5252
+	DecorationSecondaryViewportRelativeNV Decoration = 5256
+	DecorationPerPrimitiveEXT             Decoration = 5271
+	DecorationPerViewNV                   Decoration = 5272
+	DecorationPerTaskNV                   Decoration = 5273
+	DecorationPerVertexKHR                Decoration = 5285
+	DecorationNonUniform                  Decoration = 5300
+	DecorationRestrictPointer             Decoration = 5355
+	DecorationAliasedPointer              Decoration = 5356
+	DecorationCounterBuffer               Decoration = 5634
+	DecorationUserSemantic                Decoration = 5635
+	DecorationUserTypeGOOGLE              Decoration = 5636
+)
+
+func (o Decoration) String() string {
+	return enumName("Decoration", uint32(o))
+}
+
+// BuiltIn is the operand of the BuiltIn decoration.
+type BuiltIn uint32
+
+const (
+	BuiltInPosition                    BuiltIn = 0
+	BuiltInPointSize                   BuiltIn = 1
+	BuiltInClipDistance                BuiltIn = 3
+	BuiltInCullDistance                BuiltIn = 4
+	BuiltInVertexId                    BuiltIn = 5
+	BuiltInInstanceId                  BuiltIn = 6
+	BuiltInPrimitiveId                 BuiltIn = 7
+	BuiltInInvocationId                BuiltIn = 8
+	BuiltInLayer                       BuiltIn = 9
+	BuiltInViewportIndex               BuiltIn = 10
+	BuiltInTessLevelOuter              BuiltIn = 11
+	BuiltInTessLevelInner              BuiltIn = 12
+	BuiltInTessCoord                   BuiltIn = 13
+	BuiltInPatchVertices               BuiltIn = 14
+	BuiltInFragCoord                   BuiltIn = 15
+	BuiltInPointCoord                  BuiltIn = 16
+	BuiltInFrontFacing                 BuiltIn = 17
+	BuiltInSampleId                    BuiltIn = 18
+	BuiltInSamplePosition              BuiltIn = 19
+	BuiltInSampleMask                  BuiltIn = 20
+	BuiltInFragDepth                   BuiltIn = 22
+	BuiltInHelperInvocation            BuiltIn = 23
+	BuiltInNumWorkgroups               BuiltIn = 24
+	BuiltInWorkgroupSize               BuiltIn = 25
+	BuiltInWorkgroupId                 BuiltIn = 26
+	BuiltInLocalInvocationId           BuiltIn = 27
+	BuiltInGlobalInvocationId          BuiltIn = 28
+	BuiltInLocalInvocationIndex        BuiltIn = 29
+	BuiltInWorkDim                     BuiltIn = 30
+	BuiltInGlobalSize                  BuiltIn = 31
+	BuiltInEnqueuedWorkgroupSize       BuiltIn = 32
+	BuiltInGlobalOffset                BuiltIn = 33
+	BuiltInGlobalLinearId              BuiltIn = 34
+	BuiltInSubgroupSize                BuiltIn = 36
+	BuiltInSubgroupMaxSize             BuiltIn = 37
+	BuiltInNumSubgroups                BuiltIn = 38
+	BuiltInNumEnqueuedSubgroups        BuiltIn = 39
+	BuiltInSubgroupId                  BuiltIn = 40
+	BuiltInSubgroupLocalInvocationId   BuiltIn = 41
+	BuiltInVertexIndex                 BuiltIn = 42
+	BuiltInInstanceIndex               BuiltIn = 43
+	BuiltInSubgroupEqMask              BuiltIn = 4416
+	BuiltInSubgroupGeMask              BuiltIn = 4417
+	BuiltInSubgroupGtMask              BuiltIn = 4418
+	BuiltInSubgroupLeMask              BuiltIn = 4419
+	BuiltInSubgroupLtMask              BuiltIn = 4420
+	BuiltInBaseVertex                  BuiltIn = 4424
+	BuiltInBaseInstance                BuiltIn = 4425
+	BuiltInDrawIndex                   BuiltIn = 4426
+	BuiltInPrimitiveShadingRateKHR     BuiltIn = 4432
+	BuiltInDeviceIndex                 BuiltIn = 4438
+	BuiltInViewIndex                   BuiltIn = 4440
+	BuiltInShadingRateKHR              BuiltIn = 4444
+	BuiltInBaryCoordKHR                BuiltIn = 5286
+	BuiltInBaryCoordNoPerspKHR         BuiltIn = 5287
+	BuiltInPrimitivePointIndicesEXT    BuiltIn = 5294
+	BuiltInPrimitiveLineIndicesEXT     BuiltIn = 5295
+	BuiltInPrimitiveTriangleIndicesEXT BuiltIn = 5296
+	BuiltInCullPrimitiveEXT            BuiltIn = 5299
+	BuiltInLaunchIdKHR                 BuiltIn = 5319
+	BuiltInLaunchSizeKHR               BuiltIn = 5320
+	BuiltInWorldRayOriginKHR           BuiltIn = 5321
+	BuiltInWorldRayDirectionKHR        BuiltIn = 5322
+	BuiltInObjectRayOriginKHR          BuiltIn = 5323
+	BuiltInObjectRayDirectionKHR       BuiltIn = 5324
+	BuiltInRayTminKHR                  BuiltIn = 5325
+	BuiltInRayTmaxKHR                  BuiltIn = 5326
+	BuiltInInstanceCustomIndexKHR      BuiltIn = 5327
+	BuiltInObjectToWorldKHR            BuiltIn = 5330
+	BuiltInWorldToObjectKHR            BuiltIn = 5331
+	BuiltInHitKindKHR                  BuiltIn = 5333
+	BuiltInIncomingRayFlagsKHR         BuiltIn = 5351
+	BuiltInRayGeometryIndexKHR         BuiltIn = 5352
+)
+
+func (o BuiltIn) String() string {
+	return enumName("BuiltIn", uint32(o))
+}
+
+// Capability is the operand of OpCapability.
+type Capability uint32
+
+const (
+	CapabilityMatrix                                    Capability = 0
+	CapabilityShader                                    Capability = 1
+	CapabilityGeometry                                  Capability = 2
+	CapabilityTessellation                              Capability = 3
+	CapabilityAddresses                                 Capability = 4
+	CapabilityLinkage                                   Capability = 5
+	CapabilityKernel                                    Capability = 6
+	CapabilityVector16                                  Capability = 7
+	CapabilityFloat16Buffer                             Capability = 8
+	CapabilityFloat16                                   Capability = 9
+	CapabilityFloat64                                   Capability = 10
+	CapabilityInt64                                     Capability = 11
+	CapabilityInt64Atomics                              Capability = 12
+	CapabilityImageBasic                                Capability = 13
+	CapabilityImageReadWrite                            Capability = 14
+	CapabilityImageMipmap                               Capability = 15
+	CapabilityPipes                                     Capability = 17
+	CapabilityGroups                                    Capability = 18
+	CapabilityDeviceEnqueue                             Capability = 19
+	CapabilityLiteralSampler                            Capability = 20
+	CapabilityAtomicStorage                             Capability = 21
+	CapabilityInt16                                     Capability = 22
+	CapabilityTessellationPointSize                     Capability = 23
+	CapabilityGeometryPointSize                         Capability = 24
+	CapabilityImageGatherExtended                       Capability = 25
+	CapabilityStorageImageMultisample                   Capability = 27
+	CapabilityUniformBufferArrayDynamicIndexing         Capability = 28
+	CapabilitySampledImageArrayDynamicIndexing          Capability = 29
+	CapabilityStorageBufferArrayDynamicIndexing         Capability = 30
+	CapabilityStorageImageArrayDynamicIndexing          Capability = 31
+	CapabilityClipDistance                              Capability = 32
+	CapabilityCullDistance                              Capability = 33
+	CapabilityImageCubeArray                            Capability = 34
+	CapabilitySampleRateShading                         Capability = 35
+	CapabilityImageRect                                 Capability = 36
+	CapabilitySampledRect                               Capability = 37
+	CapabilityGenericPointer                            Capability = 38
+	CapabilityInt8                                      Capability = 39
+	CapabilityInputAttachment                           Capability = 40
+	CapabilitySparseResidency                           Capability = 41
+	CapabilityMinLod                                    Capability = 42
+	CapabilitySampled1D                                 Capability = 43
+	CapabilityImage1D                                   Capability = 44
+	CapabilitySampledCubeArray                          Capability = 45
+	CapabilitySampledBuffer                             Capability = 46
+	CapabilityImageBuffer                               Capability = 47
+	CapabilityImageMSArray                              Capability = 48
+	CapabilityStorageImageExtendedFormats               Capability = 49
+	CapabilityImageQuery                                Capability = 50
+	CapabilityDerivativeControl                         Capability = 51
+	CapabilityInterpolationFunction                     Capability = 52
+	CapabilityTransformFeedback                         Capability = 53
+	CapabilityGeometryStreams                           Capability = 54
+	CapabilityStorageImageReadWithoutFormat             Capability = 55
+	CapabilityStorageImageWriteWithoutFormat            Capability = 56
+	CapabilityMultiViewport                             Capability = 57
+	CapabilitySubgroupDispatch                          Capability = 58
+	CapabilityNamedBarrier                              Capability = 59
+	CapabilityPipeStorage                               Capability = 60
+	CapabilityGroupNonUniform                           Capability = 61
+	CapabilityGroupNonUniformVote                       Capability = 62
+	CapabilityGroupNonUniformArithmetic                 Capability = 63
+	CapabilityGroupNonUniformBallot                     Capability = 64
+	CapabilityGroupNonUniformShuffle                    Capability = 65
+	CapabilityGroupNonUniformShuffleRelative            Capability = 66
+	CapabilityGroupNonUniformClustered                  Capability = 67
+	CapabilityGroupNonUniformQuad                       Capability = 68
+	CapabilityShaderLayer                               Capability = 69
+	CapabilityShaderViewportIndex                       Capability = 70
+	CapabilityUniformDecoration                         Capability = 71
+	CapabilityFragmentShadingRateKHR                    Capability = 4422
+	CapabilitySubgroupBallotKHR                         Capability = 4423
+	CapabilityDrawParameters                            Capability = 4427
+	CapabilitySubgroupVoteKHR                           Capability = 4431
+	CapabilityStorageBuffer16BitAccess                  Capability = 4433
+	CapabilityUniformAndStorageBuffer16BitAccess        Capability = 4434
+	CapabilityStoragePushConstant16                     Capability = 4435
+	CapabilityStorageInputOutput16                      Capability = 4436
+	CapabilityDeviceGroup                               Capability = 4437
+	CapabilityMultiView                                 Capability = 4439
+	CapabilityVariablePointersStorageBuffer             Capability = 4441
+	CapabilityVariablePointers                          Capability = 4442
+	CapabilityAtomicStorageOps                          Capability = 4445
+	CapabilitySampleMaskPostDepthCoverage               Capability = 4447
+	CapabilityStorageBuffer8BitAccess                   Capability = 4448
+	CapabilityUniformAndStorageBuffer8BitAccess         Capability = 4449
+	CapabilityStoragePushConstant8                      Capability = 4450
+	CapabilityDenormPreserve                            Capability = 4464
+	CapabilityDenormFlushToZero                         Capability = 4465
+	CapabilitySignedZeroInfNanPreserve                  Capability = 4466
+	CapabilityRoundingModeRTE                           Capability = 4467
+	CapabilityRoundingModeRTZ                           Capability = 4468
+	CapabilityRayQueryKHR                               Capability = 4471
+	CapabilityRayTracingKHR                             Capability = 4478
+	CapabilityShaderClockKHR                            Capability = 5055
+	CapabilitySampleMaskOverrideCoverageNV              Capability = 5249
+	CapabilityGeometryShaderPassthroughNV               Capability = 5251
+	CapabilityShaderViewportIndexLayerEXT               Capability = 5254
+	CapabilityShaderViewportMaskNV                      Capability = 5255
+	CapabilityShaderStereoViewNV                        Capability = 5259
+	CapabilityPerViewAttributesNV                       Capability = 5260
+	CapabilityFragmentFullyCoveredEXT                   Capability = 5265
+	CapabilityMeshShadingNV                             Capability = 5266
+	CapabilityImageFootprintNV                          Capability = 5282
+	CapabilityMeshShadingEXT                            Capability = 5283
+	CapabilityFragmentBarycentricKHR                    Capability = 5284
+	CapabilityComputeDerivativeGroupQuadsNV             Capability = 5288
+	CapabilityFragmentDensityEXT                        Capability = 5291
+	CapabilityGroupNonUniformPartitionedNV              Capability = 5297
+	CapabilityShaderNonUniform                          Capability = 5301
+	CapabilityRuntimeDescriptorArray                    Capability = 5302
+	CapabilityInputAttachmentArrayDynamicIndexing       Capability = 5303
+	CapabilityUniformTexelBufferArrayDynamicIndexing    Capability = 5304
+	CapabilityStorageTexelBufferArrayDynamicIndexing    Capability = 5305
+	CapabilityUniformBufferArrayNonUniformIndexing      Capability = 5306
+	CapabilitySampledImageArrayNonUniformIndexing       Capability = 5307
+	CapabilityStorageBufferArrayNonUniformIndexing      Capability = 5308
+	CapabilityStorageImageArrayNonUniformIndexing       Capability = 5309
+	CapabilityInputAttachmentArrayNonUniformIndexing    Capability = 5310
+	CapabilityUniformTexelBufferArrayNonUniformIndexing Capability = 5311
+	CapabilityStorageTexelBufferArrayNonUniformIndexing Capability = 5312
+	CapabilityRayTracingNV                              Capability = 5340
+	CapabilityVulkanMemoryModel                         Capability = 5345
+	CapabilityVulkanMemoryModelDeviceScope              Capability = 5346
+	CapabilityPhysicalStorageBufferAddresses            Capability = 5347
+	CapabilityComputeDerivativeGroupLinearNV            Capability = 5350
+	CapabilityCooperativeMatrixNV                       Capability = 5357
+	CapabilityFragmentShaderSampleInterlockEXT          Capability = 5363
+	CapabilityFragmentShaderShadingRateInterlockEXT     Capability = 5372
+	CapabilityShaderSMBuiltinsNV                        Capability = 5373
+	CapabilityFragmentShaderPixelInterlockEXT           Capability = 5378
+	CapabilityDemoteToHelperInvocation                  Capability = 5379
+)
+
+func (o Capability) String() string {
+	return enumName("Capability", uint32(o))
+}
+
+// Enumerant of an operand kind and the extra operands that follow it, in
+// the opInfo grammar.
+type enumValue struct {
+	name     string
+	operands string
+}
+
+// Operand kind. Bit set kinds combine several enumerants, each followed by
+// its own operands in bit order.
+type enumKind struct {
+	bits   bool
+	values map[uint32]enumValue
+}
+
+var enums = map[string]*enumKind{
+	"SourceLanguage": {false, map[uint32]enumValue{
+		0: {"Unknown", ""},
+		1: {"ESSL", ""},
+		2: {"GLSL", ""},
+		3: {"OpenCL_C", ""},
+		4: {"OpenCL_CPP", ""},
+		5: {"HLSL", ""},
+		6: {"CPP_for_OpenCL", ""},
+		7: {"SYCL", ""},
+	}},
+	"ExecutionModel": {false, map[uint32]enumValue{
+		0:    {"Vertex", ""},
+		1:    {"TessellationControl", ""},
+		2:    {"TessellationEvaluation", ""},
+		3:    {"Geometry", ""},
+		4:    {"Fragment", ""},
+		5:    {"GLCompute", ""},
+		6:    {"Kernel", ""},
+		5267: {"TaskNV", ""},
+		5268: {"MeshNV", ""},
+		5313: {"RayGenerationKHR", ""},
+		5314: {"IntersectionKHR", ""},
+		5315: {"AnyHitKHR", ""},
+		5316: {"ClosestHitKHR", ""},
+		5317: {"MissKHR", ""},
+		5318: {"CallableKHR", ""},
+		5364: {"TaskEXT", ""},
+		5365: {"MeshEXT", ""},
+	}},
+	"AddressingModel": {false, map[uint32]enumValue{
+		0:    {"Logical", ""},
+		1:    {"Physical32", ""},
+		2:    {"Physical64", ""},
+		5348: {"PhysicalStorageBuffer64", ""},
+	}},
+	"MemoryModel": {false, map[uint32]enumValue{
+		0: {"Simple", ""},
+		1: {"GLSL450", ""},
+		2: {"OpenCL", ""},
+		3: {"Vulkan", ""},
+	}},
+	"ExecutionMode": {false, map[uint32]enumValue{
+		0:    {"Invocations", "lit"},
+		1:    {"SpacingEqual", ""},
+		2:    {"SpacingFractionalEven", ""},
+		3:    {"SpacingFractionalOdd", ""},
+		4:    {"VertexOrderCw", ""},
+		5:    {"VertexOrderCcw", ""},
+		6:    {"PixelCenterInteger", ""},
+		7:    {"OriginUpperLeft", ""},
+		8:    {"OriginLowerLeft", ""},
+		9:    {"EarlyFragmentTests", ""},
+		10:   {"PointMode", ""},
+		11:   {"Xfb", ""},
+		12:   {"DepthReplacing", ""},
+		14:   {"DepthGreater", ""},
+		15:   {"DepthLess", ""},
+		16:   {"DepthUnchanged", ""},
+		17:   {"LocalSize", "lit lit lit"},
+		18:   {"LocalSizeHint", "lit lit lit"},
+		19:   {"InputPoints", ""},
+		20:   {"InputLines", ""},
+		21:   {"InputLinesAdjacency", ""},
+		22:   {"Triangles", ""},
+		23:   {"InputTrianglesAdjacency", ""},
+		24:   {"Quads", ""},
+		25:   {"Isolines", ""},
+		26:   {"OutputVertices", "lit"},
+		27:   {"OutputPoints", ""},
+		28:   {"OutputLineStrip", ""},
+		29:   {"OutputTriangleStrip", ""},
+		30:   {"VecTypeHint", "lit"},
+		31:   {"ContractionOff", ""},
+		33:   {"Initializer", ""},
+		34:   {"Finalizer", ""},
+		35:   {"SubgroupSize", "lit"},
+		36:   {"SubgroupsPerWorkgroup", "lit"},
+		37:   {"SubgroupsPerWorkgroupId", "id"},
+		38:   {"LocalSizeId", "id id id"},
+		39:   {"LocalSizeHintId", "id id id"},
+		4446: {"PostDepthCoverage", ""},
+		4459: {"DenormPreserve", "lit"},
+		4460: {"DenormFlushToZero", "lit"},
+		4461: {"SignedZeroInfNanPreserve", "lit"},
+		4462: {"RoundingModeRTE", "lit"},
+		4463: {"RoundingModeRTZ", "lit"},
+		5027: {"StencilRefReplacingEXT", ""},
+		5269: {"OutputLinesEXT", ""},
+		5270: {"OutputPrimitivesEXT", "lit"},
+		5289: {"DerivativeGroupQuadsNV", ""},
+		5290: {"DerivativeGroupLinearNV", ""},
+		5298: {"OutputTrianglesEXT", ""},
+		5366: {"PixelInterlockOrderedEXT", ""},
+		5367: {"PixelInterlockUnorderedEXT", ""},
+		5368: {"SampleInterlockOrderedEXT", ""},
+		5369: {"SampleInterlockUnorderedEXT", ""},
+		5370: {"ShadingRateInterlockOrderedEXT", ""},
+		5371: {"ShadingRateInterlockUnorderedEXT", ""},
+	}},
+	"StorageClass": {false, map[uint32]enumValue{
+		0:    {"UniformConstant", ""},
+		1:    {"Input", ""},
+		2:    {"Uniform", ""},
+		3:    {"Output", ""},
+		4:    {"Workgroup", ""},
+		5:    {"CrossWorkgroup", ""},
+		6:    {"Private", ""},
+		7:    {"Function", ""},
+		8:    {"Generic", ""},
+		9:    {"PushConstant", ""},
+		10:   {"AtomicCounter", ""},
+		11:   {"Image", ""},
+		12:   {"StorageBuffer", ""},
+		5328: {"CallableDataKHR", ""},
+		5329: {"IncomingCallableDataKHR", ""},
+		5338: {"RayPayloadKHR", ""},
+		5339: {"HitAttributeKHR", ""},
+		5342: {"IncomingRayPayloadKHR", ""},
+		5343: {"ShaderRecordBufferKHR", ""},
+		5349: {"PhysicalStorageBuffer", ""},
+		5402: {"TaskPayloadWorkgroupEXT", ""},
+	}},
+	"Dim": {false, map[uint32]enumValue{
+		0: {"1D", ""},
+		1: {"2D", ""},
+		2: {"3D", ""},
+		3: {"Cube", ""},
+		4: {"Rect", ""},
+		5: {"Buffer", ""},
+		6: {"SubpassData", ""},
+	}},
+	"ImageFormat": {false, map[uint32]enumValue{
+		0:  {"Unknown", ""},
+		1:  {"Rgba32f", ""},
+		2:  {"Rgba16f", ""},
+		3:  {"R32f", ""},
+		4:  {"Rgba8", ""},
+		5:  {"Rgba8Snorm", ""},
+		6:  {"Rg32f", ""},
+		7:  {"Rg16f", ""},
+		8:  {"R11fG11fB10f", ""},
+		9:  {"R16f", ""},
+		10: {"Rgba16", ""},
+		11: {"Rgb10A2", ""},
+		12: {"Rg16", ""},
+		13: {"Rg8", ""},
+		14: {"R16", ""},
+		15: {"R8", ""},
+		16: {"Rgba16Snorm", ""},
+		17: {"Rg16Snorm", ""},
+		18: {"Rg8Snorm", ""},
+		19: {"R16Snorm", ""},
+		20: {"R8Snorm", ""},
+		21: {"Rgba32i", ""},
+		22: {"Rgba16i", ""},
+		23: {"Rgba8i", ""},
+		24: {"R32i", ""},
+		25: {"Rg32i", ""},
+		26: {"Rg16i", ""},
+		27: {"Rg8i", ""},
+		28: {"R16i", ""},
+		29: {"R8i", ""},
+		30: {"Rgba32ui", ""},
+		31: {"Rgba16ui", ""},
+		32: {"Rgba8ui", ""},
+		33: {"R32ui", ""},
+		34: {"Rgb10a2ui", ""},
+		35: {"Rg32ui", ""},
+		36: {"Rg16ui", ""},
+		37: {"Rg8ui", ""},
+		38: {"R16ui", ""},
+		39: {"R8ui", ""},
+		40: {"R64ui", ""},
+		41: {"R64i", ""},
+	}},
+	"AccessQualifier": {false, map[uint32]enumValue{
+		0: {"ReadOnly", ""},
+		1: {"WriteOnly", ""},
+		2: {"ReadWrite", ""},
+	}},
+	"SamplerAddressingMode": {false, map[uint32]enumValue{
+		0: {"None", ""},
+		1: {"ClampToEdge", ""},
+		2: {"Clamp", ""},
+		3: {"Repeat", ""},
+		4: {"RepeatMirrored", ""},
+	}},
+	"SamplerFilterMode": {false, map[uint32]enumValue{
+		0: {"Nearest", ""},
+		1: {"Linear", ""},
+	}},
+	"GroupOperation": {false, map[uint32]enumValue{
+		0: {"Reduce", ""},
+		1: {"InclusiveScan", ""},
+		2: {"ExclusiveScan", ""},
+		3: {"ClusteredReduce", ""},
+		6: {"PartitionedReduceNV", ""},
+		7: {"PartitionedInclusiveScanNV", ""},
+		8: {"PartitionedExclusiveScanNV", ""},
+	}},
+	"FunctionControl": {true, map[uint32]enumValue{
+		1: {"Inline", ""},
+		2: {"DontInline", ""},
+		4: {"Pure", ""},
+		8: {"Const", ""},
+	}},
+	"SelectionControl": {true, map[uint32]enumValue{
+		1: {"Flatten", ""},
+		2: {"DontFlatten", ""},
+	}},
+	"LoopControl": {true, map[uint32]enumValue{
+		1:   {"Unroll", ""},
+		2:   {"DontUnroll", ""},
+		4:   {"DependencyInfinite", ""},
+		8:   {"DependencyLength", "lit"},
+		16:  {"MinIterations", "lit"},
+		32:  {"MaxIterations", "lit"},
+		64:  {"IterationMultiple", "lit"},
+		128: {"PeelCount", "lit"},
+		256: {"PartialCount", "lit"},
+	}},
+	"MemoryAccess": {true, map[uint32]enumValue{
+		1:  {"Volatile", ""},
+		2:  {"Aligned", "lit"},
+		4:  {"Nontemporal", ""},
+		8:  {"MakePointerAvailable", "id"},
+		16: {"MakePointerVisible", "id"},
+		32: {"NonPrivatePointer", ""},
+	}},
+	"ImageOperands": {true, map[uint32]enumValue{
+		1:     {"Bias", "id"},
+		2:     {"Lod", "id"},
+		4:     {"Grad", "id id"},
+		8:     {"ConstOffset", "id"},
+		16:    {"Offset", "id"},
+		32:    {"ConstOffsets", "id"},
+		64:    {"Sample", "id"},
+		128:   {"MinLod", "id"},
+		256:   {"MakeTexelAvailable", "id"},
+		512:   {"MakeTexelVisible", "id"},
+		1024:  {"NonPrivateTexel", ""},
+		2048:  {"VolatileTexel", ""},
+		4096:  {"SignExtend", ""},
+		8192:  {"ZeroExtend", ""},
+		16384: {"Nontemporal", ""},
+		65536: {"Offsets", "id"},
+	}},
+	"Decoration": {false, map[uint32]enumValue{
+		0:    {"RelaxedPrecision", ""},
+		1:    {"SpecId", "lit"},
+		2:    {"Block", ""},
+		3:    {"BufferBlock", ""},
+		4:    {"RowMajor", ""},
+		5:    {"ColMajor", ""},
+		6:    {"ArrayStride", "lit"},
+		7:    {"MatrixStride", "lit"},
+		8:    {"GLSLShared", ""},
+		9:    {"GLSLPacked", ""},
+		10:   {"CPacked", ""},
+		11:   {"BuiltIn", "BuiltIn"},
+		13:   {"NoPerspective", ""},
+		14:   {"Flat", ""},
+		15:   {"Patch", ""},
+		16:   {"Centroid", ""},
+		17:   {"Sample", ""},
+		18:   {"Invariant", ""},
+		19:   {"Restrict", ""},
+		20:   {"Aliased", ""},
+		21:   {"Volatile", ""},
+		22:   {"Constant", ""},
+		23:   {"Coherent", ""},
+		24:   {"NonWritable", ""},
+		25:   {"NonReadable", ""},
+		26:   {"Uniform", ""},
+		27:   {"UniformId", "id"},
+		28:   {"SaturatedConversion", ""},
+		29:   {"Stream", "lit"},
+		30:   {"Location", "lit"},
+		31:   {"Component", "lit"},
+		32:   {"Index", "lit"},
+		33:   {"Binding", "lit"},
+		34:   {"DescriptorSet", "lit"},
+		35:   {"Offset", "lit"},
+		36:   {"XfbBuffer", "lit"},
+		37:   {"XfbStride", "lit"},
+		38:   {"FuncParamAttr", "lit"},
+		39:   {"FPRoundingMode", "lit"},
+		40:   {"FPFastMathMode", "lit"},
+		41:   {"LinkageAttributes", "str lit"},
+		42:   {"NoContraction", ""},
+		43:   {"InputAttachmentIndex", "lit"},
+		44:   {"Alignment", "lit"},
+		45:   {"MaxByteOffset", "lit"},
+		46:   {"AlignmentId", "id"},
+		47:   {"MaxByteOffsetId", "id"},
+		4469: {"NoSignedWrap", ""},
+		4470: {"NoUnsignedWrap", ""},
+		4999: {"ExplicitInterpAMD", ""},
+		5248: {"OverrideCoverageNV", ""},
+		5250: {"PassthroughNV", ""},
+		5252: {"ViewportRelativeNV", ""},
+		5256: {"SecondaryViewportRelativeNV", "lit"},
+		5271: {"PerPrimitiveEXT", ""},
+		5272: {"PerViewNV", ""},
+		5273: {"PerTaskNV", ""},
+		5285: {"PerVertexKHR", ""},
+		5300: {"NonUniform", ""},
+		5355: {"RestrictPointer", ""},
+		5356: {"AliasedPointer", ""},
+		5634: {"CounterBuffer", "id"},
+		5635: {"UserSemantic", "str"},
+		5636: {"UserTypeGOOGLE", "str"},
+	}},
+	"BuiltIn": {false, map[uint32]enumValue{
+		0:    {"Position", ""},
+		1:    {"PointSize", ""},
+		3:    {"ClipDistance", ""},
+		4:    {"CullDistance", ""},
+		5:    {"VertexId", ""},
+		6:    {"InstanceId", ""},
+		7:    {"PrimitiveId", ""},
+		8:    {"InvocationId", ""},
+		9:    {"Layer", ""},
+		10:   {"ViewportIndex", ""},
+		11:   {"TessLevelOuter", ""},
+		12:   {"TessLevelInner", ""},
+		13:   {"TessCoord", ""},
+		14:   {"PatchVertices", ""},
+		15:   {"FragCoord", ""},
+		16:   {"PointCoord", ""},
+		17:   {"FrontFacing", ""},
+		18:   {"SampleId", ""},
+		19:   {"SamplePosition", ""},
+		20:   {"SampleMask", ""},
+		22:   {"FragDepth", ""},
+		23:   {"HelperInvocation", ""},
+		24:   {"NumWorkgroups", ""},
+		25:   {"WorkgroupSize", ""},
+		26:   {"WorkgroupId", ""},
+		27:   {"LocalInvocationId", ""},
+		28:   {"GlobalInvocationId", ""},
+		29:   {"LocalInvocationIndex", ""},
+		30:   {"WorkDim", ""},
+		31:   {"GlobalSize", ""},
+		32:   {"EnqueuedWorkgroupSize", ""},
+		33:   {"GlobalOffset", ""},
+		34:   {"GlobalLinearId", ""},
+		36:   {"SubgroupSize", ""},
+		37:   {"SubgroupMaxSize", ""},
+		38:   {"NumSubgroups", ""},
+		39:   {"NumEnqueuedSubgroups", ""},
+		40:   {"SubgroupId", ""},
+		41:   {"SubgroupLocalInvocationId", ""},
+		42:   {"VertexIndex", ""},
+		43:   {"InstanceIndex", ""},
+		4416: {"SubgroupEqMask", ""},
+		4417: {"SubgroupGeMask", ""},
+		4418: {"SubgroupGtMask", ""},
+		4419: {"SubgroupLeMask", ""},
+		4420: {"SubgroupLtMask", ""},
+		4424: {"BaseVertex", ""},
+		4425: {"BaseInstance", ""},
+		4426: {"DrawIndex", ""},
+		4432: {"PrimitiveShadingRateKHR", ""},
+		4438: {"DeviceIndex", ""},
+		4440: {"ViewIndex", ""},
+		4444: {"ShadingRateKHR", ""},
+		5286: {"BaryCoordKHR", ""},
+		5287: {"BaryCoordNoPerspKHR", ""},
+		5294: {"PrimitivePointIndicesEXT", ""},
+		5295: {"PrimitiveLineIndicesEXT", ""},
+		5296: {"PrimitiveTriangleIndicesEXT", ""},
+		5299: {"CullPrimitiveEXT", ""},
+		5319: {"LaunchIdKHR", ""},
+		5320: {"LaunchSizeKHR", ""},
+		5321: {"WorldRayOriginKHR", ""},
+		5322: {"WorldRayDirectionKHR", ""},
+		5323: {"ObjectRayOriginKHR", ""},
+		5324: {"ObjectRayDirectionKHR", ""},
+		5325: {"RayTminKHR", ""},
+		5326: {"RayTmaxKHR", ""},
+		5327: {"InstanceCustomIndexKHR", ""},
+		5330: {"ObjectToWorldKHR", ""},
+		5331: {"WorldToObjectKHR", ""},
+		5333: {"HitKindKHR", ""},
+		5351: {"IncomingRayFlagsKHR", ""},
+		5352: {"RayGeometryIndexKHR", ""},
+	}},
+	"Capability": {false, map[uint32]enumValue{
+		0:    {"Matrix", ""},
+		1:    {"Shader", ""},
+		2:    {"Geometry", ""},
+		3:    {"Tessellation", ""},
+		4:    {"Addresses", ""},
+		5:    {"Linkage", ""},
+		6:    {"Kernel", ""},
+		7:    {"Vector16", ""},
+		8:    {"Float16Buffer", ""},
+		9:    {"Float16", ""},
+		10:   {"Float64", ""},
+		11:   {"Int64", ""},
+		12:   {"Int64Atomics", ""},
+		13:   {"ImageBasic", ""},
+		14:   {"ImageReadWrite", ""},
+		15:   {"ImageMipmap", ""},
+		17:   {"Pipes", ""},
+		18:   {"Groups", ""},
+		19:   {"DeviceEnqueue", ""},
+		20:   {"LiteralSampler", ""},
+		21:   {"AtomicStorage", ""},
+		22:   {"Int16", ""},
+		23:   {"TessellationPointSize", ""},
+		24:   {"GeometryPointSize", ""},
+		25:   {"ImageGatherExtended", ""},
+		27:   {"StorageImageMultisample", ""},
+		28:   {"UniformBufferArrayDynamicIndexing", ""},
+		29:   {"SampledImageArrayDynamicIndexing", ""},
+		30:   {"StorageBufferArrayDynamicIndexing", ""},
+		31:   {"StorageImageArrayDynamicIndexing", ""},
+		32:   {"ClipDistance", ""},
+		33:   {"CullDistance", ""},
+		34:   {"ImageCubeArray", ""},
+		35:   {"SampleRateShading", ""},
+		36:   {"ImageRect", ""},
+		37:   {"SampledRect", ""},
+		38:   {"GenericPointer", ""},
+		39:   {"Int8", ""},
+		40:   {"InputAttachment", ""},
+		41:   {"SparseResidency", ""},
+		42:   {"MinLod", ""},
+		43:   {"Sampled1D", ""},
+		44:   {"Image1D", ""},
+		45:   {"SampledCubeArray", ""},
+		46:   {"SampledBuffer", ""},
+		47:   {"ImageBuffer", ""},
+		48:   {"ImageMSArray", ""},
+		49:   {"StorageImageExtendedFormats", ""},
+		50:   {"ImageQuery", ""},
+		51:   {"DerivativeControl", ""},
+		52:   {"InterpolationFunction", ""},
+		53:   {"TransformFeedback", ""},
+		54:   {"GeometryStreams", ""},
+		55:   {"StorageImageReadWithoutFormat", ""},
+		56:   {"StorageImageWriteWithoutFormat", ""},
+		57:   {"MultiViewport", ""},
+		58:   {"SubgroupDispatch", ""},
+		59:   {"NamedBarrier", ""},
+		60:   {"PipeStorage", ""},
+		61:   {"GroupNonUniform", ""},
+		62:   {"GroupNonUniformVote", ""},
+		63:   {"GroupNonUniformArithmetic", ""},
+		64:   {"GroupNonUniformBallot", ""},
+		65:   {"GroupNonUniformShuffle", ""},
+		66:   {"GroupNonUniformShuffleRelative", ""},
+		67:   {"GroupNonUniformClustered", ""},
+		68:   {"GroupNonUniformQuad", ""},
+		69:   {"ShaderLayer", ""},
+		70:   {"ShaderViewportIndex", ""},
+		71:   {"UniformDecoration", ""},
+		4422: {"FragmentShadingRateKHR", ""},
+		4423: {"SubgroupBallotKHR", ""},
+		4427: {"DrawParameters", ""},
+		4431: {"SubgroupVoteKHR", ""},
+		4433: {"StorageBuffer16BitAccess", ""},
+		4434: {"UniformAndStorageBuffer16BitAccess", ""},
+		4435: {"StoragePushConstant16", ""},
+		4436: {"StorageInputOutput16", ""},
+		4437: {"DeviceGroup", ""},
+		4439: {"MultiView", ""},
+		4441: {"VariablePointersStorageBuffer", ""},
+		4442: {"VariablePointers", ""},
+		4445: {"AtomicStorageOps", ""},
+		4447: {"SampleMaskPostDepthCoverage", ""},
+		4448: {"StorageBuffer8BitAccess", ""},
+		4449: {"UniformAndStorageBuffer8BitAccess", ""},
+		4450: {"StoragePushConstant8", ""},
+		4464: {"DenormPreserve", ""},
+		4465: {"DenormFlushToZero", ""},
+		4466: {"SignedZeroInfNanPreserve", ""},
+		4467: {"RoundingModeRTE", ""},
+		4468: {"RoundingModeRTZ", ""},
+		4471: {"RayQueryKHR", ""},
+		4478: {"RayTracingKHR", ""},
+		5055: {"ShaderClockKHR", ""},
+		5249: {"SampleMaskOverrideCoverageNV", ""},
+		5251: {"GeometryShaderPassthroughNV", ""},
+		5254: {"ShaderViewportIndexLayerEXT", ""},
+		5255: {"ShaderViewportMaskNV", ""},
+		5259: {"ShaderStereoViewNV", ""},
+		5260: {"PerViewAttributesNV", ""},
+		5265: {"FragmentFullyCoveredEXT", ""},
+		5266: {"MeshShadingNV", ""},
+		5282: {"ImageFootprintNV", ""},
+		5283: {"MeshShadingEXT", ""},
+		5284: {"FragmentBarycentricKHR", ""},
+		5288: {"ComputeDerivativeGroupQuadsNV", ""},
+		5291: {"FragmentDensityEXT", ""},
+		5297: {"GroupNonUniformPartitionedNV", ""},
+		5301: {"ShaderNonUniform", ""},
+		5302: {"RuntimeDescriptorArray", ""},
+		5303: {"InputAttachmentArrayDynamicIndexing", ""},
+		5304: {"UniformTexelBufferArrayDynamicIndexing", ""},
+		5305: {"StorageTexelBufferArrayDynamicIndexing", ""},
+		5306: {"UniformBufferArrayNonUniformIndexing", ""},
+		5307: {"SampledImageArrayNonUniformIndexing", ""},
+		5308: {"StorageBufferArrayNonUniformIndexing", ""},
+		5309: {"StorageImageArrayNonUniformIndexing", ""},
+		5310: {"InputAttachmentArrayNonUniformIndexing", ""},
+		5311: {"UniformTexelBufferArrayNonUniformIndexing", ""},
+		5312: {"StorageTexelBufferArrayNonUniformIndexing", ""},
+		5340: {"RayTracingNV", ""},
+		5345: {"VulkanMemoryModel", ""},
+		5346: {"VulkanMemoryModelDeviceScope", ""},
+		5347: {"PhysicalStorageBufferAddresses", ""},
+		5350: {"ComputeDerivativeGroupLinearNV", ""},
+		5357: {"CooperativeMatrixNV", ""},
+		5363: {"FragmentShaderSampleInterlockEXT", ""},
+		5372: {"FragmentShaderShadingRateInterlockEXT", ""},
+		5373: {"ShaderSMBuiltinsNV", ""},
+		5378: {"FragmentShaderPixelInterlockEXT", ""},
+		5379: {"DemoteToHelperInvocation", ""},
+	}},
+}
+
+// enumName names a value of operand kind, or prints the number when it is
+// unknown. Bit sets are joined with "|".
+func enumName(kind string, v uint32) string {
+
+	var k = enums[kind]
+	if nil == k {
+		return fmt.Sprint(v)
+	}
+
+	if !k.bits {
+		if e, ok := k.values[v]; ok {
+			return e.name
+		}
+		return fmt.Sprint(v)
+	}
+
+	if 0 == v {
+		return "None"
+	}
+
+	var names []string
+	for bit := uint32(1); 0 != bit; bit <<= 1 {
+		if 0 == v&bit {
+			continue
+		}
+		if e, ok := k.values[bit]; ok {
+			names = append(names, e.name)
+		} else {
+			names = append(names, fmt.Sprintf("0x%x", bit))
+		}
+	} // for
+
+	return strings.Join(names, "|")
+}
@@ -0,0 +1,680 @@
+package spirv
+
+import "fmt"
+
+// Op is a SPIR-V opcode.
+type Op uint16
+
+const (
+	OpNop                                  Op = 0
+	OpUndef                                Op = 1
+	OpSourceContinued                      Op = 2
+	OpSource                               Op = 3
+	OpSourceExtension                      Op = 4
+	OpName                                 Op = 5
+	OpMemberName                           Op = 6
+	OpString                               Op = 7
+	OpLine                                 Op = 8
+	OpExtension                            Op = 10
+	OpExtInstImport                        Op = 11
+	OpExtInst                              Op = 12
+	OpMemoryModel                          Op = 14
+	OpEntryPoint                           Op = 15
+	OpExecutionMode                        Op = 16
+	OpCapability                           Op = 17
+	OpTypeVoid                             Op = 19
+	OpTypeBool                             Op = 20
+	OpTypeInt                              Op = 21
+	OpTypeFloat                            Op = 22
+	OpTypeVector                           Op = 23
+	OpTypeMatrix                           Op = 24
+	OpTypeImage                            Op = 25
+	OpTypeSampler                          Op = 26
+	OpTypeSampledImage                     Op = 27
+	OpTypeArray                            Op = 28
+	OpTypeRuntimeArray                     Op = 29
+	OpTypeStruct                           Op = 30
+	OpTypeOpaque                           Op = 31
+	OpTypePointer                          Op = 32
+	OpTypeFunction                         Op = 33
+	OpTypeEvent                            Op = 34
+	OpTypeDeviceEvent                      Op = 35
+	OpTypeReserveId                        Op = 36
+	OpTypeQueue                            Op = 37
+	OpTypePipe                             Op = 38
+	OpTypeForwardPointer                   Op = 39
+	OpConstantTrue                         Op = 41
+	OpConstantFalse                        Op = 42
+	OpConstant                             Op = 43
+	OpConstantComposite                    Op = 44
+	OpConstantSampler                      Op = 45
+	OpConstantNull                         Op = 46
+	OpSpecConstantTrue                     Op = 48
+	OpSpecConstantFalse                    Op = 49
+	OpSpecConstant                         Op = 50
+	OpSpecConstantComposite                Op = 51
+	OpSpecConstantOp                       Op = 52
+	OpFunction                             Op = 54
+	OpFunctionParameter                    Op = 55
+	OpFunctionEnd                          Op = 56
+	OpFunctionCall                         Op = 57
+	OpVariable                             Op = 59
+	OpImageTexelPointer                    Op = 60
+	OpLoad                                 Op = 61
+	OpStore                                Op = 62
+	OpCopyMemory                           Op = 63
+	OpCopyMemorySized                      Op = 64
+	OpAccessChain                          Op = 65
+	OpInBoundsAccessChain                  Op = 66
+	OpPtrAccessChain                       Op = 67
+	OpArrayLength                          Op = 68
+	OpGenericPtrMemSemantics               Op = 69
+	OpInBoundsPtrAccessChain               Op = 70
+	OpDecorate                             Op = 71
+	OpMemberDecorate                       Op = 72
+	OpDecorationGroup                      Op = 73
+	OpGroupDecorate                        Op = 74
+	OpGroupMemberDecorate                  Op = 75
+	OpVectorExtractDynamic                 Op = 77
+	OpVectorInsertDynamic                  Op = 78
+	OpVectorShuffle                        Op = 79
+	OpCompositeConstruct                   Op = 80
+	OpCompositeExtract                     Op = 81
+	OpCompositeInsert                      Op = 82
+	OpCopyObject                           Op = 83
+	OpTranspose                            Op = 84
+	OpSampledImage                         Op = 86
+	OpImageSampleImplicitLod               Op = 87
+	OpImageSampleExplicitLod               Op = 88
+	OpImageSampleDrefImplicitLod           Op = 89
+	OpImageSampleDrefExplicitLod           Op = 90
+	OpImageSampleProjImplicitLod           Op = 91
+	OpImageSampleProjExplicitLod           Op = 92
+	OpImageSampleProjDrefImplicitLod       Op = 93
+	OpImageSampleProjDrefExplicitLod       Op = 94
+	OpImageFetch                           Op = 95
+	OpImageGather                          Op = 96
+	OpImageDrefGather                      Op = 97
+	OpImageRead                            Op = 98
+	OpImageWrite                           Op = 99
+	OpImage                                Op = 100
+	OpImageQueryFormat                     Op = 101
+	OpImageQueryOrder                      Op = 102
+	OpImageQuerySizeLod                    Op = 103
+	OpImageQuerySize                       Op = 104
+	OpImageQueryLod                        Op = 105
+	OpImageQueryLevels                     Op = 106
+	OpImageQuerySamples                    Op = 107
+	OpConvertFToU                          Op = 109
+	OpConvertFToS                          Op = 110
+	OpConvertSToF                          Op = 111
+	OpConvertUToF                          Op = 112
+	OpUConvert                             Op = 113
+	OpSConvert                             Op = 114
+	OpFConvert                             Op = 115
+	OpQuantizeToF16                        Op = 116
+	OpConvertPtrToU                        Op = 117
+	OpSatConvertSToU                       Op = 118
+	OpSatConvertUToS                       Op = 119
+	OpConvertUToPtr                        Op = 120
+	OpPtrCastToGeneric                     Op = 121
+	OpGenericCastToPtr                     Op = 122
+	OpGenericCastToPtrExplicit             Op = 123
+	OpBitcast                              Op = 124
+	OpSNegate                              Op = 126
+	OpFNegate                              Op = 127
+	OpIAdd                                 Op = 128
+	OpFAdd                                 Op = 129
+	OpISub                                 Op = 130
+	OpFSub                                 Op = 131
+	OpIMul                                 Op = 132
+	OpFMul                                 Op = 133
+	OpUDiv                                 Op = 134
+	OpSDiv                                 Op = 135
+	OpFDiv                                 Op = 136
+	OpUMod                                 Op = 137
+	OpSRem                                 Op = 138
+	OpSMod                                 Op = 139
+	OpFRem                                 Op = 140
+	OpFMod                                 Op = 141
+	OpVectorTimesScalar                    Op = 142
+	OpMatrixTimesScalar                    Op = 143
+	OpVectorTimesMatrix                    Op = 144
+	OpMatrixTimesVector                    Op = 145
+	OpMatrixTimesMatrix                    Op = 146
+	OpOuterProduct                         Op = 147
+	OpDot                                  Op = 148
+	OpIAddCarry                            Op = 149
+	OpISubBorrow                           Op = 150
+	OpUMulExtended                         Op = 151
+	OpSMulExtended                         Op = 152
+	OpAny                                  Op = 154
+	OpAll                                  Op = 155
+	OpIsNan                                Op = 156
+	OpIsInf                                Op = 157
+	OpIsFinite                             Op = 158
+	OpIsNormal                             Op = 159
+	OpSignBitSet                           Op = 160
+	OpLessOrGreater                        Op = 161
+	OpOrdered                              Op = 162
+	OpUnordered                            Op = 163
+	OpLogicalEqual                         Op = 164
+	OpLogicalNotEqual                      Op = 165
+	OpLogicalOr                            Op = 166
+	OpLogicalAnd                           Op = 167
+	OpLogicalNot                           Op = 168
+	OpSelect                               Op = 169
+	OpIEqual                               Op = 170
+	OpINotEqual                            Op = 171
+	OpUGreaterThan                         Op = 172
+	OpSGreaterThan                         Op = 173
+	OpUGreaterThanEqual                    Op = 174
+	OpSGreaterThanEqual                    Op = 175
+	OpULessThan                            Op = 176
+	OpSLessThan                            Op = 177
+	OpULessThanEqual                       Op = 178
+	OpSLessThanEqual                       Op = 179
+	OpFOrdEqual                            Op = 180
+	OpFUnordEqual                          Op = 181
+	OpFOrdNotEqual                         Op = 182
+	OpFUnordNotEqual                       Op = 183
+	OpFOrdLessThan                         Op = 184
+	OpFUnordLessThan                       Op = 185
+	OpFOrdGreaterThan                      Op = 186
+	OpFUnordGreaterThan                    Op = 187
+	OpFOrdLessThanEqual                    Op = 188
+	OpFUnordLessThanEqual                  Op = 189
+	OpFOrdGreaterThanEqual                 Op = 190
+	OpFUnordGreaterThanEqual               Op = 191
+	OpShiftRightLogical                    Op = 194
+	OpShiftRightArithmetic                 Op = 195
+	OpShiftLeftLogical                     Op = 196
+	OpBitwiseOr                            Op = 197
+	OpBitwiseXor                           Op = 198
+	OpBitwiseAnd                           Op = 199
+	OpNot                                  Op = 200
+	OpBitFieldInsert                       Op = 201
+	OpBitFieldSExtract                     Op = 202
+	OpBitFieldUExtract                     Op = 203
+	OpBitReverse                           Op = 204
+	OpBitCount                             Op = 205
+	OpDPdx                                 Op = 207
+	OpDPdy                                 Op = 208
+	OpFwidth                               Op = 209
+	OpDPdxFine                             Op = 210
+	OpDPdyFine                             Op = 211
+	OpFwidthFine                           Op = 212
+	OpDPdxCoarse                           Op = 213
+	OpDPdyCoarse                           Op = 214
+	OpFwidthCoarse                         Op = 215
+	OpEmitVertex                           Op = 218
+	OpEndPrimitive                         Op = 219
+	OpEmitStreamVertex                     Op = 220
+	OpEndStreamPrimitive                   Op = 221
+	OpControlBarrier                       Op = 224
+	OpMemoryBarrier                        Op = 225
+	OpAtomicLoad                           Op = 227
+	OpAtomicStore                          Op = 228
+	OpAtomicExchange                       Op = 229
+	OpAtomicCompareExchange                Op = 230
+	OpAtomicCompareExchangeWeak            Op = 231
+	OpAtomicIIncrement                     Op = 232
+	OpAtomicIDecrement                     Op = 233
+	OpAtomicIAdd                           Op = 234
+	OpAtomicISub                           Op = 235
+	OpAtomicSMin                           Op = 236
+	OpAtomicUMin                           Op = 237
+	OpAtomicSMax                           Op = 238
+	OpAtomicUMax                           Op = 239
+	OpAtomicAnd                            Op = 240
+	OpAtomicOr                             Op = 241
+	OpAtomicXor                            Op = 242
+	OpPhi                                  Op = 245
+	OpLoopMerge                            Op = 246
+	OpSelectionMerge                       Op = 247
+	OpLabel                                Op = 248
+	OpBranch                               Op = 249
+	OpBranchConditional                    Op = 250
+	OpSwitch                               Op = 251
+	OpKill                                 Op = 252
+	OpReturn                               Op = 253
+	OpReturnValue                          Op = 254
+	OpUnreachable                          Op = 255
+	OpLifetimeStart                        Op = 256
+	OpLifetimeStop                         Op = 257
+	OpGroupAll                             Op = 261
+	OpGroupAny                             Op = 262
+	OpGroupBroadcast                       Op = 263
+	OpGroupIAdd                            Op = 264
+	OpGroupFAdd                            Op = 265
+	OpGroupFMin                            Op = 266
+	OpGroupUMin                            Op = 267
+	OpGroupSMin                            Op = 268
+	OpGroupFMax                            Op = 269
+	OpGroupUMax                            Op = 270
+	OpGroupSMax                            Op = 271
+	OpImageSparseSampleImplicitLod         Op = 305
+	OpImageSparseSampleExplicitLod         Op = 306
+	OpImageSparseSampleDrefImplicitLod     Op = 307
+	OpImageSparseSampleDrefExplicitLod     Op = 308
+	OpImageSparseSampleProjImplicitLod     Op = 309
+	OpImageSparseSampleProjExplicitLod     Op = 310
+	OpImageSparseSampleProjDrefImplicitLod Op = 311
+	OpImageSparseSampleProjDrefExplicitLod Op = 312
+	OpImageSparseFetch                     Op = 313
+	OpImageSparseGather                    Op = 314
+	OpImageSparseDrefGather                Op = 315
+	OpImageSparseTexelsResident            Op = 316
+	OpNoLine                               Op = 317
+	OpImageSparseRead                      Op = 320
+	OpSizeOf                               Op = 321
+	OpModuleProcessed                      Op = 330
+	OpExecutionModeId                      Op = 331
+	OpDecorateId                           Op = 332
+	OpGroupNonUniformElect                 Op = 333
+	OpGroupNonUniformAll                   Op = 334
+	OpGroupNonUniformAny                   Op = 335
+	OpGroupNonUniformAllEqual              Op = 336
+	OpGroupNonUniformBroadcast             Op = 337
+	OpGroupNonUniformBroadcastFirst        Op = 338
+	OpGroupNonUniformBallot                Op = 339
+	OpGroupNonUniformInverseBallot         Op = 340
+	OpGroupNonUniformBallotBitExtract      Op = 341
+	OpGroupNonUniformBallotBitCount        Op = 342
+	OpGroupNonUniformBallotFindLSB         Op = 343
+	OpGroupNonUniformBallotFindMSB         Op = 344
+	OpGroupNonUniformShuffle               Op = 345
+	OpGroupNonUniformShuffleXor            Op = 346
+	OpGroupNonUniformShuffleUp             Op = 347
+	OpGroupNonUniformShuffleDown           Op = 348
+	OpGroupNonUniformIAdd                  Op = 349
+	OpGroupNonUniformFAdd                  Op = 350
+	OpGroupNonUniformIMul                  Op = 351
+	OpGroupNonUniformFMul                  Op = 352
+	OpGroupNonUniformSMin                  Op = 353
+	OpGroupNonUniformUMin                  Op = 354
+	OpGroupNonUniformFMin                  Op = 355
+	OpGroupNonUniformSMax                  Op = 356
+	OpGroupNonUniformUMax                  Op = 357
+	OpGroupNonUniformFMax                  Op = 358
+	OpGroupNonUniformBitwiseAnd            Op = 359
+	OpGroupNonUniformBitwiseOr             Op = 360
+	OpGroupNonUniformBitwiseXor            Op = 361
+	OpGroupNonUniformLogicalAnd            Op = 362
+	OpGroupNonUniformLogicalOr             Op = 363
+	OpGroupNonUniformLogicalXor            Op = 364
+	OpGroupNonUniformQuadBroadcast         Op = 365
+	OpGroupNonUniformQuadSwap              Op = 366
+	OpCopyLogical                          Op = 400
+	OpPtrEqual                             Op = 401
+	OpPtrNotEqual                          Op = 402
+	OpPtrDiff                              Op = 403
+	OpTerminateInvocation                  Op = 4416
+	OpSubgroupBallotKHR                    Op = 4421
+	OpSubgroupFirstInvocationKHR           Op = 4422
+	OpTraceRayKHR                          Op = 4445
+	OpExecuteCallableKHR                   Op = 4446
+	OpConvertUToAccelerationStructureKHR   Op = 4447
+	OpIgnoreIntersectionKHR                Op = 4448
+	OpTerminateRayKHR                      Op = 4449
+	OpTypeRayQueryKHR                      Op = 4472
+	OpReadClockKHR                         Op = 5056
+	OpEmitMeshTasksEXT                     Op = 5294
+	OpSetMeshOutputsEXT                    Op = 5295
+	OpReportIntersectionKHR                Op = 5334
+	OpTypeAccelerationStructureKHR         Op = 5341
+	OpDemoteToHelperInvocation             Op = 5380
+	OpIsHelperInvocationEXT                Op = 5381
+	OpDecorateString                       Op = 5632
+	OpMemberDecorateString                 Op = 5633
+)
+
+// Operand grammar of an opcode. Operands are separated by spaces:
+//
+//	T      result type ID
+//	R      result ID
+//	id     ID
+//	lit    32-bit literal
+//	str    literal string
+//	ctx    literal sized by the result type (OpConstant)
+//	ext    extended instruction number (OpExtInst)
+//	specop opcode operand (OpSpecConstantOp)
+//	Name   enumerant of the named operand kind, see enums
+//
+// A trailing "?" marks an optional operand and "*" a repeated one. The
+// pairs idid, litid and idlit repeat two operands together.
+type opInfo struct {
+	name     string
+	operands string
+}
+
+var opInfos = map[Op]opInfo{
+	OpNop:                                  {"OpNop", ""},
+	OpUndef:                                {"OpUndef", "T R"},
+	OpSourceContinued:                      {"OpSourceContinued", "str"},
+	OpSource:                               {"OpSource", "SourceLanguage lit id? str?"},
+	OpSourceExtension:                      {"OpSourceExtension", "str"},
+	OpName:                                 {"OpName", "id str"},
+	OpMemberName:                           {"OpMemberName", "id lit str"},
+	OpString:                               {"OpString", "R str"},
+	OpLine:                                 {"OpLine", "id lit lit"},
+	OpExtension:                            {"OpExtension", "str"},
+	OpExtInstImport:                        {"OpExtInstImport", "R str"},
+	OpExtInst:                              {"OpExtInst", "T R id ext id*"},
+	OpMemoryModel:                          {"OpMemoryModel", "AddressingModel MemoryModel"},
+	OpEntryPoint:                           {"OpEntryPoint", "ExecutionModel id str id*"},
+	OpExecutionMode:                        {"OpExecutionMode", "id ExecutionMode"},
+	OpCapability:                           {"OpCapability", "Capability"},
+	OpTypeVoid:                             {"OpTypeVoid", "R"},
+	OpTypeBool:                             {"OpTypeBool", "R"},
+	OpTypeInt:                              {"OpTypeInt", "R lit lit"},
+	OpTypeFloat:                            {"OpTypeFloat", "R lit lit?"},
+	OpTypeVector:                           {"OpTypeVector", "R id lit"},
+	OpTypeMatrix:                           {"OpTypeMatrix", "R id lit"},
+	OpTypeImage:                            {"OpTypeImage", "R id Dim lit lit lit lit ImageFormat AccessQualifier?"},
+	OpTypeSampler:                          {"OpTypeSampler", "R"},
+	OpTypeSampledImage:                     {"OpTypeSampledImage", "R id"},
+	OpTypeArray:                            {"OpTypeArray", "R id id"},
+	OpTypeRuntimeArray:                     {"OpTypeRuntimeArray", "R id"},
+	OpTypeStruct:                           {"OpTypeStruct", "R id*"},
+	OpTypeOpaque:                           {"OpTypeOpaque", "R str"},
+	OpTypePointer:                          {"OpTypePointer", "R StorageClass id"},
+	OpTypeFunction:                         {"OpTypeFunction", "R id id*"},
+	OpTypeEvent:                            {"OpTypeEvent", "R"},
+	OpTypeDeviceEvent:                      {"OpTypeDeviceEvent", "R"},
+	OpTypeReserveId:                        {"OpTypeReserveId", "R"},
+	OpTypeQueue:                            {"OpTypeQueue", "R"},
+	OpTypePipe:                             {"OpTypePipe", "R AccessQualifier"},
+	OpTypeForwardPointer:                   {"OpTypeForwardPointer", "id StorageClass"},
+	OpConstantTrue:                         {"OpConstantTrue", "T R"},
+	OpConstantFalse:                        {"OpConstantFalse", "T R"},
+	OpConstant:                             {"OpConstant", "T R ctx"},
+	OpConstantComposite:                    {"OpConstantComposite", "T R id*"},
+	OpConstantSampler:                      {"OpConstantSampler", "T R SamplerAddressingMode lit SamplerFilterMode"},
+	OpConstantNull:                         {"OpConstantNull", "T R"},
+	OpSpecConstantTrue:                     {"OpSpecConstantTrue", "T R"},
+	OpSpecConstantFalse:                    {"OpSpecConstantFalse", "T R"},
+	OpSpecConstant:                         {"OpSpecConstant", "T R ctx"},
+	OpSpecConstantComposite:                {"OpSpecConstantComposite", "T R id*"},
+	OpSpecConstantOp:                       {"OpSpecConstantOp", "T R specop id*"},
+	OpFunction:                             {"OpFunction", "T R FunctionControl id"},
+	OpFunctionParameter:                    {"OpFunctionParameter", "T R"},
+	OpFunctionEnd:                          {"OpFunctionEnd", ""},
+	OpFunctionCall:                         {"OpFunctionCall", "T R id id*"},
+	OpVariable:                             {"OpVariable", "T R StorageClass id?"},
+	OpImageTexelPointer:                    {"OpImageTexelPointer", "T R id id id"},
+	OpLoad:                                 {"OpLoad", "T R id MemoryAccess?"},
+	OpStore:                                {"OpStore", "id id MemoryAccess?"},
+	OpCopyMemory:                           {"OpCopyMemory", "id id MemoryAccess? MemoryAccess?"},
+	OpCopyMemorySized:                      {"OpCopyMemorySized", "id id id MemoryAccess?"},
+	OpAccessChain:                          {"OpAccessChain", "T R id id*"},
+	OpInBoundsAccessChain:                  {"OpInBoundsAccessChain", "T R id id*"},
+	OpPtrAccessChain:                       {"OpPtrAccessChain", "T R id id id*"},
+	OpArrayLength:                          {"OpArrayLength", "T R id lit"},
+	OpGenericPtrMemSemantics:               {"OpGenericPtrMemSemantics", "T R id"},
+	OpInBoundsPtrAccessChain:               {"OpInBoundsPtrAccessChain", "T R id id id*"},
+	OpDecorate:                             {"OpDecorate", "id Decoration"},
+	OpMemberDecorate:                       {"OpMemberDecorate", "id lit Decoration"},
+	OpDecorationGroup:                      {"OpDecorationGroup", "R"},
+	OpGroupDecorate:                        {"OpGroupDecorate", "id id*"},
+	OpGroupMemberDecorate:                  {"OpGroupMemberDecorate", "id idlit*"},
+	OpVectorExtractDynamic:                 {"OpVectorExtractDynamic", "T R id id"},
+	OpVectorInsertDynamic:                  {"OpVectorInsertDynamic", "T R id id id"},
+	OpVectorShuffle:                        {"OpVectorShuffle", "T R id id lit*"},
+	OpCompositeConstruct:                   {"OpCompositeConstruct", "T R id*"},
+	OpCompositeExtract:                     {"OpCompositeExtract", "T R id lit*"},
+	OpCompositeInsert:                      {"OpCompositeInsert", "T R id id lit*"},
+	OpCopyObject:                           {"OpCopyObject", "T R id"},
+	OpTranspose:                            {"OpTranspose", "T R id"},
+	OpSampledImage:                         {"OpSampledImage", "T R id id"},
+	OpImageSampleImplicitLod:               {"OpImageSampleImplicitLod", "T R id id ImageOperands?"},
+	OpImageSampleExplicitLod:               {"OpImageSampleExplicitLod", "T R id id ImageOperands"},
+	OpImageSampleDrefImplicitLod:           {"OpImageSampleDrefImplicitLod", "T R id id id ImageOperands?"},
+	OpImageSampleDrefExplicitLod:           {"OpImageSampleDrefExplicitLod", "T R id id id ImageOperands"},
+	OpImageSampleProjImplicitLod:           {"OpImageSampleProjImplicitLod", "T R id id ImageOperands?"},
+	OpImageSampleProjExplicitLod:           {"OpImageSampleProjExplicitLod", "T R id id ImageOperands"},
+	OpImageSampleProjDrefImplicitLod:       {"OpImageSampleProjDrefImplicitLod", "T R id id id ImageOperands?"},
+	OpImageSampleProjDrefExplicitLod:       {"OpImageSampleProjDrefExplicitLod", "T R id id id ImageOperands"},
+	OpImageFetch:                           {"OpImageFetch", "T R id id ImageOperands?"},
+	OpImageGather:                          {"OpImageGather", "T R id id id ImageOperands?"},
+	OpImageDrefGather:                      {"OpImageDrefGather", "T R id id id ImageOperands?"},
+	OpImageRead:                            {"OpImageRead", "T R id id ImageOperands?"},
+	OpImageWrite:                           {"OpImageWrite", "id id id ImageOperands?"},
+	OpImage:                                {"OpImage", "T R id"},
+	OpImageQueryFormat:                     {"OpImageQueryFormat", "T R id"},
+	OpImageQueryOrder:                      {"OpImageQueryOrder", "T R id"},
+	OpImageQuerySizeLod:                    {"OpImageQuerySizeLod", "T R id id"},
+	OpImageQuerySize:                       {"OpImageQuerySize", "T R id"},
+	OpImageQueryLod:                        {"OpImageQueryLod", "T R id id"},
+	OpImageQueryLevels:                     {"OpImageQueryLevels", "T R id"},
+	OpImageQuerySamples:                    {"OpImageQuerySamples", "T R id"},
+	OpConvertFToU:                          {"OpConvertFToU", "T R id"},
+	OpConvertFToS:                          {"OpConvertFToS", "T R id"},
+	OpConvertSToF:                          {"OpConvertSToF", "T R id"},
+	OpConvertUToF:                          {"OpConvertUToF", "T R id"},
+	OpUConvert:                             {"OpUConvert", "T R id"},
+	OpSConvert:                             {"OpSConvert", "T R id"},
+	OpFConvert:                             {"OpFConvert", "T R id"},
+	OpQuantizeToF16:                        {"OpQuantizeToF16", "T R id"},
+	OpConvertPtrToU:                        {"OpConvertPtrToU", "T R id"},
+	OpSatConvertSToU:                       {"OpSatConvertSToU", "T R id"},
+	OpSatConvertUToS:                       {"OpSatConvertUToS", "T R id"},
+	OpConvertUToPtr:                        {"OpConvertUToPtr", "T R id"},
+	OpPtrCastToGeneric:                     {"OpPtrCastToGeneric", "T R id"},
+	OpGenericCastToPtr:                     {"OpGenericCastToPtr", "T R id"},
+	OpGenericCastToPtrExplicit:             {"OpGenericCastToPtrExplicit", "T R id StorageClass"},
+	OpBitcast:                              {"OpBitcast", "T R id"},
+	OpSNegate:                              {"OpSNegate", "T R id"},
+	OpFNegate:                              {"OpFNegate", "T R id"},
+	OpIAdd:                                 {"OpIAdd", "T R id id"},
+	OpFAdd:                                 {"OpFAdd", "T R id id"},
+	OpISub:                                 {"OpISub", "T R id id"},
+	OpFSub:                                 {"OpFSub", "T R id id"},
+	OpIMul:                                 {"OpIMul", "T R id id"},
+	OpFMul:                                 {"OpFMul", "T R id id"},
+	OpUDiv:                                 {"OpUDiv", "T R id id"},
+	OpSDiv:                                 {"OpSDiv", "T R id id"},
+	OpFDiv:                                 {"OpFDiv", "T R id id"},
+	OpUMod:                                 {"OpUMod", "T R id id"},
+	OpSRem:                                 {"OpSRem", "T R id id"},
+	OpSMod:                                 {"OpSMod", "T R id id"},
+	OpFRem:                                 {"OpFRem", "T R id id"},
+	OpFMod:                                 {"OpFMod", "T R id id"},
+	OpVectorTimesScalar:                    {"OpVectorTimesScalar", "T R id id"},
+	OpMatrixTimesScalar:                    {"OpMatrixTimesScalar", "T R id id"},
+	OpVectorTimesMatrix:                    {"OpVectorTimesMatrix", "T R id id"},
+	OpMatrixTimesVector:                    {"OpMatrixTimesVector", "T R id id"},
+	OpMatrixTimesMatrix:                    {"OpMatrixTimesMatrix", "T R id id"},
+	OpOuterProduct:                         {"OpOuterProduct", "T R id id"},
+	OpDot:                                  {"OpDot", "T R id id"},
+	OpIAddCarry:                            {"OpIAddCarry", "T R id id"},
+	OpISubBorrow:                           {"OpISubBorrow", "T R id id"},
+	OpUMulExtended:                         {"OpUMulExtended", "T R id id"},
+	OpSMulExtended:                         {"OpSMulExtended", "T R id id"},
+	OpAny:                                  {"OpAny", "T R id"},
+	OpAll:                                  {"OpAll", "T R id"},
+	OpIsNan:                                {"OpIsNan", "T R id"},
+	OpIsInf:                                {"OpIsInf", "T R id"},
+	OpIsFinite:                             {"OpIsFinite", "T R id"},
+	OpIsNormal:                             {"OpIsNormal", "T R id"},
+	OpSignBitSet:                           {"OpSignBitSet", "T R id"},
+	OpLessOrGreater:                        {"OpLessOrGreater", "T R id id"},
+	OpOrdered:                              {"OpOrdered", "T R id id"},
+	OpUnordered:                            {"OpUnordered", "T R id id"},
+	OpLogicalEqual:                         {"OpLogicalEqual", "T R id id"},
+	OpLogicalNotEqual:                      {"OpLogicalNotEqual", "T R id id"},
+	OpLogicalOr:                            {"OpLogicalOr", "T R id id"},
+	OpLogicalAnd:                           {"OpLogicalAnd", "T R id id"},
+	OpLogicalNot:                           {"OpLogicalNot", "T R id"},
+	OpSelect:                               {"OpSelect", "T R id id id"},
+	OpIEqual:                               {"OpIEqual", "T R id id"},
+	OpINotEqual:                            {"OpINotEqual", "T R id id"},
+	OpUGreaterThan:                         {"OpUGreaterThan", "T R id id"},
+	OpSGreaterThan:                         {"OpSGreaterThan", "T R id id"},
+	OpUGreaterThanEqual:                    {"OpUGreaterThanEqual", "T R id id"},
+	OpSGreaterThanEqual:                    {"OpSGreaterThanEqual", "T R id id"},
+	OpULessThan:                            {"OpULessThan", "T R id id"},
+	OpSLessThan:                            {"OpSLessThan", "T R id id"},
+	OpULessThanEqual:                       {"OpULessThanEqual", "T R id id"},
+	OpSLessThanEqual:                       {"OpSLessThanEqual", "T R id id"},
+	OpFOrdEqual:                            {"OpFOrdEqual", "T R id id"},
+	OpFUnordEqual:                          {"OpFUnordEqual", "T R id id"},
+	OpFOrdNotEqual:                         {"OpFOrdNotEqual", "T R id id"},
+	OpFUnordNotEqual:                       {"OpFUnordNotEqual", "T R id id"},
+	OpFOrdLessThan:                         {"OpFOrdLessThan", "T R id id"},
+	OpFUnordLessThan:                       {"OpFUnordLessThan", "T R id id"},
+	OpFOrdGreaterThan:                      {"OpFOrdGreaterThan", "T R id id"},
+	OpFUnordGreaterThan:                    {"OpFUnordGreaterThan", "T R id id"},
+	OpFOrdLessThanEqual:                    {"OpFOrdLessThanEqual", "T R id id"},
+	OpFUnordLessThanEqual:                  {"OpFUnordLessThanEqual", "T R id id"},
+	OpFOrdGreaterThanEqual:                 {"OpFOrdGreaterThanEqual", "T R id id"},
+	OpFUnordGreaterThanEqual:               {"OpFUnordGreaterThanEqual", "T R id id"},
+	OpShiftRightLogical:                    {"OpShiftRightLogical", "T R id id"},
+	OpShiftRightArithmetic:                 {"OpShiftRightArithmetic", "T R id id"},
+	OpShiftLeftLogical:                     {"OpShiftLeftLogical", "T R id id"},
+	OpBitwiseOr:                            {"OpBitwiseOr", "T R id id"},
+	OpBitwiseXor:                           {"OpBitwiseXor", "T R id id"},
+	OpBitwiseAnd:                           {"OpBitwiseAnd", "T R id id"},
+	OpNot:                                  {"OpNot", "T R id"},
+	OpBitFieldInsert:                       {"OpBitFieldInsert", "T R id id id id"},
+	OpBitFieldSExtract:                     {"OpBitFieldSExtract", "T R id id id"},
+	OpBitFieldUExtract:                     {"OpBitFieldUExtract", "T R id id id"},
+	OpBitReverse:                           {"OpBitReverse", "T R id"},
+	OpBitCount:                             {"OpBitCount", "T R id"},
+	OpDPdx:                                 {"OpDPdx", "T R id"},
+	OpDPdy:                                 {"OpDPdy", "T R id"},
+	OpFwidth:                               {"OpFwidth", "T R id"},
+	OpDPdxFine:                             {"OpDPdxFine", "T R id"},
+	OpDPdyFine:                             {"OpDPdyFine", "T R id"},
+	OpFwidthFine:                           {"OpFwidthFine", "T R id"},
+	OpDPdxCoarse:                           {"OpDPdxCoarse", "T R id"},
+	OpDPdyCoarse:                           {"OpDPdyCoarse", "T R id"},
+	OpFwidthCoarse:                         {"OpFwidthCoarse", "T R id"},
+	OpEmitVertex:                           {"OpEmitVertex", ""},
+	OpEndPrimitive:                         {"OpEndPrimitive", ""},
+	OpEmitStreamVertex:                     {"OpEmitStreamVertex", "id"},
+	OpEndStreamPrimitive:                   {"OpEndStreamPrimitive", "id"},
+	OpControlBarrier:                       {"OpControlBarrier", "id id id"},
+	OpMemoryBarrier:                        {"OpMemoryBarrier", "id id"},
+	OpAtomicLoad:                           {"OpAtomicLoad", "T R id id id"},
+	OpAtomicStore:                          {"OpAtomicStore", "id id id id"},
+	OpAtomicExchange:                       {"OpAtomicExchange", "T R id id id id"},
+	OpAtomicCompareExchange:                {"OpAtomicCompareExchange", "T R id id id id id id"},
+	OpAtomicCompareExchangeWeak:            {"OpAtomicCompareExchangeWeak", "T R id id id id id id"},
+	OpAtomicIIncrement:                     {"OpAtomicIIncrement", "T R id id id"},
+	OpAtomicIDecrement:                     {"OpAtomicIDecrement", "T R id id id"},
+	OpAtomicIAdd:                           {"OpAtomicIAdd", "T R id id id id"},
+	OpAtomicISub:                           {"OpAtomicISub", "T R id id id id"},
+	OpAtomicSMin:                           {"OpAtomicSMin", "T R id id id id"},
+	OpAtomicUMin:                           {"OpAtomicUMin", "T R id id id id"},
+	OpAtomicSMax:                           {"OpAtomicSMax", "T R id id id id"},
+	OpAtomicUMax:                           {"OpAtomicUMax", "T R id id id id"},
+	OpAtomicAnd:                            {"OpAtomicAnd", "T R id id id id"},
+	OpAtomicOr:                             {"OpAtomicOr", "T R id id id id"},
+	OpAtomicXor:                            {"OpAtomicXor", "T R id id id id"},
+	OpPhi:                                  {"OpPhi", "T R idid*"},
+	OpLoopMerge:                            {"OpLoopMerge", "id id LoopControl"},
+	OpSelectionMerge:                       {"OpSelectionMerge", "id SelectionControl"},
+	OpLabel:                                {"OpLabel", "R"},
+	OpBranch:                               {"OpBranch", "id"},
+	OpBranchConditional:                    {"OpBranchConditional", "id id id lit*"},
+	OpSwitch:                               {"OpSwitch", "id id litid*"},
+	OpKill:                                 {"OpKill", ""},
+	OpReturn:                               {"OpReturn", ""},
+	OpReturnValue:                          {"OpReturnValue", "id"},
+	OpUnreachable:                          {"OpUnreachable", ""},
+	OpLifetimeStart:                        {"OpLifetimeStart", "id lit"},
+	OpLifetimeStop:                         {"OpLifetimeStop", "id lit"},
+	OpGroupAll:                             {"OpGroupAll", "T R id id"},
+	OpGroupAny:                             {"OpGroupAny", "T R id id"},
+	OpGroupBroadcast:                       {"OpGroupBroadcast", "T R id id id"},
+	OpGroupIAdd:                            {"OpGroupIAdd", "T R id GroupOperation id"},
+	OpGroupFAdd:                            {"OpGroupFAdd", "T R id GroupOperation id"},
+	OpGroupFMin:                            {"OpGroupFMin", "T R id GroupOperation id"},
+	OpGroupUMin:                            {"OpGroupUMin", "T R id GroupOperation id"},
+	OpGroupSMin:                            {"OpGroupSMin", "T R id GroupOperation id"},
+	OpGroupFMax:                            {"OpGroupFMax", "T R id GroupOperation id"},
+	OpGroupUMax:                            {"OpGroupUMax", "T R id GroupOperation id"},
+	OpGroupSMax:                            {"OpGroupSMax", "T R id GroupOperation id"},
+	OpImageSparseSampleImplicitLod:         {"OpImageSparseSampleImplicitLod", "T R id id ImageOperands?"},
+	OpImageSparseSampleExplicitLod:         {"OpImageSparseSampleExplicitLod", "T R id id ImageOperands"},
+	OpImageSparseSampleDrefImplicitLod:     {"OpImageSparseSampleDrefImplicitLod", "T R id id id ImageOperands?"},
+	OpImageSparseSampleDrefExplicitLod:     {"OpImageSparseSampleDrefExplicitLod", "T R id id id ImageOperands"},
+	OpImageSparseSampleProjImplicitLod:     {"OpImageSparseSampleProjImplicitLod", "T R id id ImageOperands?"},
+	OpImageSparseSampleProjExplicitLod:     {"OpImageSparseSampleProjExplicitLod", "T R id id ImageOperands"},
+	OpImageSparseSampleProjDrefImplicitLod: {"OpImageSparseSampleProjDrefImplicitLod", "T R id id id ImageOperands?"},
+	OpImageSparseSampleProjDrefExplicitLod: {"OpImageSparseSampleProjDrefExplicitLod", "T R id id id ImageOperands"},
+	OpImageSparseFetch:                     {"OpImageSparseFetch", "T R id id ImageOperands?"},
+	OpImageSparseGather:                    {"OpImageSparseGather", "T R id id id ImageOperands?"},
+	OpImageSparseDrefGather:                {"OpImageSparseDrefGather", "T R id id id ImageOperands?"},
+	OpImageSparseTexelsResident:            {"OpImageSparseTexelsResident", "T R id"},
+	OpNoLine:                               {"OpNoLine", ""},
+	OpImageSparseRead:                      {"OpImageSparseRead", "T R id id ImageOperands?"},
+	OpSizeOf:                               {"OpSizeOf", "T R id"},
+	OpModuleProcessed:                      {"OpModuleProcessed", "str"},
+	OpExecutionModeId:                      {"OpExecutionModeId", "id ExecutionMode"},
+	OpDecorateId:                           {"OpDecorateId", "id Decoration"},
+	OpGroupNonUniformElect:                 {"OpGroupNonUniformElect", "T R id"},
+	OpGroupNonUniformAll:                   {"OpGroupNonUniformAll", "T R id id"},
+	OpGroupNonUniformAny:                   {"OpGroupNonUniformAny", "T R id id"},
+	OpGroupNonUniformAllEqual:              {"OpGroupNonUniformAllEqual", "T R id id"},
+	OpGroupNonUniformBroadcast:             {"OpGroupNonUniformBroadcast", "T R id id id"},
+	OpGroupNonUniformBroadcastFirst:        {"OpGroupNonUniformBroadcastFirst", "T R id id"},
+	OpGroupNonUniformBallot:                {"OpGroupNonUniformBallot", "T R id id"},
+	OpGroupNonUniformInverseBallot:         {"OpGroupNonUniformInverseBallot", "T R id id"},
+	OpGroupNonUniformBallotBitExtract:      {"OpGroupNonUniformBallotBitExtract", "T R id id id"},
+	OpGroupNonUniformBallotBitCount:        {"OpGroupNonUniformBallotBitCount", "T R id GroupOperation id"},
+	OpGroupNonUniformBallotFindLSB:         {"OpGroupNonUniformBallotFindLSB", "T R id id"},
+	OpGroupNonUniformBallotFindMSB:         {"OpGroupNonUniformBallotFindMSB", "T R id id"},
+	OpGroupNonUniformShuffle:               {"OpGroupNonUniformShuffle", "T R id id id"},
+	OpGroupNonUniformShuffleXor:            {"OpGroupNonUniformShuffleXor", "T R id id id"},
+	OpGroupNonUniformShuffleUp:             {"OpGroupNonUniformShuffleUp", "T R id id id"},
+	OpGroupNonUniformShuffleDown:           {"OpGroupNonUniformShuffleDown", "T R id id id"},
+	OpGroupNonUniformIAdd:                  {"OpGroupNonUniformIAdd", "T R id GroupOperation id id?"},
+	OpGroupNonUniformFAdd:                  {"OpGroupNonUniformFAdd", "T R id GroupOperation id id?"},
+	OpGroupNonUniformIMul:                  {"OpGroupNonUniformIMul", "T R id GroupOperation id id?"},
+	OpGroupNonUniformFMul:                  {"OpGroupNonUniformFMul", "T R id GroupOperation id id?"},
+	OpGroupNonUniformSMin:                  {"OpGroupNonUniformSMin", "T R id GroupOperation id id?"},
+	OpGroupNonUniformUMin:                  {"OpGroupNonUniformUMin", "T R id GroupOperation id id?"},
+	OpGroupNonUniformFMin:                  {"OpGroupNonUniformFMin", "T R id GroupOperation id id?"},
+	OpGroupNonUniformSMax:                  {"OpGroupNonUniformSMax", "T R id GroupOperation id id?"},
+	OpGroupNonUniformUMax:                  {"OpGroupNonUniformUMax", "T R id GroupOperation id id?"},
+	OpGroupNonUniformFMax:                  {"OpGroupNonUniformFMax", "T R id GroupOperation id id?"},
+	OpGroupNonUniformBitwiseAnd:            {"OpGroupNonUniformBitwiseAnd", "T R id GroupOperation id id?"},
+	OpGroupNonUniformBitwiseOr:             {"OpGroupNonUniformBitwiseOr", "T R id GroupOperation id id?"},
+	OpGroupNonUniformBitwiseXor:            {"OpGroupNonUniformBitwiseXor", "T R id GroupOperation id id?"},
+	OpGroupNonUniformLogicalAnd:            {"OpGroupNonUniformLogicalAnd", "T R id GroupOperation id id?"},
+	OpGroupNonUniformLogicalOr:             {"OpGroupNonUniformLogicalOr", "T R id GroupOperation id id?"},
+	OpGroupNonUniformLogicalXor:            {"OpGroupNonUniformLogicalXor", "T R id GroupOperation id id?"},
+	OpGroupNonUniformQuadBroadcast:         {"OpGroupNonUniformQuadBroadcast", "T R id id id"},
+	OpGroupNonUniformQuadSwap:              {"OpGroupNonUniformQuadSwap", "T R id id id"},
+	OpCopyLogical:                          {"OpCopyLogical", "T R id"},
+	OpPtrEqual:                             {"OpPtrEqual", "T R id id"},
+	OpPtrNotEqual:                          {"OpPtrNotEqual", "T R id id"},
+	OpPtrDiff:                              {"OpPtrDiff", "T R id id"},
+	OpTerminateInvocation:                  {"OpTerminateInvocation", ""},
+	OpSubgroupBallotKHR:                    {"OpSubgroupBallotKHR", "T R id"},
+	OpSubgroupFirstInvocationKHR:           {"OpSubgroupFirstInvocationKHR", "T R id"},
+	OpTraceRayKHR:                          {"OpTraceRayKHR", "id id id id id id id id id id id"},
+	OpExecuteCallableKHR:                   {"OpExecuteCallableKHR", "id id"},
+	OpConvertUToAccelerationStructureKHR:   {"OpConvertUToAccelerationStructureKHR", "T R id"},
+	OpIgnoreIntersectionKHR:                {"OpIgnoreIntersectionKHR", ""},
+	OpTerminateRayKHR:                      {"OpTerminateRayKHR", ""},
+	OpTypeRayQueryKHR:                      {"OpTypeRayQueryKHR", "R"},
+	OpReadClockKHR:                         {"OpReadClockKHR", "T R id"},
+	OpEmitMeshTasksEXT:                     {"OpEmitMeshTasksEXT", "id id id id?"},
+	OpSetMeshOutputsEXT:                    {"OpSetMeshOutputsEXT", "id id"},
+	OpReportIntersectionKHR:                {"OpReportIntersectionKHR", "T R id id"},
+	OpTypeAccelerationStructureKHR:         {"OpTypeAccelerationStructureKHR", "R"},
+	OpDemoteToHelperInvocation:             {"OpDemoteToHelperInvocation", ""},
+	OpIsHelperInvocationEXT:                {"OpIsHelperInvocationEXT", "T R"},
+	OpDecorateString:                       {"OpDecorateString", "id Decoration"},
+	OpMemberDecorateString:                 {"OpMemberDecorateString", "id lit Decoration"},
+}
+
+func (o Op) String() string {
+	if info, ok := opInfos[o]; ok {
+		return info.name
+	}
+	return fmt.Sprintf("Op(%d)", uint16(o))
+}
@@ -0,0 +1,487 @@
+package spirv
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Kind of a SPIR-V type declaration.
+type Kind int
+
+const (
+	KindVoid Kind = iota
+	KindBool
+	KindInt
+	KindFloat
+	KindVector
+	KindMatrix
+	KindArray
+	KindRuntimeArray
+	KindStruct
+	KindPointer
+	KindImage
+	KindSampler
+	KindSampledImage
+	KindFunction
+	KindOpaque
+)
+
+var kindNames = [...]string{
+	KindVoid:         "void",
+	KindBool:         "bool",
+	KindInt:          "int",
+	KindFloat:        "float",
+	KindVector:       "vector",
+	KindMatrix:       "matrix",
+	KindArray:        "array",
+	KindRuntimeArray: "runtime array",
+	KindStruct:       "struct",
+	KindPointer:      "pointer",
+	KindImage:        "image",
+	KindSampler:      "sampler",
+	KindSampledImage: "sampled image",
+	KindFunction:     "function",
+	KindOpaque:       "opaque",
+}
+
+func (o Kind) String() string {
+	if o >= 0 && int(o) < len(kindNames) {
+		return kindNames[o]
+	}
+	return fmt.Sprintf("Kind(%d)", int(o))
+}
+
+// Type is a reflected SPIR-V type declaration.
+type Type struct {
+	ID   uint32
+	Kind Kind
+	Name string // From OpName, may be empty
+
+	Width  int  // Bit width of Int and Float
+	Signed bool // Int signedness
+
+	// Component type of Vector, column type of Matrix, element type of
+	// Array and RuntimeArray, pointee of Pointer
+	Elem *Type
+
+	// Component count of Vector, column count of Matrix, length of Array
+	Len int
+
+	ArrayStride  int          // ArrayStride decoration, 0 when absent
+	StorageClass StorageClass // Pointer storage class
+
+	Members     []Member // Struct members
+	Block       bool     // Decorated Block
+	BufferBlock bool     // Decorated BufferBlock
+}
+
+// Member of a struct type.
+type Member struct {
+	Name         string // From OpMemberName, may be empty
+	Type         *Type
+	Offset       int // Offset decoration, -1 when absent
+	MatrixStride int // MatrixStride decoration, 0 when absent
+	RowMajor     bool
+	BuiltIn      bool
+}
+
+// Variable is a module scope OpVariable.
+type Variable struct {
+	ID           uint32
+	Name         string
+	Type         *Type // Pointee type
+	StorageClass StorageClass
+	Set          int // DescriptorSet decoration, -1 when absent
+	Binding      int // Binding decoration, -1 when absent
+	Location     int // Location decoration, -1 when absent
+	BuiltIn      bool
+}
+
+// Reflection is the type and interface information of a module.
+type Reflection struct {
+	Types     map[uint32]*Type
+	Variables []*Variable
+}
+
+type decoration struct {
+	member int // -1 for OpDecorate
+	kind   Decoration
+	args   []uint32
+}
+
+// Reflect extracts type declarations and module scope variables.
+func (o *Module) Reflect() (*Reflection, error) {
+
+	var r = &Reflection{
+		Types: make(map[uint32]*Type),
+	}
+
+	var names = make(map[uint32]string)
+	var member_names = make(map[uint32]map[int]string)
+	var decorations = make(map[uint32][]decoration)
+	var constants = make(map[uint32]uint32)
+
+	var get = func(id uint32) *Type {
+		var t = r.Types[id]
+		if nil == t {
+			t = &Type{ID: id}
+			r.Types[id] = t
+		}
+		return t
+	}
+
+	for _, inst := range o.Instructions {
+
+		var w = inst.Words
+
+		// Every instruction handled below has at least one operand
+		if 0 == len(w) {
+			continue
+		}
+
+		switch inst.Opcode {
+
+		case OpName:
+			names[w[0]], _ = String(w, 1)
+
+		case OpMemberName:
+			if len(w) < 2 {
+				return nil, fmt.Errorf("spirv: truncated %v", inst.Opcode)
+			}
+			if nil == member_names[w[0]] {
+				member_names[w[0]] = make(map[int]string)
+			}
+			member_names[w[0]][int(w[1])], _ = String(w, 2)
+
+		case OpDecorate:
+			if len(w) < 2 {
+				return nil, fmt.Errorf("spirv: truncated %v", inst.Opcode)
+			}
+			decorations[w[0]] = append(decorations[w[0]], decoration{-1, Decoration(w[1]), w[2:]})
+
+		case OpMemberDecorate:
+			if len(w) < 3 {
+				return nil, fmt.Errorf("spirv: truncated %v", inst.Opcode)
+			}
+			decorations[w[0]] = append(decorations[w[0]], decoration{int(w[1]), Decoration(w[2]), w[3:]})
+
+		case OpConstant, OpSpecConstant:
+			// Result type, result ID, value. Only the low word matters for
+			// array lengths.
+			if len(w) >= 3 {
+				constants[w[1]] = w[2]
+			}
+
+		case OpTypeVoid:
+			get(w[0]).Kind = KindVoid
+
+		case OpTypeBool:
+			get(w[0]).Kind = KindBool
+
+		case OpTypeInt:
+			if len(w) < 3 {
+				return nil, fmt.Errorf("spirv: truncated %v", inst.Opcode)
+			}
+			var t = get(w[0])
+			t.Kind = KindInt
+			t.Width = int(w[1])
+			t.Signed = 0 != w[2]
+
+		case OpTypeFloat:
+			if len(w) < 2 {
+				return nil, fmt.Errorf("spirv: truncated %v", inst.Opcode)
+			}
+			var t = get(w[0])
+			t.Kind = KindFloat
+			t.Width = int(w[1])
+
+		case OpTypeVector, OpTypeMatrix:
+			if len(w) < 3 {
+				return nil, fmt.Errorf("spirv: truncated %v", inst.Opcode)
+			}
+			var t = get(w[0])
+			t.Kind = KindVector
+			if OpTypeMatrix == inst.Opcode {
+				t.Kind = KindMatrix
+			}
+			t.Elem = get(w[1])
+			t.Len = int(w[2])
+
+		case OpTypeArray:
+			if len(w) < 3 {
+				return nil, fmt.Errorf("spirv: truncated %v", inst.Opcode)
+			}
+			var n, ok = constants[w[2]]
+			if !ok {
+				return nil, fmt.Errorf("spirv: array type %%%v: length %%%v is not a constant", w[0], w[2])
+			}
+			var t = get(w[0])
+			t.Kind = KindArray
+			t.Elem = get(w[1])
+			t.Len = int(n)
+
+		case OpTypeRuntimeArray:
+			if len(w) < 2 {
+				return nil, fmt.Errorf("spirv: truncated %v", inst.Opcode)
+			}
+			var t = get(w[0])
+			t.Kind = KindRuntimeArray
+			t.Elem = get(w[1])
+
+		case OpTypeStruct:
+			var t = get(w[0])
+			t.Kind = KindStruct
+			t.Members = make([]Member, len(w)-1)
+			for i, id := range w[1:] {
+				t.Members[i] = Member{Type: get(id), Offset: -1}
+			}
+
+		case OpTypePointer:
+			if len(w) < 3 {
+				return nil, fmt.Errorf("spirv: truncated %v", inst.Opcode)
+			}
+			var t = get(w[0])
+			t.Kind = KindPointer
+			t.StorageClass = StorageClass(w[1])
+			t.Elem = get(w[2])
+
+		case OpTypeImage:
+			get(w[0]).Kind = KindImage
+
+		case OpTypeSampler:
+			get(w[0]).Kind = KindSampler
+
+		case OpTypeSampledImage:
+			var t = get(w[0])
+			t.Kind = KindSampledImage
+			if len(w) > 1 {
+				t.Elem = get(w[1])
+			}
+
+		case OpTypeFunction:
+			get(w[0]).Kind = KindFunction
+
+		case OpTypeAccelerationStructureKHR:
+			get(w[0]).Kind = KindOpaque
+
+		case OpVariable:
+			if len(w) < 3 {
+				return nil, fmt.Errorf("spirv: truncated %v", inst.Opcode)
+			}
+			var sc = StorageClass(w[2])
+			if StorageClassFunction == sc {
+				continue
+			}
+			var v = &Variable{
+				ID:           w[1],
+				StorageClass: sc,
+				Set:          -1,
+				Binding:      -1,
+				Location:     -1,
+			}
+			if p := get(w[0]); nil != p.Elem {
+				v.Type = p.Elem
+			} else {
+				v.Type = p
+			}
+			r.Variables = append(r.Variables, v)
+
+		} // switch
+	} // for
+
+	// Names and decorations precede the declarations they refer to, apply
+	// them once everything is declared.
+
+	for id, t := range r.Types {
+
+		t.Name = names[id]
+
+		for i := range t.Members {
+			t.Members[i].Name = member_names[id][i]
+		}
+
+		for _, d := range decorations[id] {
+
+			if d.member < 0 {
+				switch d.kind {
+				case DecorationBlock:
+					t.Block = true
+				case DecorationBufferBlock:
+					t.BufferBlock = true
+				case DecorationArrayStride:
+					if len(d.args) > 0 {
+						t.ArrayStride = int(d.args[0])
+					}
+				}
+				continue
+			}
+
+			if d.member >= len(t.Members) {
+				return nil, fmt.Errorf("spirv: type %%%v: decoration of member %v out of range", id, d.member)
+			}
+
+			var m = &t.Members[d.member]
+			switch d.kind {
+			case DecorationOffset:
+				if len(d.args) > 0 {
+					m.Offset = int(d.args[0])
+				}
+			case DecorationMatrixStride:
+				if len(d.args) > 0 {
+					m.MatrixStride = int(d.args[0])
+				}
+			case DecorationRowMajor:
+				m.RowMajor = true
+			case DecorationColMajor:
+				m.RowMajor = false
+			case DecorationBuiltIn:
+				m.BuiltIn = true
+			}
+		} // for
+	} // for
+
+	for _, v := range r.Variables {
+
+		v.Name = names[v.ID]
+
+		for _, d := range decorations[v.ID] {
+			if len(d.args) > 0 {
+				switch d.kind {
+				case DecorationDescriptorSet:
+					v.Set = int(d.args[0])
+				case DecorationBinding:
+					v.Binding = int(d.args[0])
+				case DecorationLocation:
+					v.Location = int(d.args[0])
+				}
+			}
+			if DecorationBuiltIn == d.kind {
+				v.BuiltIn = true
+			}
+		} // for
+	} // for
+
+	return r, nil
+}
+
+// Blocks returns the uniform, storage and push constant block variables,
+// ordered by descriptor set and binding.
+func (o *Reflection) Blocks() []*Variable {
+
+	var r []*Variable
+
+	for _, v := range o.Variables {
+
+		switch v.StorageClass {
+		case StorageClassUniform, StorageClassStorageBuffer, StorageClassPushConstant, StorageClassPhysicalStorageBuffer:
+		default:
+			continue
+		}
+
+		if nil != v.BlockType() {
+			r = append(r, v)
+		}
+	} // for
+
+	sort.SliceStable(r, func(i, j int) bool {
+		if r[i].Set != r[j].Set {
+			return r[i].Set < r[j].Set
+		}
+		return r[i].Binding < r[j].Binding
+	})
+
+	return r
+}
+
+// Block looks up a block variable by its instance name or its block type
+// name, e.g. "ubo" or "UniformBufferObject".
+func (o *Reflection) Block(name string) *Variable {
+
+	for _, v := range o.Blocks() {
+		if name == v.Name || name == v.BlockType().Name {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// BlockType returns the Block or BufferBlock struct of the variable, looking
+// through descriptor arrays. Returns nil when the variable is not a block.
+func (o *Variable) BlockType() *Type {
+
+	var t = o.Type
+	for nil != t && (KindArray == t.Kind || KindRuntimeArray == t.Kind) {
+		t = t.Elem
+	}
+
+	if nil == t || KindStruct != t.Kind || !(t.Block || t.BufferBlock) {
+		return nil
+	}
+
+	return t
+}
+
+// GLSL-like spelling of the type, used in messages.
+func (o *Type) String() string {
+
+	switch o.Kind {
+
+	case KindInt:
+		if o.Signed {
+			return fmt.Sprintf("int%v", o.Width)
+		}
+		return fmt.Sprintf("uint%v", o.Width)
+
+	case KindFloat:
+		return fmt.Sprintf("float%v", o.Width)
+
+	case KindVector:
+		return fmt.Sprintf("%vvec%v", vectorPrefix(o.Elem), o.Len)
+
+	case KindMatrix:
+		if nil != o.Elem && KindVector == o.Elem.Kind {
+			return fmt.Sprintf("%vmat%vx%v", vectorPrefix(o.Elem.Elem), o.Len, o.Elem.Len)
+		}
+
+	case KindArray:
+		return fmt.Sprintf("%v[%v]", o.Elem, o.Len)
+
+	case KindRuntimeArray:
+		return fmt.Sprintf("%v[]", o.Elem)
+
+	case KindStruct:
+		if "" != o.Name {
+			return o.Name
+		}
+		return fmt.Sprintf("struct %%%v", o.ID)
+
+	case KindPointer:
+		return fmt.Sprintf("%v*", o.Elem)
+	}
+
+	return o.Kind.String()
+}
+
+func vectorPrefix(t *Type) string {
+
+	if nil == t {
+		return ""
+	}
+
+	switch t.Kind {
+	case KindBool:
+		return "b"
+	case KindInt:
+		if t.Signed {
+			return "i"
+		}
+		return "u"
+	case KindFloat:
+		if 64 == t.Width {
+			return "d"
+		}
+	}
+
+	return ""
+}
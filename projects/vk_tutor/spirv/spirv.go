@@ -0,0 +1,150 @@
+package spirv
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// Magic number at the start of every SPIR-V module, in the module's own
+// byte order.
+const MagicNumber uint32 = 0x07230203
+
+// Number of words in the module header.
+const HeaderWords = 5
+
+// Module is a parsed SPIR-V binary.
+type Module struct {
+	Version      uint32 // 0x00MMmm00
+	Generator    uint32
+	Bound        uint32 // All result IDs are less than this
+	Schema       uint32
+	Instructions []Instruction
+}
+
+// Instruction is one SPIR-V instruction. Words holds the operands only; the
+// word count and opcode of the first word are split into Opcode.
+type Instruction struct {
+	Opcode Op
+	Words  []uint32
+}
+
+// Major and minor SPIR-V version of the module.
+func (o *Module) VersionNumbers() (major, minor int) {
+	return int(o.Version>>16) & 0xff, int(o.Version>>8) & 0xff
+}
+
+// Parse decodes a SPIR-V binary. Both byte orders are accepted.
+func Parse(b []byte) (*Module, error) {
+
+	if 0 != len(b)%4 {
+		return nil, fmt.Errorf("spirv: size %v is not a multiple of 4", len(b))
+	}
+
+	if len(b) < HeaderWords*4 {
+		return nil, fmt.Errorf("spirv: size %v is too small for a module header", len(b))
+	}
+
+	var order binary.ByteOrder
+	switch MagicNumber {
+	case binary.LittleEndian.Uint32(b):
+		order = binary.LittleEndian
+	case binary.BigEndian.Uint32(b):
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("spirv: bad magic number 0x%08x", binary.LittleEndian.Uint32(b))
+	}
+
+	var words = make([]uint32, len(b)/4)
+	for i := range words {
+		words[i] = order.Uint32(b[i*4:])
+	}
+
+	return ParseWords(words)
+}
+
+// ParseWords decodes a SPIR-V module that is already split into words in
+// host byte order.
+func ParseWords(words []uint32) (*Module, error) {
+
+	if len(words) < HeaderWords || MagicNumber != words[0] {
+		return nil, fmt.Errorf("spirv: missing module header")
+	}
+
+	var m = &Module{
+		Version:   words[1],
+		Generator: words[2],
+		Bound:     words[3],
+		Schema:    words[4],
+	}
+
+	for i := HeaderWords; i < len(words); {
+
+		var cnt = int(words[i] >> 16)
+		var op = Op(words[i] & 0xffff)
+
+		if 0 == cnt {
+			return nil, fmt.Errorf("spirv: word %v: %v has zero word count", i, op)
+		}
+
+		if i+cnt > len(words) {
+			return nil, fmt.Errorf("spirv: word %v: %v runs past the end of the module", i, op)
+		}
+
+		m.Instructions = append(m.Instructions, Instruction{
+			Opcode: op,
+			Words:  words[i+1 : i+cnt],
+		})
+
+		i += cnt
+	} // for
+
+	return m, nil
+}
+
+// Words serializes the module back into SPIR-V words.
+func (o *Module) Words() []uint32 {
+
+	var r = []uint32{MagicNumber, o.Version, o.Generator, o.Bound, o.Schema}
+
+	for _, inst := range o.Instructions {
+		r = append(r, uint32(len(inst.Words)+1)<<16|uint32(inst.Opcode))
+		r = append(r, inst.Words...)
+	}
+
+	return r
+}
+
+// Bytes serializes the module into a little-endian SPIR-V binary, the byte
+// order expected by vkCreateShaderModule on every platform we run on.
+func (o *Module) Bytes() []byte {
+
+	var words = o.Words()
+	var r = make([]byte, len(words)*4)
+
+	for i, w := range words {
+		binary.LittleEndian.PutUint32(r[i*4:], w)
+	}
+
+	return r
+}
+
+// String decodes a literal string operand starting at word i. It returns the
+// string and the index of the first word after it.
+func String(words []uint32, i int) (string, int) {
+
+	var sb strings.Builder
+
+	for ; i < len(words); i++ {
+		var w = words[i]
+		for j := 0; j < 4; j++ {
+			var c = byte(w >> (8 * j))
+			if 0 == c {
+				return sb.String(), i + 1
+			}
+			sb.WriteByte(c)
+		}
+	} // for
+
+	return sb.String(), i
+}
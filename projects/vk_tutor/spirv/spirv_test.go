@@ -0,0 +1,182 @@
+package spirv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testdata/shader.vert.spv is the vertex shader of triangle, the same SPIR-V
+// as spvShaderVert in triangle/shaders.go.
+func loadModule(t *testing.T) ([]byte, *Module) {
+
+	var b, err = os.ReadFile("testdata/shader.vert.spv")
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	var m *Module
+	if m, err = Parse(b); nil != err {
+		t.Fatal(err)
+	}
+
+	return b, m
+}
+
+func TestParse(t *testing.T) {
+
+	var b, m = loadModule(t)
+
+	if major, minor := m.VersionNumbers(); 1 != major || 0 != minor {
+		t.Errorf("version %v.%v, want 1.0", major, minor)
+	}
+	if "Google Shaderc over Glslang; 11" != GeneratorName(m.Generator) {
+		t.Errorf("generator %q", GeneratorName(m.Generator))
+	}
+	if 54 != m.Bound || 0 != m.Schema {
+		t.Errorf("bound %v, schema %v, want 54, 0", m.Bound, m.Schema)
+	}
+	if OpCapability != m.Instructions[0].Opcode {
+		t.Errorf("first instruction %v, want OpCapability", m.Instructions[0].Opcode)
+	}
+
+	if !bytes.Equal(b, m.Bytes()) {
+		t.Error("Bytes does not reproduce the input")
+	}
+
+	// The same module in big-endian words
+	var be = make([]byte, len(b))
+	for i := 0; i < len(b); i += 4 {
+		binary.BigEndian.PutUint32(be[i:], binary.LittleEndian.Uint32(b[i:]))
+	}
+	if m1, err := Parse(be); nil != err {
+		t.Errorf("big-endian: %v", err)
+	} else if !reflect.DeepEqual(m, m1) {
+		t.Error("big-endian module differs")
+	}
+}
+
+func TestSummary(t *testing.T) {
+
+	var _, m = loadModule(t)
+
+	var s, err = m.Summary()
+	if nil != err {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual([]Capability{CapabilityShader}, s.Capabilities) {
+		t.Errorf("capabilities %v", s.Capabilities)
+	}
+	if !reflect.DeepEqual([]string{"GLSL.std.450"}, s.ExtInstImports) {
+		t.Errorf("ext inst imports %v", s.ExtInstImports)
+	}
+	if 1 != len(s.EntryPoints) {
+		t.Fatalf("%v entry points, want 1", len(s.EntryPoints))
+	}
+
+	var e = s.EntryPoints[0]
+	if ExecutionModelVertex != e.Model || "main" != e.Name || 3 != len(e.Interface) {
+		t.Errorf("entry point %v %q with %v interface variables", e.Model, e.Name, len(e.Interface))
+	}
+	if 0 != len(s.Resources) {
+		t.Errorf("%v resources, want none", len(s.Resources))
+	}
+}
+
+func TestStrip(t *testing.T) {
+
+	var _, m = loadModule(t)
+	var stripped = m.Strip()
+
+	for _, inst := range stripped.Instructions {
+		switch inst.Opcode {
+		case OpSource, OpSourceExtension, OpName, OpMemberName:
+			t.Errorf("%v left in the stripped module", inst.Opcode)
+		}
+	} // for
+
+	var m1, err = Parse(stripped.Bytes())
+	if nil != err {
+		t.Fatal(err)
+	}
+	if len(m1.Instructions) >= len(m.Instructions) {
+		t.Errorf("%v instructions after Strip, %v before", len(m1.Instructions), len(m.Instructions))
+	}
+	if _, err = m1.Summary(); nil != err {
+		t.Error(err)
+	}
+}
+
+func TestDisassemble(t *testing.T) {
+
+	var _, m = loadModule(t)
+
+	var sb strings.Builder
+	if err := m.Disassemble(&sb, nil); nil != err {
+		t.Fatal(err)
+	}
+
+	var lines = strings.Split(sb.String(), "\n")
+	for _, want := range []string{
+		"; Version: 1.0",
+		"; Bound: 54",
+		"                 OpCapability Shader",
+		"            %1 = OpExtInstImport \"GLSL.std.450\"",
+		"                 OpMemoryModel Logical GLSL450",
+		"                 OpEntryPoint Vertex %main \"main\" %34 %gl_VertexIndex %fragColor",
+		"                 OpDecorate %gl_VertexIndex BuiltIn VertexIndex",
+		"   %float_n0_5 = OpConstant %float -0.5",
+	} {
+		var found bool
+		for _, l := range lines {
+			found = found || want == l
+		}
+		if !found {
+			t.Errorf("missing line %q", want)
+		}
+	} // for
+
+	// Raw IDs drop the names
+	sb.Reset()
+	m.Disassemble(&sb, &DisasmOptions{RawIDs: true})
+	if strings.Contains(sb.String(), "%main") {
+		t.Error("%main with RawIDs")
+	}
+}
+
+func TestTruncated(t *testing.T) {
+
+	var b, m = loadModule(t)
+
+	// Word offsets where an instruction starts
+	var starts = map[int]bool{len(b): true}
+	var at = HeaderWords * 4
+	for _, inst := range m.Instructions {
+		starts[at] = true
+		at += (len(inst.Words) + 1) * 4
+	}
+
+	for n := 0; n < len(b); n++ {
+
+		var m1, err = Parse(b[:n])
+
+		if n >= HeaderWords*4 && starts[n] {
+			if nil != err {
+				t.Errorf("%v bytes: %v", n, err)
+			}
+			// Whatever survives must not panic the readers
+			m1.Summary()
+			m1.Disassemble(io.Discard, &DisasmOptions{Comments: true})
+			continue
+		}
+
+		if nil == err {
+			t.Errorf("%v bytes: no error", n)
+		}
+	} // for
+}
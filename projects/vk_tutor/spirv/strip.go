@@ -0,0 +1,52 @@
+package spirv
+
+import "strings"
+
+// Strip returns a copy of the module without debug information: sources,
+// names, line information, OpModuleProcessed and non-semantic extended
+// instructions. Result IDs are left as they are.
+func (o *Module) Strip() *Module {
+
+	var nonsemantic = make(map[uint32]bool)
+
+	for _, inst := range o.Instructions {
+		if OpExtInstImport == inst.Opcode && len(inst.Words) >= 1 {
+			if name, _ := String(inst.Words, 1); strings.HasPrefix(name, "NonSemantic.") {
+				nonsemantic[inst.Words[0]] = true
+			}
+		}
+	} // for
+
+	var r = *o
+	r.Instructions = nil
+
+	for _, inst := range o.Instructions {
+
+		switch inst.Opcode {
+
+		case OpSourceContinued, OpSource, OpSourceExtension, OpString,
+			OpName, OpMemberName, OpLine, OpNoLine, OpModuleProcessed:
+			continue
+
+		case OpExtension:
+			// Only needed for the non-semantic sets dropped below
+			if name, _ := String(inst.Words, 0); "SPV_KHR_non_semantic_info" == name {
+				continue
+			}
+
+		case OpExtInstImport:
+			if len(inst.Words) >= 1 && nonsemantic[inst.Words[0]] {
+				continue
+			}
+
+		case OpExtInst:
+			if len(inst.Words) >= 3 && nonsemantic[inst.Words[2]] {
+				continue
+			}
+		} // switch
+
+		r.Instructions = append(r.Instructions, inst)
+	} // for
+
+	return &r
+}
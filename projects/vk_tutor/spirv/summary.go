@@ -0,0 +1,119 @@
+package spirv
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// EntryPoint is an OpEntryPoint with its execution modes.
+type EntryPoint struct {
+	Model     ExecutionModel
+	Name      string
+	Function  uint32
+	Interface []*Variable // Input and output variables (all used globals since SPIR-V 1.4)
+	Modes     []string    // e.g. "OriginUpperLeft", "LocalSize 8 8 1"
+}
+
+// Summary is the module level information of a module.
+type Summary struct {
+	Major, Minor    int
+	Generator       string
+	Capabilities    []Capability
+	Extensions      []string
+	ExtInstImports  []string
+	AddressingModel AddressingModel
+	MemoryModel     MemoryModel
+	EntryPoints     []EntryPoint
+	Resources       []*Variable // Descriptors and push constants, by set and binding
+}
+
+// Summary collects capabilities, extensions, entry points and their
+// interfaces, and the resources of the module.
+func (o *Module) Summary() (*Summary, error) {
+
+	var refl, err = o.Reflect()
+	if nil != err {
+		return nil, err
+	}
+
+	var vars = make(map[uint32]*Variable)
+	for _, v := range refl.Variables {
+		vars[v.ID] = v
+	}
+
+	var s = &Summary{Generator: GeneratorName(o.Generator)}
+	s.Major, s.Minor = o.VersionNumbers()
+
+	var modes = make(map[uint32][]string)
+
+	for _, inst := range o.Instructions {
+
+		var w = inst.Words
+		if 0 == len(w) {
+			continue
+		}
+
+		switch inst.Opcode {
+
+		case OpCapability:
+			s.Capabilities = append(s.Capabilities, Capability(w[0]))
+
+		case OpExtension:
+			var name, _ = String(w, 0)
+			s.Extensions = append(s.Extensions, name)
+
+		case OpExtInstImport:
+			var name, _ = String(w, 1)
+			s.ExtInstImports = append(s.ExtInstImports, name)
+
+		case OpMemoryModel:
+			if len(w) >= 2 {
+				s.AddressingModel = AddressingModel(w[0])
+				s.MemoryModel = MemoryModel(w[1])
+			}
+
+		case OpEntryPoint:
+			if len(w) < 3 {
+				return nil, fmt.Errorf("spirv: truncated %v", inst.Opcode)
+			}
+			var e = EntryPoint{Model: ExecutionModel(w[0]), Function: w[1]}
+			var n int
+			e.Name, n = String(w, 2)
+			for _, id := range w[n:] {
+				if v := vars[id]; nil != v {
+					e.Interface = append(e.Interface, v)
+				}
+			}
+			s.EntryPoints = append(s.EntryPoints, e)
+
+		case OpExecutionMode, OpExecutionModeId:
+			var p = operandPrinter{d: &disasm{}, words: w, i: 1}
+			if len(w) >= 2 {
+				p.enum("ExecutionMode")
+			}
+			modes[w[0]] = append(modes[w[0]], strings.Join(p.out, " "))
+
+		} // switch
+	} // for
+
+	for i := range s.EntryPoints {
+		s.EntryPoints[i].Modes = modes[s.EntryPoints[i].Function]
+	}
+
+	for _, v := range refl.Variables {
+		if v.Set >= 0 || v.Binding >= 0 || StorageClassPushConstant == v.StorageClass {
+			s.Resources = append(s.Resources, v)
+		}
+	}
+
+	sort.SliceStable(s.Resources, func(i, j int) bool {
+		var a, b = s.Resources[i], s.Resources[j]
+		if a.Set != b.Set {
+			return a.Set < b.Set
+		}
+		return a.Binding < b.Binding
+	})
+
+	return s, nil
+}
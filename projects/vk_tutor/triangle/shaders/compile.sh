@@ -1,4 +0,0 @@
-#!/bin/sh
-
-glslc shader.vert -o vert.spv
-glslc shader.frag -o frag.spv
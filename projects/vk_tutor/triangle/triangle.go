@@ -1,16 +1,30 @@
 package main
 
 import (
+	"flag"
 	"fmt"
-	"os"
 	"runtime"
 
+	"example.com/vk_tutor/bootstrap"
+	"example.com/vk_tutor/pipelinecache"
+	"example.com/vk_tutor/profiles"
 	"example.com/vk_tutor/sdl2"
+	"example.com/vk_tutor/selector"
+	"example.com/vk_tutor/shaderc"
 	"example.com/vk_tutor/vulkan"
 )
 
+//go:generate go run example.com/vk_tutor/cmd/shaderc -o shaders.go shaders/shader.vert shaders/shader.frag
+
+var deviceOverride = flag.String("device", "", "physical device index, name substring or pipeline cache UUID (default $"+selector.OverrideEnv+")")
+var profileFile = flag.String("profile", "", "Vulkan Profiles JSON file the device must meet")
+var profileName = flag.String("profile-name", "", "profile of -profile to use, needed when it has several")
+var devShaders = flag.Bool("dev", false, "compile shaders/shader.vert and shader.frag at run time and reload them on change, needs glslc or glslangValidator")
+
 func main() {
 
+	flag.Parse()
+
 	runtime.LockOSThread()
 
 	var app HelloTriangleApplication
@@ -26,19 +40,16 @@ const (
 
 const enableValidationLayers = true
 
-var validationLayers = []string{
-	// "VK_LAYER_KHRONOS_validation",
-	"VK_LAYER_LUNARG_standard_validation",
-}
-
 var deviceExtensions = []string{
 	vulkan.VK_KHR_SWAPCHAIN_EXTENSION_NAME,
 }
 
 type HelloTriangleApplication struct {
 	Window         *sdl2.SDL_Window
+	Bootstrap      *bootstrap.Instance
 	Instance       vulkan.VkInstance
 	Surface        vulkan.VkSurfaceKHR
+	Selected       *selector.Device
 	PhysicalDevice vulkan.VkPhysicalDevice
 	Device         vulkan.VkDevice
 	GraphicsQueue  vulkan.VkQueue
@@ -48,9 +59,12 @@ type HelloTriangleApplication struct {
 	ImageFormat    vulkan.VkFormat
 	Extent         vulkan.VkExtent2D
 	ImageViews     []vulkan.VkImageView
+	PipelineCache  *pipelinecache.Cache
+	Shaders        *shaderc.Reloader // -dev only
 }
 
 type QueueFamilyIndices struct {
+	Plan           *bootstrap.QueuePlan
 	GraphicsFamily int
 	PresentFamily  int
 }
@@ -72,18 +86,14 @@ func (o *HelloTriangleApplication) initWindow() {
 
 	sdl2.SDL_SetMainReady()
 
-	if 0 != sdl2.SDL_Init(sdl2.SDL_INIT_EVERYTHING) {
-		// var msg = sdl2.SDL_GetError()
-		// TODO
-		fmt.Println("SDL_Init failed")
+	if err := sdl2.SDL_Init(sdl2.SDL_INIT_EVERYTHING); nil != err {
+		panic(err)
 	}
 
-	var window = sdl2.SDL_CreateWindow("Triangle", sdl2.SDL_WINDOWPOS_UNDEFINED, sdl2.SDL_WINDOWPOS_UNDEFINED, WINDOW_WIDTH, WINDOW_HEIGHT,
+	var window, err = sdl2.SDL_CreateWindow("Triangle", sdl2.SDL_WINDOWPOS_UNDEFINED, sdl2.SDL_WINDOWPOS_UNDEFINED, WINDOW_WIDTH, WINDOW_HEIGHT,
 		sdl2.SDL_WINDOW_SHOWN|sdl2.SDL_WINDOW_VULKAN)
-	if nil == window {
-		// var msg = sdl2.SDL_GetError()
-		// TODO
-		fmt.Println("SDL_CreateWindow failed")
+	if nil != err {
+		panic(err)
 	}
 
 	o.Window = window
@@ -100,30 +110,34 @@ func (o *HelloTriangleApplication) initVulkan() {
 	o.pickPhysicalDevice(&queue_families, &swap_chain_support)
 
 	o.createLogicalDevice(&queue_families)
+	o.createPipelineCache()
 	o.createSwapChain(&queue_families, &swap_chain_support)
 	o.createImageViews()
-	// o.createGraphicsPipeline()
+
+	if *devShaders {
+		o.watchShaders()
+	} else if err := o.createGraphicsPipeline(spvShaderVert, spvShaderFrag); nil != err {
+		panic(err)
+	}
 
 }
 
 func (o *HelloTriangleApplication) mainLoop() {
 
-	var e sdl2.SDL_Event
-
-loop:
-	for {
-
-		// Handle events
-		if 0 != sdl2.SDL_PollEvent(&e) {
-			switch e.Type() {
-			case sdl2.SDL_QUIT:
-				break loop
-			} // switch
-		}
+	var loop, err = sdl2.NewEventLoop()
+	if nil != err {
+		panic(err)
+	}
 
-		// More work here..
+	// Nothing is drawn yet, so the loop sleeps until SDL_QUIT. Drawing goes
+	// in loop.Frame.
+	if nil != o.Shaders {
+		loop.Frame = o.reloadFrame()
+	}
 
-	} // for
+	if err := loop.Run(); nil != err {
+		panic(err)
+	}
 }
 
 func (o *HelloTriangleApplication) cleanup() {
@@ -133,122 +147,61 @@ func (o *HelloTriangleApplication) cleanup() {
 	}
 
 	vulkan.VkDestroySwapchainKHR(o.Device, o.SwapChain, nil)
+
+	if nil != o.PipelineCache {
+		if err := o.PipelineCache.Save(); nil != err {
+			fmt.Printf("Cannot save pipeline cache: %v\n", err)
+		}
+		o.PipelineCache.Destroy()
+	}
+
 	vulkan.VkDestroyDevice(o.Device, nil)
 	vulkan.VkDestroySurfaceKHR(o.Instance, o.Surface, nil)
-	vulkan.VkDestroyInstance(o.Instance, nil)
+	o.Bootstrap.Destroy()
 	sdl2.SDL_DestroyWindow(o.Window)
 	sdl2.SDL_Quit()
 }
 
 func (o *HelloTriangleApplication) createInstance() {
 
-	var app_info vulkan.VkApplicationInfo
-	var app_name = "Hello Triangle"
-	var engine_name = "No Engine"
-	app_info.PApplicationName = &app_name
-	app_info.ApplicationVersion = vulkan.VK_MAKE_VERSION(1, 0, 0)
-	app_info.PEngineName = &engine_name
-	app_info.EngineVersion = vulkan.VK_MAKE_VERSION(1, 0, 0)
-	app_info.ApiVersion = vulkan.VK_API_VERSION_1_0
-
-	var create_info vulkan.VkInstanceCreateInfo
-	create_info.PApplicationInfo = &app_info
-
-	var ext_names []string
-	{
-		// Get avaible extension count
-		var cnt uint32
-		vulkan.VkEnumerateInstanceExtensionProperties(nil, &cnt, nil)
-
-		if 0 == cnt {
-			// TODO
-			fmt.Println("VkEnumerateInstanceExtensionProperties returns 0")
-		}
-
-		ext_names = make([]string, cnt)
-
-		// Get required extensions by SDL
-		if cnt1 := uint(cnt); sdl2.SDL_Vulkan_GetInstanceExtensions(o.Window, &cnt1, ext_names) {
-			cnt = uint32(cnt1)
-			ext_names = ext_names[:cnt]
-		} else {
-			// TODO
-			fmt.Println("SDL_Vulkan_GetInstanceExtensions failed")
-		}
+	// Extensions SDL needs for the window surface
+	var cnt uint
+	if err := sdl2.SDL_Vulkan_GetInstanceExtensions(o.Window, &cnt, nil); nil != err {
+		panic(err)
+	}
 
-		fmt.Printf("Required instance extension count is %v\n", cnt)
-		for i := 0; i < int(cnt); i++ {
-			fmt.Printf("\t#%v: %v\n", i, ext_names[i])
-		}
+	var ext_names = make([]string, cnt)
+	if err := sdl2.SDL_Vulkan_GetInstanceExtensions(o.Window, &cnt, ext_names); nil != err {
+		panic(err)
 	}
 
-	create_info.EnabledExtensionCount = len(ext_names)
-	create_info.PpEnabledExtensionNames = ext_names
+	var builder = bootstrap.NewInstanceBuilder().
+		AppName("Hello Triangle", vulkan.VK_MAKE_VERSION(1, 0, 0)).
+		RequireExtensions(ext_names[:cnt]...)
 
 	if enableValidationLayers {
-
-		if !checkValidationLayerSupport(validationLayers) {
-			// TODO
-			fmt.Println("Validation layers not supported")
-		}
-
-		create_info.EnabledLayerCount = len(validationLayers)
-		create_info.PpEnabledLayerNames = validationLayers
-	} else {
-		create_info.EnabledLayerCount = 0
-	}
-
-	var instance vulkan.VkInstance
-	var err = vulkan.VkCreateInstance(&create_info, nil, &instance)
-	if vulkan.VK_SUCCESS != err {
-		// TODO
-		fmt.Println("VkCreateInstance failed")
+		builder.RequestValidation()
 	}
 
-	o.Instance = instance
-}
-
-func checkValidationLayerSupport(a []string) bool {
-
-	var cnt uint32
-	vulkan.VkEnumerateInstanceLayerProperties(&cnt, nil)
-
-	var a1 = make([]vulkan.VkLayerProperties, cnt)
-	vulkan.VkEnumerateInstanceLayerProperties(&cnt, a1)
-
-	fmt.Printf("%v available layers\n", cnt)
-	for i := 0; i < int(cnt); i++ {
-		var p = a1[i]
-		fmt.Printf("\t#%v: %v\n", i, p.LayerName)
+	var instance, err = builder.Build()
+	if nil != err {
+		panic(err)
 	}
 
-	for i := 0; i < len(a); i++ {
-		var name = a[i]
-
-		var b = false
-		for j := 0; j < len(a1); j++ {
-			var prop = &a1[j]
-			if name == prop.LayerName {
-				b = true
-				break
-			}
-		} // for j
+	fmt.Printf("Vulkan instance %v.%v, extensions %v\n", vulkan.VK_API_VERSION_MAJOR(instance.APIVersion),
+		vulkan.VK_API_VERSION_MINOR(instance.APIVersion), instance.Extensions)
+	fmt.Print(bootstrap.Report(instance.Optional))
 
-		if !b {
-			return false
-		}
-	} // for i
-
-	return true
+	o.Bootstrap = instance
+	o.Instance = instance.Handle
 }
 
 func (o *HelloTriangleApplication) createSurface() {
 
 	var surface vulkan.VkSurfaceKHR
 
-	if !sdl2.SDL_Vulkan_CreateSurface(o.Window, o.Instance, &surface) {
-		// TODO
-		fmt.Println("SDL_Vulkan_CreateSurface() failed")
+	if err := sdl2.SDL_Vulkan_CreateSurface(o.Window, o.Instance, &surface); nil != err {
+		panic(err)
 	}
 
 	o.Surface = surface
@@ -259,159 +212,66 @@ func (o *HelloTriangleApplication) pickPhysicalDevice(
 	swap_chain_support *SwapChainSupportDetails,
 ) {
 
-	var devices []vulkan.VkPhysicalDevice
-	{
-		var cnt uint32
-		vulkan.VkEnumeratePhysicalDevices(o.Instance, &cnt, nil)
-
-		fmt.Printf("%v physical devices\n", cnt)
-
-		if 0 == cnt {
-			// TODO
-			fmt.Println("No physical device")
-		}
-
-		devices = make([]vulkan.VkPhysicalDevice, cnt)
-		vulkan.VkEnumeratePhysicalDevices(o.Instance, &cnt, devices)
+	var req = selector.Requirements{
+		Extensions: deviceExtensions,
+		QueueFlags: vulkan.VkQueueFlags(vulkan.VK_QUEUE_GRAPHICS_BIT),
+		Present:    true,
 	}
 
-	for _, d := range devices {
-		if o.isDeviceSuitable(d, queue_families, swap_chain_support) {
-			o.PhysicalDevice = d
-			return
+	if "" != *profileFile {
+		var p, err = loadProfile(*profileFile, *profileName)
+		if nil != err {
+			panic(err)
 		}
+		req.Checks = append(req.Checks, p.Reasons)
 	}
 
-	// TODO
-	fmt.Println("No suitable device")
-	panic("no suitable device")
-}
-
-func (o *HelloTriangleApplication) isDeviceSuitable(
-	device vulkan.VkPhysicalDevice,
-	queue_families *QueueFamilyIndices,
-	swap_chain_support *SwapChainSupportDetails,
-) bool {
-
-	var prop vulkan.VkPhysicalDeviceProperties
-	var feat vulkan.VkPhysicalDeviceFeatures
+	var best, ranked, err = selector.Select(o.Instance, o.Surface, &req,
+		selector.DefaultPreferences, selector.OverrideFromEnv(*deviceOverride))
 
-	vulkan.VkGetPhysicalDeviceProperties(device, &prop)
-	vulkan.VkGetPhysicalDeviceFeatures(device, &feat)
-
-	// Dedicated graphics card
-	if vulkan.VK_PHYSICAL_DEVICE_TYPE_DISCRETE_GPU != prop.DeviceType {
-		fmt.Println("VK_PHYSICAL_DEVICE_TYPE_DISCRETE_GPU not available")
-		return false
+	if nil != ranked {
+		fmt.Print(selector.Report(ranked))
 	}
 
-	// Support geometry shader
-	if !feat.GeometryShader {
-		fmt.Println("GeometryShader feature not available")
-		return false
+	if nil != err {
+		panic(err)
 	}
 
-	if !findQueueFamilies(device, o.Surface, queue_families) {
-		fmt.Println("Required queue families not avaible")
-		return false
-	}
+	fmt.Printf("Using %v\n", best.Device)
 
-	if !checkDeviceExtensionSupport(device) {
-		fmt.Println("Device extensions not avaible")
-		return false
-	}
+	o.Selected = best.Device
+	o.PhysicalDevice = best.Handle
 
-	querySwapChainSupport(device, o.Surface, swap_chain_support)
-	if 0 == len(swap_chain_support.Formats) && 0 == len(swap_chain_support.PresentModes) {
-		fmt.Println("Swap chian support not available")
-		return false
-	}
-
-	return true
+	findQueueFamilies(best.Device, queue_families)
+	querySwapChainSupport(o.PhysicalDevice, o.Surface, swap_chain_support)
 }
 
-func findQueueFamilies(device vulkan.VkPhysicalDevice, surface vulkan.VkSurfaceKHR, queue_families *QueueFamilyIndices) bool {
-
-	var cnt uint32
-	vulkan.VkGetPhysicalDeviceQueueFamilyProperties(device, &cnt, nil)
+func loadProfile(path, name string) (*profiles.Profile, error) {
 
-	var a = make([]vulkan.VkQueueFamilyProperties, cnt)
-	vulkan.VkGetPhysicalDeviceQueueFamilyProperties(device, &cnt, a)
-
-	if 0 == cnt {
-		// TODO
-		fmt.Println("No queue families")
-		return false
+	var f, err = profiles.LoadFile(path)
+	if nil != err {
+		return nil, err
 	}
 
-	queue_families.GraphicsFamily = -1
-	queue_families.PresentFamily = -1
-
-	for i, prop := range a {
-
-		if 0 != prop.QueueFlags&vulkan.VkQueueFlags(vulkan.VK_QUEUE_GRAPHICS_BIT) {
-			queue_families.GraphicsFamily = i
-		}
-
-		var b = false
-		vulkan.VkGetPhysicalDeviceSurfaceSupportKHR(device, i, surface, &b)
-		if b {
-			queue_families.PresentFamily = i
-		}
-
-		if -1 != queue_families.GraphicsFamily && -1 != queue_families.PresentFamily {
-
-			// TODO
-			fmt.Printf("Graphics queue family = %v, present queue faimly = %v\n",
-				queue_families.GraphicsFamily, queue_families.PresentFamily)
-
-			return true
-		}
-	} // for
-
-	return false
+	return f.Profile(name)
 }
 
-func checkDeviceExtensionSupport(device vulkan.VkPhysicalDevice) bool {
-
-	var cnt int
-	vulkan.VkEnumerateDeviceExtensionProperties(device, nil, &cnt, nil)
-
-	var a = make([]vulkan.VkExtensionProperties, cnt)
-	vulkan.VkEnumerateDeviceExtensionProperties(device, nil, &cnt, a)
-
-	fmt.Printf("%v avaible device extensions: \n", cnt)
-	{
-		const max = 10
-		for i, p := range a {
+func findQueueFamilies(device *selector.Device, queue_families *QueueFamilyIndices) {
 
-			if i >= max {
-				fmt.Println("\t...")
-				break
-			}
+	var plan, err = bootstrap.PlanQueues(device, []bootstrap.QueueRequest{
+		bootstrap.GraphicsQueue,
+		bootstrap.PresentQueue,
+	})
 
-			fmt.Printf("\t#%v: %v\n", i, p.ExtensionName)
-		}
+	if nil != err {
+		panic(err)
 	}
 
-	for _, name := range deviceExtensions {
+	fmt.Print(plan)
 
-		var exists = false
-		for _, prop := range a {
-			if name == prop.ExtensionName {
-				exists = true
-				break
-			}
-		} // for
-
-		if !exists {
-			fmt.Printf("Device extension %v not avaible\n", name)
-			return false
-		}
-
-	} // for
-
-	return true
+	queue_families.Plan = plan
+	queue_families.GraphicsFamily = plan.Family("graphics")
+	queue_families.PresentFamily = plan.Family("present")
 }
 
 func querySwapChainSupport(device vulkan.VkPhysicalDevice, surface vulkan.VkSurfaceKHR, swap_chain_support *SwapChainSupportDetails) {
@@ -452,72 +312,22 @@ func querySwapChainSupport(device vulkan.VkPhysicalDevice, surface vulkan.VkSurf
 
 func (o *HelloTriangleApplication) createLogicalDevice(queue_families *QueueFamilyIndices) {
 
-	var create_info vulkan.VkDeviceCreateInfo
-
-	// Queue create info -----
-
-	var queue_create_infos []vulkan.VkDeviceQueueCreateInfo
-	{
-		var indices []int
-
-		if queue_families.GraphicsFamily == queue_families.PresentFamily {
-			indices = make([]int, 1)
-			indices[0] = queue_families.GraphicsFamily
-		} else {
-			indices = make([]int, 2)
-			indices[0] = queue_families.GraphicsFamily
-			indices[1] = queue_families.PresentFamily
-		}
+	var device, err = bootstrap.NewDeviceBuilder(o.Selected).
+		APIVersion(o.Bootstrap.APIVersion).
+		QueuePlan(queue_families.Plan).
+		RequireExtensions(deviceExtensions...).
+		RequestFeatures(vulkan.MustParseFeatureSet("samplerAnisotropy")).
+		Build()
 
-		var queue_create_infos = make([]vulkan.VkDeviceQueueCreateInfo, len(indices))
-
-		const queue_prio float32 = 1.0
-
-		for i, family := range indices {
-			var p1 = &queue_create_infos[i]
-			p1.QueueFamilyIndex = family
-			p1.QueueCount = 1
-			p1.PQueuePriorities = []float32{queue_prio}
-		} // for
-	}
-
-	create_info.PQueueCreateInfos = queue_create_infos
-	create_info.QueueCreateInfoCount = len(queue_create_infos)
-
-	// Device features -----
-
-	var features vulkan.VkPhysicalDeviceFeatures
-	create_info.PEnabledFeatures = &features
-
-	// Enable extensions ------
-
-	create_info.PpEnabledExtensionNames = deviceExtensions // Global variable
-	create_info.EnabledExtensionCount = len(deviceExtensions)
-
-	// Create logical device -----
-
-	var device vulkan.VkDevice
-	var err = vulkan.VkCreateDevice(o.PhysicalDevice, &create_info, nil, &device)
-	if vulkan.VK_SUCCESS != err {
-		// TODO
-		fmt.Println("VkCreateDevice() failed")
+	if nil != err {
+		panic(err)
 	}
 
-	// Look up queues -----
-
-	var graph_queue, present_queue vulkan.VkQueue
-	vulkan.VkGetDeviceQueue(device, queue_families.GraphicsFamily, 0, &graph_queue)
+	fmt.Print(device.Requirements)
 
-	if queue_families.GraphicsFamily == queue_families.PresentFamily {
-		present_queue = graph_queue
-	} else {
-		vulkan.VkGetDeviceQueue(device, queue_families.PresentFamily, 0, &present_queue)
-	}
-
-	//
-	o.Device = device
-	o.GraphicsQueue = graph_queue
-	o.PresentQueue = present_queue
+	o.Device = device.Handle
+	o.GraphicsQueue = device.Role("graphics")
+	o.PresentQueue = device.Role("present")
 }
 
 func (o *HelloTriangleApplication) createSwapChain(
@@ -676,15 +486,89 @@ func (o *HelloTriangleApplication) createImageView(image vulkan.VkImage, image_v
 
 }
 
-func (o *HelloTriangleApplication) createGraphicsPipeline() {
+func (o *HelloTriangleApplication) createPipelineCache() {
+
+	var props vulkan.VkPhysicalDeviceProperties
+	vulkan.VkGetPhysicalDeviceProperties(o.PhysicalDevice, &props)
+
+	var cache, err = pipelinecache.Open(o.Device, &props, pipelinecache.DefaultDir())
+	if nil != err {
+		fmt.Println(err)
+		return
+	}
+
+	if nil != cache.Rejected {
+		fmt.Printf("Pipeline cache not loaded: %v\n", cache.Rejected)
+	}
+
+	o.PipelineCache = cache
+}
+
+// watchShaders compiles the shader sources instead of using the embedded
+// SPIR-V and rebuilds the pipeline from reloadFrame when they change.
+func (o *HelloTriangleApplication) watchShaders() {
 
-	var vert_shader, frag_shader = o.createShaderModule("shaders/vert.spv"),
-		o.createShaderModule("shaders/frag.spv")
+	const vert, frag = "shaders/shader.vert", "shaders/shader.frag"
 
-	defer func() {
-		vulkan.VkDestroyShaderModule(o.Device, vert_shader, nil)
-		vulkan.VkDestroyShaderModule(o.Device, frag_shader, nil)
-	}()
+	var reloader = shaderc.NewReloader(&shaderc.Options{CacheDir: shaderc.DefaultCacheDir()})
+
+	var _, err = reloader.Add([]string{vert, frag}, func(spirv map[string][]byte) error {
+		return o.createGraphicsPipeline(spirv[vert], spirv[frag])
+	})
+	if nil != err {
+		panic(err)
+	}
+
+	o.Shaders = reloader
+}
+
+// reloadFrame returns the frame callback of -dev: it polls the shaders a few
+// times a second and shows in the title whether the pipeline is current. A
+// failed rebuild is logged by the reloader, the last good pipeline stays.
+func (o *HelloTriangleApplication) reloadFrame() func() bool {
+
+	var clock = sdl2.NewFrameClock()
+	clock.MaxFPS = 10
+
+	var shown string
+
+	return func() bool {
+
+		clock.Tick()
+
+		if o.Shaders.Update() {
+			fmt.Println("Shaders reloaded")
+		}
+
+		var title = "Triangle"
+		if err := o.Shaders.Err(); nil != err {
+			title = "Triangle (shader error, see log)"
+		}
+
+		if title != shown {
+			sdl2.SDL_SetWindowTitle(o.Window, title)
+			shown = title
+		}
+
+		return true
+	}
+}
+
+// createGraphicsPipeline builds the pipeline from the SPIR-V of the shaders.
+// On error the current pipeline is left in place.
+func (o *HelloTriangleApplication) createGraphicsPipeline(vert_code, frag_code []byte) error {
+
+	var vert_shader, err = o.createShaderModule(vert_code)
+	if nil != err {
+		return err
+	}
+	defer vulkan.VkDestroyShaderModule(o.Device, vert_shader, nil)
+
+	frag_shader, err := o.createShaderModule(frag_code)
+	if nil != err {
+		return err
+	}
+	defer vulkan.VkDestroyShaderModule(o.Device, frag_shader, nil)
 
 	var shader_stages = make([]vulkan.VkPipelineShaderStageCreateInfo, 2)
 	var name = "main"
@@ -705,28 +589,24 @@ func (o *HelloTriangleApplication) createGraphicsPipeline() {
 		create_info.PName = &name
 	}
 
+	return nil
 }
 
-func (o *HelloTriangleApplication) createShaderModule(file string) vulkan.VkShaderModule {
-
-	var code, err = os.ReadFile(file)
-	if nil != err {
-		fmt.Printf("Cannot read file: %v\n", file)
-	}
+func (o *HelloTriangleApplication) createShaderModule(code []byte) (vulkan.VkShaderModule, error) {
 
 	var create_info vulkan.VkShaderModuleCreateInfo
 	create_info.CodeSize = len(code)
 	create_info.PCode = code
 
 	var shader vulkan.VkShaderModule
-	if vulkan.VK_SUCCESS != vulkan.VkCreateShaderModule(
+	if res := vulkan.VkCreateShaderModule(
 		o.Device,
 		&create_info,
 		nil,
 		&shader,
-	) {
-		fmt.Println("VkCreateShaderModule() failed")
+	); vulkan.VK_SUCCESS != res {
+		return shader, fmt.Errorf("vkCreateShaderModule failed: %v", res)
 	}
 
-	return shader
+	return shader, nil
 }
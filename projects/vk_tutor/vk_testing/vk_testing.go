@@ -13,9 +13,14 @@ func main() {
 	runtime.LockOSThread()
 
 	sdl2.SDL_SetMainReady()
-	sdl2.SDL_Init(sdl2.SDL_INIT_EVERYTHING)
+	if err := sdl2.SDL_Init(sdl2.SDL_INIT_EVERYTHING); nil != err {
+		panic(err)
+	}
 
-	var window = sdl2.SDL_CreateWindow("Vulkan window", 100, 100, 640, 480, sdl2.SDL_WINDOW_SHOWN|sdl2.SDL_WINDOW_VULKAN)
+	var window, err = sdl2.SDL_CreateWindow("Vulkan window", 100, 100, 640, 480, sdl2.SDL_WINDOW_SHOWN|sdl2.SDL_WINDOW_VULKAN)
+	if nil != err {
+		panic(err)
+	}
 
 	var ext_cnt uint32
 	vulkan.VkEnumerateInstanceExtensionProperties(nil, &ext_cnt, nil)
@@ -29,17 +34,12 @@ func main() {
 
 func untilQuit() {
 
-	var e sdl2.SDL_Event
+	var loop, err = sdl2.NewEventLoop()
+	if nil != err {
+		panic(err)
+	}
 
-loop:
-	for {
-		if 0 == sdl2.SDL_PollEvent(&e) {
-			continue
-		}
-
-		switch e.Type() {
-		case sdl2.SDL_QUIT:
-			break loop
-		} // switch
-	} // for
+	if err := loop.Run(); nil != err {
+		panic(err)
+	}
 }
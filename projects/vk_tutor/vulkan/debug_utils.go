@@ -0,0 +1,46 @@
+package vulkan
+
+// Messengers, including the one chained into VkInstanceCreateInfo, call
+// _vkDebugUtilsMessengerCallback of vulkan_core.go, which forwards to
+// goDebugUtilsMessengerCallback below.
+
+// #include "vulkan.h"
+import "C"
+
+import (
+	"runtime/cgo"
+	"sync"
+	"unsafe"
+)
+
+// Callback handles of the live messengers, keyed by C.VkDebugUtilsMessengerEXT
+var debugUtilsMessengers sync.Map
+
+// Callback handles of messengers chained into VkInstanceCreateInfo, keyed by
+// C.VkInstance
+var instanceMessengers sync.Map
+
+//export goDebugUtilsMessengerCallback
+func goDebugUtilsMessengerCallback(
+	messageSeverity C.VkDebugUtilsMessageSeverityFlagBitsEXT,
+	messageTypes C.VkDebugUtilsMessageTypeFlagsEXT,
+	pCallbackData *C.VkDebugUtilsMessengerCallbackDataEXT,
+	pUserData unsafe.Pointer,
+) C.VkBool32 {
+
+	var f, ok = cgo.Handle(uintptr(pUserData)).Value().(PFN_vkDebugUtilsMessengerCallbackEXT)
+	if !ok || nil == f {
+		return C.VK_FALSE
+	}
+
+	var data VkDebugUtilsMessengerCallbackDataEXT
+	if nil != pCallbackData {
+		data.copyFromCObj(unsafe.Pointer(pCallbackData))
+	}
+
+	if f(VkDebugUtilsMessageSeverityFlagBitsEXT(messageSeverity), VkDebugUtilsMessageTypeFlagsEXT(messageTypes), &data) {
+		return C.VK_TRUE
+	}
+
+	return C.VK_FALSE
+}
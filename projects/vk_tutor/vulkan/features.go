@@ -0,0 +1,261 @@
+package vulkan
+
+import (
+	"fmt"
+	"math/bits"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Feature is a device feature, a VkBool32 member of one of the feature
+// structures, e.g. VkPhysicalDeviceFeatures.samplerAnisotropy.
+type Feature int
+
+type featureInfo struct {
+	name      string // Member name, e.g. "samplerAnisotropy"
+	structure string
+}
+
+// featureStructs are the structures whose members are Features, in Feature
+// order. Structures bound later are appended.
+var featureStructs = []reflect.Type{
+	reflect.TypeOf(VkPhysicalDeviceFeatures{}),
+}
+
+var (
+	featureTable  []featureInfo
+	featureByName = make(map[string]Feature) // Lower case
+	featureBase   = make(map[reflect.Type]Feature)
+)
+
+func init() {
+
+	for _, t := range featureStructs {
+		featureBase[t] = Feature(len(featureTable))
+		for i := 0; i < t.NumField(); i++ {
+			var f = t.Field(i)
+			if reflect.Bool != f.Type.Kind() {
+				panic("vulkan: feature " + t.Name() + "." + f.Name + " is not a bool")
+			}
+			var c, n = utf8.DecodeRuneInString(f.Name)
+			var name = string(unicode.ToLower(c)) + f.Name[n:]
+			featureByName[strings.ToLower(name)] = Feature(len(featureTable))
+			featureTable = append(featureTable, featureInfo{name, t.Name()})
+		}
+	} // for
+
+	if len(featureTable) > len(FeatureSet{}.bits)*64 {
+		panic("vulkan: FeatureSet too small")
+	}
+}
+
+// String returns the member name, e.g. "samplerAnisotropy".
+func (f Feature) String() string {
+	if f < 0 || int(f) >= len(featureTable) {
+		return fmt.Sprintf("Feature(%d)", int(f))
+	}
+	return featureTable[f].name
+}
+
+// Structure returns the name of the structure f is a member of, "" for an
+// unknown feature.
+func (f Feature) Structure() string {
+	if f < 0 || int(f) >= len(featureTable) {
+		return ""
+	}
+	return featureTable[f].structure
+}
+
+// LookupFeature finds a feature by member name, e.g. "samplerAnisotropy".
+// The case is ignored, so the Go field name works too.
+func LookupFeature(name string) (Feature, bool) {
+	var f, ok = featureByName[strings.ToLower(name)]
+	return f, ok
+}
+
+// AllFeatures returns the set of every known feature.
+func AllFeatures() FeatureSet {
+	var r FeatureSet
+	for f := range featureTable {
+		r.Add(Feature(f))
+	}
+	return r
+}
+
+// FeatureSet is a set of features. The zero value is empty, sets compare
+// with ==.
+type FeatureSet struct {
+	bits [4]uint64
+}
+
+// NewFeatureSet returns the set of the given features.
+func NewFeatureSet(features ...Feature) FeatureSet {
+	var r FeatureSet
+	r.Add(features...)
+	return r
+}
+
+// ParseFeatureSet parses feature names separated by commas or white space,
+// see LookupFeature.
+func ParseFeatureSet(s string) (FeatureSet, error) {
+
+	var r FeatureSet
+
+	var names = strings.FieldsFunc(s, func(c rune) bool { return ',' == c || unicode.IsSpace(c) })
+	for _, name := range names {
+		var f, ok = LookupFeature(name)
+		if !ok {
+			return FeatureSet{}, fmt.Errorf("unknown feature %q", name)
+		}
+		r.Add(f)
+	}
+
+	return r, nil
+}
+
+// MustParseFeatureSet is ParseFeatureSet panicking on errors, for sets
+// written in the source.
+func MustParseFeatureSet(s string) FeatureSet {
+	var r, err = ParseFeatureSet(s)
+	if nil != err {
+		panic("vulkan: " + err.Error())
+	}
+	return r
+}
+
+func (o *FeatureSet) Add(features ...Feature) {
+	for _, f := range features {
+		o.bits[f/64] |= 1 << (f % 64)
+	}
+}
+
+func (o *FeatureSet) Remove(features ...Feature) {
+	for _, f := range features {
+		o.bits[f/64] &^= 1 << (f % 64)
+	}
+}
+
+func (o FeatureSet) Has(f Feature) bool {
+	return 0 != o.bits[f/64]&(1<<(f%64))
+}
+
+// Union returns the features in o or b.
+func (o FeatureSet) Union(b FeatureSet) FeatureSet {
+	for i := range o.bits {
+		o.bits[i] |= b.bits[i]
+	}
+	return o
+}
+
+// Intersect returns the features in both o and b.
+func (o FeatureSet) Intersect(b FeatureSet) FeatureSet {
+	for i := range o.bits {
+		o.bits[i] &= b.bits[i]
+	}
+	return o
+}
+
+// Difference returns the features in o but not in b, e.g. the required
+// features a device lacks.
+func (o FeatureSet) Difference(b FeatureSet) FeatureSet {
+	for i := range o.bits {
+		o.bits[i] &^= b.bits[i]
+	}
+	return o
+}
+
+func (o FeatureSet) IsEmpty() bool {
+	return FeatureSet{} == o
+}
+
+func (o FeatureSet) Len() int {
+	var n int
+	for _, w := range o.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Features lists the features of the set, in structure order.
+func (o FeatureSet) Features() []Feature {
+
+	var r []Feature
+	for f := range featureTable {
+		if o.Has(Feature(f)) {
+			r = append(r, Feature(f))
+		}
+	}
+
+	return r
+}
+
+// Names lists the member names of the features of the set.
+func (o FeatureSet) Names() []string {
+
+	var r []string
+	for _, f := range o.Features() {
+		r = append(r, f.String())
+	}
+
+	return r
+}
+
+// String returns the names joined by ", ".
+func (o FeatureSet) String() string {
+	return strings.Join(o.Names(), ", ")
+}
+
+func (o FeatureSet) MarshalText() ([]byte, error) {
+	return []byte(strings.Join(o.Names(), ",")), nil
+}
+
+func (o *FeatureSet) UnmarshalText(b []byte) error {
+	var s, err = ParseFeatureSet(string(b))
+	if nil != err {
+		return err
+	}
+	*o = s
+	return nil
+}
+
+// FeatureSet returns the features set to true.
+func (o VkPhysicalDeviceFeatures) FeatureSet() FeatureSet {
+	return featureSetOf(&o)
+}
+
+// VkPhysicalDeviceFeatures returns the structure with the features of the
+// set to true, features of other structures are left out.
+func (o FeatureSet) VkPhysicalDeviceFeatures() VkPhysicalDeviceFeatures {
+	var r VkPhysicalDeviceFeatures
+	o.fill(&r)
+	return r
+}
+
+// featureSetOf converts a pointer to a feature structure.
+func featureSetOf(p interface{}) FeatureSet {
+
+	var r FeatureSet
+	var v = reflect.ValueOf(p).Elem()
+	var base = featureBase[v.Type()]
+
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).Bool() {
+			r.Add(base + Feature(i))
+		}
+	}
+
+	return r
+}
+
+// fill sets the members of the feature structure at p.
+func (o FeatureSet) fill(p interface{}) {
+
+	var v = reflect.ValueOf(p).Elem()
+	var base = featureBase[v.Type()]
+
+	for i := 0; i < v.NumField(); i++ {
+		v.Field(i).SetBool(o.Has(base + Feature(i)))
+	}
+}
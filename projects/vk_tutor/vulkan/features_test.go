@@ -0,0 +1,110 @@
+package vulkan
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFeature(t *testing.T) {
+
+	var f, ok = LookupFeature("samplerAnisotropy")
+	if !ok || "samplerAnisotropy" != f.String() || "VkPhysicalDeviceFeatures" != f.Structure() {
+		t.Errorf("LookupFeature = %v %q %q", ok, f, f.Structure())
+	}
+
+	// The Go field name and any case find the same feature
+	for _, name := range []string{"SamplerAnisotropy", "SAMPLERANISOTROPY", "sampleranisotropy"} {
+		if g, ok := LookupFeature(name); !ok || f != g {
+			t.Errorf("LookupFeature(%q) = %v, %v", name, g, ok)
+		}
+	} // for
+
+	if _, ok = LookupFeature("samplerAnisotropyX"); ok {
+		t.Error("found an unknown feature")
+	}
+
+	for _, f := range []Feature{-1, Feature(len(featureTable))} {
+		if "" != f.Structure() || !strings.HasPrefix(f.String(), "Feature(") {
+			t.Errorf("%d: %q in %q", int(f), f, f.Structure())
+		}
+	} // for
+}
+
+func TestFeatureSet(t *testing.T) {
+
+	var a = MustParseFeatureSet("robustBufferAccess geometryShader samplerAnisotropy")
+	var b = MustParseFeatureSet("geometryShader,wideLines")
+
+	for _, c := range []struct {
+		name string
+		got  FeatureSet
+		want string
+	}{
+		{"union", a.Union(b), "robustBufferAccess, geometryShader, wideLines, samplerAnisotropy"},
+		{"intersect", a.Intersect(b), "geometryShader"},
+		{"difference", a.Difference(b), "robustBufferAccess, samplerAnisotropy"},
+		{"empty", a.Difference(a), ""},
+	} {
+		if s := c.got.String(); c.want != s {
+			t.Errorf("%v: %q, want %q", c.name, s, c.want)
+		}
+	} // for
+
+	// The operations return new sets
+	if 3 != a.Len() || 2 != b.Len() {
+		t.Errorf("operands changed: %v; %v", a, b)
+	}
+
+	var s = NewFeatureSet(a.Features()...)
+	if a != s {
+		t.Errorf("NewFeatureSet(Features()) = %v, want %v", s, a)
+	}
+	s.Remove(a.Features()...)
+	if !s.IsEmpty() || 0 != s.Len() {
+		t.Errorf("%v left after Remove", s)
+	}
+
+	if all := AllFeatures(); len(featureTable) != all.Len() || all.Difference(a).Has(a.Features()[0]) {
+		t.Errorf("AllFeatures has %v features", all.Len())
+	}
+
+	var features = VkPhysicalDeviceFeatures{GeometryShader: true, WideLines: true}
+	if features.FeatureSet() != b || b.VkPhysicalDeviceFeatures() != features {
+		t.Errorf("VkPhysicalDeviceFeatures round trip: %v", features.FeatureSet())
+	}
+}
+
+func TestParseFeatureSet(t *testing.T) {
+
+	var s, err = ParseFeatureSet(" SamplerAnisotropy,\tGEOMETRYSHADER ,, ")
+	if nil != err {
+		t.Fatal(err)
+	}
+	if want := []string{"geometryShader", "samplerAnisotropy"}; !reflect.DeepEqual(want, s.Names()) {
+		t.Errorf("names %v, want %v", s.Names(), want)
+	}
+
+	if e, err := ParseFeatureSet(""); nil != err || !e.IsEmpty() {
+		t.Errorf("empty string: %v, %v", e, err)
+	}
+
+	if _, err = ParseFeatureSet("geometryShader, meshShader"); nil == err || !strings.Contains(err.Error(), `"meshShader"`) {
+		t.Errorf("unknown name: %v", err)
+	}
+
+	var b []byte
+	if b, err = s.MarshalText(); nil != err || "geometryShader,samplerAnisotropy" != string(b) {
+		t.Errorf("MarshalText = %q, %v", b, err)
+	}
+
+	var r FeatureSet
+	if err = r.UnmarshalText(b); nil != err || s != r {
+		t.Errorf("UnmarshalText(%q) = %v, %v", b, r, err)
+	}
+
+	// A bad name leaves the set alone
+	if err = r.UnmarshalText([]byte("wideLines,bogus")); nil == err || s != r {
+		t.Errorf("UnmarshalText with an unknown name: %v, set %v", err, r)
+	}
+}
@@ -0,0 +1,91 @@
+package vulkan
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// PCI vendor IDs found in VkPhysicalDeviceProperties.VendorID. Vendors
+// without one use the Khronos IDs of VkVendorId.
+const (
+	VendorAMD       uint32 = 0x1002
+	VendorImgTec    uint32 = 0x1010
+	VendorApple     uint32 = 0x106b
+	VendorNVIDIA    uint32 = 0x10de
+	VendorARM       uint32 = 0x13b5
+	VendorMicrosoft uint32 = 0x1414
+	VendorSamsung   uint32 = 0x144d
+	VendorBroadcom  uint32 = 0x14e4
+	VendorGoogle    uint32 = 0x1ae0 // SwiftShader
+	VendorQualcomm  uint32 = 0x5143
+	VendorIntel     uint32 = 0x8086
+)
+
+var vendorNames = map[uint32]string{
+	VendorAMD:       "AMD",
+	VendorImgTec:    "Imagination Technologies",
+	VendorApple:     "Apple",
+	VendorNVIDIA:    "NVIDIA",
+	VendorARM:       "ARM",
+	VendorMicrosoft: "Microsoft",
+	VendorSamsung:   "Samsung",
+	VendorBroadcom:  "Broadcom",
+	VendorGoogle:    "Google",
+	VendorQualcomm:  "Qualcomm",
+	VendorIntel:     "Intel",
+
+	uint32(VK_VENDOR_ID_VIV):      "Vivante",
+	uint32(VK_VENDOR_ID_VSI):      "VeriSilicon",
+	uint32(VK_VENDOR_ID_KAZAN):    "Kazan",
+	uint32(VK_VENDOR_ID_CODEPLAY): "Codeplay",
+	uint32(VK_VENDOR_ID_MESA):     "Mesa",
+	uint32(VK_VENDOR_ID_POCL):     "PoCL",
+	uint32(VK_VENDOR_ID_MOBILEYE): "Mobileye",
+}
+
+// VendorName returns e.g. "NVIDIA" for 0x10de, the ID in hex for unknown
+// vendors.
+func VendorName(vendorID uint32) string {
+	if s, ok := vendorNames[vendorID]; ok {
+		return s
+	}
+	return fmt.Sprintf("0x%04x", vendorID)
+}
+
+// DriverVersion decodes VkPhysicalDeviceProperties.DriverVersion, whose
+// packing is up to the vendor, into the numbers its vendor publishes.
+func DriverVersion(vendorID, driverVersion uint32) []uint32 {
+	return decodeDriverVersion(runtime.GOOS, vendorID, driverVersion)
+}
+
+// decodeDriverVersion is DriverVersion for drivers running on goos.
+func decodeDriverVersion(goos string, vendorID, v uint32) []uint32 {
+
+	switch {
+
+	// 10.8.8.6 bits, e.g. 537.58.0.0
+	case VendorNVIDIA == vendorID:
+		return []uint32{v >> 22, (v >> 14) & 0xff, (v >> 6) & 0xff, v & 0x3f}
+
+	// 18.14 bits on Windows, e.g. 101.4502; Mesa on Linux uses the
+	// Vulkan packing
+	case VendorIntel == vendorID && "windows" == goos:
+		return []uint32{v >> 14, v & 0x3fff}
+	} // switch
+
+	return []uint32{VK_API_VERSION_MAJOR(v), VK_API_VERSION_MINOR(v), VK_API_VERSION_PATCH(v)}
+}
+
+// DriverVersionString returns DriverVersion joined by dots.
+func DriverVersionString(vendorID, driverVersion uint32) string {
+
+	var s string
+	for i, n := range DriverVersion(vendorID, driverVersion) {
+		if i > 0 {
+			s += "."
+		}
+		s += fmt.Sprint(n)
+	}
+
+	return s
+}
@@ -0,0 +1,33 @@
+package vulkan
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDriverVersion(t *testing.T) {
+
+	for _, c := range []struct {
+		goos     string
+		vendorID uint32
+		v        uint32
+		want     []uint32
+	}{
+		// 10.8.8.6 bits
+		{"linux", VendorNVIDIA, 537<<22 | 58<<14, []uint32{537, 58, 0, 0}},
+		{"windows", VendorNVIDIA, 1023<<22 | 255<<14 | 255<<6 | 63, []uint32{1023, 255, 255, 63}},
+		// 18.14 bits on Windows only
+		{"windows", VendorIntel, 101<<14 | 4502, []uint32{101, 4502}},
+		{"windows", VendorIntel, 0x3ffff<<14 | 0x3fff, []uint32{0x3ffff, 0x3fff}},
+		{"linux", VendorIntel, VK_MAKE_API_VERSION(0, 23, 1, 4), []uint32{23, 1, 4}},
+		{"windows", VendorAMD, VK_MAKE_API_VERSION(0, 2, 0, 279), []uint32{2, 0, 279}},
+	} {
+		if got := decodeDriverVersion(c.goos, c.vendorID, c.v); !reflect.DeepEqual(c.want, got) {
+			t.Errorf("%v %v %#x: %v, want %v", c.goos, VendorName(c.vendorID), c.v, got, c.want)
+		}
+	} // for
+
+	if s := DriverVersionString(VendorNVIDIA, 537<<22|58<<14); "537.58.0.0" != s {
+		t.Errorf("DriverVersionString = %q", s)
+	}
+}
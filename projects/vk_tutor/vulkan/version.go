@@ -0,0 +1,76 @@
+package vulkan
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a version number packed like VK_MAKE_API_VERSION, e.g.
+// VkPhysicalDeviceProperties.ApiVersion. Versions of the same variant
+// compare with < and >.
+type Version uint32
+
+// MakeVersion is VK_MAKE_API_VERSION as a Version.
+func MakeVersion(variant, major, minor, patch uint32) Version {
+	return Version(VK_MAKE_API_VERSION(variant, major, minor, patch))
+}
+
+func (v Version) Variant() uint32 {
+	return VK_API_VERSION_VARIANT(uint32(v))
+}
+
+func (v Version) Major() uint32 {
+	return VK_API_VERSION_MAJOR(uint32(v))
+}
+
+func (v Version) Minor() uint32 {
+	return VK_API_VERSION_MINOR(uint32(v))
+}
+
+func (v Version) Patch() uint32 {
+	return VK_API_VERSION_PATCH(uint32(v))
+}
+
+// String returns "major.minor.patch", prefixed by "variant:" when the
+// variant is not 0.
+func (v Version) String() string {
+	var s = fmt.Sprintf("%v.%v.%v", v.Major(), v.Minor(), v.Patch())
+	if 0 != v.Variant() {
+		s = fmt.Sprintf("%v:%v", v.Variant(), s)
+	}
+	return s
+}
+
+// ParseVersion parses what String returns. The patch may be left out.
+func ParseVersion(s string) (Version, error) {
+
+	var variant uint64
+	var rest = s
+
+	if i := strings.IndexByte(s, ':'); i >= 0 {
+		var err error
+		if variant, err = strconv.ParseUint(s[:i], 10, 3); nil != err {
+			return 0, fmt.Errorf("bad version %q", s)
+		}
+		rest = s[i+1:]
+	}
+
+	var parts = strings.Split(rest, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("bad version %q", s)
+	}
+
+	// Field widths of VK_MAKE_API_VERSION
+	var bits = [3]int{7, 10, 12}
+	var n [3]uint64
+
+	for i, p := range parts {
+		var err error
+		if n[i], err = strconv.ParseUint(p, 10, bits[i]); nil != err {
+			return 0, fmt.Errorf("bad version %q", s)
+		}
+	}
+
+	return MakeVersion(uint32(variant), uint32(n[0]), uint32(n[1]), uint32(n[2])), nil
+}
@@ -0,0 +1,59 @@
+package vulkan
+
+import "testing"
+
+func TestVersionRoundTrip(t *testing.T) {
+
+	for _, c := range []struct {
+		v Version
+		s string
+	}{
+		{MakeVersion(0, 1, 0, 0), "1.0.0"},
+		{MakeVersion(0, 1, 3, 250), "1.3.250"},
+		{MakeVersion(1, 1, 2, 0), "1:1.2.0"},
+		// Largest value of every field
+		{MakeVersion(7, 127, 1023, 4095), "7:127.1023.4095"},
+	} {
+		if s := c.v.String(); c.s != s {
+			t.Errorf("String() = %q, want %q", s, c.s)
+		}
+
+		var v, err = ParseVersion(c.s)
+		if nil != err {
+			t.Errorf("ParseVersion(%q): %v", c.s, err)
+		} else if c.v != v {
+			t.Errorf("ParseVersion(%q) = %v, want %v", c.s, v, c.v)
+		}
+	} // for
+}
+
+func TestParseVersion(t *testing.T) {
+
+	if v, err := ParseVersion("1.2"); nil != err || MakeVersion(0, 1, 2, 0) != v {
+		t.Errorf("ParseVersion(\"1.2\") = %v, %v", v, err)
+	}
+
+	// Compares like the packed numbers
+	if a, b := MakeVersion(0, 1, 2, 4095), MakeVersion(0, 1, 3, 0); !(a < b) {
+		t.Errorf("%v not before %v", a, b)
+	}
+
+	for _, s := range []string{
+		"",
+		"1",
+		"1.2.3.4",
+		"a.b",
+		"-1.0",
+		"1..0",
+		":1.0",
+		"8:1.0.0",  // Variant has 3 bits
+		"128.0.0",  // Major has 7 bits
+		"1.1024.0", // Minor has 10 bits
+		"1.0.4096", // Patch has 12 bits
+		"1:2:1.0.0",
+	} {
+		if v, err := ParseVersion(s); nil == err {
+			t.Errorf("ParseVersion(%q) = %v, want an error", s, v)
+		}
+	} // for
+}
@@ -1,7 +1,8 @@
 package vulkan
 
 // #cgo CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/vulkan
-// #cgo LDFLAGS: -lvulkan-1.dll
+// #cgo windows LDFLAGS: -lvulkan-1.dll
+// #cgo linux LDFLAGS: -lvulkan
 // #include "vulkan.h"
 // #include <stdlib.h>
 import "C"
@@ -1,13 +1,60 @@
 package vulkan
 
 // #cgo CFLAGS: -I${SRCDIR}/include -I${SRCDIR}/include/vulkan
-// #cgo LDFLAGS: -lvulkan-1.dll
+// #cgo windows LDFLAGS: -lvulkan-1.dll
+// #cgo linux LDFLAGS: -lvulkan
 // #include "vulkan.h"
 // #include <stdlib.h>
+//
+// // Commands the loader may not export (Vulkan 1.1+, extensions) are
+// // resolved through vkGetInstanceProcAddr.
+//
+// static VkResult _vkEnumerateInstanceVersion(uint32_t* pApiVersion) {
+//     PFN_vkEnumerateInstanceVersion f = (PFN_vkEnumerateInstanceVersion)vkGetInstanceProcAddr(NULL, "vkEnumerateInstanceVersion");
+//     if (NULL == f) {
+//         // Vulkan 1.0 loader
+//         *pApiVersion = VK_API_VERSION_1_0;
+//         return VK_SUCCESS;
+//     }
+//     return f(pApiVersion);
+// }
+//
+// extern VkBool32 goDebugUtilsMessengerCallback(VkDebugUtilsMessageSeverityFlagBitsEXT, VkDebugUtilsMessageTypeFlagsEXT, VkDebugUtilsMessengerCallbackDataEXT*, void*);
+//
+// static VkBool32 VKAPI_PTR _vkDebugUtilsMessengerCallback(
+//     VkDebugUtilsMessageSeverityFlagBitsEXT messageSeverity,
+//     VkDebugUtilsMessageTypeFlagsEXT messageTypes,
+//     const VkDebugUtilsMessengerCallbackDataEXT* pCallbackData,
+//     void* pUserData) {
+//     return goDebugUtilsMessengerCallback(messageSeverity, messageTypes, (VkDebugUtilsMessengerCallbackDataEXT*)pCallbackData, pUserData);
+// }
+//
+// static VkResult _vkCreateDebugUtilsMessengerEXT(VkInstance instance, VkDebugUtilsMessengerCreateInfoEXT* pCreateInfo, VkDebugUtilsMessengerEXT* pMessenger) {
+//     PFN_vkCreateDebugUtilsMessengerEXT f = (PFN_vkCreateDebugUtilsMessengerEXT)vkGetInstanceProcAddr(instance, "vkCreateDebugUtilsMessengerEXT");
+//     if (NULL == f) {
+//         return VK_ERROR_EXTENSION_NOT_PRESENT;
+//     }
+//     if (NULL != pCreateInfo->pUserData) {
+//         pCreateInfo->pfnUserCallback = _vkDebugUtilsMessengerCallback;
+//     }
+//     return f(instance, pCreateInfo, NULL, pMessenger);
+// }
+//
+// static void _vkDestroyDebugUtilsMessengerEXT(VkInstance instance, VkDebugUtilsMessengerEXT messenger) {
+//     PFN_vkDestroyDebugUtilsMessengerEXT f = (PFN_vkDestroyDebugUtilsMessengerEXT)vkGetInstanceProcAddr(instance, "vkDestroyDebugUtilsMessengerEXT");
+//     if (NULL != f) {
+//         f(instance, messenger, NULL);
+//     }
+// }
+//
+// static void* _vkHandleToPointer(uintptr_t h) {
+//     return (void*)h;
+// }
 import "C"
 
 import (
 	"example.com/vk_tutor/vulkan/internal"
+	"runtime/cgo"
 	"unsafe"
 )
 
@@ -89,9 +136,25 @@ func VK_MAKE_VERSION(major, minor, patch uint32) uint32 {
 // #define VK_VERSION_PATCH(version) ((uint32_t)(version) & 0xFFFU)
 
 // #define VK_API_VERSION_VARIANT(version) ((uint32_t)(version) >> 29U)
+func VK_API_VERSION_VARIANT(version uint32) uint32 {
+	return version >> 29
+}
+
 // #define VK_API_VERSION_MAJOR(version) (((uint32_t)(version) >> 22U) & 0x7FU)
+func VK_API_VERSION_MAJOR(version uint32) uint32 {
+	return (version >> 22) & 0x7f
+}
+
 // #define VK_API_VERSION_MINOR(version) (((uint32_t)(version) >> 12U) & 0x3FFU)
+func VK_API_VERSION_MINOR(version uint32) uint32 {
+	return (version >> 12) & 0x3ff
+}
+
 // #define VK_API_VERSION_PATCH(version) ((uint32_t)(version) & 0xFFFU)
+func VK_API_VERSION_PATCH(version uint32) uint32 {
+	return version & 0xfff
+}
+
 // typedef uint32_t VkBool32;
 // typedef uint64_t VkDeviceAddress;
 
@@ -142,6 +205,8 @@ type VkImageView internal.CHandleWrapper[C.VkImageView]
 type VkShaderModule internal.CHandleWrapper[C.VkShaderModule]
 
 // VK_DEFINE_NON_DISPATCHABLE_HANDLE(VkPipelineCache)
+type VkPipelineCache internal.CHandleWrapper[C.VkPipelineCache]
+
 // VK_DEFINE_NON_DISPATCHABLE_HANDLE(VkPipelineLayout)
 // VK_DEFINE_NON_DISPATCHABLE_HANDLE(VkPipeline)
 // VK_DEFINE_NON_DISPATCHABLE_HANDLE(VkRenderPass)
@@ -248,7 +313,7 @@ const (
 	//     VK_TIMEOUT = 2,
 	//     VK_EVENT_SET = 3,
 	//     VK_EVENT_RESET = 4,
-	//     VK_INCOMPLETE = 5,
+	VK_INCOMPLETE VkResult = C.VK_INCOMPLETE
 	//     VK_ERROR_OUT_OF_HOST_MEMORY = -1,
 	//     VK_ERROR_OUT_OF_DEVICE_MEMORY = -2,
 	//     VK_ERROR_INITIALIZATION_FAILED = -3,
@@ -1362,9 +1427,9 @@ const (
 	//	VK_STRUCTURE_TYPE_BUFFER_CREATE_INFO = 12,
 	//	VK_STRUCTURE_TYPE_BUFFER_VIEW_CREATE_INFO = 13,
 	//	VK_STRUCTURE_TYPE_IMAGE_CREATE_INFO = 14,
-	VK_STRUCTURE_TYPE_IMAGE_VIEW_CREATE_INFO    VkStructureType = C.VK_STRUCTURE_TYPE_IMAGE_VIEW_CREATE_INFO
-	VK_STRUCTURE_TYPE_SHADER_MODULE_CREATE_INFO VkStructureType = C.VK_STRUCTURE_TYPE_SHADER_MODULE_CREATE_INFO
-	//	VK_STRUCTURE_TYPE_PIPELINE_CACHE_CREATE_INFO = 17,
+	VK_STRUCTURE_TYPE_IMAGE_VIEW_CREATE_INFO            VkStructureType = C.VK_STRUCTURE_TYPE_IMAGE_VIEW_CREATE_INFO
+	VK_STRUCTURE_TYPE_SHADER_MODULE_CREATE_INFO         VkStructureType = C.VK_STRUCTURE_TYPE_SHADER_MODULE_CREATE_INFO
+	VK_STRUCTURE_TYPE_PIPELINE_CACHE_CREATE_INFO        VkStructureType = C.VK_STRUCTURE_TYPE_PIPELINE_CACHE_CREATE_INFO
 	VK_STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_CREATE_INFO VkStructureType = C.VK_STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_CREATE_INFO
 	//	VK_STRUCTURE_TYPE_PIPELINE_VERTEX_INPUT_STATE_CREATE_INFO = 19,
 	//	VK_STRUCTURE_TYPE_PIPELINE_INPUT_ASSEMBLY_STATE_CREATE_INFO = 20,
@@ -1563,7 +1628,8 @@ const (
 	//	VK_STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_4_PROPERTIES = 1000413001,
 	//	VK_STRUCTURE_TYPE_DEVICE_BUFFER_MEMORY_REQUIREMENTS = 1000413002,
 	//	VK_STRUCTURE_TYPE_DEVICE_IMAGE_MEMORY_REQUIREMENTS = 1000413003,
-	VK_STRUCTURE_TYPE_SWAPCHAIN_CREATE_INFO_KHR VkStructureType = C.VK_STRUCTURE_TYPE_SWAPCHAIN_CREATE_INFO_KHR
+	VK_STRUCTURE_TYPE_SWAPCHAIN_CREATE_INFO_KHR             VkStructureType = C.VK_STRUCTURE_TYPE_SWAPCHAIN_CREATE_INFO_KHR
+	VK_STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CREATE_INFO_EXT VkStructureType = C.VK_STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CREATE_INFO_EXT
 
 //	VK_STRUCTURE_TYPE_PRESENT_INFO_KHR = 1000001001,
 //	VK_STRUCTURE_TYPE_DEVICE_GROUP_PRESENT_CAPABILITIES_KHR = 1000060007,
@@ -1795,7 +1861,6 @@ const (
 //	VK_STRUCTURE_TYPE_DEBUG_UTILS_OBJECT_TAG_INFO_EXT = 1000128001,
 //	VK_STRUCTURE_TYPE_DEBUG_UTILS_LABEL_EXT = 1000128002,
 //	VK_STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CALLBACK_DATA_EXT = 1000128003,
-//	VK_STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CREATE_INFO_EXT = 1000128004,
 //	VK_STRUCTURE_TYPE_ANDROID_HARDWARE_BUFFER_USAGE_ANDROID = 1000129000,
 //	VK_STRUCTURE_TYPE_ANDROID_HARDWARE_BUFFER_PROPERTIES_ANDROID = 1000129001,
 //	VK_STRUCTURE_TYPE_ANDROID_HARDWARE_BUFFER_FORMAT_PROPERTIES_ANDROID = 1000129002,
@@ -2392,6 +2457,13 @@ const (
 //     VK_PIPELINE_CACHE_HEADER_VERSION_MAX_ENUM = 0x7FFFFFFF
 // } VkPipelineCacheHeaderVersion;
 
+type VkPipelineCacheHeaderVersion int
+
+const (
+	VK_PIPELINE_CACHE_HEADER_VERSION_ONE      VkPipelineCacheHeaderVersion = C.VK_PIPELINE_CACHE_HEADER_VERSION_ONE
+	VK_PIPELINE_CACHE_HEADER_VERSION_MAX_ENUM VkPipelineCacheHeaderVersion = C.VK_PIPELINE_CACHE_HEADER_VERSION_MAX_ENUM
+)
+
 // typedef enum VkImageLayout {
 //     VK_IMAGE_LAYOUT_UNDEFINED = 0,
 //     VK_IMAGE_LAYOUT_GENERAL = 1,
@@ -2494,6 +2566,8 @@ const (
 //     VK_OBJECT_TYPE_MAX_ENUM = 0x7FFFFFFF
 // } VkObjectType;
 
+type VkObjectType int
+
 // typedef enum VkVendorId {
 //     VK_VENDOR_ID_VIV = 0x10001,
 //     VK_VENDOR_ID_VSI = 0x10002,
@@ -2505,6 +2579,19 @@ const (
 //     VK_VENDOR_ID_MAX_ENUM = 0x7FFFFFFF
 // } VkVendorId;
 
+type VkVendorId int
+
+const (
+	VK_VENDOR_ID_VIV      VkVendorId = C.VK_VENDOR_ID_VIV
+	VK_VENDOR_ID_VSI      VkVendorId = C.VK_VENDOR_ID_VSI
+	VK_VENDOR_ID_KAZAN    VkVendorId = C.VK_VENDOR_ID_KAZAN
+	VK_VENDOR_ID_CODEPLAY VkVendorId = C.VK_VENDOR_ID_CODEPLAY
+	VK_VENDOR_ID_MESA     VkVendorId = C.VK_VENDOR_ID_MESA
+	VK_VENDOR_ID_POCL     VkVendorId = C.VK_VENDOR_ID_POCL
+	VK_VENDOR_ID_MOBILEYE VkVendorId = C.VK_VENDOR_ID_MOBILEYE
+	VK_VENDOR_ID_MAX_ENUM VkVendorId = C.VK_VENDOR_ID_MAX_ENUM
+)
+
 // typedef enum VkSystemAllocationScope {
 //     VK_SYSTEM_ALLOCATION_SCOPE_COMMAND = 0,
 //     VK_SYSTEM_ALLOCATION_SCOPE_OBJECT = 1,
@@ -2827,254 +2914,254 @@ const (
 type VkFormat int
 
 const (
-	VK_FORMAT_UNDEFINED VkFormat = C.VK_FORMAT_UNDEFINED
-	// VK_FORMAT_R4G4_UNORM_PACK8 VkFormat=C.
-	// VK_FORMAT_R4G4B4A4_UNORM_PACK16 VkFormat=C.
-	// VK_FORMAT_B4G4R4A4_UNORM_PACK16 VkFormat=C.
-	// VK_FORMAT_R5G6B5_UNORM_PACK16 VkFormat=C.
-	// VK_FORMAT_B5G6R5_UNORM_PACK16 VkFormat=C.
-	// VK_FORMAT_R5G5B5A1_UNORM_PACK16 VkFormat=C.
-	// VK_FORMAT_B5G5R5A1_UNORM_PACK16 VkFormat=C.
-	// VK_FORMAT_A1R5G5B5_UNORM_PACK16 VkFormat=C.
-	// VK_FORMAT_R8_UNORM VkFormat=C.
-	// VK_FORMAT_R8_SNORM VkFormat=C.
-	// VK_FORMAT_R8_USCALED VkFormat=C.
-	// VK_FORMAT_R8_SSCALED VkFormat=C.
-	// VK_FORMAT_R8_UINT VkFormat=C.
-	// VK_FORMAT_R8_SINT VkFormat=C.
-	// VK_FORMAT_R8_SRGB VkFormat=C.
-	// VK_FORMAT_R8G8_UNORM VkFormat=C.
-	// VK_FORMAT_R8G8_SNORM VkFormat=C.
-	// VK_FORMAT_R8G8_USCALED VkFormat=C.
-	// VK_FORMAT_R8G8_SSCALED VkFormat=C.
-	// VK_FORMAT_R8G8_UINT VkFormat=C.
-	// VK_FORMAT_R8G8_SINT VkFormat=C.
-	// VK_FORMAT_R8G8_SRGB VkFormat=C.
-	// VK_FORMAT_R8G8B8_UNORM VkFormat=C.
-	// VK_FORMAT_R8G8B8_SNORM VkFormat=C.
-	// VK_FORMAT_R8G8B8_USCALED VkFormat=C.
-	// VK_FORMAT_R8G8B8_SSCALED VkFormat=C.
-	// VK_FORMAT_R8G8B8_UINT VkFormat=C.
-	// VK_FORMAT_R8G8B8_SINT VkFormat=C.
-	// VK_FORMAT_R8G8B8_SRGB VkFormat=C.
-	// VK_FORMAT_B8G8R8_UNORM VkFormat=C.
-	// VK_FORMAT_B8G8R8_SNORM VkFormat=C.
-	// VK_FORMAT_B8G8R8_USCALED VkFormat=C.
-	// VK_FORMAT_B8G8R8_SSCALED VkFormat=C.
-	// VK_FORMAT_B8G8R8_UINT VkFormat=C.
-	// VK_FORMAT_B8G8R8_SINT VkFormat=C.
-	// VK_FORMAT_B8G8R8_SRGB VkFormat=C.
-	// VK_FORMAT_R8G8B8A8_UNORM VkFormat=C.
-	// VK_FORMAT_R8G8B8A8_SNORM VkFormat=C.
-	// VK_FORMAT_R8G8B8A8_USCALED VkFormat=C.
-	// VK_FORMAT_R8G8B8A8_SSCALED VkFormat=C.
-	// VK_FORMAT_R8G8B8A8_UINT VkFormat=C.
-	// VK_FORMAT_R8G8B8A8_SINT VkFormat=C.
-	// VK_FORMAT_R8G8B8A8_SRGB VkFormat=C.
-	// VK_FORMAT_B8G8R8A8_UNORM VkFormat=C.
-	// VK_FORMAT_B8G8R8A8_SNORM VkFormat=C.
-	// VK_FORMAT_B8G8R8A8_USCALED VkFormat=C.
-	// VK_FORMAT_B8G8R8A8_SSCALED VkFormat=C.
-	// VK_FORMAT_B8G8R8A8_UINT VkFormat=C.
-	// VK_FORMAT_B8G8R8A8_SINT VkFormat=C.
-	VK_FORMAT_B8G8R8A8_SRGB VkFormat = C.VK_FORMAT_B8G8R8A8_SRGB
-	// VK_FORMAT_A8B8G8R8_UNORM_PACK32 VkFormat=C.
-	// VK_FORMAT_A8B8G8R8_SNORM_PACK32 VkFormat=C.
-	// VK_FORMAT_A8B8G8R8_USCALED_PACK32 VkFormat=C.
-	// VK_FORMAT_A8B8G8R8_SSCALED_PACK32 VkFormat=C.
-	// VK_FORMAT_A8B8G8R8_UINT_PACK32 VkFormat=C.
-	// VK_FORMAT_A8B8G8R8_SINT_PACK32 VkFormat=C.
-	// VK_FORMAT_A8B8G8R8_SRGB_PACK32 VkFormat=C.
-	// VK_FORMAT_A2R10G10B10_UNORM_PACK32 VkFormat=C.
-	// VK_FORMAT_A2R10G10B10_SNORM_PACK32 VkFormat=C.
-	// VK_FORMAT_A2R10G10B10_USCALED_PACK32 VkFormat=C.
-	// VK_FORMAT_A2R10G10B10_SSCALED_PACK32 VkFormat=C.
-	// VK_FORMAT_A2R10G10B10_UINT_PACK32 VkFormat=C.
-	// VK_FORMAT_A2R10G10B10_SINT_PACK32 VkFormat=C.
-	// VK_FORMAT_A2B10G10R10_UNORM_PACK32 VkFormat=C.
-	// VK_FORMAT_A2B10G10R10_SNORM_PACK32 VkFormat=C.
-	// VK_FORMAT_A2B10G10R10_USCALED_PACK32 VkFormat=C.
-	// VK_FORMAT_A2B10G10R10_SSCALED_PACK32 VkFormat=C.
-	// VK_FORMAT_A2B10G10R10_UINT_PACK32 VkFormat=C.
-	// VK_FORMAT_A2B10G10R10_SINT_PACK32 VkFormat=C.
-	// VK_FORMAT_R16_UNORM VkFormat=C.
-	// VK_FORMAT_R16_SNORM VkFormat=C.
-	// VK_FORMAT_R16_USCALED VkFormat=C.
-	// VK_FORMAT_R16_SSCALED VkFormat=C.
-	// VK_FORMAT_R16_UINT VkFormat=C.
-	// VK_FORMAT_R16_SINT VkFormat=C.
-	// VK_FORMAT_R16_SFLOAT VkFormat=C.
-	// VK_FORMAT_R16G16_UNORM VkFormat=C.
-	// VK_FORMAT_R16G16_SNORM VkFormat=C.
-	// VK_FORMAT_R16G16_USCALED VkFormat=C.
-	// VK_FORMAT_R16G16_SSCALED VkFormat=C.
-	// VK_FORMAT_R16G16_UINT VkFormat=C.
-	// VK_FORMAT_R16G16_SINT VkFormat=C.
-	// VK_FORMAT_R16G16_SFLOAT VkFormat=C.
-	// VK_FORMAT_R16G16B16_UNORM VkFormat=C.
-	// VK_FORMAT_R16G16B16_SNORM VkFormat=C.
-	// VK_FORMAT_R16G16B16_USCALED VkFormat=C.
-	// VK_FORMAT_R16G16B16_SSCALED VkFormat=C.
-	// VK_FORMAT_R16G16B16_UINT VkFormat=C.
-	// VK_FORMAT_R16G16B16_SINT VkFormat=C.
-	// VK_FORMAT_R16G16B16_SFLOAT VkFormat=C.
-	// VK_FORMAT_R16G16B16A16_UNORM VkFormat=C.
-	// VK_FORMAT_R16G16B16A16_SNORM VkFormat=C.
-	// VK_FORMAT_R16G16B16A16_USCALED VkFormat=C.
-	// VK_FORMAT_R16G16B16A16_SSCALED VkFormat=C.
-	// VK_FORMAT_R16G16B16A16_UINT VkFormat=C.
-	// VK_FORMAT_R16G16B16A16_SINT VkFormat=C.
-	// VK_FORMAT_R16G16B16A16_SFLOAT VkFormat=C.
-	// VK_FORMAT_R32_UINT VkFormat=C.
-	// VK_FORMAT_R32_SINT VkFormat=C.
-	// VK_FORMAT_R32_SFLOAT VkFormat=C.
-	// VK_FORMAT_R32G32_UINT VkFormat=C.
-	// VK_FORMAT_R32G32_SINT VkFormat=C.
-	// VK_FORMAT_R32G32_SFLOAT VkFormat=C.
-	// VK_FORMAT_R32G32B32_UINT VkFormat=C.
-	// VK_FORMAT_R32G32B32_SINT VkFormat=C.
-	// VK_FORMAT_R32G32B32_SFLOAT VkFormat=C.
-	// VK_FORMAT_R32G32B32A32_UINT VkFormat=C.
-	// VK_FORMAT_R32G32B32A32_SINT VkFormat=C.
-	// VK_FORMAT_R32G32B32A32_SFLOAT VkFormat=C.
-	// VK_FORMAT_R64_UINT VkFormat=C.
-	// VK_FORMAT_R64_SINT VkFormat=C.
-	// VK_FORMAT_R64_SFLOAT VkFormat=C.
-	// VK_FORMAT_R64G64_UINT VkFormat=C.
-	// VK_FORMAT_R64G64_SINT VkFormat=C.
-	// VK_FORMAT_R64G64_SFLOAT VkFormat=C.
-	// VK_FORMAT_R64G64B64_UINT VkFormat=C.
-	// VK_FORMAT_R64G64B64_SINT VkFormat=C.
-	// VK_FORMAT_R64G64B64_SFLOAT VkFormat=C.
-	// VK_FORMAT_R64G64B64A64_UINT VkFormat=C.
-	// VK_FORMAT_R64G64B64A64_SINT VkFormat=C.
-	// VK_FORMAT_R64G64B64A64_SFLOAT VkFormat=C.
-	// VK_FORMAT_B10G11R11_UFLOAT_PACK32 VkFormat=C.
-	// VK_FORMAT_E5B9G9R9_UFLOAT_PACK32 VkFormat=C.
-	// VK_FORMAT_D16_UNORM VkFormat=C.
-	// VK_FORMAT_X8_D24_UNORM_PACK32 VkFormat=C.
-	// VK_FORMAT_D32_SFLOAT VkFormat=C.
-	// VK_FORMAT_S8_UINT VkFormat=C.
-	// VK_FORMAT_D16_UNORM_S8_UINT VkFormat=C.
-	// VK_FORMAT_D24_UNORM_S8_UINT VkFormat=C.
-	// VK_FORMAT_D32_SFLOAT_S8_UINT VkFormat=C.
-	// VK_FORMAT_BC1_RGB_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_BC1_RGB_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_BC1_RGBA_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_BC1_RGBA_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_BC2_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_BC2_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_BC3_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_BC3_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_BC4_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_BC4_SNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_BC5_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_BC5_SNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_BC6H_UFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_BC6H_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_BC7_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_BC7_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ETC2_R8G8B8_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ETC2_R8G8B8_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ETC2_R8G8B8A1_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ETC2_R8G8B8A1_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ETC2_R8G8B8A8_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ETC2_R8G8B8A8_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_EAC_R11_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_EAC_R11_SNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_EAC_R11G11_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_EAC_R11G11_SNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_4x4_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_4x4_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_5x4_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_5x4_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_5x5_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_5x5_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_6x5_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_6x5_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_6x6_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_6x6_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_8x5_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_8x5_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_8x6_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_8x6_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_8x8_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_8x8_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_10x5_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_10x5_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_10x6_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_10x6_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_10x8_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_10x8_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_10x10_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_10x10_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_12x10_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_12x10_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_12x12_UNORM_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_12x12_SRGB_BLOCK VkFormat=C.
-	// VK_FORMAT_G8B8G8R8_422_UNORM VkFormat=C.
-	// VK_FORMAT_B8G8R8G8_422_UNORM VkFormat=C.
-	// VK_FORMAT_G8_B8_R8_3PLANE_420_UNORM VkFormat=C.
-	// VK_FORMAT_G8_B8R8_2PLANE_420_UNORM VkFormat=C.
-	// VK_FORMAT_G8_B8_R8_3PLANE_422_UNORM VkFormat=C.
-	// VK_FORMAT_G8_B8R8_2PLANE_422_UNORM VkFormat=C.
-	// VK_FORMAT_G8_B8_R8_3PLANE_444_UNORM VkFormat=C.
-	// VK_FORMAT_R10X6_UNORM_PACK16 VkFormat=C.
-	// VK_FORMAT_R10X6G10X6_UNORM_2PACK16 VkFormat=C.
-	// VK_FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16 VkFormat=C.
-	// VK_FORMAT_G10X6B10X6G10X6R10X6_422_UNORM_4PACK16 VkFormat=C.
-	// VK_FORMAT_B10X6G10X6R10X6G10X6_422_UNORM_4PACK16 VkFormat=C.
-	// VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_420_UNORM_3PACK16 VkFormat=C.
-	// VK_FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16 VkFormat=C.
-	// VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_422_UNORM_3PACK16 VkFormat=C.
-	// VK_FORMAT_G10X6_B10X6R10X6_2PLANE_422_UNORM_3PACK16 VkFormat=C.
-	// VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_444_UNORM_3PACK16 VkFormat=C.
-	// VK_FORMAT_R12X4_UNORM_PACK16 VkFormat=C.
-	// VK_FORMAT_R12X4G12X4_UNORM_2PACK16 VkFormat=C.
-	// VK_FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16 VkFormat=C.
-	// VK_FORMAT_G12X4B12X4G12X4R12X4_422_UNORM_4PACK16 VkFormat=C.
-	// VK_FORMAT_B12X4G12X4R12X4G12X4_422_UNORM_4PACK16 VkFormat=C.
-	// VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_420_UNORM_3PACK16 VkFormat=C.
-	// VK_FORMAT_G12X4_B12X4R12X4_2PLANE_420_UNORM_3PACK16 VkFormat=C.
-	// VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_422_UNORM_3PACK16 VkFormat=C.
-	// VK_FORMAT_G12X4_B12X4R12X4_2PLANE_422_UNORM_3PACK16 VkFormat=C.
-	// VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_444_UNORM_3PACK16 VkFormat=C.
-	// VK_FORMAT_G16B16G16R16_422_UNORM VkFormat=C.
-	// VK_FORMAT_B16G16R16G16_422_UNORM VkFormat=C.
-	// VK_FORMAT_G16_B16_R16_3PLANE_420_UNORM VkFormat=C.
-	// VK_FORMAT_G16_B16R16_2PLANE_420_UNORM VkFormat=C.
-	// VK_FORMAT_G16_B16_R16_3PLANE_422_UNORM VkFormat=C.
-	// VK_FORMAT_G16_B16R16_2PLANE_422_UNORM VkFormat=C.
-	// VK_FORMAT_G16_B16_R16_3PLANE_444_UNORM VkFormat=C.
-	// VK_FORMAT_G8_B8R8_2PLANE_444_UNORM VkFormat=C.
-	// VK_FORMAT_G10X6_B10X6R10X6_2PLANE_444_UNORM_3PACK16 VkFormat=C.
-	// VK_FORMAT_G12X4_B12X4R12X4_2PLANE_444_UNORM_3PACK16 VkFormat=C.
-	// VK_FORMAT_G16_B16R16_2PLANE_444_UNORM VkFormat=C.
-	// VK_FORMAT_A4R4G4B4_UNORM_PACK16 VkFormat=C.
-	// VK_FORMAT_A4B4G4R4_UNORM_PACK16 VkFormat=C.
-	// VK_FORMAT_ASTC_4x4_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_5x4_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_5x5_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_6x5_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_6x6_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_8x5_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_8x6_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_8x8_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_10x5_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_10x6_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_10x8_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_10x10_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_12x10_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_ASTC_12x12_SFLOAT_BLOCK VkFormat=C.
-	// VK_FORMAT_PVRTC1_2BPP_UNORM_BLOCK_IMG VkFormat=C.
-	// VK_FORMAT_PVRTC1_4BPP_UNORM_BLOCK_IMG VkFormat=C.
-	// VK_FORMAT_PVRTC2_2BPP_UNORM_BLOCK_IMG VkFormat=C.
-	// VK_FORMAT_PVRTC2_4BPP_UNORM_BLOCK_IMG VkFormat=C.
-	// VK_FORMAT_PVRTC1_2BPP_SRGB_BLOCK_IMG VkFormat=C.
-	// VK_FORMAT_PVRTC1_4BPP_SRGB_BLOCK_IMG VkFormat=C.
-	// VK_FORMAT_PVRTC2_2BPP_SRGB_BLOCK_IMG VkFormat=C.
-	// VK_FORMAT_PVRTC2_4BPP_SRGB_BLOCK_IMG VkFormat=C.
-	// VK_FORMAT_R16G16_S10_5_NV VkFormat=C.
+	VK_FORMAT_UNDEFINED                                  VkFormat = C.VK_FORMAT_UNDEFINED
+	VK_FORMAT_R4G4_UNORM_PACK8                           VkFormat = C.VK_FORMAT_R4G4_UNORM_PACK8
+	VK_FORMAT_R4G4B4A4_UNORM_PACK16                      VkFormat = C.VK_FORMAT_R4G4B4A4_UNORM_PACK16
+	VK_FORMAT_B4G4R4A4_UNORM_PACK16                      VkFormat = C.VK_FORMAT_B4G4R4A4_UNORM_PACK16
+	VK_FORMAT_R5G6B5_UNORM_PACK16                        VkFormat = C.VK_FORMAT_R5G6B5_UNORM_PACK16
+	VK_FORMAT_B5G6R5_UNORM_PACK16                        VkFormat = C.VK_FORMAT_B5G6R5_UNORM_PACK16
+	VK_FORMAT_R5G5B5A1_UNORM_PACK16                      VkFormat = C.VK_FORMAT_R5G5B5A1_UNORM_PACK16
+	VK_FORMAT_B5G5R5A1_UNORM_PACK16                      VkFormat = C.VK_FORMAT_B5G5R5A1_UNORM_PACK16
+	VK_FORMAT_A1R5G5B5_UNORM_PACK16                      VkFormat = C.VK_FORMAT_A1R5G5B5_UNORM_PACK16
+	VK_FORMAT_R8_UNORM                                   VkFormat = C.VK_FORMAT_R8_UNORM
+	VK_FORMAT_R8_SNORM                                   VkFormat = C.VK_FORMAT_R8_SNORM
+	VK_FORMAT_R8_USCALED                                 VkFormat = C.VK_FORMAT_R8_USCALED
+	VK_FORMAT_R8_SSCALED                                 VkFormat = C.VK_FORMAT_R8_SSCALED
+	VK_FORMAT_R8_UINT                                    VkFormat = C.VK_FORMAT_R8_UINT
+	VK_FORMAT_R8_SINT                                    VkFormat = C.VK_FORMAT_R8_SINT
+	VK_FORMAT_R8_SRGB                                    VkFormat = C.VK_FORMAT_R8_SRGB
+	VK_FORMAT_R8G8_UNORM                                 VkFormat = C.VK_FORMAT_R8G8_UNORM
+	VK_FORMAT_R8G8_SNORM                                 VkFormat = C.VK_FORMAT_R8G8_SNORM
+	VK_FORMAT_R8G8_USCALED                               VkFormat = C.VK_FORMAT_R8G8_USCALED
+	VK_FORMAT_R8G8_SSCALED                               VkFormat = C.VK_FORMAT_R8G8_SSCALED
+	VK_FORMAT_R8G8_UINT                                  VkFormat = C.VK_FORMAT_R8G8_UINT
+	VK_FORMAT_R8G8_SINT                                  VkFormat = C.VK_FORMAT_R8G8_SINT
+	VK_FORMAT_R8G8_SRGB                                  VkFormat = C.VK_FORMAT_R8G8_SRGB
+	VK_FORMAT_R8G8B8_UNORM                               VkFormat = C.VK_FORMAT_R8G8B8_UNORM
+	VK_FORMAT_R8G8B8_SNORM                               VkFormat = C.VK_FORMAT_R8G8B8_SNORM
+	VK_FORMAT_R8G8B8_USCALED                             VkFormat = C.VK_FORMAT_R8G8B8_USCALED
+	VK_FORMAT_R8G8B8_SSCALED                             VkFormat = C.VK_FORMAT_R8G8B8_SSCALED
+	VK_FORMAT_R8G8B8_UINT                                VkFormat = C.VK_FORMAT_R8G8B8_UINT
+	VK_FORMAT_R8G8B8_SINT                                VkFormat = C.VK_FORMAT_R8G8B8_SINT
+	VK_FORMAT_R8G8B8_SRGB                                VkFormat = C.VK_FORMAT_R8G8B8_SRGB
+	VK_FORMAT_B8G8R8_UNORM                               VkFormat = C.VK_FORMAT_B8G8R8_UNORM
+	VK_FORMAT_B8G8R8_SNORM                               VkFormat = C.VK_FORMAT_B8G8R8_SNORM
+	VK_FORMAT_B8G8R8_USCALED                             VkFormat = C.VK_FORMAT_B8G8R8_USCALED
+	VK_FORMAT_B8G8R8_SSCALED                             VkFormat = C.VK_FORMAT_B8G8R8_SSCALED
+	VK_FORMAT_B8G8R8_UINT                                VkFormat = C.VK_FORMAT_B8G8R8_UINT
+	VK_FORMAT_B8G8R8_SINT                                VkFormat = C.VK_FORMAT_B8G8R8_SINT
+	VK_FORMAT_B8G8R8_SRGB                                VkFormat = C.VK_FORMAT_B8G8R8_SRGB
+	VK_FORMAT_R8G8B8A8_UNORM                             VkFormat = C.VK_FORMAT_R8G8B8A8_UNORM
+	VK_FORMAT_R8G8B8A8_SNORM                             VkFormat = C.VK_FORMAT_R8G8B8A8_SNORM
+	VK_FORMAT_R8G8B8A8_USCALED                           VkFormat = C.VK_FORMAT_R8G8B8A8_USCALED
+	VK_FORMAT_R8G8B8A8_SSCALED                           VkFormat = C.VK_FORMAT_R8G8B8A8_SSCALED
+	VK_FORMAT_R8G8B8A8_UINT                              VkFormat = C.VK_FORMAT_R8G8B8A8_UINT
+	VK_FORMAT_R8G8B8A8_SINT                              VkFormat = C.VK_FORMAT_R8G8B8A8_SINT
+	VK_FORMAT_R8G8B8A8_SRGB                              VkFormat = C.VK_FORMAT_R8G8B8A8_SRGB
+	VK_FORMAT_B8G8R8A8_UNORM                             VkFormat = C.VK_FORMAT_B8G8R8A8_UNORM
+	VK_FORMAT_B8G8R8A8_SNORM                             VkFormat = C.VK_FORMAT_B8G8R8A8_SNORM
+	VK_FORMAT_B8G8R8A8_USCALED                           VkFormat = C.VK_FORMAT_B8G8R8A8_USCALED
+	VK_FORMAT_B8G8R8A8_SSCALED                           VkFormat = C.VK_FORMAT_B8G8R8A8_SSCALED
+	VK_FORMAT_B8G8R8A8_UINT                              VkFormat = C.VK_FORMAT_B8G8R8A8_UINT
+	VK_FORMAT_B8G8R8A8_SINT                              VkFormat = C.VK_FORMAT_B8G8R8A8_SINT
+	VK_FORMAT_B8G8R8A8_SRGB                              VkFormat = C.VK_FORMAT_B8G8R8A8_SRGB
+	VK_FORMAT_A8B8G8R8_UNORM_PACK32                      VkFormat = C.VK_FORMAT_A8B8G8R8_UNORM_PACK32
+	VK_FORMAT_A8B8G8R8_SNORM_PACK32                      VkFormat = C.VK_FORMAT_A8B8G8R8_SNORM_PACK32
+	VK_FORMAT_A8B8G8R8_USCALED_PACK32                    VkFormat = C.VK_FORMAT_A8B8G8R8_USCALED_PACK32
+	VK_FORMAT_A8B8G8R8_SSCALED_PACK32                    VkFormat = C.VK_FORMAT_A8B8G8R8_SSCALED_PACK32
+	VK_FORMAT_A8B8G8R8_UINT_PACK32                       VkFormat = C.VK_FORMAT_A8B8G8R8_UINT_PACK32
+	VK_FORMAT_A8B8G8R8_SINT_PACK32                       VkFormat = C.VK_FORMAT_A8B8G8R8_SINT_PACK32
+	VK_FORMAT_A8B8G8R8_SRGB_PACK32                       VkFormat = C.VK_FORMAT_A8B8G8R8_SRGB_PACK32
+	VK_FORMAT_A2R10G10B10_UNORM_PACK32                   VkFormat = C.VK_FORMAT_A2R10G10B10_UNORM_PACK32
+	VK_FORMAT_A2R10G10B10_SNORM_PACK32                   VkFormat = C.VK_FORMAT_A2R10G10B10_SNORM_PACK32
+	VK_FORMAT_A2R10G10B10_USCALED_PACK32                 VkFormat = C.VK_FORMAT_A2R10G10B10_USCALED_PACK32
+	VK_FORMAT_A2R10G10B10_SSCALED_PACK32                 VkFormat = C.VK_FORMAT_A2R10G10B10_SSCALED_PACK32
+	VK_FORMAT_A2R10G10B10_UINT_PACK32                    VkFormat = C.VK_FORMAT_A2R10G10B10_UINT_PACK32
+	VK_FORMAT_A2R10G10B10_SINT_PACK32                    VkFormat = C.VK_FORMAT_A2R10G10B10_SINT_PACK32
+	VK_FORMAT_A2B10G10R10_UNORM_PACK32                   VkFormat = C.VK_FORMAT_A2B10G10R10_UNORM_PACK32
+	VK_FORMAT_A2B10G10R10_SNORM_PACK32                   VkFormat = C.VK_FORMAT_A2B10G10R10_SNORM_PACK32
+	VK_FORMAT_A2B10G10R10_USCALED_PACK32                 VkFormat = C.VK_FORMAT_A2B10G10R10_USCALED_PACK32
+	VK_FORMAT_A2B10G10R10_SSCALED_PACK32                 VkFormat = C.VK_FORMAT_A2B10G10R10_SSCALED_PACK32
+	VK_FORMAT_A2B10G10R10_UINT_PACK32                    VkFormat = C.VK_FORMAT_A2B10G10R10_UINT_PACK32
+	VK_FORMAT_A2B10G10R10_SINT_PACK32                    VkFormat = C.VK_FORMAT_A2B10G10R10_SINT_PACK32
+	VK_FORMAT_R16_UNORM                                  VkFormat = C.VK_FORMAT_R16_UNORM
+	VK_FORMAT_R16_SNORM                                  VkFormat = C.VK_FORMAT_R16_SNORM
+	VK_FORMAT_R16_USCALED                                VkFormat = C.VK_FORMAT_R16_USCALED
+	VK_FORMAT_R16_SSCALED                                VkFormat = C.VK_FORMAT_R16_SSCALED
+	VK_FORMAT_R16_UINT                                   VkFormat = C.VK_FORMAT_R16_UINT
+	VK_FORMAT_R16_SINT                                   VkFormat = C.VK_FORMAT_R16_SINT
+	VK_FORMAT_R16_SFLOAT                                 VkFormat = C.VK_FORMAT_R16_SFLOAT
+	VK_FORMAT_R16G16_UNORM                               VkFormat = C.VK_FORMAT_R16G16_UNORM
+	VK_FORMAT_R16G16_SNORM                               VkFormat = C.VK_FORMAT_R16G16_SNORM
+	VK_FORMAT_R16G16_USCALED                             VkFormat = C.VK_FORMAT_R16G16_USCALED
+	VK_FORMAT_R16G16_SSCALED                             VkFormat = C.VK_FORMAT_R16G16_SSCALED
+	VK_FORMAT_R16G16_UINT                                VkFormat = C.VK_FORMAT_R16G16_UINT
+	VK_FORMAT_R16G16_SINT                                VkFormat = C.VK_FORMAT_R16G16_SINT
+	VK_FORMAT_R16G16_SFLOAT                              VkFormat = C.VK_FORMAT_R16G16_SFLOAT
+	VK_FORMAT_R16G16B16_UNORM                            VkFormat = C.VK_FORMAT_R16G16B16_UNORM
+	VK_FORMAT_R16G16B16_SNORM                            VkFormat = C.VK_FORMAT_R16G16B16_SNORM
+	VK_FORMAT_R16G16B16_USCALED                          VkFormat = C.VK_FORMAT_R16G16B16_USCALED
+	VK_FORMAT_R16G16B16_SSCALED                          VkFormat = C.VK_FORMAT_R16G16B16_SSCALED
+	VK_FORMAT_R16G16B16_UINT                             VkFormat = C.VK_FORMAT_R16G16B16_UINT
+	VK_FORMAT_R16G16B16_SINT                             VkFormat = C.VK_FORMAT_R16G16B16_SINT
+	VK_FORMAT_R16G16B16_SFLOAT                           VkFormat = C.VK_FORMAT_R16G16B16_SFLOAT
+	VK_FORMAT_R16G16B16A16_UNORM                         VkFormat = C.VK_FORMAT_R16G16B16A16_UNORM
+	VK_FORMAT_R16G16B16A16_SNORM                         VkFormat = C.VK_FORMAT_R16G16B16A16_SNORM
+	VK_FORMAT_R16G16B16A16_USCALED                       VkFormat = C.VK_FORMAT_R16G16B16A16_USCALED
+	VK_FORMAT_R16G16B16A16_SSCALED                       VkFormat = C.VK_FORMAT_R16G16B16A16_SSCALED
+	VK_FORMAT_R16G16B16A16_UINT                          VkFormat = C.VK_FORMAT_R16G16B16A16_UINT
+	VK_FORMAT_R16G16B16A16_SINT                          VkFormat = C.VK_FORMAT_R16G16B16A16_SINT
+	VK_FORMAT_R16G16B16A16_SFLOAT                        VkFormat = C.VK_FORMAT_R16G16B16A16_SFLOAT
+	VK_FORMAT_R32_UINT                                   VkFormat = C.VK_FORMAT_R32_UINT
+	VK_FORMAT_R32_SINT                                   VkFormat = C.VK_FORMAT_R32_SINT
+	VK_FORMAT_R32_SFLOAT                                 VkFormat = C.VK_FORMAT_R32_SFLOAT
+	VK_FORMAT_R32G32_UINT                                VkFormat = C.VK_FORMAT_R32G32_UINT
+	VK_FORMAT_R32G32_SINT                                VkFormat = C.VK_FORMAT_R32G32_SINT
+	VK_FORMAT_R32G32_SFLOAT                              VkFormat = C.VK_FORMAT_R32G32_SFLOAT
+	VK_FORMAT_R32G32B32_UINT                             VkFormat = C.VK_FORMAT_R32G32B32_UINT
+	VK_FORMAT_R32G32B32_SINT                             VkFormat = C.VK_FORMAT_R32G32B32_SINT
+	VK_FORMAT_R32G32B32_SFLOAT                           VkFormat = C.VK_FORMAT_R32G32B32_SFLOAT
+	VK_FORMAT_R32G32B32A32_UINT                          VkFormat = C.VK_FORMAT_R32G32B32A32_UINT
+	VK_FORMAT_R32G32B32A32_SINT                          VkFormat = C.VK_FORMAT_R32G32B32A32_SINT
+	VK_FORMAT_R32G32B32A32_SFLOAT                        VkFormat = C.VK_FORMAT_R32G32B32A32_SFLOAT
+	VK_FORMAT_R64_UINT                                   VkFormat = C.VK_FORMAT_R64_UINT
+	VK_FORMAT_R64_SINT                                   VkFormat = C.VK_FORMAT_R64_SINT
+	VK_FORMAT_R64_SFLOAT                                 VkFormat = C.VK_FORMAT_R64_SFLOAT
+	VK_FORMAT_R64G64_UINT                                VkFormat = C.VK_FORMAT_R64G64_UINT
+	VK_FORMAT_R64G64_SINT                                VkFormat = C.VK_FORMAT_R64G64_SINT
+	VK_FORMAT_R64G64_SFLOAT                              VkFormat = C.VK_FORMAT_R64G64_SFLOAT
+	VK_FORMAT_R64G64B64_UINT                             VkFormat = C.VK_FORMAT_R64G64B64_UINT
+	VK_FORMAT_R64G64B64_SINT                             VkFormat = C.VK_FORMAT_R64G64B64_SINT
+	VK_FORMAT_R64G64B64_SFLOAT                           VkFormat = C.VK_FORMAT_R64G64B64_SFLOAT
+	VK_FORMAT_R64G64B64A64_UINT                          VkFormat = C.VK_FORMAT_R64G64B64A64_UINT
+	VK_FORMAT_R64G64B64A64_SINT                          VkFormat = C.VK_FORMAT_R64G64B64A64_SINT
+	VK_FORMAT_R64G64B64A64_SFLOAT                        VkFormat = C.VK_FORMAT_R64G64B64A64_SFLOAT
+	VK_FORMAT_B10G11R11_UFLOAT_PACK32                    VkFormat = C.VK_FORMAT_B10G11R11_UFLOAT_PACK32
+	VK_FORMAT_E5B9G9R9_UFLOAT_PACK32                     VkFormat = C.VK_FORMAT_E5B9G9R9_UFLOAT_PACK32
+	VK_FORMAT_D16_UNORM                                  VkFormat = C.VK_FORMAT_D16_UNORM
+	VK_FORMAT_X8_D24_UNORM_PACK32                        VkFormat = C.VK_FORMAT_X8_D24_UNORM_PACK32
+	VK_FORMAT_D32_SFLOAT                                 VkFormat = C.VK_FORMAT_D32_SFLOAT
+	VK_FORMAT_S8_UINT                                    VkFormat = C.VK_FORMAT_S8_UINT
+	VK_FORMAT_D16_UNORM_S8_UINT                          VkFormat = C.VK_FORMAT_D16_UNORM_S8_UINT
+	VK_FORMAT_D24_UNORM_S8_UINT                          VkFormat = C.VK_FORMAT_D24_UNORM_S8_UINT
+	VK_FORMAT_D32_SFLOAT_S8_UINT                         VkFormat = C.VK_FORMAT_D32_SFLOAT_S8_UINT
+	VK_FORMAT_BC1_RGB_UNORM_BLOCK                        VkFormat = C.VK_FORMAT_BC1_RGB_UNORM_BLOCK
+	VK_FORMAT_BC1_RGB_SRGB_BLOCK                         VkFormat = C.VK_FORMAT_BC1_RGB_SRGB_BLOCK
+	VK_FORMAT_BC1_RGBA_UNORM_BLOCK                       VkFormat = C.VK_FORMAT_BC1_RGBA_UNORM_BLOCK
+	VK_FORMAT_BC1_RGBA_SRGB_BLOCK                        VkFormat = C.VK_FORMAT_BC1_RGBA_SRGB_BLOCK
+	VK_FORMAT_BC2_UNORM_BLOCK                            VkFormat = C.VK_FORMAT_BC2_UNORM_BLOCK
+	VK_FORMAT_BC2_SRGB_BLOCK                             VkFormat = C.VK_FORMAT_BC2_SRGB_BLOCK
+	VK_FORMAT_BC3_UNORM_BLOCK                            VkFormat = C.VK_FORMAT_BC3_UNORM_BLOCK
+	VK_FORMAT_BC3_SRGB_BLOCK                             VkFormat = C.VK_FORMAT_BC3_SRGB_BLOCK
+	VK_FORMAT_BC4_UNORM_BLOCK                            VkFormat = C.VK_FORMAT_BC4_UNORM_BLOCK
+	VK_FORMAT_BC4_SNORM_BLOCK                            VkFormat = C.VK_FORMAT_BC4_SNORM_BLOCK
+	VK_FORMAT_BC5_UNORM_BLOCK                            VkFormat = C.VK_FORMAT_BC5_UNORM_BLOCK
+	VK_FORMAT_BC5_SNORM_BLOCK                            VkFormat = C.VK_FORMAT_BC5_SNORM_BLOCK
+	VK_FORMAT_BC6H_UFLOAT_BLOCK                          VkFormat = C.VK_FORMAT_BC6H_UFLOAT_BLOCK
+	VK_FORMAT_BC6H_SFLOAT_BLOCK                          VkFormat = C.VK_FORMAT_BC6H_SFLOAT_BLOCK
+	VK_FORMAT_BC7_UNORM_BLOCK                            VkFormat = C.VK_FORMAT_BC7_UNORM_BLOCK
+	VK_FORMAT_BC7_SRGB_BLOCK                             VkFormat = C.VK_FORMAT_BC7_SRGB_BLOCK
+	VK_FORMAT_ETC2_R8G8B8_UNORM_BLOCK                    VkFormat = C.VK_FORMAT_ETC2_R8G8B8_UNORM_BLOCK
+	VK_FORMAT_ETC2_R8G8B8_SRGB_BLOCK                     VkFormat = C.VK_FORMAT_ETC2_R8G8B8_SRGB_BLOCK
+	VK_FORMAT_ETC2_R8G8B8A1_UNORM_BLOCK                  VkFormat = C.VK_FORMAT_ETC2_R8G8B8A1_UNORM_BLOCK
+	VK_FORMAT_ETC2_R8G8B8A1_SRGB_BLOCK                   VkFormat = C.VK_FORMAT_ETC2_R8G8B8A1_SRGB_BLOCK
+	VK_FORMAT_ETC2_R8G8B8A8_UNORM_BLOCK                  VkFormat = C.VK_FORMAT_ETC2_R8G8B8A8_UNORM_BLOCK
+	VK_FORMAT_ETC2_R8G8B8A8_SRGB_BLOCK                   VkFormat = C.VK_FORMAT_ETC2_R8G8B8A8_SRGB_BLOCK
+	VK_FORMAT_EAC_R11_UNORM_BLOCK                        VkFormat = C.VK_FORMAT_EAC_R11_UNORM_BLOCK
+	VK_FORMAT_EAC_R11_SNORM_BLOCK                        VkFormat = C.VK_FORMAT_EAC_R11_SNORM_BLOCK
+	VK_FORMAT_EAC_R11G11_UNORM_BLOCK                     VkFormat = C.VK_FORMAT_EAC_R11G11_UNORM_BLOCK
+	VK_FORMAT_EAC_R11G11_SNORM_BLOCK                     VkFormat = C.VK_FORMAT_EAC_R11G11_SNORM_BLOCK
+	VK_FORMAT_ASTC_4x4_UNORM_BLOCK                       VkFormat = C.VK_FORMAT_ASTC_4x4_UNORM_BLOCK
+	VK_FORMAT_ASTC_4x4_SRGB_BLOCK                        VkFormat = C.VK_FORMAT_ASTC_4x4_SRGB_BLOCK
+	VK_FORMAT_ASTC_5x4_UNORM_BLOCK                       VkFormat = C.VK_FORMAT_ASTC_5x4_UNORM_BLOCK
+	VK_FORMAT_ASTC_5x4_SRGB_BLOCK                        VkFormat = C.VK_FORMAT_ASTC_5x4_SRGB_BLOCK
+	VK_FORMAT_ASTC_5x5_UNORM_BLOCK                       VkFormat = C.VK_FORMAT_ASTC_5x5_UNORM_BLOCK
+	VK_FORMAT_ASTC_5x5_SRGB_BLOCK                        VkFormat = C.VK_FORMAT_ASTC_5x5_SRGB_BLOCK
+	VK_FORMAT_ASTC_6x5_UNORM_BLOCK                       VkFormat = C.VK_FORMAT_ASTC_6x5_UNORM_BLOCK
+	VK_FORMAT_ASTC_6x5_SRGB_BLOCK                        VkFormat = C.VK_FORMAT_ASTC_6x5_SRGB_BLOCK
+	VK_FORMAT_ASTC_6x6_UNORM_BLOCK                       VkFormat = C.VK_FORMAT_ASTC_6x6_UNORM_BLOCK
+	VK_FORMAT_ASTC_6x6_SRGB_BLOCK                        VkFormat = C.VK_FORMAT_ASTC_6x6_SRGB_BLOCK
+	VK_FORMAT_ASTC_8x5_UNORM_BLOCK                       VkFormat = C.VK_FORMAT_ASTC_8x5_UNORM_BLOCK
+	VK_FORMAT_ASTC_8x5_SRGB_BLOCK                        VkFormat = C.VK_FORMAT_ASTC_8x5_SRGB_BLOCK
+	VK_FORMAT_ASTC_8x6_UNORM_BLOCK                       VkFormat = C.VK_FORMAT_ASTC_8x6_UNORM_BLOCK
+	VK_FORMAT_ASTC_8x6_SRGB_BLOCK                        VkFormat = C.VK_FORMAT_ASTC_8x6_SRGB_BLOCK
+	VK_FORMAT_ASTC_8x8_UNORM_BLOCK                       VkFormat = C.VK_FORMAT_ASTC_8x8_UNORM_BLOCK
+	VK_FORMAT_ASTC_8x8_SRGB_BLOCK                        VkFormat = C.VK_FORMAT_ASTC_8x8_SRGB_BLOCK
+	VK_FORMAT_ASTC_10x5_UNORM_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_10x5_UNORM_BLOCK
+	VK_FORMAT_ASTC_10x5_SRGB_BLOCK                       VkFormat = C.VK_FORMAT_ASTC_10x5_SRGB_BLOCK
+	VK_FORMAT_ASTC_10x6_UNORM_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_10x6_UNORM_BLOCK
+	VK_FORMAT_ASTC_10x6_SRGB_BLOCK                       VkFormat = C.VK_FORMAT_ASTC_10x6_SRGB_BLOCK
+	VK_FORMAT_ASTC_10x8_UNORM_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_10x8_UNORM_BLOCK
+	VK_FORMAT_ASTC_10x8_SRGB_BLOCK                       VkFormat = C.VK_FORMAT_ASTC_10x8_SRGB_BLOCK
+	VK_FORMAT_ASTC_10x10_UNORM_BLOCK                     VkFormat = C.VK_FORMAT_ASTC_10x10_UNORM_BLOCK
+	VK_FORMAT_ASTC_10x10_SRGB_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_10x10_SRGB_BLOCK
+	VK_FORMAT_ASTC_12x10_UNORM_BLOCK                     VkFormat = C.VK_FORMAT_ASTC_12x10_UNORM_BLOCK
+	VK_FORMAT_ASTC_12x10_SRGB_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_12x10_SRGB_BLOCK
+	VK_FORMAT_ASTC_12x12_UNORM_BLOCK                     VkFormat = C.VK_FORMAT_ASTC_12x12_UNORM_BLOCK
+	VK_FORMAT_ASTC_12x12_SRGB_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_12x12_SRGB_BLOCK
+	VK_FORMAT_G8B8G8R8_422_UNORM                         VkFormat = C.VK_FORMAT_G8B8G8R8_422_UNORM
+	VK_FORMAT_B8G8R8G8_422_UNORM                         VkFormat = C.VK_FORMAT_B8G8R8G8_422_UNORM
+	VK_FORMAT_G8_B8_R8_3PLANE_420_UNORM                  VkFormat = C.VK_FORMAT_G8_B8_R8_3PLANE_420_UNORM
+	VK_FORMAT_G8_B8R8_2PLANE_420_UNORM                   VkFormat = C.VK_FORMAT_G8_B8R8_2PLANE_420_UNORM
+	VK_FORMAT_G8_B8_R8_3PLANE_422_UNORM                  VkFormat = C.VK_FORMAT_G8_B8_R8_3PLANE_422_UNORM
+	VK_FORMAT_G8_B8R8_2PLANE_422_UNORM                   VkFormat = C.VK_FORMAT_G8_B8R8_2PLANE_422_UNORM
+	VK_FORMAT_G8_B8_R8_3PLANE_444_UNORM                  VkFormat = C.VK_FORMAT_G8_B8_R8_3PLANE_444_UNORM
+	VK_FORMAT_R10X6_UNORM_PACK16                         VkFormat = C.VK_FORMAT_R10X6_UNORM_PACK16
+	VK_FORMAT_R10X6G10X6_UNORM_2PACK16                   VkFormat = C.VK_FORMAT_R10X6G10X6_UNORM_2PACK16
+	VK_FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16         VkFormat = C.VK_FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16
+	VK_FORMAT_G10X6B10X6G10X6R10X6_422_UNORM_4PACK16     VkFormat = C.VK_FORMAT_G10X6B10X6G10X6R10X6_422_UNORM_4PACK16
+	VK_FORMAT_B10X6G10X6R10X6G10X6_422_UNORM_4PACK16     VkFormat = C.VK_FORMAT_B10X6G10X6R10X6G10X6_422_UNORM_4PACK16
+	VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_420_UNORM_3PACK16 VkFormat = C.VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_420_UNORM_3PACK16
+	VK_FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16  VkFormat = C.VK_FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16
+	VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_422_UNORM_3PACK16 VkFormat = C.VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_422_UNORM_3PACK16
+	VK_FORMAT_G10X6_B10X6R10X6_2PLANE_422_UNORM_3PACK16  VkFormat = C.VK_FORMAT_G10X6_B10X6R10X6_2PLANE_422_UNORM_3PACK16
+	VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_444_UNORM_3PACK16 VkFormat = C.VK_FORMAT_G10X6_B10X6_R10X6_3PLANE_444_UNORM_3PACK16
+	VK_FORMAT_R12X4_UNORM_PACK16                         VkFormat = C.VK_FORMAT_R12X4_UNORM_PACK16
+	VK_FORMAT_R12X4G12X4_UNORM_2PACK16                   VkFormat = C.VK_FORMAT_R12X4G12X4_UNORM_2PACK16
+	VK_FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16         VkFormat = C.VK_FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16
+	VK_FORMAT_G12X4B12X4G12X4R12X4_422_UNORM_4PACK16     VkFormat = C.VK_FORMAT_G12X4B12X4G12X4R12X4_422_UNORM_4PACK16
+	VK_FORMAT_B12X4G12X4R12X4G12X4_422_UNORM_4PACK16     VkFormat = C.VK_FORMAT_B12X4G12X4R12X4G12X4_422_UNORM_4PACK16
+	VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_420_UNORM_3PACK16 VkFormat = C.VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_420_UNORM_3PACK16
+	VK_FORMAT_G12X4_B12X4R12X4_2PLANE_420_UNORM_3PACK16  VkFormat = C.VK_FORMAT_G12X4_B12X4R12X4_2PLANE_420_UNORM_3PACK16
+	VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_422_UNORM_3PACK16 VkFormat = C.VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_422_UNORM_3PACK16
+	VK_FORMAT_G12X4_B12X4R12X4_2PLANE_422_UNORM_3PACK16  VkFormat = C.VK_FORMAT_G12X4_B12X4R12X4_2PLANE_422_UNORM_3PACK16
+	VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_444_UNORM_3PACK16 VkFormat = C.VK_FORMAT_G12X4_B12X4_R12X4_3PLANE_444_UNORM_3PACK16
+	VK_FORMAT_G16B16G16R16_422_UNORM                     VkFormat = C.VK_FORMAT_G16B16G16R16_422_UNORM
+	VK_FORMAT_B16G16R16G16_422_UNORM                     VkFormat = C.VK_FORMAT_B16G16R16G16_422_UNORM
+	VK_FORMAT_G16_B16_R16_3PLANE_420_UNORM               VkFormat = C.VK_FORMAT_G16_B16_R16_3PLANE_420_UNORM
+	VK_FORMAT_G16_B16R16_2PLANE_420_UNORM                VkFormat = C.VK_FORMAT_G16_B16R16_2PLANE_420_UNORM
+	VK_FORMAT_G16_B16_R16_3PLANE_422_UNORM               VkFormat = C.VK_FORMAT_G16_B16_R16_3PLANE_422_UNORM
+	VK_FORMAT_G16_B16R16_2PLANE_422_UNORM                VkFormat = C.VK_FORMAT_G16_B16R16_2PLANE_422_UNORM
+	VK_FORMAT_G16_B16_R16_3PLANE_444_UNORM               VkFormat = C.VK_FORMAT_G16_B16_R16_3PLANE_444_UNORM
+	VK_FORMAT_G8_B8R8_2PLANE_444_UNORM                   VkFormat = C.VK_FORMAT_G8_B8R8_2PLANE_444_UNORM
+	VK_FORMAT_G10X6_B10X6R10X6_2PLANE_444_UNORM_3PACK16  VkFormat = C.VK_FORMAT_G10X6_B10X6R10X6_2PLANE_444_UNORM_3PACK16
+	VK_FORMAT_G12X4_B12X4R12X4_2PLANE_444_UNORM_3PACK16  VkFormat = C.VK_FORMAT_G12X4_B12X4R12X4_2PLANE_444_UNORM_3PACK16
+	VK_FORMAT_G16_B16R16_2PLANE_444_UNORM                VkFormat = C.VK_FORMAT_G16_B16R16_2PLANE_444_UNORM
+	VK_FORMAT_A4R4G4B4_UNORM_PACK16                      VkFormat = C.VK_FORMAT_A4R4G4B4_UNORM_PACK16
+	VK_FORMAT_A4B4G4R4_UNORM_PACK16                      VkFormat = C.VK_FORMAT_A4B4G4R4_UNORM_PACK16
+	VK_FORMAT_ASTC_4x4_SFLOAT_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_4x4_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_5x4_SFLOAT_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_5x4_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_5x5_SFLOAT_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_5x5_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_6x5_SFLOAT_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_6x5_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_6x6_SFLOAT_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_6x6_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_8x5_SFLOAT_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_8x5_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_8x6_SFLOAT_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_8x6_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_8x8_SFLOAT_BLOCK                      VkFormat = C.VK_FORMAT_ASTC_8x8_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_10x5_SFLOAT_BLOCK                     VkFormat = C.VK_FORMAT_ASTC_10x5_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_10x6_SFLOAT_BLOCK                     VkFormat = C.VK_FORMAT_ASTC_10x6_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_10x8_SFLOAT_BLOCK                     VkFormat = C.VK_FORMAT_ASTC_10x8_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_10x10_SFLOAT_BLOCK                    VkFormat = C.VK_FORMAT_ASTC_10x10_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_12x10_SFLOAT_BLOCK                    VkFormat = C.VK_FORMAT_ASTC_12x10_SFLOAT_BLOCK
+	VK_FORMAT_ASTC_12x12_SFLOAT_BLOCK                    VkFormat = C.VK_FORMAT_ASTC_12x12_SFLOAT_BLOCK
+	VK_FORMAT_PVRTC1_2BPP_UNORM_BLOCK_IMG                VkFormat = C.VK_FORMAT_PVRTC1_2BPP_UNORM_BLOCK_IMG
+	VK_FORMAT_PVRTC1_4BPP_UNORM_BLOCK_IMG                VkFormat = C.VK_FORMAT_PVRTC1_4BPP_UNORM_BLOCK_IMG
+	VK_FORMAT_PVRTC2_2BPP_UNORM_BLOCK_IMG                VkFormat = C.VK_FORMAT_PVRTC2_2BPP_UNORM_BLOCK_IMG
+	VK_FORMAT_PVRTC2_4BPP_UNORM_BLOCK_IMG                VkFormat = C.VK_FORMAT_PVRTC2_4BPP_UNORM_BLOCK_IMG
+	VK_FORMAT_PVRTC1_2BPP_SRGB_BLOCK_IMG                 VkFormat = C.VK_FORMAT_PVRTC1_2BPP_SRGB_BLOCK_IMG
+	VK_FORMAT_PVRTC1_4BPP_SRGB_BLOCK_IMG                 VkFormat = C.VK_FORMAT_PVRTC1_4BPP_SRGB_BLOCK_IMG
+	VK_FORMAT_PVRTC2_2BPP_SRGB_BLOCK_IMG                 VkFormat = C.VK_FORMAT_PVRTC2_2BPP_SRGB_BLOCK_IMG
+	VK_FORMAT_PVRTC2_4BPP_SRGB_BLOCK_IMG                 VkFormat = C.VK_FORMAT_PVRTC2_4BPP_SRGB_BLOCK_IMG
+	VK_FORMAT_R16G16_S10_5_NV                            VkFormat = C.VK_FORMAT_R16G16_S10_5_NV
 	// VK_FORMAT_ASTC_4x4_SFLOAT_BLOCK_EXT VkFormat=C.
 	// VK_FORMAT_ASTC_5x4_SFLOAT_BLOCK_EXT VkFormat=C.
 	// VK_FORMAT_ASTC_5x5_SFLOAT_BLOCK_EXT VkFormat=C.
@@ -3732,7 +3819,57 @@ type VkImageAspectFlags VkFlags
 //     VK_FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT_KHR = VK_FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT,
 //     VK_FORMAT_FEATURE_FLAG_BITS_MAX_ENUM = 0x7FFFFFFF
 // } VkFormatFeatureFlagBits;
+
+type VkFormatFeatureFlagBits VkFormatFeatureFlags
+
+const (
+	VK_FORMAT_FEATURE_SAMPLED_IMAGE_BIT                                                           VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_BIT
+	VK_FORMAT_FEATURE_STORAGE_IMAGE_BIT                                                           VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_STORAGE_IMAGE_BIT
+	VK_FORMAT_FEATURE_STORAGE_IMAGE_ATOMIC_BIT                                                    VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_STORAGE_IMAGE_ATOMIC_BIT
+	VK_FORMAT_FEATURE_UNIFORM_TEXEL_BUFFER_BIT                                                    VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_UNIFORM_TEXEL_BUFFER_BIT
+	VK_FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_BIT                                                    VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_BIT
+	VK_FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_ATOMIC_BIT                                             VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_ATOMIC_BIT
+	VK_FORMAT_FEATURE_VERTEX_BUFFER_BIT                                                           VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_VERTEX_BUFFER_BIT
+	VK_FORMAT_FEATURE_COLOR_ATTACHMENT_BIT                                                        VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_COLOR_ATTACHMENT_BIT
+	VK_FORMAT_FEATURE_COLOR_ATTACHMENT_BLEND_BIT                                                  VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_COLOR_ATTACHMENT_BLEND_BIT
+	VK_FORMAT_FEATURE_DEPTH_STENCIL_ATTACHMENT_BIT                                                VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_DEPTH_STENCIL_ATTACHMENT_BIT
+	VK_FORMAT_FEATURE_BLIT_SRC_BIT                                                                VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_BLIT_SRC_BIT
+	VK_FORMAT_FEATURE_BLIT_DST_BIT                                                                VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_BLIT_DST_BIT
+	VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_LINEAR_BIT                                             VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_LINEAR_BIT
+	VK_FORMAT_FEATURE_TRANSFER_SRC_BIT                                                            VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_TRANSFER_SRC_BIT
+	VK_FORMAT_FEATURE_TRANSFER_DST_BIT                                                            VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_TRANSFER_DST_BIT
+	VK_FORMAT_FEATURE_MIDPOINT_CHROMA_SAMPLES_BIT                                                 VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_MIDPOINT_CHROMA_SAMPLES_BIT
+	VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT                            VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT
+	VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT           VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT
+	VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT           VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT
+	VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT
+	VK_FORMAT_FEATURE_DISJOINT_BIT                                                                VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_DISJOINT_BIT
+	VK_FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT                                                  VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT
+	VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_MINMAX_BIT                                             VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_MINMAX_BIT
+	VK_FORMAT_FEATURE_VIDEO_DECODE_OUTPUT_BIT_KHR                                                 VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_VIDEO_DECODE_OUTPUT_BIT_KHR
+	VK_FORMAT_FEATURE_VIDEO_DECODE_DPB_BIT_KHR                                                    VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_VIDEO_DECODE_DPB_BIT_KHR
+	VK_FORMAT_FEATURE_ACCELERATION_STRUCTURE_VERTEX_BUFFER_BIT_KHR                                VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_ACCELERATION_STRUCTURE_VERTEX_BUFFER_BIT_KHR
+	VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_CUBIC_BIT_EXT                                          VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_CUBIC_BIT_EXT
+	VK_FORMAT_FEATURE_FRAGMENT_DENSITY_MAP_BIT_EXT                                                VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_FRAGMENT_DENSITY_MAP_BIT_EXT
+	VK_FORMAT_FEATURE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR                                    VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR
+	// VK_FORMAT_FEATURE_VIDEO_ENCODE_INPUT_BIT_KHR VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_VIDEO_ENCODE_INPUT_BIT_KHR
+	// VK_FORMAT_FEATURE_VIDEO_ENCODE_DPB_BIT_KHR VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_VIDEO_ENCODE_DPB_BIT_KHR
+	// VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_CUBIC_BIT_IMG VkFormatFeatureFlagBits=C.
+	// VK_FORMAT_FEATURE_TRANSFER_SRC_BIT_KHR VkFormatFeatureFlagBits=C.
+	// VK_FORMAT_FEATURE_TRANSFER_DST_BIT_KHR VkFormatFeatureFlagBits=C.
+	// VK_FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_MINMAX_BIT_EXT VkFormatFeatureFlagBits=C.
+	// VK_FORMAT_FEATURE_MIDPOINT_CHROMA_SAMPLES_BIT_KHR VkFormatFeatureFlagBits=C.
+	// VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT_KHR VkFormatFeatureFlagBits=C.
+	// VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT_KHR VkFormatFeatureFlagBits=C.
+	// VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT_KHR VkFormatFeatureFlagBits=C.
+	// VK_FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT_KHR VkFormatFeatureFlagBits=C.
+	// VK_FORMAT_FEATURE_DISJOINT_BIT_KHR VkFormatFeatureFlagBits=C.
+	// VK_FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT_KHR VkFormatFeatureFlagBits=C.
+	VK_FORMAT_FEATURE_FLAG_BITS_MAX_ENUM VkFormatFeatureFlagBits = C.VK_FORMAT_FEATURE_FLAG_BITS_MAX_ENUM
+)
+
 // typedef VkFlags VkFormatFeatureFlags;
+type VkFormatFeatureFlags VkFlags
 
 // typedef enum VkImageCreateFlagBits {
 //     VK_IMAGE_CREATE_SPARSE_BINDING_BIT = 0x00000001,
@@ -3878,7 +4015,18 @@ const (
 //     VK_MEMORY_HEAP_MULTI_INSTANCE_BIT_KHR = VK_MEMORY_HEAP_MULTI_INSTANCE_BIT,
 //     VK_MEMORY_HEAP_FLAG_BITS_MAX_ENUM = 0x7FFFFFFF
 // } VkMemoryHeapFlagBits;
+
+type VkMemoryHeapFlagBits VkMemoryHeapFlags
+
+const (
+	VK_MEMORY_HEAP_DEVICE_LOCAL_BIT   VkMemoryHeapFlagBits = C.VK_MEMORY_HEAP_DEVICE_LOCAL_BIT
+	VK_MEMORY_HEAP_MULTI_INSTANCE_BIT VkMemoryHeapFlagBits = C.VK_MEMORY_HEAP_MULTI_INSTANCE_BIT
+	// VK_MEMORY_HEAP_MULTI_INSTANCE_BIT_KHR VkMemoryHeapFlagBits=C.
+	VK_MEMORY_HEAP_FLAG_BITS_MAX_ENUM VkMemoryHeapFlagBits = C.VK_MEMORY_HEAP_FLAG_BITS_MAX_ENUM
+)
+
 // typedef VkFlags VkMemoryHeapFlags;
+type VkMemoryHeapFlags VkFlags
 
 // typedef enum VkMemoryPropertyFlagBits {
 //     VK_MEMORY_PROPERTY_DEVICE_LOCAL_BIT = 0x00000001,
@@ -3892,7 +4040,24 @@ const (
 //     VK_MEMORY_PROPERTY_RDMA_CAPABLE_BIT_NV = 0x00000100,
 //     VK_MEMORY_PROPERTY_FLAG_BITS_MAX_ENUM = 0x7FFFFFFF
 // } VkMemoryPropertyFlagBits;
+
+type VkMemoryPropertyFlagBits VkMemoryPropertyFlags
+
+const (
+	VK_MEMORY_PROPERTY_DEVICE_LOCAL_BIT        VkMemoryPropertyFlagBits = C.VK_MEMORY_PROPERTY_DEVICE_LOCAL_BIT
+	VK_MEMORY_PROPERTY_HOST_VISIBLE_BIT        VkMemoryPropertyFlagBits = C.VK_MEMORY_PROPERTY_HOST_VISIBLE_BIT
+	VK_MEMORY_PROPERTY_HOST_COHERENT_BIT       VkMemoryPropertyFlagBits = C.VK_MEMORY_PROPERTY_HOST_COHERENT_BIT
+	VK_MEMORY_PROPERTY_HOST_CACHED_BIT         VkMemoryPropertyFlagBits = C.VK_MEMORY_PROPERTY_HOST_CACHED_BIT
+	VK_MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT    VkMemoryPropertyFlagBits = C.VK_MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT
+	VK_MEMORY_PROPERTY_PROTECTED_BIT           VkMemoryPropertyFlagBits = C.VK_MEMORY_PROPERTY_PROTECTED_BIT
+	VK_MEMORY_PROPERTY_DEVICE_COHERENT_BIT_AMD VkMemoryPropertyFlagBits = C.VK_MEMORY_PROPERTY_DEVICE_COHERENT_BIT_AMD
+	VK_MEMORY_PROPERTY_DEVICE_UNCACHED_BIT_AMD VkMemoryPropertyFlagBits = C.VK_MEMORY_PROPERTY_DEVICE_UNCACHED_BIT_AMD
+	VK_MEMORY_PROPERTY_RDMA_CAPABLE_BIT_NV     VkMemoryPropertyFlagBits = C.VK_MEMORY_PROPERTY_RDMA_CAPABLE_BIT_NV
+	VK_MEMORY_PROPERTY_FLAG_BITS_MAX_ENUM      VkMemoryPropertyFlagBits = C.VK_MEMORY_PROPERTY_FLAG_BITS_MAX_ENUM
+)
+
 // typedef VkFlags VkMemoryPropertyFlags;
+type VkMemoryPropertyFlags VkFlags
 
 //	typedef enum VkQueueFlagBits {
 //	    VK_QUEUE_GRAPHICS_BIT = 0x00000001,
@@ -4119,7 +4284,17 @@ type VkShaderModuleCreateFlags VkFlags
 //     VK_PIPELINE_CACHE_CREATE_EXTERNALLY_SYNCHRONIZED_BIT_EXT = VK_PIPELINE_CACHE_CREATE_EXTERNALLY_SYNCHRONIZED_BIT,
 //     VK_PIPELINE_CACHE_CREATE_FLAG_BITS_MAX_ENUM = 0x7FFFFFFF
 // } VkPipelineCacheCreateFlagBits;
+
+type VkPipelineCacheCreateFlagBits VkPipelineCacheCreateFlags
+
+const (
+	VK_PIPELINE_CACHE_CREATE_EXTERNALLY_SYNCHRONIZED_BIT     VkPipelineCacheCreateFlagBits = C.VK_PIPELINE_CACHE_CREATE_EXTERNALLY_SYNCHRONIZED_BIT
+	VK_PIPELINE_CACHE_CREATE_EXTERNALLY_SYNCHRONIZED_BIT_EXT VkPipelineCacheCreateFlagBits = C.VK_PIPELINE_CACHE_CREATE_EXTERNALLY_SYNCHRONIZED_BIT_EXT
+	VK_PIPELINE_CACHE_CREATE_FLAG_BITS_MAX_ENUM              VkPipelineCacheCreateFlagBits = C.VK_PIPELINE_CACHE_CREATE_FLAG_BITS_MAX_ENUM
+)
+
 // typedef VkFlags VkPipelineCacheCreateFlags;
+type VkPipelineCacheCreateFlags VkFlags
 
 // typedef enum VkColorComponentFlagBits {
 //     VK_COLOR_COMPONENT_R_BIT = 0x00000001,
@@ -4561,6 +4736,14 @@ func (o *VkImageSubresourceRange) copyToCObj(p unsafe.Pointer) {
 //     uint8_t                         pipelineCacheUUID[VK_UUID_SIZE];
 // } VkPipelineCacheHeaderVersionOne;
 
+type VkPipelineCacheHeaderVersionOne struct {
+	HeaderSize        uint32
+	HeaderVersion     VkPipelineCacheHeaderVersion
+	VendorID          uint32
+	DeviceID          uint32
+	PipelineCacheUUID [VK_UUID_SIZE]byte
+}
+
 // typedef void* (VKAPI_PTR *PFN_vkAllocationFunction)(
 //     void*                                       pUserData,
 //     size_t                                      size,
@@ -4664,6 +4847,21 @@ func (o *VkApplicationInfo) copyToCObj(p unsafe.Pointer) []func() {
 //     VkFormatFeatureFlags    bufferFeatures;
 // } VkFormatProperties;
 
+type VkFormatProperties struct {
+	LinearTilingFeatures  VkFormatFeatureFlags
+	OptimalTilingFeatures VkFormatFeatureFlags
+	BufferFeatures        VkFormatFeatureFlags
+}
+
+func (o *VkFormatProperties) copyFromCObj(p unsafe.Pointer) {
+
+	var p1 = (*C.VkFormatProperties)(p)
+
+	o.LinearTilingFeatures = VkFormatFeatureFlags(p1.linearTilingFeatures)
+	o.OptimalTilingFeatures = VkFormatFeatureFlags(p1.optimalTilingFeatures)
+	o.BufferFeatures = VkFormatFeatureFlags(p1.bufferFeatures)
+}
+
 // typedef struct VkImageFormatProperties {
 //     VkExtent3D            maxExtent;
 //     uint32_t              maxMipLevels;
@@ -4683,7 +4881,8 @@ func (o *VkApplicationInfo) copyToCObj(p unsafe.Pointer) []func() {
 //	    const char* const*          ppEnabledExtensionNames;
 //	} VkInstanceCreateInfo;
 type VkInstanceCreateInfo struct {
-	// TODO: sType, pNext
+	// TODO: sType
+	PNext                   *VkDebugUtilsMessengerCreateInfoEXT // Reports on vkCreateInstance and vkDestroyInstance
 	Flags                   VkInstanceCreateFlags
 	PApplicationInfo        *VkApplicationInfo
 	EnabledLayerCount       int
@@ -4701,6 +4900,16 @@ func (o *VkInstanceCreateInfo) copyToCObj(p unsafe.Pointer) []func() {
 	p1.sType = C.VkStructureType(VK_STRUCTURE_TYPE_INSTANCE_CREATE_INFO)
 	p1.pNext = nil
 
+	if nil != o.PNext {
+		var p2 = C.malloc(C.sizeof_VkDebugUtilsMessengerCreateInfoEXT)
+		p1.pNext = p2
+		o.PNext.copyToCObj(p2)
+
+		r = append(r, func() {
+			C.free(p2)
+		})
+	}
+
 	p1.flags = C.VkInstanceCreateFlags(o.Flags)
 
 	if nil == o.PApplicationInfo {
@@ -4753,11 +4962,33 @@ func (o *VkInstanceCreateInfo) copyToCObj(p unsafe.Pointer) []func() {
 //     VkMemoryHeapFlags    flags;
 // } VkMemoryHeap;
 
+type VkMemoryHeap struct {
+	Size  VkDeviceSize
+	Flags VkMemoryHeapFlags
+}
+
+func (o *VkMemoryHeap) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.VkMemoryHeap)(p)
+	o.Size = VkDeviceSize(p1.size)
+	o.Flags = VkMemoryHeapFlags(p1.flags)
+}
+
 // typedef struct VkMemoryType {
 //     VkMemoryPropertyFlags    propertyFlags;
 //     uint32_t                 heapIndex;
 // } VkMemoryType;
 
+type VkMemoryType struct {
+	PropertyFlags VkMemoryPropertyFlags
+	HeapIndex     uint32
+}
+
+func (o *VkMemoryType) copyFromCObj(p unsafe.Pointer) {
+	var p1 = (*C.VkMemoryType)(p)
+	o.PropertyFlags = VkMemoryPropertyFlags(p1.propertyFlags)
+	o.HeapIndex = uint32(p1.heapIndex)
+}
+
 //	typedef struct VkPhysicalDeviceFeatures {
 //	    VkBool32    robustBufferAccess;
 //	    VkBool32    fullDrawIndexUint32;
@@ -5361,6 +5592,28 @@ func (o *VkPhysicalDeviceLimits) copyFromCObj(p unsafe.Pointer) {
 //     VkMemoryHeap    memoryHeaps[VK_MAX_MEMORY_HEAPS];
 // } VkPhysicalDeviceMemoryProperties;
 
+type VkPhysicalDeviceMemoryProperties struct {
+	MemoryTypeCount uint32
+	MemoryTypes     [VK_MAX_MEMORY_TYPES]VkMemoryType
+	MemoryHeapCount uint32
+	MemoryHeaps     [VK_MAX_MEMORY_HEAPS]VkMemoryHeap
+}
+
+func (o *VkPhysicalDeviceMemoryProperties) copyFromCObj(p unsafe.Pointer) {
+
+	var p1 = (*C.VkPhysicalDeviceMemoryProperties)(p)
+
+	o.MemoryTypeCount = uint32(p1.memoryTypeCount)
+	for i := 0; i < int(o.MemoryTypeCount); i++ {
+		o.MemoryTypes[i].copyFromCObj(unsafe.Pointer(&p1.memoryTypes[i]))
+	}
+
+	o.MemoryHeapCount = uint32(p1.memoryHeapCount)
+	for i := 0; i < int(o.MemoryHeapCount); i++ {
+		o.MemoryHeaps[i].copyFromCObj(unsafe.Pointer(&p1.memoryHeaps[i]))
+	}
+}
+
 //	typedef struct VkPhysicalDeviceSparseProperties {
 //	    VkBool32    residencyStandard2DBlockShape;
 //	    VkBool32    residencyStandard2DMultisampleBlockShape;
@@ -5940,6 +6193,45 @@ func (o *VkShaderModuleCreateInfo) copyToCObj(p unsafe.Pointer) []func() {
 //     const void*                   pInitialData;
 // } VkPipelineCacheCreateInfo;
 
+type VkPipelineCacheCreateInfo struct {
+	// VkStructureType               sType;
+	// const void*                   pNext;
+	Flags           VkPipelineCacheCreateFlags
+	InitialDataSize int
+	PInitialData    []byte
+}
+
+func (o *VkPipelineCacheCreateInfo) copyToCObj(p unsafe.Pointer) []func() {
+
+	var r []func()
+
+	var p1 = (*C.VkPipelineCacheCreateInfo)(p)
+
+	p1.sType = C.VkStructureType(VK_STRUCTURE_TYPE_PIPELINE_CACHE_CREATE_INFO)
+	p1.pNext = nil
+
+	p1.flags = C.VkPipelineCacheCreateFlags(o.Flags)
+
+	p1.initialDataSize = C.size_t(o.InitialDataSize)
+
+	if nil != o.PInitialData && o.InitialDataSize > 0 {
+		var p2 = C.malloc(C.ulonglong(o.InitialDataSize))
+		r = append(r, func() { C.free(p2) })
+
+		p1.pInitialData = p2
+
+		var p3 = uintptr(p2)
+
+		for i := 0; i < o.InitialDataSize; i++ {
+			var p4 = (*C.char)(unsafe.Pointer(p3))
+			*p4 = (C.char)(o.PInitialData[i])
+			p3 += uintptr(1)
+		}
+	}
+
+	return r
+}
+
 //	typedef struct VkSpecializationMapEntry {
 //	    uint32_t    constantID;
 //	    uint32_t    offset;
@@ -6687,6 +6979,7 @@ func VkCreateInstance(
 ) VkResult {
 
 	var r []func()
+	var h cgo.Handle
 
 	// var createInfo1 C.VkInstanceCreateInfo
 	var pCreateInfo1 *C.VkInstanceCreateInfo
@@ -6704,6 +6997,14 @@ func VkCreateInstance(
 		if nil != r1 {
 			r = append(r, r1...)
 		}
+
+		if nil != pCreateInfo.PNext && nil != pCreateInfo.PNext.PfnUserCallback {
+			h = cgo.NewHandle(pCreateInfo.PNext.PfnUserCallback)
+
+			var p1 = (*C.VkDebugUtilsMessengerCreateInfoEXT)(pCreateInfo1.pNext)
+			p1.pfnUserCallback = C.PFN_vkDebugUtilsMessengerCallbackEXT(C._vkDebugUtilsMessengerCallback)
+			p1.pUserData = C._vkHandleToPointer(C.uintptr_t(h))
+		}
 	}
 
 	// TODO: pAllocator
@@ -6724,9 +7025,17 @@ func VkCreateInstance(
 	)
 
 	if C.VK_SUCCESS != err {
+		if 0 != h {
+			h.Delete()
+		}
 		return VkResult(err)
 	}
 
+	// The chained messenger is called up to vkDestroyInstance
+	if 0 != h {
+		instanceMessengers.Store(*pInstance1, h)
+	}
+
 	return VK_SUCCESS
 }
 
@@ -6740,6 +7049,10 @@ func VkDestroyInstance(
 ) {
 	var instance1 = internal.Unwrap[C.VkInstance](unsafe.Pointer(&instance))
 	C.vkDestroyInstance(*instance1, nil)
+
+	if h, ok := instanceMessengers.LoadAndDelete(*instance1); ok {
+		h.(cgo.Handle).Delete()
+	}
 }
 
 // VKAPI_ATTR VkResult VKAPI_CALL vkEnumeratePhysicalDevices(
@@ -6806,6 +7119,17 @@ func VkGetPhysicalDeviceFeatures(
 //     VkFormat                                    format,
 //     VkFormatProperties*                         pFormatProperties);
 
+func VkGetPhysicalDeviceFormatProperties(
+	physicalDevice VkPhysicalDevice,
+	format VkFormat,
+	pFormatProperties *VkFormatProperties,
+) {
+	var pPhysicalDevice1 = internal.Unwrap[C.VkPhysicalDevice](unsafe.Pointer(&physicalDevice))
+	var properties1 C.VkFormatProperties
+	C.vkGetPhysicalDeviceFormatProperties(*pPhysicalDevice1, C.VkFormat(format), &properties1)
+	pFormatProperties.copyFromCObj(unsafe.Pointer(&properties1))
+}
+
 // VKAPI_ATTR VkResult VKAPI_CALL vkGetPhysicalDeviceImageFormatProperties(
 //     VkPhysicalDevice                            physicalDevice,
 //     VkFormat                                    format,
@@ -6869,6 +7193,16 @@ func VkGetPhysicalDeviceQueueFamilyProperties(
 //     VkPhysicalDevice                            physicalDevice,
 //     VkPhysicalDeviceMemoryProperties*           pMemoryProperties);
 
+func VkGetPhysicalDeviceMemoryProperties(
+	physicalDevice VkPhysicalDevice,
+	pMemoryProperties *VkPhysicalDeviceMemoryProperties,
+) {
+	var pPhysicalDevice1 = internal.Unwrap[C.VkPhysicalDevice](unsafe.Pointer(&physicalDevice))
+	var properties1 C.VkPhysicalDeviceMemoryProperties
+	C.vkGetPhysicalDeviceMemoryProperties(*pPhysicalDevice1, &properties1)
+	pMemoryProperties.copyFromCObj(unsafe.Pointer(&properties1))
+}
+
 // VKAPI_ATTR PFN_vkVoidFunction VKAPI_CALL vkGetInstanceProcAddr(
 //     VkInstance                                  instance,
 //     const char*                                 pName);
@@ -7461,23 +7795,148 @@ func VkDestroyShaderModule(
 //     const VkAllocationCallbacks*                pAllocator,
 //     VkPipelineCache*                            pPipelineCache);
 
+func VkCreatePipelineCache(
+	device VkDevice,
+	pCreateInfo *VkPipelineCacheCreateInfo,
+	pAllocator *VkAllocationCallbacks,
+	pPipelineCache *VkPipelineCache,
+) VkResult {
+
+	var pDevice1 = internal.Unwrap[C.VkDevice](unsafe.Pointer(&device))
+
+	var createInfo1 C.VkPipelineCacheCreateInfo
+	{
+		var r1 = pCreateInfo.copyToCObj(unsafe.Pointer(&createInfo1))
+		if nil != r1 {
+			defer internal.CallAll(r1)
+		}
+	}
+
+	var pipelineCache1 C.VkPipelineCache
+
+	var err = C.vkCreatePipelineCache(
+		*pDevice1,
+		&createInfo1,
+		nil,
+		&pipelineCache1,
+	)
+
+	if C.VK_SUCCESS != err {
+		return VkResult(err)
+	}
+
+	internal.Wrap[C.VkPipelineCache](unsafe.Pointer(pPipelineCache), &pipelineCache1)
+
+	return VK_SUCCESS
+}
+
 // VKAPI_ATTR void VKAPI_CALL vkDestroyPipelineCache(
 //     VkDevice                                    device,
 //     VkPipelineCache                             pipelineCache,
 //     const VkAllocationCallbacks*                pAllocator);
 
+func VkDestroyPipelineCache(
+	device VkDevice,
+	pipelineCache VkPipelineCache,
+	pAllocator *VkAllocationCallbacks,
+) {
+	var pDevice1 = internal.Unwrap[C.VkDevice](unsafe.Pointer(&device))
+	var pPipelineCache1 = internal.Unwrap[C.VkPipelineCache](unsafe.Pointer(&pipelineCache))
+	C.vkDestroyPipelineCache(
+		*pDevice1,
+		*pPipelineCache1,
+		nil,
+	)
+}
+
 // VKAPI_ATTR VkResult VKAPI_CALL vkGetPipelineCacheData(
 //     VkDevice                                    device,
 //     VkPipelineCache                             pipelineCache,
 //     size_t*                                     pDataSize,
 //     void*                                       pData);
 
+func VkGetPipelineCacheData(
+	device VkDevice,
+	pipelineCache VkPipelineCache,
+	pDataSize *int,
+	pData []byte,
+) VkResult {
+
+	var pDevice1 = internal.Unwrap[C.VkDevice](unsafe.Pointer(&device))
+	var pPipelineCache1 = internal.Unwrap[C.VkPipelineCache](unsafe.Pointer(&pipelineCache))
+	var dataSize1 = C.size_t(*pDataSize)
+
+	var pData1 unsafe.Pointer
+
+	if nil != pData && *pDataSize > 0 {
+		pData1 = C.malloc(C.ulonglong(*pDataSize))
+		defer C.free(pData1)
+	}
+
+	var err = C.vkGetPipelineCacheData(
+		*pDevice1,
+		*pPipelineCache1,
+		&dataSize1,
+		pData1,
+	)
+
+	// VK_INCOMPLETE still writes dataSize bytes
+	if C.VK_SUCCESS != err && C.VK_INCOMPLETE != err {
+		return VkResult(err)
+	}
+
+	*pDataSize = int(dataSize1)
+
+	if nil != pData && *pDataSize > 0 {
+		copy(pData, unsafe.Slice((*byte)(pData1), *pDataSize))
+	}
+
+	return VkResult(err)
+}
+
 // VKAPI_ATTR VkResult VKAPI_CALL vkMergePipelineCaches(
 //     VkDevice                                    device,
 //     VkPipelineCache                             dstCache,
 //     uint32_t                                    srcCacheCount,
 //     const VkPipelineCache*                      pSrcCaches);
 
+func VkMergePipelineCaches(
+	device VkDevice,
+	dstCache VkPipelineCache,
+	srcCacheCount int,
+	pSrcCaches []VkPipelineCache,
+) VkResult {
+
+	var pDevice1 = internal.Unwrap[C.VkDevice](unsafe.Pointer(&device))
+	var pDstCache1 = internal.Unwrap[C.VkPipelineCache](unsafe.Pointer(&dstCache))
+
+	var pSrcCaches1 *C.VkPipelineCache
+
+	if nil != pSrcCaches && srcCacheCount > 0 {
+		var p1 = C.malloc(C.ulonglong(srcCacheCount * C.sizeof_VkPipelineCache))
+		defer C.free(p1)
+
+		pSrcCaches1 = (*C.VkPipelineCache)(p1)
+
+		var p2 = uintptr(p1)
+
+		for i := 0; i < srcCacheCount; i++ {
+			var p3 = (*C.VkPipelineCache)(unsafe.Pointer(p2))
+			*p3 = *internal.Unwrap[C.VkPipelineCache](unsafe.Pointer(&pSrcCaches[i]))
+			p2 += uintptr(C.sizeof_VkPipelineCache)
+		} // for
+	}
+
+	var err = C.vkMergePipelineCaches(
+		*pDevice1,
+		*pDstCache1,
+		C.uint32_t(srcCacheCount),
+		pSrcCaches1,
+	)
+
+	return VkResult(err)
+}
+
 // VKAPI_ATTR VkResult VKAPI_CALL vkCreateGraphicsPipelines(
 //     VkDevice                                    device,
 //     VkPipelineCache                             pipelineCache,
@@ -7930,6 +8389,7 @@ func VkDestroyShaderModule(
 // #define VK_VERSION_1_1 1
 // // Vulkan 1.1 version number
 // #define VK_API_VERSION_1_1 VK_MAKE_API_VERSION(0, 1, 1, 0)// Patch version should always be set to 0
+const VK_API_VERSION_1_1 uint32 = C.VK_API_VERSION_1_1
 
 // VK_DEFINE_NON_DISPATCHABLE_HANDLE(VkSamplerYcbcrConversion)
 // VK_DEFINE_NON_DISPATCHABLE_HANDLE(VkDescriptorUpdateTemplate)
@@ -8648,6 +9108,26 @@ func VkDestroyShaderModule(
 // VKAPI_ATTR VkResult VKAPI_CALL vkEnumerateInstanceVersion(
 //     uint32_t*                                   pApiVersion);
 
+// Reports VK_API_VERSION_1_0 on loaders that predate the command.
+func VkEnumerateInstanceVersion(
+	pApiVersion *uint32,
+) VkResult {
+
+	var apiVersion1 C.uint32_t
+
+	var err = C._vkEnumerateInstanceVersion(&apiVersion1)
+
+	if C.VK_SUCCESS != err {
+		return VkResult(err)
+	}
+
+	if nil != pApiVersion {
+		*pApiVersion = uint32(apiVersion1)
+	}
+
+	return VK_SUCCESS
+}
+
 // VKAPI_ATTR VkResult VKAPI_CALL vkBindBufferMemory2(
 //     VkDevice                                    device,
 //     uint32_t                                    bindInfoCount,
@@ -8794,6 +9274,7 @@ func VkDestroyShaderModule(
 // #define VK_VERSION_1_2 1
 // // Vulkan 1.2 version number
 // #define VK_API_VERSION_1_2 VK_MAKE_API_VERSION(0, 1, 2, 0)// Patch version should always be set to 0
+const VK_API_VERSION_1_2 uint32 = C.VK_API_VERSION_1_2
 
 // #define VK_MAX_DRIVER_NAME_SIZE           256U
 // #define VK_MAX_DRIVER_INFO_SIZE           256U
@@ -9547,6 +10028,7 @@ func VkDestroyShaderModule(
 // #define VK_VERSION_1_3 1
 // // Vulkan 1.3 version number
 // #define VK_API_VERSION_1_3 VK_MAKE_API_VERSION(0, 1, 3, 0)// Patch version should always be set to 0
+const VK_API_VERSION_1_3 uint32 = C.VK_API_VERSION_1_3
 
 // typedef uint64_t VkFlags64;
 // VK_DEFINE_NON_DISPATCHABLE_HANDLE(VkPrivateDataSlot)
@@ -14785,10 +15267,19 @@ const (
 // #define VK_QUEUE_FAMILY_FOREIGN_EXT       (~2U)
 
 // #define VK_EXT_debug_utils 1
+
 // VK_DEFINE_NON_DISPATCHABLE_HANDLE(VkDebugUtilsMessengerEXT)
+type VkDebugUtilsMessengerEXT internal.CHandleWrapper[C.VkDebugUtilsMessengerEXT]
+
 // #define VK_EXT_DEBUG_UTILS_SPEC_VERSION   2
 // #define VK_EXT_DEBUG_UTILS_EXTENSION_NAME "VK_EXT_debug_utils"
+const (
+	VK_EXT_DEBUG_UTILS_SPEC_VERSION   uint32 = C.VK_EXT_DEBUG_UTILS_SPEC_VERSION
+	VK_EXT_DEBUG_UTILS_EXTENSION_NAME        = "VK_EXT_debug_utils"
+)
+
 // typedef VkFlags VkDebugUtilsMessengerCallbackDataFlagsEXT;
+type VkDebugUtilsMessengerCallbackDataFlagsEXT VkFlags
 
 // typedef enum VkDebugUtilsMessageSeverityFlagBitsEXT {
 //     VK_DEBUG_UTILS_MESSAGE_SEVERITY_VERBOSE_BIT_EXT = 0x00000001,
@@ -14798,6 +15289,16 @@ const (
 //     VK_DEBUG_UTILS_MESSAGE_SEVERITY_FLAG_BITS_MAX_ENUM_EXT = 0x7FFFFFFF
 // } VkDebugUtilsMessageSeverityFlagBitsEXT;
 
+type VkDebugUtilsMessageSeverityFlagBitsEXT VkDebugUtilsMessageSeverityFlagsEXT
+
+const (
+	VK_DEBUG_UTILS_MESSAGE_SEVERITY_VERBOSE_BIT_EXT        VkDebugUtilsMessageSeverityFlagBitsEXT = C.VK_DEBUG_UTILS_MESSAGE_SEVERITY_VERBOSE_BIT_EXT
+	VK_DEBUG_UTILS_MESSAGE_SEVERITY_INFO_BIT_EXT           VkDebugUtilsMessageSeverityFlagBitsEXT = C.VK_DEBUG_UTILS_MESSAGE_SEVERITY_INFO_BIT_EXT
+	VK_DEBUG_UTILS_MESSAGE_SEVERITY_WARNING_BIT_EXT        VkDebugUtilsMessageSeverityFlagBitsEXT = C.VK_DEBUG_UTILS_MESSAGE_SEVERITY_WARNING_BIT_EXT
+	VK_DEBUG_UTILS_MESSAGE_SEVERITY_ERROR_BIT_EXT          VkDebugUtilsMessageSeverityFlagBitsEXT = C.VK_DEBUG_UTILS_MESSAGE_SEVERITY_ERROR_BIT_EXT
+	VK_DEBUG_UTILS_MESSAGE_SEVERITY_FLAG_BITS_MAX_ENUM_EXT VkDebugUtilsMessageSeverityFlagBitsEXT = C.VK_DEBUG_UTILS_MESSAGE_SEVERITY_FLAG_BITS_MAX_ENUM_EXT
+)
+
 // typedef enum VkDebugUtilsMessageTypeFlagBitsEXT {
 //     VK_DEBUG_UTILS_MESSAGE_TYPE_GENERAL_BIT_EXT = 0x00000001,
 //     VK_DEBUG_UTILS_MESSAGE_TYPE_VALIDATION_BIT_EXT = 0x00000002,
@@ -14805,9 +15306,26 @@ const (
 //     VK_DEBUG_UTILS_MESSAGE_TYPE_DEVICE_ADDRESS_BINDING_BIT_EXT = 0x00000008,
 //     VK_DEBUG_UTILS_MESSAGE_TYPE_FLAG_BITS_MAX_ENUM_EXT = 0x7FFFFFFF
 // } VkDebugUtilsMessageTypeFlagBitsEXT;
+
+type VkDebugUtilsMessageTypeFlagBitsEXT VkDebugUtilsMessageTypeFlagsEXT
+
+const (
+	VK_DEBUG_UTILS_MESSAGE_TYPE_GENERAL_BIT_EXT                VkDebugUtilsMessageTypeFlagBitsEXT = C.VK_DEBUG_UTILS_MESSAGE_TYPE_GENERAL_BIT_EXT
+	VK_DEBUG_UTILS_MESSAGE_TYPE_VALIDATION_BIT_EXT             VkDebugUtilsMessageTypeFlagBitsEXT = C.VK_DEBUG_UTILS_MESSAGE_TYPE_VALIDATION_BIT_EXT
+	VK_DEBUG_UTILS_MESSAGE_TYPE_PERFORMANCE_BIT_EXT            VkDebugUtilsMessageTypeFlagBitsEXT = C.VK_DEBUG_UTILS_MESSAGE_TYPE_PERFORMANCE_BIT_EXT
+	VK_DEBUG_UTILS_MESSAGE_TYPE_DEVICE_ADDRESS_BINDING_BIT_EXT VkDebugUtilsMessageTypeFlagBitsEXT = C.VK_DEBUG_UTILS_MESSAGE_TYPE_DEVICE_ADDRESS_BINDING_BIT_EXT
+	VK_DEBUG_UTILS_MESSAGE_TYPE_FLAG_BITS_MAX_ENUM_EXT         VkDebugUtilsMessageTypeFlagBitsEXT = C.VK_DEBUG_UTILS_MESSAGE_TYPE_FLAG_BITS_MAX_ENUM_EXT
+)
+
 // typedef VkFlags VkDebugUtilsMessageTypeFlagsEXT;
+type VkDebugUtilsMessageTypeFlagsEXT VkFlags
+
 // typedef VkFlags VkDebugUtilsMessageSeverityFlagsEXT;
+type VkDebugUtilsMessageSeverityFlagsEXT VkFlags
+
 // typedef VkFlags VkDebugUtilsMessengerCreateFlagsEXT;
+type VkDebugUtilsMessengerCreateFlagsEXT VkFlags
+
 // typedef struct VkDebugUtilsLabelEXT {
 //     VkStructureType    sType;
 //     const void*        pNext;
@@ -14823,6 +15341,29 @@ const (
 //     const char*        pObjectName;
 // } VkDebugUtilsObjectNameInfoEXT;
 
+type VkDebugUtilsObjectNameInfoEXT struct {
+	// VkStructureType    sType;
+	// const void*        pNext;
+	ObjectType   VkObjectType
+	ObjectHandle uint64
+	PObjectName  *string
+}
+
+func (o *VkDebugUtilsObjectNameInfoEXT) copyFromCObj(p unsafe.Pointer) {
+
+	var p1 = (*C.VkDebugUtilsObjectNameInfoEXT)(p)
+
+	o.ObjectType = VkObjectType(p1.objectType)
+	o.ObjectHandle = uint64(p1.objectHandle)
+
+	if nil == p1.pObjectName {
+		o.PObjectName = nil
+	} else {
+		var s = C.GoString(p1.pObjectName)
+		o.PObjectName = &s
+	}
+}
+
 // typedef struct VkDebugUtilsMessengerCallbackDataEXT {
 //     VkStructureType                              sType;
 //     const void*                                  pNext;
@@ -14838,12 +15379,65 @@ const (
 //     const VkDebugUtilsObjectNameInfoEXT*         pObjects;
 // } VkDebugUtilsMessengerCallbackDataEXT;
 
+type VkDebugUtilsMessengerCallbackDataEXT struct {
+	// VkStructureType                              sType;
+	// const void*                                  pNext;
+	Flags           VkDebugUtilsMessengerCallbackDataFlagsEXT
+	PMessageIdName  string
+	MessageIdNumber int32
+	PMessage        string
+	// TODO: pQueueLabels, pCmdBufLabels
+	ObjectCount int
+	PObjects    []VkDebugUtilsObjectNameInfoEXT
+}
+
+func (o *VkDebugUtilsMessengerCallbackDataEXT) copyFromCObj(p unsafe.Pointer) {
+
+	var p1 = (*C.VkDebugUtilsMessengerCallbackDataEXT)(p)
+
+	o.Flags = VkDebugUtilsMessengerCallbackDataFlagsEXT(p1.flags)
+
+	if nil != p1.pMessageIdName {
+		o.PMessageIdName = C.GoString(p1.pMessageIdName)
+	}
+
+	o.MessageIdNumber = int32(p1.messageIdNumber)
+
+	if nil != p1.pMessage {
+		o.PMessage = C.GoString(p1.pMessage)
+	}
+
+	o.ObjectCount = int(p1.objectCount)
+	o.PObjects = nil
+
+	if nil != p1.pObjects && o.ObjectCount > 0 {
+
+		o.PObjects = make([]VkDebugUtilsObjectNameInfoEXT, o.ObjectCount)
+
+		var p2 = uintptr(unsafe.Pointer(p1.pObjects))
+
+		for i := 0; i < o.ObjectCount; i++ {
+			o.PObjects[i].copyFromCObj(unsafe.Pointer(p2))
+			p2 += uintptr(C.sizeof_VkDebugUtilsObjectNameInfoEXT)
+		}
+	}
+}
+
 // typedef VkBool32 (VKAPI_PTR *PFN_vkDebugUtilsMessengerCallbackEXT)(
 //     VkDebugUtilsMessageSeverityFlagBitsEXT           messageSeverity,
 //     VkDebugUtilsMessageTypeFlagsEXT                  messageTypes,
 //     const VkDebugUtilsMessengerCallbackDataEXT*      pCallbackData,
 //     void*                                            pUserData);
 
+// pUserData is left out, Go closures carry their own state. The callback runs
+// on the thread that triggered the message and must not call back into
+// Vulkan. Returning true aborts the call that triggered it.
+type PFN_vkDebugUtilsMessengerCallbackEXT func(
+	messageSeverity VkDebugUtilsMessageSeverityFlagBitsEXT,
+	messageTypes VkDebugUtilsMessageTypeFlagsEXT,
+	pCallbackData *VkDebugUtilsMessengerCallbackDataEXT,
+) bool
+
 // typedef struct VkDebugUtilsMessengerCreateInfoEXT {
 //     VkStructureType                         sType;
 //     const void*                             pNext;
@@ -14854,6 +15448,33 @@ const (
 //     void*                                   pUserData;
 // } VkDebugUtilsMessengerCreateInfoEXT;
 
+type VkDebugUtilsMessengerCreateInfoEXT struct {
+	// VkStructureType                         sType;
+	// const void*                             pNext;
+	Flags           VkDebugUtilsMessengerCreateFlagsEXT
+	MessageSeverity VkDebugUtilsMessageSeverityFlagsEXT
+	MessageType     VkDebugUtilsMessageTypeFlagsEXT
+	PfnUserCallback PFN_vkDebugUtilsMessengerCallbackEXT
+	// void*                                   pUserData;
+}
+
+// pfnUserCallback and pUserData are filled in by
+// VkCreateDebugUtilsMessengerEXT, which owns the callback handle.
+func (o *VkDebugUtilsMessengerCreateInfoEXT) copyToCObj(p unsafe.Pointer) []func() {
+
+	var p1 = (*C.VkDebugUtilsMessengerCreateInfoEXT)(p)
+
+	p1.sType = C.VkStructureType(VK_STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CREATE_INFO_EXT)
+	p1.pNext = nil
+	p1.flags = C.VkDebugUtilsMessengerCreateFlagsEXT(o.Flags)
+	p1.messageSeverity = C.VkDebugUtilsMessageSeverityFlagsEXT(o.MessageSeverity)
+	p1.messageType = C.VkDebugUtilsMessageTypeFlagsEXT(o.MessageType)
+	p1.pfnUserCallback = nil
+	p1.pUserData = nil
+
+	return nil
+}
+
 // typedef struct VkDebugUtilsObjectTagInfoEXT {
 //     VkStructureType    sType;
 //     const void*        pNext;
@@ -14913,11 +15534,81 @@ const (
 //     const VkAllocationCallbacks*                pAllocator,
 //     VkDebugUtilsMessengerEXT*                   pMessenger);
 
+// The loader does not export extension commands, so this goes through
+// vkGetInstanceProcAddr and fails with VK_ERROR_EXTENSION_NOT_PRESENT when
+// VK_EXT_debug_utils was not enabled on the instance.
+func VkCreateDebugUtilsMessengerEXT(
+	instance VkInstance,
+	pCreateInfo *VkDebugUtilsMessengerCreateInfoEXT,
+	pAllocator *VkAllocationCallbacks,
+	pMessenger *VkDebugUtilsMessengerEXT,
+) VkResult {
+
+	var instance1 = internal.Unwrap[C.VkInstance](unsafe.Pointer(&instance))
+
+	var createInfo1 C.VkDebugUtilsMessengerCreateInfoEXT
+	var pCreateInfo1 *C.VkDebugUtilsMessengerCreateInfoEXT
+	var h cgo.Handle
+
+	if nil != pCreateInfo {
+		pCreateInfo1 = &createInfo1
+		pCreateInfo.copyToCObj(unsafe.Pointer(pCreateInfo1))
+
+		if nil != pCreateInfo.PfnUserCallback {
+			h = cgo.NewHandle(pCreateInfo.PfnUserCallback)
+			pCreateInfo1.pUserData = C._vkHandleToPointer(C.uintptr_t(h))
+		}
+	}
+
+	// TODO: pAllocator
+
+	var messenger1 C.VkDebugUtilsMessengerEXT
+
+	var err = C._vkCreateDebugUtilsMessengerEXT(
+		*instance1,
+		pCreateInfo1,
+		&messenger1,
+	)
+
+	if C.VK_SUCCESS != err {
+		if 0 != h {
+			h.Delete()
+		}
+		return VkResult(err)
+	}
+
+	if 0 != h {
+		debugUtilsMessengers.Store(messenger1, h)
+	}
+
+	if nil != pMessenger {
+		internal.Wrap[C.VkDebugUtilsMessengerEXT](unsafe.Pointer(pMessenger), &messenger1)
+	}
+
+	return VK_SUCCESS
+}
+
 // VKAPI_ATTR void VKAPI_CALL vkDestroyDebugUtilsMessengerEXT(
 //     VkInstance                                  instance,
 //     VkDebugUtilsMessengerEXT                    messenger,
 //     const VkAllocationCallbacks*                pAllocator);
 
+func VkDestroyDebugUtilsMessengerEXT(
+	instance VkInstance,
+	messenger VkDebugUtilsMessengerEXT,
+	pAllocator *VkAllocationCallbacks,
+) {
+	var instance1 = internal.Unwrap[C.VkInstance](unsafe.Pointer(&instance))
+	var messenger1 = internal.Unwrap[C.VkDebugUtilsMessengerEXT](unsafe.Pointer(&messenger))
+
+	C._vkDestroyDebugUtilsMessengerEXT(*instance1, *messenger1)
+
+	// The callback can no longer be called, release it
+	if h, ok := debugUtilsMessengers.LoadAndDelete(*messenger1); ok {
+		h.(cgo.Handle).Delete()
+	}
+}
+
 // VKAPI_ATTR void VKAPI_CALL vkSubmitDebugUtilsMessageEXT(
 //     VkInstance                                  instance,
 //     VkDebugUtilsMessageSeverityFlagBitsEXT      messageSeverity,